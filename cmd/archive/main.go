@@ -0,0 +1,115 @@
+package main
+
+import (
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/internals/repositories/mongodb/archive"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Backs up the school database into a compressed archive and restores it again
+//
+//	archive backup [-db NAME] [-out FILE]
+//	archive restore -in FILE [-verify] [-uri URI] [-db NAME] [-drop]
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: archive backup [-db NAME] [-out FILE] | restore -in FILE [-verify] [-uri URI] [-db NAME] [-drop]")
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	command := flag.Arg(0)
+	args := flag.NewFlagSet(command, flag.ExitOnError)
+	switch command {
+	case "backup":
+		database := args.String("db", "school", "database to back up")
+		out := args.String("out", fmt.Sprintf("school-%s.zip", time.Now().UTC().Format("20060102-150405")), "file to write the archive to")
+		args.Parse(flag.Args()[1:])
+
+		client, err := mongodb.CreateMongoClient()
+		if err != nil {
+			log.Fatal("Error connecting to the database", err)
+		}
+		defer client.Disconnect(ctx)
+
+		// The archive is written next to its destination first, so a failed backup never leaves a truncated file behind
+		file, err := os.CreateTemp(filepath.Dir(*out), ".archive-*.zip")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		manifest, err := archive.Create(ctx, client, *database, file)
+		if err == nil {
+			err = file.Close()
+		}
+		if err == nil {
+			err = os.Rename(file.Name(), *out)
+		}
+		if err != nil {
+			file.Close()
+			os.Remove(file.Name())
+			log.Fatal(err)
+		}
+		fmt.Printf("Backed up %d collections of %s at schema version %d to %s\n", len(manifest.Collections), manifest.Database, manifest.SchemaVersion, *out)
+
+	case "restore":
+		in := args.String("in", "", "archive to restore")
+		verify := args.Bool("verify", false, "only check the archive against its checksums without writing anything")
+		uri := args.String("uri", "", "deployment to restore into (default: MONGODB_URI)")
+		database := args.String("db", "school", "database to restore into")
+		drop := args.Bool("drop", false, "replace collections that already hold documents")
+		args.Parse(flag.Args()[1:])
+
+		if *in == "" {
+			flag.Usage()
+			os.Exit(2)
+		}
+
+		if *verify {
+			manifest, err := archive.Verify(*in)
+			if err != nil {
+				log.Fatal(err)
+			}
+			report(manifest)
+			fmt.Println("Archive is intact")
+			return
+		}
+
+		if *uri != "" {
+			os.Setenv("MONGODB_URI", *uri)
+		}
+		client, err := mongodb.CreateMongoClient()
+		if err != nil {
+			log.Fatal("Error connecting to the database", err)
+		}
+		defer client.Disconnect(ctx)
+
+		manifest, err := archive.Restore(ctx, client, *in, *database, *drop)
+		if err != nil {
+			log.Fatal(err)
+		}
+		report(manifest)
+		fmt.Printf("Restored into %s\n", *database)
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func report(manifest *archive.Manifest) {
+	fmt.Printf("Archive of %s taken %s at schema version %d\n", manifest.Database, manifest.CreatedAt.Format("2006-01-02 15:04:05"), manifest.SchemaVersion)
+	for _, collection := range manifest.Collections {
+		fmt.Printf("  %-24s %8d documents %3d indexes\n", collection.Name, collection.Documents, collection.Indexes)
+	}
+}
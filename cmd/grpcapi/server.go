@@ -3,13 +3,19 @@ package main
 import (
 	"ClassConnectRPC/internals/api/handlers"
 	"ClassConnectRPC/internals/api/interceptors"
+	"ClassConnectRPC/internals/blobstore"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/cache"
 	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/internals/repositories/sqlite"
 	"ClassConnectRPC/pkg/utils"
 	pb "ClassConnectRPC/proto/gen"
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"strconv"
 	"time"
 
 	"google.golang.org/grpc"
@@ -17,17 +23,96 @@ import (
 )
 
 func main() {
-	mongodb.CreateMongoClient()
+	repo, err := openRepository(context.Background())
+	if err != nil {
+		log.Fatal("Error opening the storage backend", err)
+	}
+
+	// Put the read cache in front of the backend unless it is turned off with CACHE_SIZE=0
+	cacheSize := 1000
+	if value := os.Getenv("CACHE_SIZE"); value != "" {
+		cacheSize, err = strconv.Atoi(value)
+		if err != nil {
+			log.Fatal("Invalid CACHE_SIZE", err)
+		}
+	}
+	cacheTTL := 30 * time.Second
+	if value := os.Getenv("CACHE_TTL"); value != "" {
+		cacheTTL, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatal("Invalid CACHE_TTL", err)
+		}
+	}
+	// Backends that only provide the core services do not serve courses, attendance, grades and the rest
+	extended, _ := repo.(repositories.ExtendedRepository)
+	if cacheSize > 0 {
+		if extended != nil {
+			cached := cache.NewExtended(extended, cache.NewLRU(cacheSize, cacheTTL))
+			repo, extended = cached, cached
+		} else {
+			repo = cache.New(repo, cache.NewLRU(cacheSize, cacheTTL))
+		}
+	}
 
 	// Start the background goroutine to clean up expired tokens from the blacklist
 	go utils.JwtStore.CleanUpExpiredTokens()
 
+	// Start the background goroutine that permanently removes soft deleted records after the retention period
+	retention := 30 * 24 * time.Hour
+	if value := os.Getenv("SOFT_DELETE_RETENTION"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatal("Invalid SOFT_DELETE_RETENTION", err)
+		}
+		retention = parsed
+	}
+	go purgeDeletedRecords(repo, retention)
+
 	r := interceptors.NewRateLimiter(5, time.Minute)
-	s := grpc.NewServer(grpc.ChainUnaryInterceptor(r.RateLimitingInterceptor, interceptors.ResponseTimeInterceptor, interceptors.AuthenticationInterceptor))
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(r.RateLimitingInterceptor, interceptors.ResponseTimeInterceptor, interceptors.AuthenticationInterceptor),
+		grpc.ChainStreamInterceptor(r.RateLimitingStreamInterceptor, interceptors.AuthenticationStreamInterceptor),
+	)
 
-	pb.RegisterTeachersServiceServer(s, &handlers.Server{})
-	pb.RegisterStudentsSerciesServer(s, &handlers.Server{})
-	pb.RegisterExecsServiceServer(s, &handlers.Server{})
+	blobs, err := openBlobStore()
+	if err != nil {
+		log.Fatal("Error opening the blob store", err)
+	}
+	maxSubmissionSize := int64(20 << 20)
+	if value := os.Getenv("MAX_SUBMISSION_SIZE"); value != "" {
+		maxSubmissionSize, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			log.Fatal("Invalid MAX_SUBMISSION_SIZE", err)
+		}
+	}
+
+	// Attendance sessions fall on the calendar days of the school, not those of UTC
+	schoolLocation := time.UTC
+	if value := os.Getenv("SCHOOL_TIMEZONE"); value != "" {
+		schoolLocation, err = time.LoadLocation(value)
+		if err != nil {
+			log.Fatal("Invalid SCHOOL_TIMEZONE", err)
+		}
+	}
+
+	server := &handlers.Server{Repo: repo, Extended: extended, Blobs: blobs, MaxSubmissionSize: maxSubmissionSize, SchoolLocation: schoolLocation}
+	pb.RegisterTeachersServiceServer(s, server)
+	pb.RegisterStudentsSerciesServer(s, server)
+	pb.RegisterExecsServiceServer(s, server)
+	pb.RegisterPrivacyServiceServer(s, server)
+	pb.RegisterClassesServiceServer(s, server)
+	if extended != nil {
+		pb.RegisterCoursesServiceServer(s, server)
+		pb.RegisterAttendanceServiceServer(s, server)
+		pb.RegisterGradesServiceServer(s, server)
+		pb.RegisterTimetableServiceServer(s, server)
+		pb.RegisterAssignmentsServiceServer(s, server)
+		pb.RegisterAnnouncementsServiceServer(s, server)
+		pb.RegisterGuardiansServiceServer(s, server)
+		pb.RegisterAcademicYearsServiceServer(s, server)
+	} else {
+		log.Println("The storage backend only provides the core services, the courses, attendance, grades, timetable, assignments, announcements, guardians and academic years services are not served")
+	}
 
 	reflection.Register(s)
 
@@ -49,3 +134,65 @@ func main() {
 	}
 
 }
+
+// How often the purge job looks for soft deleted records past their retention period
+const purgeInterval = time.Hour
+
+// purgeDeletedRecords permanently removes the records that were soft deleted longer than the retention period ago, until the process exits
+func purgeDeletedRecords(repo repositories.Repository, retention time.Duration) {
+	for {
+		time.Sleep(purgeInterval)
+
+		err := repo.PurgeDeletedRecordsFromDB(context.Background(), time.Now().Add(-retention))
+		if err != nil {
+			utils.ErrorHandler(err, "Purge job failed")
+		}
+	}
+}
+
+// openRepository opens the storage backend named by STORAGE_BACKEND, mongodb unless sqlite is asked for
+func openRepository(ctx context.Context) (repositories.Repository, error) {
+	switch backend := os.Getenv("STORAGE_BACKEND"); backend {
+	case "", "mongodb":
+		// Fail early when the database cannot be reached
+		client, err := mongodb.CreateMongoClient()
+		if err != nil {
+			return nil, err
+		}
+		client.Disconnect(ctx)
+
+		// Create the indexes declared by the repositories, this also enforces the uniqueness constraints
+		err = mongodb.EnsureIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Older servers cannot keep the documents before a change, watching still works without LEFT events
+		err = mongodb.EnablePreImages(ctx)
+		if err != nil {
+			log.Println("Watchers will not be told when records leave their filter:", err)
+		}
+		return mongodb.Repository{}, nil
+	case "sqlite":
+		path := os.Getenv("SQLITE_PATH")
+		if path == "" {
+			path = "school.db"
+		}
+		return sqlite.Open(ctx, path)
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_BACKEND: %s", backend)
+	}
+}
+
+// openBlobStore opens the blob store named by BLOB_STORE, files are kept on the local filesystem unless another store is asked for
+func openBlobStore() (blobstore.Store, error) {
+	switch store := os.Getenv("BLOB_STORE"); store {
+	case "", "local":
+		path := os.Getenv("BLOB_STORE_PATH")
+		if path == "" {
+			path = "blobs"
+		}
+		return blobstore.NewLocal(path)
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE: %s", store)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/internals/repositories/mongodb/migrations"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+// Applies or reverts the schema migrations of the school database
+//
+//	migrate [-dry-run] up [-to VERSION]
+//	migrate [-dry-run] down [-steps N]
+//	migrate status
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only print the migrations that would run")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: migrate [-dry-run] up [-to VERSION] | down [-steps N] | status")
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	client, err := mongodb.CreateMongoClient()
+	if err != nil {
+		log.Fatal("Error connecting to the database", err)
+	}
+	defer client.Disconnect(ctx)
+
+	runner := migrations.NewRunner(client.Database("school"))
+	runner.DryRun = *dryRun
+
+	command := flag.Arg(0)
+	args := flag.NewFlagSet(command, flag.ExitOnError)
+	switch command {
+	case "up":
+		target := args.Int("to", 0, "apply migrations up to this version (default: all)")
+		args.Parse(flag.Args()[1:])
+
+		ran, err := runner.Up(ctx, *target)
+		if err != nil {
+			log.Fatal(err)
+		}
+		report("Applied", ran, *dryRun)
+
+	case "down":
+		steps := args.Int("steps", 1, "number of migrations to revert")
+		args.Parse(flag.Args()[1:])
+
+		ran, err := runner.Down(ctx, *steps)
+		if err != nil {
+			log.Fatal(err)
+		}
+		report("Reverted", ran, *dryRun)
+
+	case "status":
+		applied, err := runner.Applied(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		done := map[int]bool{}
+		for _, migration := range applied {
+			done[migration.Version] = true
+			fmt.Printf("applied  %04d %s (%s)\n", migration.Version, migration.Name, migration.AppliedAt.Format("2006-01-02 15:04:05"))
+		}
+		for _, migration := range migrations.All() {
+			if !done[migration.Version] {
+				fmt.Printf("pending  %04d %s\n", migration.Version, migration.Name)
+			}
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func report(action string, ran []migrations.Migration, dryRun bool) {
+	if dryRun {
+		action = "[dry-run] " + action
+	}
+	if len(ran) == 0 {
+		fmt.Println("Nothing to do")
+		return
+	}
+	for _, migration := range ran {
+		fmt.Printf("%s %04d %s\n", action, migration.Version, migration.Name)
+	}
+}
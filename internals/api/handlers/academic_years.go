@@ -0,0 +1,582 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AddAcademicYears(ctx context.Context, req *pb.AddAcademicYearsRequest) (*pb.AddAcademicYearsResponse, error) {
+	var proposed []period
+	for _, year := range req.GetAcademicYears() {
+		if year.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if year.Name == "" || year.StartDate == nil || year.EndDate == nil {
+			return nil, status.Error(codes.InvalidArgument, "name, start_date and end_date are required")
+		}
+		proposed = append(proposed, period{name: year.Name, start: year.StartDate.AsTime(), end: year.EndDate.AsTime()})
+	}
+
+	stored, err := s.Extended.GetAcademicYearsFromDB(ctx, nil, excludeDeleted(bson.M{}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	err = checkPeriods("academic year", proposed, yearPeriods(stored))
+	if err != nil {
+		return nil, err
+	}
+
+	addedYears, results, err := s.Extended.AddAcademicYearsToDb(ctx, req.GetAcademicYears(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddAcademicYearsResponse{AcademicYears: addedYears, Results: results}, nil
+}
+
+func (s *Server) GetAcademicYears(ctx context.Context, req *pb.GetAcademicYearsRequest) (*pb.AcademicYears, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.AcademicYear, &models.AcademicYear{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	years, err := s.Extended.GetAcademicYearsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AcademicYears{AcademicYears: years}, nil
+}
+
+func (s *Server) UpdateAcademicYears(ctx context.Context, req *pb.UpdateAcademicYearsRequest) (*pb.AcademicYears, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.AcademicYear{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	paths := req.GetUpdateMask().GetPaths()
+	if slices.Contains(paths, "name") && slices.ContainsFunc(req.GetAcademicYears(), func(year *pb.AcademicYear) bool { return year.Name == "" }) {
+		return nil, status.Error(codes.InvalidArgument, "name cannot be cleared")
+	}
+
+	stored, err := s.Extended.GetAcademicYearsFromDB(ctx, nil, excludeDeleted(bson.M{}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	var proposed []period
+	for _, year := range req.GetAcademicYears() {
+		i := slices.IndexFunc(stored, func(current *pb.AcademicYear) bool { return current.Id == year.Id })
+		if i < 0 {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("no academic year found with ID: %s", year.Id))
+		}
+		changed, err := changedPeriod(yearPeriods(stored[i : i+1])[0], year.StartDate, year.EndDate, paths)
+		if err != nil {
+			return nil, err
+		}
+		if year.Name != "" {
+			changed.name = year.Name
+		}
+		proposed = append(proposed, changed)
+	}
+	err = checkPeriods("academic year", proposed, yearPeriods(stored))
+	if err != nil {
+		return nil, err
+	}
+
+	updatedYears, err := s.Extended.ModifyAcademicYearsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.AcademicYears{AcademicYears: updatedYears}, nil
+}
+
+func (s *Server) DeleteAcademicYears(ctx context.Context, req *pb.DeleteAcademicYearsRequest) (*pb.DeleteAcademicYearsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	err := s.checkYearsUnreferenced(ctx, objectIdsToDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedIds, err := s.Extended.DeleteAcademicYearsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteAcademicYearsConfirmation{
+		Status:     "Academic years successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) AddTerms(ctx context.Context, req *pb.AddTermsRequest) (*pb.AddTermsResponse, error) {
+	proposed := map[string][]period{}
+	for _, term := range req.GetTerms() {
+		if term.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if term.AcademicYearId == "" || term.Name == "" || term.StartDate == nil || term.EndDate == nil {
+			return nil, status.Error(codes.InvalidArgument, "academic_year_id, name, start_date and end_date are required")
+		}
+		proposed[term.AcademicYearId] = append(proposed[term.AcademicYearId], period{name: term.Name, start: term.StartDate.AsTime(), end: term.EndDate.AsTime()})
+	}
+
+	err := s.checkTerms(ctx, proposed)
+	if err != nil {
+		return nil, err
+	}
+
+	addedTerms, results, err := s.Extended.AddTermsToDb(ctx, req.GetTerms(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddTermsResponse{Terms: addedTerms, Results: results}, nil
+}
+
+func (s *Server) GetTerms(ctx context.Context, req *pb.GetTermsRequest) (*pb.Terms, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Term, &models.Term{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	terms, err := s.Extended.GetTermsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.Terms{Terms: terms}, nil
+}
+
+func (s *Server) UpdateTerms(ctx context.Context, req *pb.UpdateTermsRequest) (*pb.Terms, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Term{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	paths := req.GetUpdateMask().GetPaths()
+	if slices.Contains(paths, "academic_year_id") || slices.ContainsFunc(req.GetTerms(), func(term *pb.Term) bool { return term.AcademicYearId != "" }) {
+		return nil, status.Error(codes.InvalidArgument, "academic_year_id of a term cannot be changed")
+	}
+
+	var ids []string
+	for _, term := range req.GetTerms() {
+		if slices.Contains(paths, "name") && term.Name == "" {
+			return nil, status.Error(codes.InvalidArgument, "name cannot be cleared")
+		}
+		ids = append(ids, term.Id)
+	}
+	objIds, err := parseObjectIds(ids)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	stored, err := s.Extended.GetTermsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	proposed := map[string][]period{}
+	for _, term := range req.GetTerms() {
+		i := slices.IndexFunc(stored, func(current *pb.Term) bool { return current.Id == term.Id })
+		if i < 0 {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("no term found with ID: %s", term.Id))
+		}
+		current := stored[i]
+		changed, err := changedPeriod(period{id: current.Id, name: current.Name, start: current.StartDate.AsTime(), end: current.EndDate.AsTime()}, term.StartDate, term.EndDate, paths)
+		if err != nil {
+			return nil, err
+		}
+		if term.Name != "" {
+			changed.name = term.Name
+		}
+		proposed[current.AcademicYearId] = append(proposed[current.AcademicYearId], changed)
+	}
+
+	err = s.checkTerms(ctx, proposed)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTerms, err := s.Extended.ModifyTermsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Terms{Terms: updatedTerms}, nil
+}
+
+func (s *Server) DeleteTerms(ctx context.Context, req *pb.DeleteTermsRequest) (*pb.DeleteTermsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteTermsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteTermsConfirmation{
+		Status:     "Terms successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) GetClassMemberships(ctx context.Context, req *pb.GetClassMembershipsRequest) (*pb.ClassMemberships, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Membership, &models.ClassMembership{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetMembership().GetOutcome() != pb.PromotionOutcome_PROMOTION_OUTCOME_UNSPECIFIED {
+		filters["outcome"] = req.GetMembership().GetOutcome().String()
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	memberships, err := s.Extended.GetClassMembershipsFromDB(ctx, sortOptions, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.ClassMemberships{Memberships: memberships}, nil
+}
+
+func (s *Server) PromoteStudents(ctx context.Context, req *pb.PromoteStudentsRequest) (*pb.PromotionResult, error) {
+	if req.GetGrade() < 1 {
+		return nil, status.Error(codes.InvalidArgument, "grade has to be at least 1")
+	}
+	from, to, err := s.promotionYears(ctx, req.GetFromAcademicYearId(), req.GetToAcademicYearId())
+	if err != nil {
+		return nil, err
+	}
+
+	fromClasses, err := s.Repo.GetClassesFromDB(ctx, nil, excludeDeleted(bson.M{"academic_year_id": from.Id, "grade": req.GetGrade()}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(fromClasses) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("no classes of grade %d in %s", req.GetGrade(), from.Name))
+	}
+	classes := map[string]*pb.Class{}
+	var fromClassIds []string
+	for _, class := range fromClasses {
+		classes[class.Id] = class
+		fromClassIds = append(fromClassIds, class.Id)
+	}
+	toClasses, err := s.Repo.GetClassesFromDB(ctx, nil, excludeDeleted(bson.M{"academic_year_id": to.Id}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	students, err := s.Repo.GetStudentsFromDB(ctx, bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}}, excludeDeleted(bson.M{"class_id": bson.M{"$in": fromClassIds}}, false), repositories.Page{})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(students) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("no students of grade %d left to promote in %s", req.GetGrade(), from.Name))
+	}
+
+	exceptions, err := promotionExceptions(req.GetExceptions(), students, toClasses)
+	if err != nil {
+		return nil, err
+	}
+
+	incoming := map[string]int32{}
+	var memberships []*pb.ClassMembership
+	for _, student := range students {
+		fromClass := classes[student.ClassId]
+		membership := &pb.ClassMembership{StudentId: student.Id, ClassId: fromClass.Id, AcademicYearId: from.Id, Outcome: pb.PromotionOutcome_PROMOTED}
+		if req.GetGraduating() {
+			membership.Outcome = pb.PromotionOutcome_GRADUATED
+		}
+		exception := exceptions[student.Id]
+		if exception != nil {
+			membership.Outcome = exception.Outcome
+			membership.ToClassId = exception.ClassId
+		}
+
+		// Promoted students go up a grade and retained ones stay in theirs, both keep their section unless told otherwise
+		if membership.ToClassId == "" && (membership.Outcome == pb.PromotionOutcome_PROMOTED || membership.Outcome == pb.PromotionOutcome_RETAINED) {
+			grade := fromClass.Grade
+			if membership.Outcome == pb.PromotionOutcome_PROMOTED {
+				grade++
+			}
+			i := slices.IndexFunc(toClasses, func(class *pb.Class) bool { return class.Grade == grade && class.Section == fromClass.Section })
+			if i < 0 {
+				return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("no class of grade %d section %s in %s for student %s, name one in an exception", grade, fromClass.Section, to.Name, student.Id))
+			}
+			membership.ToClassId = toClasses[i].Id
+		}
+		if membership.ToClassId != "" {
+			incoming[membership.ToClassId]++
+		}
+		memberships = append(memberships, membership)
+	}
+
+	// The storage backend checks the capacity again in the promotion transaction, this reports full classes on a dry run
+	err = s.checkPromotionCapacity(ctx, toClasses, incoming)
+	if err != nil {
+		return nil, err
+	}
+
+	promotion := &pb.Promotion{Grade: req.GetGrade(), FromAcademicYearId: from.Id, ToAcademicYearId: to.Id}
+	if req.GetDryRun() {
+		return &pb.PromotionResult{Promotion: promotion, Memberships: memberships}, nil
+	}
+
+	promotion, memberships, err = s.Extended.PromoteStudentsInDB(ctx, promotion, memberships, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.PromotionResult{Promotion: promotion, Memberships: memberships}, nil
+}
+
+func (s *Server) UndoPromotion(ctx context.Context, req *pb.PromotionId) (*pb.PromotionResult, error) {
+	objIds, err := parseObjectIds([]string{req.GetId()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	promotion, memberships, err := s.Extended.UndoPromotionInDB(ctx, objIds[0], actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.PromotionResult{Promotion: promotion, Memberships: memberships}, nil
+}
+
+// period is the time an academic year or a term takes up, id is empty for records that are not stored yet
+type period struct {
+	id    string
+	name  string
+	start time.Time
+	end   time.Time
+}
+
+func yearPeriods(years []*pb.AcademicYear) []period {
+	periods := make([]period, len(years))
+	for i, year := range years {
+		periods[i] = period{id: year.Id, name: year.Name, start: year.StartDate.AsTime(), end: year.EndDate.AsTime()}
+	}
+	return periods
+}
+
+// changedPeriod applies the dates an update writes to a stored period, the dates cannot be cleared
+func changedPeriod(current period, start, end *timestamppb.Timestamp, paths []string) (period, error) {
+	for field, value := range map[string]*timestamppb.Timestamp{"start_date": start, "end_date": end} {
+		if slices.Contains(paths, field) && value == nil {
+			return current, status.Error(codes.InvalidArgument, fmt.Sprintf("%s cannot be cleared", field))
+		}
+	}
+	if start != nil {
+		current.start = start.AsTime()
+	}
+	if end != nil {
+		current.end = end.AsTime()
+	}
+	return current, nil
+}
+
+// checkPeriods fails when a proposed period does not end after it starts or overlaps another proposed or stored one
+// Stored periods that are proposed again with the same ID are replaced by the proposal
+func checkPeriods(kind string, proposed, stored []period) error {
+	all := slices.Clone(proposed)
+	for _, existing := range stored {
+		if !slices.ContainsFunc(proposed, func(p period) bool { return p.id != "" && p.id == existing.id }) {
+			all = append(all, existing)
+		}
+	}
+
+	for i, p := range proposed {
+		if !p.end.After(p.start) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("%s %s has to end after it starts", kind, p.name))
+		}
+		for j, other := range all {
+			if i != j && p.start.Before(other.end) && other.start.Before(p.end) {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("%s %s overlaps %s", kind, p.name, other.name))
+			}
+		}
+	}
+	return nil
+}
+
+// checkTerms fails when the proposed terms of an academic year do not lie within the year or overlap its other terms
+func (s *Server) checkTerms(ctx context.Context, proposed map[string][]period) error {
+	for yearId, terms := range proposed {
+		objIds, err := parseObjectIds([]string{yearId})
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		years, err := s.Extended.GetAcademicYearsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objIds[0]}, false))
+		if err != nil {
+			return repositoryError(err)
+		}
+		if len(years) == 0 {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("no academic year found with ID: %s", yearId))
+		}
+		year := yearPeriods(years)[0]
+		for _, term := range terms {
+			if term.start.Before(year.start) || term.end.After(year.end) {
+				return status.Error(codes.InvalidArgument, fmt.Sprintf("term %s has to lie within academic year %s", term.name, year.name))
+			}
+		}
+
+		stored, err := s.Extended.GetTermsFromDB(ctx, nil, excludeDeleted(bson.M{"academic_year_id": yearId}, false))
+		if err != nil {
+			return repositoryError(err)
+		}
+		var storedPeriods []period
+		for _, term := range stored {
+			storedPeriods = append(storedPeriods, period{id: term.Id, name: term.Name, start: term.StartDate.AsTime(), end: term.EndDate.AsTime()})
+		}
+		err = checkPeriods("term", terms, storedPeriods)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkYearsUnreferenced fails when terms or classes that are not deleted still belong to one of the academic years
+func (s *Server) checkYearsUnreferenced(ctx context.Context, objIds []primitive.ObjectID) error {
+	years, err := s.Extended.GetAcademicYearsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false))
+	if err != nil {
+		return repositoryError(err)
+	}
+	for _, year := range years {
+		terms, err := s.Extended.GetTermsFromDB(ctx, nil, excludeDeleted(bson.M{"academic_year_id": year.Id}, false))
+		if err != nil {
+			return repositoryError(err)
+		}
+		if len(terms) > 0 {
+			return repositoryError(&repositories.ReferencedError{Entity: "academic year", Id: year.Id, ReferencedBy: "terms"})
+		}
+		classes, err := s.Repo.GetClassesFromDB(ctx, nil, excludeDeleted(bson.M{"academic_year_id": year.Id}, false))
+		if err != nil {
+			return repositoryError(err)
+		}
+		if len(classes) > 0 {
+			return repositoryError(&repositories.ReferencedError{Entity: "academic year", Id: year.Id, ReferencedBy: "classes"})
+		}
+	}
+	return nil
+}
+
+// promotionYears looks up the academic years a promotion moves students between, the second one has to start later
+func (s *Server) promotionYears(ctx context.Context, fromId, toId string) (*pb.AcademicYear, *pb.AcademicYear, error) {
+	objIds, err := parseObjectIds([]string{fromId, toId})
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	years, err := s.Extended.GetAcademicYearsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false))
+	if err != nil {
+		return nil, nil, repositoryError(err)
+	}
+
+	var from, to *pb.AcademicYear
+	for _, year := range years {
+		if year.Id == fromId {
+			from = year
+		}
+		if year.Id == toId {
+			to = year
+		}
+	}
+	if from == nil {
+		return nil, nil, status.Error(codes.NotFound, fmt.Sprintf("no academic year found with ID: %s", fromId))
+	}
+	if to == nil {
+		return nil, nil, status.Error(codes.NotFound, fmt.Sprintf("no academic year found with ID: %s", toId))
+	}
+	if !to.StartDate.AsTime().After(from.StartDate.AsTime()) {
+		return nil, nil, status.Error(codes.InvalidArgument, "to_academic_year_id has to start after from_academic_year_id")
+	}
+	return from, to, nil
+}
+
+// promotionExceptions checks the exceptions of a promotion and returns them keyed by student ID
+func promotionExceptions(exceptions []*pb.PromotionException, students []*pb.Student, toClasses []*pb.Class) (map[string]*pb.PromotionException, error) {
+	byStudent := map[string]*pb.PromotionException{}
+	for _, exception := range exceptions {
+		if !slices.ContainsFunc(students, func(student *pb.Student) bool { return student.Id == exception.StudentId }) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("student %s is not in a class of the promoted grade", exception.StudentId))
+		}
+		if byStudent[exception.StudentId] != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("student %s has more than one exception", exception.StudentId))
+		}
+		if _, ok := pb.PromotionOutcome_name[int32(exception.Outcome)]; !ok || exception.Outcome == pb.PromotionOutcome_PROMOTION_OUTCOME_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown outcome: %d", exception.Outcome))
+		}
+		if exception.ClassId != "" {
+			if exception.Outcome == pb.PromotionOutcome_GRADUATED || exception.Outcome == pb.PromotionOutcome_TRANSFERRED {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("student %s leaves the school and cannot get a class", exception.StudentId))
+			}
+			if !slices.ContainsFunc(toClasses, func(class *pb.Class) bool { return class.Id == exception.ClassId }) {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("class %s is not a class of the next academic year", exception.ClassId))
+			}
+		}
+		byStudent[exception.StudentId] = exception
+	}
+	return byStudent, nil
+}
+
+// checkPromotionCapacity fails when the students moving into a class would take it over its capacity
+func (s *Server) checkPromotionCapacity(ctx context.Context, toClasses []*pb.Class, incoming map[string]int32) error {
+	for _, class := range toClasses {
+		if class.Capacity == 0 || incoming[class.Id] == 0 {
+			continue
+		}
+		students, err := s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"class_id": class.Id}, false), repositories.Page{})
+		if err != nil {
+			return repositoryError(err)
+		}
+		if int32(len(students))+incoming[class.Id] > class.Capacity {
+			return status.Error(codes.FailedPrecondition, fmt.Sprintf("class %s is full, it takes %d students", class.Id, class.Capacity))
+		}
+	}
+	return nil
+}
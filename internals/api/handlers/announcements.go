@@ -0,0 +1,433 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AddAnnouncements(ctx context.Context, req *pb.AddAnnouncementsRequest) (*pb.AddAnnouncementsResponse, error) {
+	var classIds, studentIds, teacherIds []string
+	for _, announcement := range req.GetAnnouncements() {
+		if announcement.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if announcement.Title == "" || announcement.Body == "" {
+			return nil, status.Error(codes.InvalidArgument, "title and body are required")
+		}
+		err := validateAudience(announcement)
+		if err != nil {
+			return nil, err
+		}
+		if announcement.ExpiresAt != nil && !announcement.ExpiresAt.AsTime().After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at has to be in the future")
+		}
+		classIds = append(classIds, announcement.ClassIds...)
+		studentIds = append(studentIds, announcement.StudentIds...)
+		teacherIds = append(teacherIds, announcement.TeacherId)
+	}
+
+	err := s.checkClasses(ctx, classIds, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkStudentsExist(ctx, studentIds)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedAnnouncements, results, err := s.Extended.AddAnnouncementsToDb(ctx, req.GetAnnouncements(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddAnnouncementsResponse{Announcements: addedAnnouncements, Results: results}, nil
+}
+
+func (s *Server) GetAnnouncements(ctx context.Context, req *pb.GetAnnouncementsRequest) (*pb.Announcements, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Announcement, &models.Announcement{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	containsAll(filters, "class_ids", req.GetAnnouncement().GetClassIds())
+	containsAll(filters, "student_ids", req.GetAnnouncement().GetStudentIds())
+	// SCHOOL is the zero audience, so only the other audiences filter
+	if req.GetAnnouncement().GetAudience() != pb.AudienceType_SCHOOL {
+		filters["audience"] = req.GetAnnouncement().GetAudience().String()
+	}
+
+	var conditions bson.A
+	if !req.GetIncludeExpired() {
+		conditions = append(conditions, bson.M{"$or": bson.A{bson.M{"expires_at": bson.M{"$exists": false}}, bson.M{"expires_at": bson.M{"$gt": time.Now()}}}})
+	}
+	if req.GetRecipient() != nil {
+		audience, err := s.audienceFilter(ctx, req.GetRecipient())
+		if err != nil {
+			return nil, err
+		}
+		if len(audience) > 0 {
+			conditions = append(conditions, audience)
+		}
+	}
+	if req.GetUnreadOnly() {
+		if req.GetRecipient() == nil {
+			return nil, status.Error(codes.InvalidArgument, "unread_only requires a recipient")
+		}
+		receipts, err := s.Extended.GetReadReceiptsFromDB(ctx, nil, bson.M{"reader_type": req.GetRecipient().GetType().String(), "reader_id": req.GetRecipient().GetId()})
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		readIds := []primitive.ObjectID{}
+		for _, receipt := range receipts {
+			if objId, err := primitive.ObjectIDFromHex(receipt.AnnouncementId); err == nil {
+				readIds = append(readIds, objId)
+			}
+		}
+		conditions = append(conditions, bson.M{"_id": bson.M{"$nin": readIds}})
+	}
+	if len(conditions) > 0 {
+		filters["$and"] = conditions
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+	if len(sortOptions) == 0 {
+		sortOptions = bson.D{{Key: "created_at", Value: -1}}
+	}
+
+	// Querying the database
+	announcements, err := s.Extended.GetAnnouncementsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.Announcements{Announcements: announcements}, nil
+}
+
+func (s *Server) UpdateAnnouncements(ctx context.Context, req *pb.UpdateAnnouncementsRequest) (*pb.Announcements, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Announcement{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Changing the audience would reach people who never received the announcement, so it is posted again instead
+	paths := req.GetUpdateMask().GetPaths()
+	for _, field := range []string{"audience", "grade", "class_ids", "student_ids"} {
+		if slices.Contains(paths, field) {
+			return nil, status.Error(codes.InvalidArgument, "the audience of an announcement cannot be changed")
+		}
+	}
+
+	var teacherIds []string
+	for _, announcement := range req.GetAnnouncements() {
+		if announcement.Audience != pb.AudienceType_SCHOOL || announcement.Grade != 0 || len(announcement.ClassIds) > 0 || len(announcement.StudentIds) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "the audience of an announcement cannot be changed")
+		}
+		for field, value := range map[string]string{"title": announcement.Title, "body": announcement.Body} {
+			if slices.Contains(paths, field) && value == "" {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s cannot be cleared", field))
+			}
+		}
+		if announcement.ExpiresAt != nil && !announcement.ExpiresAt.AsTime().After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at has to be in the future")
+		}
+		if writesField(req.GetUpdateMask(), "teacher_id") {
+			teacherIds = append(teacherIds, announcement.TeacherId)
+		}
+	}
+
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAnnouncements, err := s.Extended.ModifyAnnouncementsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Announcements{Announcements: updatedAnnouncements}, nil
+}
+
+func (s *Server) DeleteAnnouncements(ctx context.Context, req *pb.DeleteAnnouncementsRequest) (*pb.DeleteAnnouncementsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteAnnouncementsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteAnnouncementsConfirmation{
+		Status:     "Announcements successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) Subscribe(req *pb.SubscribeRequest, stream grpc.ServerStreamingServer[pb.AnnouncementEvent]) error {
+	// Deleted announcements are not left out, so subscribers see them go as DELETED events
+	filters, err := s.audienceFilter(stream.Context(), req.GetRecipient())
+	if err != nil {
+		return err
+	}
+
+	err = s.Extended.WatchAnnouncementsInDB(stream.Context(), filters, req.GetResumeToken(), func(changeType pb.ChangeType, announcement *pb.Announcement, resumeToken string, occurredAt time.Time) error {
+		return stream.Send(&pb.AnnouncementEvent{
+			Type:         changeType,
+			Announcement: announcement,
+			ResumeToken:  resumeToken,
+			OccurredAt:   timestamppb.New(occurredAt),
+		})
+	})
+	if err != nil {
+		return repositoryError(err)
+	}
+	return nil
+}
+
+func (s *Server) MarkAnnouncementsRead(ctx context.Context, req *pb.MarkAnnouncementsReadRequest) (*pb.ReadReceipts, error) {
+	if len(req.GetAnnouncementIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "announcement_ids are required")
+	}
+	objIds, err := parseObjectIds(req.GetAnnouncementIds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	audience, err := s.audienceFilter(ctx, req.GetReader())
+	if err != nil {
+		return nil, err
+	}
+
+	filters := bson.M{"_id": bson.M{"$in": objIds}}
+	if len(audience) > 0 {
+		filters["$and"] = bson.A{audience}
+	}
+	announcements, err := s.Extended.GetAnnouncementsFromDB(ctx, nil, excludeDeleted(filters, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	addressed := map[string]bool{}
+	for _, announcement := range announcements {
+		addressed[announcement.Id] = true
+	}
+	for _, id := range req.GetAnnouncementIds() {
+		if !addressed[id] {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("announcement %s is not addressed to the reader", id))
+		}
+	}
+
+	receipts, err := s.Extended.MarkAnnouncementsReadInDB(ctx, req.GetReader().GetType(), req.GetReader().GetId(), req.GetAnnouncementIds())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.ReadReceipts{Receipts: receipts}, nil
+}
+
+func (s *Server) GetReadReceipts(ctx context.Context, req *pb.GetReadReceiptsRequest) (*pb.ReadReceipts, error) {
+	objIds, err := parseObjectIds([]string{req.GetAnnouncementId()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	announcements, err := s.Extended.GetAnnouncementsFromDB(ctx, nil, bson.M{"_id": objIds[0]})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(announcements) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no announcement found with ID: %s", req.GetAnnouncementId()))
+	}
+
+	receipts, err := s.Extended.GetReadReceiptsFromDB(ctx, bson.D{{Key: "read_at", Value: 1}}, bson.M{"announcement_id": req.GetAnnouncementId()})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.ReadReceipts{Receipts: receipts}, nil
+}
+
+// validateAudience checks that an announcement names exactly the targets its audience needs
+func validateAudience(announcement *pb.Announcement) error {
+	hasGrade := announcement.Grade != 0
+	hasClasses := len(announcement.ClassIds) > 0
+	hasStudents := len(announcement.StudentIds) > 0
+	switch announcement.Audience {
+	case pb.AudienceType_SCHOOL:
+		if hasGrade || hasClasses || hasStudents {
+			return status.Error(codes.InvalidArgument, "a SCHOOL announcement cannot name a grade, classes or students")
+		}
+	case pb.AudienceType_GRADE:
+		if announcement.Grade < 1 || hasClasses || hasStudents {
+			return status.Error(codes.InvalidArgument, "a GRADE announcement needs a grade and nothing else")
+		}
+	case pb.AudienceType_CLASS:
+		if !hasClasses || hasGrade || hasStudents {
+			return status.Error(codes.InvalidArgument, "a CLASS announcement needs class_ids and nothing else")
+		}
+	case pb.AudienceType_STUDENTS:
+		if !hasStudents || hasGrade || hasClasses {
+			return status.Error(codes.InvalidArgument, "a STUDENTS announcement needs student_ids and nothing else")
+		}
+	default:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("unknown audience: %d", announcement.Audience))
+	}
+	return nil
+}
+
+// checkStudentsExist fails when one of the IDs does not belong to a student that has not been deleted
+func (s *Server) checkStudentsExist(ctx context.Context, ids []string) error {
+	objIds, err := parseObjectIds(ids)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(objIds) == 0 {
+		return nil
+	}
+
+	students, err := s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false), repositories.Page{})
+	if err != nil {
+		return repositoryError(err)
+	}
+	for _, objId := range objIds {
+		if !slices.ContainsFunc(students, func(student *pb.Student) bool { return student.Id == objId.Hex() }) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("no student found with ID: %s", objId.Hex()))
+		}
+	}
+	return nil
+}
+
+// audienceFilter matches the announcements addressed to the person, it is empty for execs who receive every announcement
+// Students receive the announcements of the school, their grade, their class and those naming them,
+// teachers those of the school and of the grades and classes they teach or are homeroom teacher of.
+// Only the person themselves and execs may read or mark the announcements of a person
+func (s *Server) audienceFilter(ctx context.Context, person *pb.PersonRef) (bson.M, error) {
+	err := s.validatePersonRef(person)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkActingFor(ctx, person)
+	if err != nil {
+		return nil, err
+	}
+	// No audience of an announcement covers guardians
+	if person.GetType() == pb.PersonType_GUARDIAN {
+		return nil, status.Error(codes.InvalidArgument, "guardians are not recipients of announcements")
+	}
+	objId, _ := primitive.ObjectIDFromHex(person.GetId())
+	notFound := status.Error(codes.NotFound, fmt.Sprintf("no %s found with ID: %s", strings.ToLower(person.GetType().String()), person.GetId()))
+
+	switch person.GetType() {
+	case pb.PersonType_STUDENT:
+		students, err := s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objId}, false), repositories.Page{})
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		if len(students) == 0 {
+			return nil, notFound
+		}
+		audiences := bson.A{
+			bson.M{"audience": pb.AudienceType_SCHOOL.String()},
+			bson.M{"audience": pb.AudienceType_STUDENTS.String(), "student_ids": person.GetId()},
+		}
+		if classId := students[0].ClassId; classId != "" {
+			audiences = append(audiences, bson.M{"audience": pb.AudienceType_CLASS.String(), "class_ids": classId})
+			classes, err := s.classesById(ctx, []string{classId})
+			if err != nil {
+				return nil, err
+			}
+			if class := classes[classId]; class != nil {
+				audiences = append(audiences, bson.M{"audience": pb.AudienceType_GRADE.String(), "grade": class.Grade})
+			}
+		}
+		return bson.M{"$or": audiences}, nil
+
+	case pb.PersonType_TEACHER:
+		teachers, err := s.Repo.GetTeachersFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objId}, false))
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		if len(teachers) == 0 {
+			return nil, notFound
+		}
+		assignments, err := s.Repo.GetTeachingAssignmentsFromDB(ctx, nil, excludeDeleted(bson.M{"teacher_id": person.GetId()}, false))
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		assignedIds := []primitive.ObjectID{}
+		for _, assignment := range assignments {
+			if classObjId, err := primitive.ObjectIDFromHex(assignment.ClassId); err == nil {
+				assignedIds = append(assignedIds, classObjId)
+			}
+		}
+		classes, err := s.Repo.GetClassesFromDB(ctx, nil, excludeDeleted(bson.M{"$or": bson.A{bson.M{"homeroom_teacher_id": person.GetId()}, bson.M{"_id": bson.M{"$in": assignedIds}}}}, false))
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		classIds := []string{}
+		grades := []int32{}
+		for _, class := range classes {
+			classIds = append(classIds, class.Id)
+			if !slices.Contains(grades, class.Grade) {
+				grades = append(grades, class.Grade)
+			}
+		}
+		return bson.M{"$or": bson.A{
+			bson.M{"audience": pb.AudienceType_SCHOOL.String()},
+			bson.M{"audience": pb.AudienceType_GRADE.String(), "grade": bson.M{"$in": grades}},
+			bson.M{"audience": pb.AudienceType_CLASS.String(), "class_ids": bson.M{"$in": classIds}},
+		}}, nil
+
+	default:
+		execs, err := s.Repo.GetExecsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objId}, false))
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		if len(execs) == 0 {
+			return nil, notFound
+		}
+		return bson.M{}, nil
+	}
+}
+
+// checkActingFor fails unless the caller is the person or an exec, who may act on behalf of anyone
+func (s *Server) checkActingFor(ctx context.Context, person *pb.PersonRef) error {
+	actor := actorFromContext(ctx)
+	if actor != "" && actor == person.GetId() {
+		return nil
+	}
+	if objId, err := primitive.ObjectIDFromHex(actor); err == nil {
+		execs, err := s.Repo.GetExecsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objId}, false))
+		if err != nil {
+			return repositoryError(err)
+		}
+		if len(execs) > 0 {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, fmt.Sprintf("only the %s or an exec can act on behalf of %s", strings.ToLower(person.GetType().String()), person.GetId()))
+}
@@ -0,0 +1,423 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/blobstore"
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AddAssignments(ctx context.Context, req *pb.AddAssignmentsRequest) (*pb.AddAssignmentsResponse, error) {
+	var classIds, teacherIds []string
+	for _, assignment := range req.GetAssignments() {
+		if assignment.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if assignment.ClassId == "" || assignment.Subject == "" || assignment.TeacherId == "" || assignment.Title == "" {
+			return nil, status.Error(codes.InvalidArgument, "class_id, subject, teacher_id and title are required")
+		}
+		if assignment.DueAt == nil {
+			return nil, status.Error(codes.InvalidArgument, "due_at is required")
+		}
+		if assignment.MaxScore <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "max_score has to be positive")
+		}
+		classIds = append(classIds, assignment.ClassId)
+		teacherIds = append(teacherIds, assignment.TeacherId)
+	}
+
+	err := s.checkClasses(ctx, classIds, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedAssignments, results, err := s.Extended.AddAssignmentsToDb(ctx, req.GetAssignments(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddAssignmentsResponse{Assignments: addedAssignments, Results: results}, nil
+}
+
+func (s *Server) GetAssignments(ctx context.Context, req *pb.GetAssignmentsRequest) (*pb.Assignments, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Assignment, &models.Assignment{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	assignments, err := s.Extended.GetAssignmentsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.Assignments{Assignments: assignments}, nil
+}
+
+func (s *Server) UpdateAssignments(ctx context.Context, req *pb.UpdateAssignmentsRequest) (*pb.Assignments, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Assignment{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// The submissions of an assignment come from the students of its class, so it stays with the class
+	if slices.Contains(req.GetUpdateMask().GetPaths(), "class_id") {
+		return nil, status.Error(codes.InvalidArgument, "class_id of an assignment cannot be changed")
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	var teacherIds []string
+	for _, assignment := range req.GetAssignments() {
+		if assignment.ClassId != "" {
+			return nil, status.Error(codes.InvalidArgument, "class_id of an assignment cannot be changed")
+		}
+		for field, empty := range map[string]bool{"subject": assignment.Subject == "", "teacher_id": assignment.TeacherId == "", "title": assignment.Title == "", "due_at": assignment.DueAt == nil} {
+			if slices.Contains(paths, field) && empty {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s cannot be cleared", field))
+			}
+		}
+		if assignment.MaxScore < 0 || (assignment.MaxScore == 0 && slices.Contains(paths, "max_score")) {
+			return nil, status.Error(codes.InvalidArgument, "max_score has to be positive")
+		}
+		if writesField(req.GetUpdateMask(), "teacher_id") {
+			teacherIds = append(teacherIds, assignment.TeacherId)
+		}
+	}
+
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAssignments, err := s.Extended.ModifyAssignmentsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Assignments{Assignments: updatedAssignments}, nil
+}
+
+func (s *Server) DeleteAssignments(ctx context.Context, req *pb.DeleteAssignmentsRequest) (*pb.DeleteAssignmentsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteAssignmentsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteAssignmentsConfirmation{
+		Status:     "Assignments successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) UploadSubmission(stream grpc.ClientStreamingServer[pb.SubmissionUpload, pb.Submission]) error {
+	ctx := stream.Context()
+	if s.Blobs == nil {
+		return status.Error(codes.Unimplemented, "no blob store is configured for submissions")
+	}
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "The upload is empty")
+	}
+	if err != nil {
+		return err
+	}
+	if first.GetFileName() == "" {
+		return status.Error(codes.InvalidArgument, "file_name is required")
+	}
+
+	// Everything that can be checked is checked before the file is received
+	assignment, err := s.assignmentById(ctx, first.GetAssignmentId(), false)
+	if err != nil {
+		return err
+	}
+	err = s.checkClassMembers(ctx, assignment.ClassId, []string{first.GetStudentId()})
+	if err != nil {
+		return err
+	}
+	submitted, err := s.Extended.GetSubmissionsFromDB(ctx, nil, bson.M{"assignment_id": assignment.Id, "student_id": first.GetStudentId()})
+	if err != nil {
+		return repositoryError(err)
+	}
+	if len(submitted) > 0 && submitted[0].GradedAt != nil {
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("the submission with ID %s was graded, its file can no longer be replaced", submitted[0].Id))
+	}
+	// The file is stored for the submission before it is saved, so a first submission gets its ID now
+	submissionId := primitive.NewObjectID().Hex()
+	if len(submitted) > 0 {
+		submissionId = submitted[0].Id
+	}
+
+	blob, err := s.Blobs.Create(ctx)
+	if err != nil {
+		utils.ErrorHandler(err, "Error creating a blob")
+		return status.Error(codes.Internal, "Unable to store the file")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			blob.Abort()
+		}
+	}()
+
+	hash := sha256.New()
+	var size int64
+	chunk := first
+	for {
+		size += int64(len(chunk.GetData()))
+		if s.MaxSubmissionSize > 0 && size > s.MaxSubmissionSize {
+			return status.Error(codes.ResourceExhausted, fmt.Sprintf("the file is larger than the limit of %d bytes", s.MaxSubmissionSize))
+		}
+		_, err = io.MultiWriter(blob, hash).Write(chunk.GetData())
+		if err != nil {
+			utils.ErrorHandler(err, "Error writing a blob")
+			return status.Error(codes.Internal, "Unable to store the file")
+		}
+
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if size == 0 {
+		return status.Error(codes.InvalidArgument, "The file is empty")
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if first.GetSha256() != "" && !strings.EqualFold(first.GetSha256(), checksum) {
+		return status.Error(codes.DataLoss, fmt.Sprintf("the file does not match its checksum, it was received with SHA-256 %s", checksum))
+	}
+	// Mongodb keeps milliseconds, the key is worked out again from the stored submitted_at
+	submittedAt := time.Now().Truncate(time.Millisecond)
+	key := submissionBlobKey(submissionId, submittedAt)
+	// Committing discards the content itself when it fails
+	committed = true
+	err = blob.Commit(key)
+	if err != nil {
+		utils.ErrorHandler(err, "Error committing a blob")
+		return status.Error(codes.Internal, "Unable to store the file")
+	}
+
+	submission, replaced, err := s.Extended.SaveSubmissionInDB(ctx, &pb.Submission{
+		Id:           submissionId,
+		AssignmentId: assignment.Id,
+		StudentId:    first.GetStudentId(),
+		FileName:     first.GetFileName(),
+		ContentType:  first.GetContentType(),
+		Size:         size,
+		Sha256:       checksum,
+		SubmittedAt:  timestamppb.New(submittedAt),
+		Late:         submittedAt.After(assignment.DueAt.AsTime()),
+	}, actorFromContext(ctx))
+	if err != nil {
+		// No other submission refers to the file
+		if deleteErr := s.Blobs.Delete(ctx, key); deleteErr != nil {
+			utils.ErrorHandler(deleteErr, "Error deleting a blob")
+		}
+		return repositoryError(err)
+	}
+	if replaced != nil {
+		s.releaseSubmissionBlob(ctx, replaced)
+	}
+
+	return stream.SendAndClose(submission)
+}
+
+func (s *Server) GetSubmissions(ctx context.Context, req *pb.GetSubmissionsRequest) (*pb.Submissions, error) {
+	filters := bson.M{}
+	if req.GetAssignmentId() != "" {
+		filters["assignment_id"] = req.GetAssignmentId()
+	}
+	if req.GetStudentId() != "" {
+		filters["student_id"] = req.GetStudentId()
+	}
+	if len(filters) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "one of assignment_id and student_id is required")
+	}
+
+	submissions, err := s.Extended.GetSubmissionsFromDB(ctx, bson.D{{Key: "assignment_id", Value: 1}, {Key: "submitted_at", Value: 1}}, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Submissions{Submissions: submissions}, nil
+}
+
+func (s *Server) DownloadSubmission(req *pb.SubmissionId, stream grpc.ServerStreamingServer[pb.SubmissionDownload]) error {
+	ctx := stream.Context()
+	if s.Blobs == nil {
+		return status.Error(codes.Unimplemented, "no blob store is configured for submissions")
+	}
+
+	submission, err := s.submissionById(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+
+	file, err := s.Blobs.Open(ctx, submissionBlobKey(submission.Id, submission.SubmittedAt.AsTime()))
+	if errors.Is(err, blobstore.ErrNotFound) {
+		file, err = s.Blobs.Open(ctx, sharedSubmissionBlobKey(submission.Sha256))
+	}
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return status.Error(codes.DataLoss, fmt.Sprintf("the file of the submission with ID %s is missing", submission.Id))
+	}
+	if err != nil {
+		utils.ErrorHandler(err, "Error opening a blob")
+		return status.Error(codes.Internal, "Unable to read the file")
+	}
+	defer file.Close()
+
+	err = stream.Send(&pb.SubmissionDownload{Submission: submission})
+	if err != nil {
+		return err
+	}
+
+	// The checksum is verified while sending, a damaged file ends the stream with DATA_LOSS
+	hash := sha256.New()
+	buf := make([]byte, exportChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+			sendErr := stream.Send(&pb.SubmissionDownload{Data: buf[:n]})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			utils.ErrorHandler(err, "Error reading a blob")
+			return status.Error(codes.Internal, "Unable to read the file")
+		}
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != submission.Sha256 {
+		return status.Error(codes.DataLoss, fmt.Sprintf("the file of the submission with ID %s does not match its checksum", submission.Id))
+	}
+	return nil
+}
+
+func (s *Server) RecordFeedback(ctx context.Context, req *pb.SubmissionFeedback) (*pb.Submission, error) {
+	submission, err := s.submissionById(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	// Feedback can still be given on the submissions of an assignment that was deleted since
+	assignment, err := s.assignmentById(ctx, submission.AssignmentId, true)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetScore() < 0 || req.GetScore() > assignment.MaxScore {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("the score has to be between 0 and %g", assignment.MaxScore))
+	}
+
+	updated, err := s.Extended.RecordFeedbackInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return updated, nil
+}
+
+// assignmentById returns the assignment with the ID or a NOT_FOUND status
+func (s *Server) assignmentById(ctx context.Context, id string, includeDeleted bool) (*pb.Assignment, error) {
+	objIds, err := parseObjectIds([]string{id})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	assignments, err := s.Extended.GetAssignmentsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objIds[0]}, includeDeleted))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(assignments) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no assignment found with ID: %s", id))
+	}
+	return assignments[0], nil
+}
+
+// submissionById returns the submission with the ID or a NOT_FOUND status
+func (s *Server) submissionById(ctx context.Context, id string) (*pb.Submission, error) {
+	objIds, err := parseObjectIds([]string{id})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	submissions, err := s.Extended.GetSubmissionsFromDB(ctx, nil, bson.M{"_id": objIds[0]})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(submissions) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no submission found with ID: %s", id))
+	}
+	return submissions[0], nil
+}
+
+// submissionBlobKey is the key of the file a submission was uploaded with at submittedAt
+// Every upload has a key of its own, so removing a replaced file never touches a file another upload is storing
+func submissionBlobKey(submissionId string, submittedAt time.Time) string {
+	return fmt.Sprintf("submissions/%s/%d", submissionId, submittedAt.UnixMilli())
+}
+
+// sharedSubmissionBlobKey is the key files were stored under by their checksum before they were kept per upload,
+// submissions of the same file shared it. Nothing is stored under it anymore, older submissions are still read from it
+func sharedSubmissionBlobKey(checksum string) string {
+	return fmt.Sprintf("submissions/%s/%s", checksum[:2], checksum)
+}
+
+// releaseSubmissionBlob removes the file of a replaced submission
+// A file shared by checksum is only removed once no submission has the checksum anymore, no new upload is stored
+// under it, so the check cannot race with one. Failures are only logged, a file left behind takes up space but does
+// no harm
+func (s *Server) releaseSubmissionBlob(ctx context.Context, replaced *pb.Submission) {
+	err := s.Blobs.Delete(ctx, submissionBlobKey(replaced.Id, replaced.SubmittedAt.AsTime()))
+	if err != nil {
+		utils.ErrorHandler(err, "Error deleting a blob")
+	}
+
+	submissions, err := s.Extended.GetSubmissionsFromDB(ctx, nil, bson.M{"sha256": replaced.Sha256})
+	if err != nil || len(submissions) > 0 {
+		return
+	}
+	err = s.Blobs.Delete(ctx, sharedSubmissionBlobKey(replaced.Sha256))
+	if err != nil {
+		utils.ErrorHandler(err, "Error deleting a blob")
+	}
+}
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) RecordAttendance(ctx context.Context, req *pb.RecordAttendanceRequest) (*pb.RecordAttendanceResponse, error) {
+	if req.GetClassId() == "" || req.GetSessionDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "class_id and session_date are required")
+	}
+	if len(req.GetMarks()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "marks are required")
+	}
+	sessionDate := s.day(req.GetSessionDate().AsTime())
+	if sessionDate.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "attendance cannot be recorded for a future session")
+	}
+
+	var studentIds []string
+	for _, mark := range req.GetMarks() {
+		if _, ok := pb.AttendanceStatus_name[int32(mark.Status)]; !ok || mark.Status == pb.AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown status: %d", mark.Status))
+		}
+		studentIds = append(studentIds, mark.StudentId)
+	}
+
+	classes, err := s.classesById(ctx, []string{req.GetClassId()})
+	if err != nil {
+		return nil, err
+	}
+	if classes[req.GetClassId()] == nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("no class found with ID: %s", req.GetClassId()))
+	}
+	err = s.checkClassMembers(ctx, req.GetClassId(), studentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	records, results, err := s.Extended.RecordAttendanceInDB(ctx, req.GetClassId(), sessionDate, req.GetSession(), req.GetMarks(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.RecordAttendanceResponse{Records: records, Results: results}, nil
+}
+
+func (s *Server) CorrectAttendance(ctx context.Context, req *pb.CorrectAttendanceRequest) (*pb.AttendanceRecords, error) {
+	var ids []string
+	for _, correction := range req.GetCorrections() {
+		if correction.Reason == "" {
+			return nil, status.Error(codes.InvalidArgument, "reason is required for every correction")
+		}
+		if _, ok := pb.AttendanceStatus_name[int32(correction.Status)]; !ok || correction.Status == pb.AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown status: %d", correction.Status))
+		}
+		ids = append(ids, correction.Id)
+	}
+	_, err := parseObjectIds(ids)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	corrected, err := s.Extended.CorrectAttendanceInDB(ctx, req.GetCorrections(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.AttendanceRecords{Records: corrected}, nil
+}
+
+func (s *Server) GetAttendance(ctx context.Context, req *pb.GetAttendanceRequest) (*pb.AttendanceRecords, error) {
+	if req.GetStudentId() == "" && req.GetClassId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "one of student_id and class_id is required")
+	}
+	filters, err := s.attendanceFilter(req.GetStudentId(), req.GetClassId(), req.GetDates())
+	if err != nil {
+		return nil, err
+	}
+
+	sortOptions := bson.D{{Key: "session_date", Value: 1}, {Key: "session", Value: 1}, {Key: "student_id", Value: 1}}
+	records, err := s.Extended.GetAttendanceFromDB(ctx, sortOptions, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.AttendanceRecords{Records: records}, nil
+}
+
+func (s *Server) GetStudentAttendanceSummary(ctx context.Context, req *pb.StudentAttendanceSummaryRequest) (*pb.AttendanceSummary, error) {
+	if req.GetStudentId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id is required")
+	}
+	filters, err := s.attendanceFilter(req.GetStudentId(), req.GetClassId(), req.GetDates())
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.Extended.GetAttendanceCountsFromDB(ctx, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	summary := &pb.AttendanceSummary{StudentId: req.GetStudentId()}
+	if len(counts) > 0 {
+		summary = counts[0]
+	}
+	summary.ClassId = req.GetClassId()
+	summary.Percentage = attendancePercentage(summary)
+	return summary, nil
+}
+
+func (s *Server) GetClassAttendanceSummary(ctx context.Context, req *pb.ClassAttendanceSummaryRequest) (*pb.ClassAttendanceSummary, error) {
+	if req.GetClassId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "class_id is required")
+	}
+	classes, err := s.classesById(ctx, []string{req.GetClassId()})
+	if err != nil {
+		return nil, err
+	}
+	if classes[req.GetClassId()] == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no class found with ID: %s", req.GetClassId()))
+	}
+	filters, err := s.attendanceFilter("", req.GetClassId(), req.GetDates())
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.Extended.GetAttendanceCountsFromDB(ctx, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	counted := map[string]bool{}
+	for _, count := range counts {
+		counted[count.StudentId] = true
+	}
+
+	// Students of the class without any recorded session are listed as well
+	members, err := s.Repo.GetStudentsFromDB(ctx, bson.D{{Key: "_id", Value: 1}}, excludeDeleted(bson.M{"class_id": req.GetClassId()}, false), repositories.Page{})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	for _, member := range members {
+		if !counted[member.Id] {
+			counts = append(counts, &pb.AttendanceSummary{StudentId: member.Id})
+		}
+	}
+
+	total := &pb.AttendanceSummary{ClassId: req.GetClassId()}
+	for _, count := range counts {
+		count.ClassId = req.GetClassId()
+		count.Percentage = attendancePercentage(count)
+		total.Sessions += count.Sessions
+		total.Present += count.Present
+		total.Absent += count.Absent
+		total.Late += count.Late
+		total.Excused += count.Excused
+	}
+	total.Percentage = attendancePercentage(total)
+	return &pb.ClassAttendanceSummary{Total: total, Students: counts}, nil
+}
+
+// checkClassMembers fails when one of the students does not exist, has been deleted or is not in the class
+func (s *Server) checkClassMembers(ctx context.Context, classId string, studentIds []string) error {
+	objIds, err := parseObjectIds(studentIds)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	students, err := s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false), repositories.Page{})
+	if err != nil {
+		return repositoryError(err)
+	}
+	classOf := map[string]string{}
+	for _, student := range students {
+		classOf[student.Id] = student.ClassId
+	}
+	for _, objId := range objIds {
+		id := objId.Hex()
+		classIdOfStudent, ok := classOf[id]
+		if !ok {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("no student found with ID: %s", id))
+		}
+		if classIdOfStudent != classId {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("student %s is not in class %s", id, classId))
+		}
+	}
+	return nil
+}
+
+// attendanceFilter matches the records of the student and class within the days of the range, empty IDs match every one
+func (s *Server) attendanceFilter(studentId, classId string, dates *pb.DateRange) (bson.M, error) {
+	filters := bson.M{}
+	if studentId != "" {
+		filters["student_id"] = studentId
+	}
+	if classId != "" {
+		filters["class_id"] = classId
+	}
+
+	dateRange := bson.M{}
+	if dates.GetFrom() != nil {
+		dateRange["$gte"] = s.day(dates.GetFrom().AsTime())
+	}
+	if dates.GetTo() != nil {
+		// The range includes the whole last day
+		dateRange["$lt"] = s.day(dates.GetTo().AsTime()).AddDate(0, 0, 1)
+	}
+	if dates.GetFrom() != nil && dates.GetTo() != nil && dates.GetTo().AsTime().Before(dates.GetFrom().AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "the end of the date range is before its start")
+	}
+	if len(dateRange) > 0 {
+		filters["session_date"] = dateRange
+	}
+	return filters, nil
+}
+
+// attendancePercentage is the share of sessions the student was present or late for, leaving excused sessions out
+func attendancePercentage(summary *pb.AttendanceSummary) float64 {
+	counted := summary.Sessions - summary.Excused
+	if counted <= 0 {
+		return 0
+	}
+	return float64(summary.Present+summary.Late) / float64(counted) * 100
+}
+
+// day returns the day of t in the school's timezone as midnight UTC of that date, session dates are stored that way
+func (s *Server) day(t time.Time) time.Time {
+	if s.SchoolLocation != nil {
+		t = t.In(s.SchoolLocation)
+	} else {
+		t = t.UTC()
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
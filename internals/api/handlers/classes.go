@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func (s *Server) AddClasses(ctx context.Context, req *pb.AddClassesRequest) (*pb.AddClassesResponse, error) {
+	var teacherIds, yearIds []string
+	for _, class := range req.GetClasses() {
+		if class.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if class.AcademicYearId == "" {
+			return nil, status.Error(codes.InvalidArgument, "academic_year_id is required")
+		}
+		if class.Capacity < 0 {
+			return nil, status.Error(codes.InvalidArgument, "capacity cannot be negative")
+		}
+		teacherIds = append(teacherIds, class.HomeroomTeacherId)
+		yearIds = append(yearIds, class.AcademicYearId)
+	}
+
+	err := s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkAcademicYearsExist(ctx, yearIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedClasses, results, err := s.Repo.AddClassesToDb(ctx, req.GetClasses(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddClassesResponse{Classes: addedClasses, Results: results}, nil
+}
+
+func (s *Server) GetClasses(ctx context.Context, req *pb.GetClassesRequest) (*pb.Classes, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Class, &models.Class{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Adding the time range filters
+	filters, err = addTimestampFilters(filters, req.GetTimeFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	classes, err := s.Repo.GetClassesFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.Classes{Classes: classes}, nil
+}
+
+func (s *Server) UpdateClasses(ctx context.Context, req *pb.UpdateClassesRequest) (*pb.Classes, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Class{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var teacherIds, yearIds []string
+	for _, class := range req.GetClasses() {
+		if class.Capacity < 0 {
+			return nil, status.Error(codes.InvalidArgument, "capacity cannot be negative")
+		}
+		if slices.Contains(req.GetUpdateMask().GetPaths(), "academic_year_id") && class.AcademicYearId == "" {
+			return nil, status.Error(codes.InvalidArgument, "academic_year_id cannot be cleared")
+		}
+		if writesField(req.GetUpdateMask(), "homeroom_teacher_id") {
+			teacherIds = append(teacherIds, class.HomeroomTeacherId)
+		}
+		yearIds = append(yearIds, class.AcademicYearId)
+	}
+
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkAcademicYearsExist(ctx, yearIds)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedClasses, err := s.Repo.ModifyClassesInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Classes{Classes: updatedClasses}, nil
+}
+
+func (s *Server) DeleteClasses(ctx context.Context, req *pb.DeleteClassesRequest) (*pb.DeleteClassesConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Repo.DeleteClassesFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteClassesConfirmation{
+		Status:     "Classes successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) RestoreClasses(ctx context.Context, req *pb.ClassIds) (*pb.RestoreClassesConfirmation, error) {
+	var ids []string
+	for _, v := range req.GetIds() {
+		ids = append(ids, v.Id)
+	}
+
+	objIds, err := parseObjectIds(ids)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	restoredIds, err := s.Repo.RestoreClassesInDB(ctx, objIds, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.RestoreClassesConfirmation{
+		Status:      "Classes successfully restored",
+		RestoredIds: restoredIds,
+	}, nil
+}
+
+func (s *Server) GetStudentsByClass(ctx context.Context, req *pb.ClassId) (*pb.Students, error) {
+	_, err := parseObjectIds([]string{req.GetId()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	classes, err := s.classesById(ctx, []string{req.GetId()})
+	if err != nil {
+		return nil, err
+	}
+	if classes[req.GetId()] == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no class found with ID: %s", req.GetId()))
+	}
+
+	students, err := s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"class_id": req.GetId()}, false), repositories.Page{})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Students{Students: students}, nil
+}
+
+// classesById returns the classes with the given IDs that have not been deleted, keyed by ID
+// IDs that are not valid object IDs cannot belong to a class and are left out
+func (s *Server) classesById(ctx context.Context, ids []string) (map[string]*pb.Class, error) {
+	var objIds []primitive.ObjectID
+	for _, id := range ids {
+		objId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			continue
+		}
+		objIds = append(objIds, objId)
+	}
+
+	found := map[string]*pb.Class{}
+	if len(objIds) == 0 {
+		return found, nil
+	}
+	classes, err := s.Repo.GetClassesFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	for _, class := range classes {
+		found[class.Id] = class
+	}
+	return found, nil
+}
+
+// classErrors checks the class every record is assigned to and returns the problem of each record, nil when its class is fine
+// memberIds holds the IDs of records that may already belong to their class, empty for new records
+// Students also need room in their class, the students already in it count towards its capacity
+// The repository checks the capacity again in the transaction that seats them, this check only reports the problem early
+func (s *Server) classErrors(ctx context.Context, classIds, memberIds []string, students bool) ([]error, error) {
+	classes, err := s.classesById(ctx, classIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Seats already taken in every class with a capacity
+	taken := map[string]int32{}
+	if students {
+		for id, class := range classes {
+			if class.Capacity == 0 {
+				continue
+			}
+			err := s.Repo.StreamStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"class_id": id}, false), repositories.Page{}, func(student *pb.Student) error {
+				// Students of the request that already sit in the class keep their seat
+				if !slices.Contains(memberIds, student.Id) {
+					taken[id]++
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, repositoryError(err)
+			}
+		}
+	}
+
+	problems := make([]error, len(classIds))
+	for i, id := range classIds {
+		if id == "" {
+			continue
+		}
+		class, ok := classes[id]
+		if !ok {
+			problems[i] = status.Error(codes.InvalidArgument, fmt.Sprintf("no class found with ID: %s", id))
+			continue
+		}
+		if students && class.Capacity > 0 {
+			taken[id]++
+			if taken[id] > class.Capacity {
+				problems[i] = status.Error(codes.FailedPrecondition, fmt.Sprintf("class %s is full, it takes %d students", id, class.Capacity))
+			}
+		}
+	}
+	return problems, nil
+}
+
+// checkClasses fails with the first problem classErrors finds
+func (s *Server) checkClasses(ctx context.Context, classIds, memberIds []string, students bool) error {
+	problems, err := s.classErrors(ctx, classIds, memberIds, students)
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		if problem != nil {
+			return problem
+		}
+	}
+	return nil
+}
+
+// checkTeachersExist fails when one of the non-empty IDs does not belong to a teacher that has not been deleted
+func (s *Server) checkTeachersExist(ctx context.Context, ids []string) error {
+	objIds, err := parseObjectIds(slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == "" }))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(objIds) == 0 {
+		return nil
+	}
+
+	teachers, err := s.Repo.GetTeachersFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false))
+	if err != nil {
+		return repositoryError(err)
+	}
+	for _, objId := range objIds {
+		if !slices.ContainsFunc(teachers, func(teacher *pb.Teacher) bool { return teacher.Id == objId.Hex() }) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("no teacher found with ID: %s", objId.Hex()))
+		}
+	}
+	return nil
+}
+
+// checkAcademicYearsExist fails when one of the non-empty IDs names no academic year that is not deleted
+// Academic years are only stored on MongoDB, on SQLite the ID of the academic year of a class is not checked
+func (s *Server) checkAcademicYearsExist(ctx context.Context, ids []string) error {
+	if s.Extended == nil {
+		return nil
+	}
+	objIds, err := parseObjectIds(slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == "" }))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(objIds) == 0 {
+		return nil
+	}
+
+	years, err := s.Extended.GetAcademicYearsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false))
+	if err != nil {
+		return repositoryError(err)
+	}
+	for _, objId := range objIds {
+		if !slices.ContainsFunc(years, func(year *pb.AcademicYear) bool { return year.Id == objId.Hex() }) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("no academic year found with ID: %s", objId.Hex()))
+		}
+	}
+	return nil
+}
+
+// writesField reports whether an update with the mask writes the field, without a mask every non-empty field is written
+func writesField(mask *fieldmaskpb.FieldMask, field string) bool {
+	return len(mask.GetPaths()) == 0 || slices.Contains(mask.GetPaths(), field)
+}
@@ -0,0 +1,454 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) AddCourses(ctx context.Context, req *pb.AddCoursesRequest) (*pb.AddCoursesResponse, error) {
+	var teacherIds, prerequisiteIds []string
+	for _, course := range req.GetCourses() {
+		if course.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if course.Code == "" {
+			return nil, status.Error(codes.InvalidArgument, "code is required")
+		}
+		if course.Credits < 0 {
+			return nil, status.Error(codes.InvalidArgument, "credits cannot be negative")
+		}
+		teacherIds = append(teacherIds, course.TeacherIds...)
+		prerequisiteIds = append(prerequisiteIds, course.PrerequisiteIds...)
+	}
+
+	err := s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkCoursesExist(ctx, prerequisiteIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedCourses, results, err := s.Extended.AddCoursesToDb(ctx, req.GetCourses(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddCoursesResponse{Courses: addedCourses, Results: results}, nil
+}
+
+func (s *Server) GetCourses(ctx context.Context, req *pb.GetCoursesRequest) (*pb.Courses, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Course, &models.Course{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	containsAll(filters, "teacher_ids", req.GetCourse().GetTeacherIds())
+	containsAll(filters, "prerequisite_ids", req.GetCourse().GetPrerequisiteIds())
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	courses, err := s.Extended.GetCoursesFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.Courses{Courses: courses}, nil
+}
+
+func (s *Server) UpdateCourses(ctx context.Context, req *pb.UpdateCoursesRequest) (*pb.Courses, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Course{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var teacherIds, prerequisiteIds []string
+	for _, course := range req.GetCourses() {
+		if course.Credits < 0 {
+			return nil, status.Error(codes.InvalidArgument, "credits cannot be negative")
+		}
+		if slices.Contains(course.PrerequisiteIds, course.Id) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("course %s cannot be its own prerequisite", course.Id))
+		}
+		if writesField(req.GetUpdateMask(), "teacher_ids") {
+			teacherIds = append(teacherIds, course.TeacherIds...)
+		}
+		if writesField(req.GetUpdateMask(), "prerequisite_ids") {
+			prerequisiteIds = append(prerequisiteIds, course.PrerequisiteIds...)
+		}
+	}
+
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkCoursesExist(ctx, prerequisiteIds)
+	if err != nil {
+		return nil, err
+	}
+	if writesField(req.GetUpdateMask(), "prerequisite_ids") {
+		err = s.checkPrerequisiteCycles(ctx, req.GetCourses())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updatedCourses, err := s.Extended.ModifyCoursesInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Courses{Courses: updatedCourses}, nil
+}
+
+func (s *Server) DeleteCourses(ctx context.Context, req *pb.DeleteCoursesRequest) (*pb.DeleteCoursesConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteCoursesFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteCoursesConfirmation{
+		Status:     "Courses successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) AddCourseSections(ctx context.Context, req *pb.AddCourseSectionsRequest) (*pb.AddCourseSectionsResponse, error) {
+	var teacherIds, courseIds []string
+	for _, section := range req.GetSections() {
+		if section.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if section.CourseId == "" || section.Term == "" {
+			return nil, status.Error(codes.InvalidArgument, "course_id and term are required")
+		}
+		if section.Capacity < 0 {
+			return nil, status.Error(codes.InvalidArgument, "capacity cannot be negative")
+		}
+		if section.StartsAt != nil && section.EndsAt != nil && !section.EndsAt.AsTime().After(section.StartsAt.AsTime()) {
+			return nil, status.Error(codes.InvalidArgument, "ends_at has to be after starts_at")
+		}
+		teacherIds = append(teacherIds, section.TeacherIds...)
+		courseIds = append(courseIds, section.CourseId)
+	}
+
+	err := s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkCoursesExist(ctx, courseIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedSections, results, err := s.Extended.AddCourseSectionsToDb(ctx, req.GetSections(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddCourseSectionsResponse{Sections: addedSections, Results: results}, nil
+}
+
+func (s *Server) GetCourseSections(ctx context.Context, req *pb.GetCourseSectionsRequest) (*pb.CourseSections, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Section, &models.CourseSection{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	containsAll(filters, "teacher_ids", req.GetSection().GetTeacherIds())
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	sections, err := s.Extended.GetCourseSectionsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.CourseSections{Sections: sections}, nil
+}
+
+func (s *Server) DeleteCourseSections(ctx context.Context, req *pb.DeleteCourseSectionsRequest) (*pb.DeleteCourseSectionsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteCourseSectionsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteCourseSectionsConfirmation{
+		Status:     "Course sections successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) EnrollStudents(ctx context.Context, req *pb.EnrollStudentsRequest) (*pb.EnrollStudentsResponse, error) {
+	err := s.checkEnrollmentRequest(ctx, req.GetSectionId(), req.GetStudentIds())
+	if err != nil {
+		return nil, err
+	}
+
+	enrollments, results, err := s.Extended.EnrollStudentsInDB(ctx, req.GetSectionId(), req.GetStudentIds(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.EnrollStudentsResponse{Enrollments: enrollments, Results: results}, nil
+}
+
+func (s *Server) DropStudents(ctx context.Context, req *pb.DropStudentsRequest) (*pb.Enrollments, error) {
+	if req.GetSectionId() == "" || len(req.GetStudentIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "section_id and student_ids are required")
+	}
+	_, err := parseObjectIds(append([]string{req.GetSectionId()}, req.GetStudentIds()...))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dropped, err := s.Extended.DropStudentsInDB(ctx, req.GetSectionId(), req.GetStudentIds(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Enrollments{Enrollments: dropped}, nil
+}
+
+func (s *Server) GetEnrollments(ctx context.Context, req *pb.GetEnrollmentsRequest) (*pb.Enrollments, error) {
+	filters := bson.M{}
+	if req.GetStudentId() != "" {
+		filters["student_id"] = req.GetStudentId()
+	}
+	if req.GetSectionId() != "" {
+		filters["section_id"] = req.GetSectionId()
+	}
+	if req.GetCourseId() != "" {
+		filters["course_id"] = req.GetCourseId()
+	}
+	if len(filters) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "one of student_id, section_id and course_id is required")
+	}
+	if !req.GetIncludeDropped() {
+		filters["status"] = pb.EnrollmentStatus_ENROLLED.String()
+	}
+
+	enrollments, err := s.Extended.GetEnrollmentsFromDB(ctx, bson.D{{Key: "enrolled_at", Value: 1}}, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Enrollments{Enrollments: enrollments}, nil
+}
+
+func (s *Server) GetCourseRoster(ctx context.Context, req *pb.CourseRosterRequest) (*pb.CourseRoster, error) {
+	if req.GetCourseId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "course_id is required")
+	}
+	courseId, err := primitive.ObjectIDFromHex(req.GetCourseId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	courses, err := s.Extended.GetCoursesFromDB(ctx, nil, excludeDeleted(bson.M{"_id": courseId}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(courses) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no course found with ID: %s", req.GetCourseId()))
+	}
+
+	filters := bson.M{"course_id": req.GetCourseId(), "status": pb.EnrollmentStatus_ENROLLED.String()}
+	if req.GetSectionId() != "" {
+		filters["section_id"] = req.GetSectionId()
+	}
+	if req.GetTerm() != "" {
+		sections, err := s.Extended.GetCourseSectionsFromDB(ctx, nil, excludeDeleted(bson.M{"course_id": req.GetCourseId(), "term": req.GetTerm()}, false))
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		var sectionIds []string
+		for _, section := range sections {
+			if req.GetSectionId() == "" || section.Id == req.GetSectionId() {
+				sectionIds = append(sectionIds, section.Id)
+			}
+		}
+		// The course has no section in the term, so nobody is enrolled in it
+		if len(sectionIds) == 0 {
+			return &pb.CourseRoster{}, nil
+		}
+		filters["section_id"] = bson.M{"$in": sectionIds}
+	}
+
+	enrollments, err := s.Extended.GetEnrollmentsFromDB(ctx, bson.D{{Key: "enrolled_at", Value: 1}}, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	var studentIds []string
+	for _, enrollment := range enrollments {
+		studentIds = append(studentIds, enrollment.StudentId)
+	}
+	objIds, err := parseObjectIds(studentIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	roster := &pb.CourseRoster{}
+	if len(objIds) == 0 {
+		return roster, nil
+	}
+	students, err := s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false), repositories.Page{})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	byId := map[string]*pb.Student{}
+	for _, student := range students {
+		byId[student.Id] = student
+	}
+	for _, enrollment := range enrollments {
+		// Deleted students drop out of the roster but keep their enrollment
+		student, ok := byId[enrollment.StudentId]
+		if !ok {
+			continue
+		}
+		roster.Entries = append(roster.Entries, &pb.CourseRosterEntry{Student: student, SectionId: enrollment.SectionId, EnrolledAt: enrollment.EnrolledAt})
+	}
+	return roster, nil
+}
+
+// checkEnrollmentRequest fails when the section or one of the students is missing or has been deleted
+func (s *Server) checkEnrollmentRequest(ctx context.Context, sectionId string, studentIds []string) error {
+	if sectionId == "" || len(studentIds) == 0 {
+		return status.Error(codes.InvalidArgument, "section_id and student_ids are required")
+	}
+	_, err := parseObjectIds([]string{sectionId})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	objIds, err := parseObjectIds(studentIds)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	students, err := s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false), repositories.Page{})
+	if err != nil {
+		return repositoryError(err)
+	}
+	for _, objId := range objIds {
+		if !slices.ContainsFunc(students, func(student *pb.Student) bool { return student.Id == objId.Hex() }) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("no student found with ID: %s", objId.Hex()))
+		}
+	}
+	return nil
+}
+
+// checkCoursesExist fails when one of the non-empty IDs does not belong to a course that has not been deleted
+func (s *Server) checkCoursesExist(ctx context.Context, ids []string) error {
+	objIds, err := parseObjectIds(slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == "" }))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(objIds) == 0 {
+		return nil
+	}
+
+	courses, err := s.Extended.GetCoursesFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": objIds}}, false))
+	if err != nil {
+		return repositoryError(err)
+	}
+	for _, objId := range objIds {
+		if !slices.ContainsFunc(courses, func(course *pb.Course) bool { return course.Id == objId.Hex() }) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("no course found with ID: %s", objId.Hex()))
+		}
+	}
+	return nil
+}
+
+// checkPrerequisiteCycles fails when the new prerequisites of the courses would make a course a prerequisite of itself,
+// directly or through other courses. The stored catalogue is walked with the prerequisites of the request in place
+func (s *Server) checkPrerequisiteCycles(ctx context.Context, updates []*pb.Course) error {
+	courses, err := s.Extended.GetCoursesFromDB(ctx, nil, excludeDeleted(bson.M{}, false))
+	if err != nil {
+		return repositoryError(err)
+	}
+	prerequisites := map[string][]string{}
+	for _, course := range courses {
+		prerequisites[course.Id] = course.PrerequisiteIds
+	}
+	for _, course := range updates {
+		prerequisites[course.Id] = course.PrerequisiteIds
+	}
+
+	// Courses whose prerequisites were walked completely without finding a cycle
+	done := map[string]bool{}
+	var path []string
+	var walk func(id string) []string
+	walk = func(id string) []string {
+		if start := slices.Index(path, id); start >= 0 {
+			return append(slices.Clone(path[start:]), id)
+		}
+		if done[id] {
+			return nil
+		}
+		path = append(path, id)
+		for _, prerequisite := range prerequisites[id] {
+			if cycle := walk(prerequisite); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		done[id] = true
+		return nil
+	}
+
+	for _, course := range updates {
+		if cycle := walk(course.Id); cycle != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("prerequisites cannot form a cycle: %s", strings.Join(cycle, " -> ")))
+		}
+	}
+	return nil
+}
+
+// containsAll makes a list filter match records listing every one of the IDs instead of exactly those IDs
+func containsAll(filters bson.M, field string, ids []string) {
+	if len(ids) > 0 {
+		filters[field] = bson.M{"$all": ids}
+	}
+}
@@ -3,7 +3,7 @@ package handlers
 import (
 	"ClassConnectRPC/internals/api/interceptors"
 	"ClassConnectRPC/internals/models"
-	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/internals/repositories/cache"
 	"ClassConnectRPC/pkg/utils"
 	pb "ClassConnectRPC/proto/gen"
 	"context"
@@ -11,24 +11,26 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s *Server) AddExecs(ctx context.Context, req *pb.Execs) (*pb.Execs, error) {
+func (s *Server) AddExecs(ctx context.Context, req *pb.AddExecsRequest) (*pb.AddExecsResponse, error) {
 	for _, exec := range req.GetExecs() {
 		if exec.Id != "" {
 			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
 		}
 	}
 
-	addedExecs, err := mongodb.AddExecsToDb(ctx, req.GetExecs())
+	addedExecs, results, err := s.Repo.AddExecsToDb(ctx, req.GetExecs(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repositoryError(err)
 	}
 
-	return &pb.Execs{Execs: addedExecs}, nil
+	return &pb.AddExecsResponse{Execs: addedExecs, Results: results}, nil
 }
 
 func (s *Server) GetExecs(ctx context.Context, req *pb.GetExecsRequest) (*pb.Execs, error) {
@@ -44,11 +46,17 @@ func (s *Server) GetExecs(ctx context.Context, req *pb.GetExecsRequest) (*pb.Exe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Adding the time range filters
+	filters, err = addTimestampFilters(filters, req.GetTimeFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Getting all the sorting options
 	sortOptions := buildSortOptions(req.SortBy)
 
 	// Querying the database
-	execs, err := mongodb.GetExecsFromDB(ctx, sortOptions, filters)
+	execs, err := s.Repo.GetExecsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -57,17 +65,35 @@ func (s *Server) GetExecs(ctx context.Context, req *pb.GetExecsRequest) (*pb.Exe
 
 }
 
-func (s *Server) UpdateExecs(ctx context.Context, req *pb.Execs) (*pb.Execs, error) {
-	updatedExecs, err := mongodb.ModifyExecsInDB(ctx, req)
+func (s *Server) UpdateExecs(ctx context.Context, req *pb.UpdateExecsRequest) (*pb.Execs, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Exec{})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Clearing a password through the mask would lock the exec out
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		if path != "password" {
+			continue
+		}
+		for _, exec := range req.GetExecs() {
+			if exec.Password == "" {
+				return nil, status.Error(codes.InvalidArgument, "password cannot be cleared")
+			}
+		}
+	}
+
+	updatedExecs, err := s.Repo.ModifyExecsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
 	}
 	return &pb.Execs{Execs: updatedExecs}, nil
 }
 
-func (s *Server) DeleteExecs(ctx context.Context, req *pb.ExecIds) (*pb.DeleteExecsConfirmation, error) {
+func (s *Server) DeleteExecs(ctx context.Context, req *pb.DeleteExecsRequest) (*pb.DeleteExecsConfirmation, error) {
 	ids := req.GetIds()
 	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
 	for _, v := range ids {
 		if v.Id == "" {
 			return nil, status.Error(codes.InvalidArgument, "ID field cannot be empty")
@@ -77,11 +103,12 @@ func (s *Server) DeleteExecs(ctx context.Context, req *pb.ExecIds) (*pb.DeleteEx
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
 	}
 
-	deletedIds, err := mongodb.DeleteExecsFromDB(ctx, objectIdsToDelete)
+	deletedIds, err := s.Repo.DeleteExecsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repositoryError(err)
 	}
 
 	return &pb.DeleteExecsConfirmation{
@@ -90,9 +117,31 @@ func (s *Server) DeleteExecs(ctx context.Context, req *pb.ExecIds) (*pb.DeleteEx
 	}, nil
 }
 
+func (s *Server) RestoreExecs(ctx context.Context, req *pb.ExecIds) (*pb.RestoreExecsConfirmation, error) {
+	var ids []string
+	for _, v := range req.GetIds() {
+		ids = append(ids, v.Id)
+	}
+
+	objIds, err := parseObjectIds(ids)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	restoredIds, err := s.Repo.RestoreExecsInDB(ctx, objIds, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.RestoreExecsConfirmation{
+		Status:      "Execs successfully restored",
+		RestoredIds: restoredIds,
+	}, nil
+}
+
 func (s *Server) Login(ctx context.Context, req *pb.ExecLoginRequest) (*pb.ExecLoginResponse, error) {
 
-	exec, err := mongodb.GetUserByUsername(ctx, req.GetUsername())
+	exec, err := s.Repo.GetUserByUsername(ctx, req.GetUsername())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -115,7 +164,7 @@ func (s *Server) Login(ctx context.Context, req *pb.ExecLoginRequest) (*pb.ExecL
 }
 
 func (s *Server) UpdatePassword(ctx context.Context, req *pb.UpdatePasswordRequest) (*pb.UpdatePasswordResponse, error) {
-	exec, err := mongodb.UpdateUserInDB(ctx, req)
+	exec, err := s.Repo.UpdateUserInDB(ctx, req, actorFromContext(ctx))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -142,13 +191,31 @@ func (s *Server) DeactivateUser(ctx context.Context, req *pb.ExecIds) (*pb.Confi
 		objIds = append(objIds, objId)
 	}
 
-	result, err := mongodb.DeactivateUserInDB(ctx, objIds)
+	result, err := s.Repo.DeactivateUserInDB(ctx, objIds, actorFromContext(ctx))
 	if err != nil {
 		return result, err
 	}
 	return &pb.Confirmation{Confirmation: true}, nil
 }
 
+func (s *Server) GetCacheStats(ctx context.Context, req *pb.EmptyRequest) (*pb.CacheStats, error) {
+	// Both cache.Repository and cache.Extended report their stats
+	cached, ok := s.Repo.(interface{ Stats() cache.Stats })
+	if !ok {
+		return &pb.CacheStats{Enabled: false}, nil
+	}
+
+	stats := cached.Stats()
+	return &pb.CacheStats{
+		Enabled:       true,
+		Hits:          stats.Hits,
+		Misses:        stats.Misses,
+		Bypasses:      stats.Bypasses,
+		Invalidations: stats.Invalidations,
+		Entries:       stats.Entries,
+	}, nil
+}
+
 func (s *Server) Logout(ctx context.Context, req *pb.EmptyRequest) (*pb.ExecLogoutResponse, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -182,3 +249,24 @@ func (s *Server) Logout(ctx context.Context, req *pb.EmptyRequest) (*pb.ExecLogo
 		LoggedOut: true,
 	}, nil
 }
+
+func (s *Server) WatchExecs(req *pb.WatchExecsRequest, stream grpc.ServerStreamingServer[pb.ExecEvent]) error {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Filter, &models.Exec{})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.Repo.WatchExecsInDB(stream.Context(), filters, req.GetResumeToken(), func(changeType pb.ChangeType, exec *pb.Exec, resumeToken string, occurredAt time.Time) error {
+		return stream.Send(&pb.ExecEvent{
+			Type:        changeType,
+			Exec:        exec,
+			ResumeToken: resumeToken,
+			OccurredAt:  timestamppb.New(occurredAt),
+		})
+	})
+	if err != nil {
+		return repositoryError(err)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Exports are sent to the client in chunks of about this size
+const exportChunkSize = 64 << 10
+
+// Fields of an exec that are never exported, filtered or sorted on by an export
+var execCredentialFields = []string{"password", "password_reset_token", "password_token_expires"}
+
+// Fields of a student that are not stored with the student and cannot be written to a file column
+var studentRelationFields = []string{"guardians"}
+
+func (s *Server) ExportStudents(req *pb.ExportStudentsRequest, stream grpc.ServerStreamingServer[pb.ExportChunk]) error {
+	query := req.GetQuery()
+	filters, err := buildFilterForModel(query.GetStudent(), &models.Student{})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	filters, err = addTimestampFilters(filters, query.GetTimeFilters())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	sortOptions := buildSortOptions(query.GetSortBy())
+	page := repositories.Page{Number: query.GetPageNumber(), Size: query.GetPageSize()}
+
+	return runExport(stream, req.GetFormat(), req.GetColumns(), studentRelationFields, &pb.Student{}, func(fn func(*pb.Student) error) error {
+		return s.Repo.StreamStudentsFromDB(stream.Context(), sortOptions, excludeDeleted(filters, query.GetIncludeDeleted()), page, fn)
+	})
+}
+
+func (s *Server) ExportTeachers(req *pb.ExportTeachersRequest, stream grpc.ServerStreamingServer[pb.ExportChunk]) error {
+	query := req.GetQuery()
+	filters, err := buildFilterForModel(query.GetTeacher(), &models.Teacher{})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	filters, err = addTimestampFilters(filters, query.GetTimeFilters())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	sortOptions := buildSortOptions(query.GetSortBy())
+
+	return runExport(stream, req.GetFormat(), req.GetColumns(), nil, &pb.Teacher{}, func(fn func(*pb.Teacher) error) error {
+		return s.Repo.StreamTeachersFromDB(stream.Context(), sortOptions, excludeDeleted(filters, query.GetIncludeDeleted()), fn)
+	})
+}
+
+func (s *Server) ExportExecs(req *pb.ExportExecsRequest, stream grpc.ServerStreamingServer[pb.ExportChunk]) error {
+	query := req.GetQuery()
+
+	// Filtering or sorting on the credentials would leak them one comparison at a time
+	for _, field := range execCredentialFields {
+		if query.GetExec() != nil && stringField(query.GetExec(), field) != "" {
+			return status.Errorf(codes.InvalidArgument, "cannot filter an export by field: %s", field)
+		}
+	}
+	for _, sortField := range query.GetSortBy() {
+		if slices.Contains(execCredentialFields, sortField.GetField()) {
+			return status.Errorf(codes.InvalidArgument, "cannot sort an export by field: %s", sortField.GetField())
+		}
+	}
+
+	filters, err := buildFilterForModel(query.GetExec(), &models.Exec{})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	filters, err = addTimestampFilters(filters, query.GetTimeFilters())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	sortOptions := buildSortOptions(query.GetSortBy())
+
+	return runExport(stream, req.GetFormat(), req.GetColumns(), execCredentialFields, &pb.Exec{}, func(fn func(*pb.Exec) error) error {
+		return s.Repo.StreamExecsFromDB(stream.Context(), sortOptions, excludeDeleted(filters, query.GetIncludeDeleted()), fn)
+	})
+}
+
+// runExport writes every record handed over by query to the stream, encoded in the given format
+func runExport[P proto.Message](stream grpc.ServerStreamingServer[pb.ExportChunk], format pb.ImportFormat, columns []string, excluded []string, message P, query func(fn func(P) error) error) error {
+	fields, err := exportColumns(message.ProtoReflect().Descriptor(), columns, excluded)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	writer, err := newExportWriter(stream, format, fields)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Errors of the stream itself are returned as they are, the client is most likely gone
+	var sendErr error
+	err = query(func(record P) error {
+		sendErr = writer.write(record)
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return repositoryError(err)
+	}
+	return writer.flush()
+}
+
+// exportColumns resolves the requested columns to fields of the message, all fields are used when none are requested
+func exportColumns(descriptor protoreflect.MessageDescriptor, columns []string, excluded []string) ([]protoreflect.FieldDescriptor, error) {
+	var fields []protoreflect.FieldDescriptor
+	if len(columns) == 0 {
+		for i := 0; i < descriptor.Fields().Len(); i++ {
+			field := descriptor.Fields().Get(i)
+			if !slices.Contains(excluded, string(field.Name())) {
+				fields = append(fields, field)
+			}
+		}
+		return fields, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, column := range columns {
+		field := descriptor.Fields().ByName(protoreflect.Name(column))
+		if field == nil {
+			return nil, fmt.Errorf("unknown column: %s", column)
+		}
+		if slices.Contains(excluded, column) {
+			return nil, fmt.Errorf("column cannot be exported: %s", column)
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("duplicate column: %s", column)
+		}
+		seen[column] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// exportWriter encodes records into a buffer and sends the buffer whenever it holds a full chunk
+type exportWriter struct {
+	stream  grpc.ServerStreamingServer[pb.ExportChunk]
+	format  pb.ImportFormat
+	columns []protoreflect.FieldDescriptor
+	buf     bytes.Buffer
+	csv     *csv.Writer
+}
+
+func newExportWriter(stream grpc.ServerStreamingServer[pb.ExportChunk], format pb.ImportFormat, columns []protoreflect.FieldDescriptor) (*exportWriter, error) {
+	w := &exportWriter{stream: stream, format: format, columns: columns}
+	switch format {
+	case pb.ImportFormat_CSV:
+		w.csv = csv.NewWriter(&w.buf)
+		header := make([]string, len(columns))
+		for i, column := range columns {
+			header[i] = string(column.Name())
+		}
+		w.csv.Write(header)
+		w.csv.Flush()
+		return w, w.csv.Error()
+	case pb.ImportFormat_NDJSON:
+		return w, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %v", format)
+	}
+}
+
+func (w *exportWriter) write(record proto.Message) error {
+	message := record.ProtoReflect()
+	if w.csv != nil {
+		values := make([]string, len(w.columns))
+		for i, column := range w.columns {
+			if value := exportValue(message, column); value != nil {
+				values[i] = fmt.Sprint(value)
+			}
+		}
+		w.csv.Write(values)
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		// The object is written by hand so that its keys keep the order of the columns
+		w.buf.WriteByte('{')
+		for i, column := range w.columns {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			key, _ := json.Marshal(string(column.Name()))
+			value, err := json.Marshal(exportValue(message, column))
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			w.buf.Write(key)
+			w.buf.WriteByte(':')
+			w.buf.Write(value)
+		}
+		w.buf.WriteString("}\n")
+	}
+
+	if w.buf.Len() >= exportChunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// flush sends whatever is buffered, the data is copied since gRPC may still read a message after Send returns
+func (w *exportWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	data := bytes.Clone(w.buf.Bytes())
+	w.buf.Reset()
+	return w.stream.Send(&pb.ExportChunk{Data: data})
+}
+
+// exportValue returns the value of a field as a string, int64 or bool, and nil for an unset timestamp
+func exportValue(message protoreflect.Message, field protoreflect.FieldDescriptor) any {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return message.Get(field).String()
+	case protoreflect.Int64Kind:
+		return message.Get(field).Int()
+	case protoreflect.BoolKind:
+		return message.Get(field).Bool()
+	case protoreflect.MessageKind:
+		if !message.Has(field) {
+			return nil
+		}
+		if timestamp, ok := message.Get(field).Message().Interface().(*timestamppb.Timestamp); ok {
+			return timestamp.AsTime().UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return nil
+}
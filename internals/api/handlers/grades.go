@@ -0,0 +1,519 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultGradingScale is used until a grading scale has been set
+var defaultGradingScale = []*pb.GradeBand{
+	{Letter: "A", MinPercentage: 90, GradePoints: 4},
+	{Letter: "B", MinPercentage: 80, GradePoints: 3},
+	{Letter: "C", MinPercentage: 70, GradePoints: 2},
+	{Letter: "D", MinPercentage: 60, GradePoints: 1},
+	{Letter: "F", MinPercentage: 0, GradePoints: 0},
+}
+
+func (s *Server) AddAssessments(ctx context.Context, req *pb.AddAssessmentsRequest) (*pb.AddAssessmentsResponse, error) {
+	var classIds, courseIds []string
+	for _, assessment := range req.GetAssessments() {
+		if assessment.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		err := validateGradebook(assessment.ClassId, assessment.Subject, assessment.CourseId, assessment.Term)
+		if err != nil {
+			return nil, err
+		}
+		if assessment.Title == "" || assessment.Category == "" {
+			return nil, status.Error(codes.InvalidArgument, "title and category are required")
+		}
+		if assessment.MaxScore <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "max_score has to be positive")
+		}
+		if _, ok := pb.AssessmentType_name[int32(assessment.Type)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown type: %d", assessment.Type))
+		}
+		classIds = append(classIds, assessment.ClassId)
+		courseIds = append(courseIds, assessment.CourseId)
+	}
+
+	err := s.checkClasses(ctx, classIds, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkCoursesExist(ctx, courseIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedAssessments, results, err := s.Extended.AddAssessmentsToDb(ctx, req.GetAssessments(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddAssessmentsResponse{Assessments: addedAssessments, Results: results}, nil
+}
+
+func (s *Server) GetAssessments(ctx context.Context, req *pb.GetAssessmentsRequest) (*pb.Assessments, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Assessment, &models.Assessment{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	assessments, err := s.Extended.GetAssessmentsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.Assessments{Assessments: assessments}, nil
+}
+
+func (s *Server) UpdateAssessments(ctx context.Context, req *pb.UpdateAssessmentsRequest) (*pb.Assessments, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Assessment{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// The scores of an assessment belong to its gradebook, so it stays in it
+	for _, field := range []string{"class_id", "subject", "course_id"} {
+		if slices.Contains(req.GetUpdateMask().GetPaths(), field) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s of an assessment cannot be changed", field))
+		}
+	}
+
+	for _, assessment := range req.GetAssessments() {
+		if assessment.ClassId != "" || assessment.Subject != "" || assessment.CourseId != "" {
+			return nil, status.Error(codes.InvalidArgument, "class_id, subject and course_id of an assessment cannot be changed")
+		}
+		if assessment.MaxScore < 0 || (assessment.MaxScore == 0 && slices.Contains(req.GetUpdateMask().GetPaths(), "max_score")) {
+			return nil, status.Error(codes.InvalidArgument, "max_score has to be positive")
+		}
+		if slices.Contains(req.GetUpdateMask().GetPaths(), "term") && assessment.Term == "" {
+			return nil, status.Error(codes.InvalidArgument, "term cannot be cleared")
+		}
+		if _, ok := pb.AssessmentType_name[int32(assessment.Type)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown type: %d", assessment.Type))
+		}
+	}
+
+	updatedAssessments, err := s.Extended.ModifyAssessmentsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Assessments{Assessments: updatedAssessments}, nil
+}
+
+func (s *Server) DeleteAssessments(ctx context.Context, req *pb.DeleteAssessmentsRequest) (*pb.DeleteAssessmentsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteAssessmentsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteAssessmentsConfirmation{
+		Status:     "Assessments successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) RecordScores(ctx context.Context, req *pb.RecordScoresRequest) (*pb.RecordScoresResponse, error) {
+	if len(req.GetEntries()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "entries are required")
+	}
+	objId, err := primitive.ObjectIDFromHex(req.GetAssessmentId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	assessments, err := s.Extended.GetAssessmentsFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objId}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(assessments) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no assessment found with ID: %s", req.GetAssessmentId()))
+	}
+	assessment := assessments[0]
+
+	var studentIds []string
+	for _, entry := range req.GetEntries() {
+		if entry.Score < 0 || entry.Score > assessment.MaxScore {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("the score of student %s has to be between 0 and %g", entry.StudentId, assessment.MaxScore))
+		}
+		studentIds = append(studentIds, entry.StudentId)
+	}
+	if assessment.ClassId != "" {
+		err = s.checkClassMembers(ctx, assessment.ClassId, studentIds)
+	} else {
+		err = s.checkCourseStudents(ctx, assessment.CourseId, assessment.Term, studentIds)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scores, results, err := s.Extended.RecordScoresInDB(ctx, req.GetAssessmentId(), req.GetEntries(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.RecordScoresResponse{Scores: scores, Results: results}, nil
+}
+
+func (s *Server) GetScores(ctx context.Context, req *pb.GetScoresRequest) (*pb.Scores, error) {
+	filters := bson.M{}
+	if req.GetAssessmentId() != "" {
+		filters["assessment_id"] = req.GetAssessmentId()
+	}
+	if req.GetStudentId() != "" {
+		filters["student_id"] = req.GetStudentId()
+	}
+	if len(filters) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "one of assessment_id and student_id is required")
+	}
+
+	scores, err := s.Extended.GetScoresFromDB(ctx, bson.D{{Key: "assessment_id", Value: 1}, {Key: "student_id", Value: 1}}, filters)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Scores{Scores: scores}, nil
+}
+
+func (s *Server) SetCategoryWeights(ctx context.Context, req *pb.CategoryWeights) (*pb.CategoryWeights, error) {
+	err := validateGradebook(req.GetClassId(), req.GetSubject(), req.GetCourseId(), req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+	for category, weight := range req.GetWeights() {
+		if category == "" || weight < 0 {
+			return nil, status.Error(codes.InvalidArgument, "weights need a category and cannot be negative")
+		}
+	}
+	err = s.checkClasses(ctx, []string{req.GetClassId()}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkCoursesExist(ctx, []string{req.GetCourseId()})
+	if err != nil {
+		return nil, err
+	}
+
+	weights, err := s.Extended.SetCategoryWeightsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return weights, nil
+}
+
+func (s *Server) GetCategoryWeights(ctx context.Context, req *pb.GetCategoryWeightsRequest) (*pb.CategoryWeights, error) {
+	err := validateGradebook(req.GetClassId(), req.GetSubject(), req.GetCourseId(), req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.Extended.GetCategoryWeightsFromDB(ctx, bson.M{"class_id": req.GetClassId(), "subject": req.GetSubject(), "course_id": req.GetCourseId(), "term": req.GetTerm()})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(found) == 0 {
+		return &pb.CategoryWeights{ClassId: req.GetClassId(), Subject: req.GetSubject(), CourseId: req.GetCourseId(), Term: req.GetTerm()}, nil
+	}
+	return found[0], nil
+}
+
+func (s *Server) SetGradingScale(ctx context.Context, req *pb.GradingScale) (*pb.GradingScale, error) {
+	bands := slices.Clone(req.GetBands())
+	if len(bands) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "bands are required")
+	}
+	letters := map[string]bool{}
+	minimums := map[float64]bool{}
+	for _, band := range bands {
+		if band.Letter == "" || letters[band.Letter] {
+			return nil, status.Error(codes.InvalidArgument, "every band needs a letter of its own")
+		}
+		if band.MinPercentage < 0 || band.MinPercentage > 100 || minimums[band.MinPercentage] {
+			return nil, status.Error(codes.InvalidArgument, "min_percentage has to be between 0 and 100 and differ between bands")
+		}
+		if band.GradePoints < 0 {
+			return nil, status.Error(codes.InvalidArgument, "grade_points cannot be negative")
+		}
+		letters[band.Letter] = true
+		minimums[band.MinPercentage] = true
+	}
+	if !minimums[0] {
+		return nil, status.Error(codes.InvalidArgument, "a band has to start at 0 so every average gets a letter")
+	}
+	sort.Slice(bands, func(i, j int) bool { return bands[i].MinPercentage > bands[j].MinPercentage })
+
+	scale, err := s.Extended.SetGradingScaleInDB(ctx, &pb.GradingScale{Bands: bands}, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return scale, nil
+}
+
+func (s *Server) GetGradingScale(ctx context.Context, req *pb.EmptyRequest) (*pb.GradingScale, error) {
+	return s.gradingScale(ctx)
+}
+
+func (s *Server) GetStudentGrades(ctx context.Context, req *pb.StudentGradesRequest) (*pb.StudentGrades, error) {
+	if req.GetStudentId() == "" || req.GetTerm() == "" {
+		return nil, status.Error(codes.InvalidArgument, "student_id and term are required")
+	}
+
+	assessments, err := s.Extended.GetAssessmentsFromDB(ctx, nil, excludeDeleted(bson.M{"term": req.GetTerm()}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	byId := map[string]*pb.Assessment{}
+	// Never nil, a nil list in $in is rejected by mongodb rather than matching nothing
+	assessmentIds := []string{}
+	for _, assessment := range assessments {
+		byId[assessment.Id] = assessment
+		assessmentIds = append(assessmentIds, assessment.Id)
+	}
+	scores, err := s.Extended.GetScoresFromDB(ctx, nil, bson.M{"student_id": req.GetStudentId(), "assessment_id": bson.M{"$in": assessmentIds}})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	allWeights, err := s.Extended.GetCategoryWeightsFromDB(ctx, bson.M{"term": req.GetTerm()})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	var courseIds []string
+	for _, score := range scores {
+		if courseId := byId[score.AssessmentId].CourseId; courseId != "" && !slices.Contains(courseIds, courseId) {
+			courseIds = append(courseIds, courseId)
+		}
+	}
+	credits, err := s.courseCredits(ctx, courseIds)
+	if err != nil {
+		return nil, err
+	}
+	scale, err := s.gradingScale(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &pb.StudentGrades{StudentId: req.GetStudentId(), Term: req.GetTerm()}
+	report.Subjects, report.Gpa = gradeSubjects(byId, scores, allWeights, credits, scale.GetBands())
+
+	locks, err := s.Extended.GetTermLocksFromDB(ctx)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	report.Finalized = slices.ContainsFunc(locks, func(lock *pb.TermLock) bool { return lock.Term == req.GetTerm() })
+	return report, nil
+}
+
+func (s *Server) FinalizeTerm(ctx context.Context, req *pb.TermRequest) (*pb.TermLock, error) {
+	if req.GetTerm() == "" {
+		return nil, status.Error(codes.InvalidArgument, "term is required")
+	}
+	lock, err := s.Extended.FinalizeTermInDB(ctx, req.GetTerm(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return lock, nil
+}
+
+func (s *Server) ReopenTerm(ctx context.Context, req *pb.TermRequest) (*pb.TermLock, error) {
+	if req.GetTerm() == "" {
+		return nil, status.Error(codes.InvalidArgument, "term is required")
+	}
+	lock, err := s.Extended.ReopenTermInDB(ctx, req.GetTerm())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return lock, nil
+}
+
+func (s *Server) GetTermLocks(ctx context.Context, req *pb.EmptyRequest) (*pb.TermLocks, error) {
+	locks, err := s.Extended.GetTermLocksFromDB(ctx)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.TermLocks{Locks: locks}, nil
+}
+
+// gradebookKey names the gradebook of a class subject or of a course
+// gradeSubjects works out the average, letter and grade points of every gradebook the scores fall into and the
+// credit weighted GPA. Without any weights every category counts the same, otherwise unweighted categories do not
+// count, and a gradebook none of whose categories count is left out. Class subjects count one credit, courses their
+// credits. The bands are checked in order, so they have to be sorted from the highest minimum percentage down
+func gradeSubjects(assessments map[string]*pb.Assessment, scores []*pb.Score, allWeights []*pb.CategoryWeights, credits map[string]int32, bands []*pb.GradeBand) ([]*pb.SubjectGrade, float64) {
+	// Points scored and possible per gradebook and category
+	type totals struct{ scored, possible float64 }
+	gradebooks := map[gradebookKey]map[string]*totals{}
+	for _, score := range scores {
+		assessment := assessments[score.AssessmentId]
+		key := gradebookKey{assessment.ClassId, assessment.Subject, assessment.CourseId}
+		if gradebooks[key] == nil {
+			gradebooks[key] = map[string]*totals{}
+		}
+		if gradebooks[key][assessment.Category] == nil {
+			gradebooks[key][assessment.Category] = &totals{}
+		}
+		gradebooks[key][assessment.Category].scored += score.Score
+		gradebooks[key][assessment.Category].possible += assessment.MaxScore
+	}
+
+	weightsOf := map[gradebookKey]map[string]float64{}
+	for _, weights := range allWeights {
+		weightsOf[gradebookKey{weights.ClassId, weights.Subject, weights.CourseId}] = weights.Weights
+	}
+
+	var subjects []*pb.SubjectGrade
+	var points, totalCredits float64
+	for key, categories := range gradebooks {
+		subject := &pb.SubjectGrade{ClassId: key.classId, Subject: key.subject, CourseId: key.courseId, Credits: 1}
+		if key.courseId != "" {
+			subject.Credits = credits[key.courseId]
+		}
+
+		weights := weightsOf[key]
+		var weighted, weightSum float64
+		for category, total := range categories {
+			weight := 1.0
+			if len(weights) > 0 {
+				weight = weights[category]
+			}
+			percentage := total.scored / total.possible * 100
+			subject.Categories = append(subject.Categories, &pb.CategoryAverage{Category: category, Percentage: percentage, Weight: weight})
+			weighted += weight * percentage
+			weightSum += weight
+		}
+		if weightSum == 0 {
+			continue
+		}
+		sort.Slice(subject.Categories, func(i, j int) bool { return subject.Categories[i].Category < subject.Categories[j].Category })
+
+		subject.Average = weighted / weightSum
+		for _, band := range bands {
+			if subject.Average >= band.MinPercentage {
+				subject.Letter = band.Letter
+				subject.GradePoints = band.GradePoints
+				break
+			}
+		}
+		points += subject.GradePoints * float64(subject.Credits)
+		totalCredits += float64(subject.Credits)
+		subjects = append(subjects, subject)
+	}
+	sort.Slice(subjects, func(i, j int) bool {
+		a, b := subjects[i], subjects[j]
+		return a.ClassId+"/"+a.Subject+"/"+a.CourseId < b.ClassId+"/"+b.Subject+"/"+b.CourseId
+	})
+
+	var gpa float64
+	if totalCredits > 0 {
+		gpa = points / totalCredits
+	}
+	return subjects, gpa
+}
+
+type gradebookKey struct {
+	classId, subject, courseId string
+}
+
+// validateGradebook checks that exactly one of a class subject and a course is named, together with a term
+func validateGradebook(classId, subject, courseId, term string) error {
+	if (classId == "") == (courseId == "") {
+		return status.Error(codes.InvalidArgument, "exactly one of class_id and course_id is required")
+	}
+	if classId != "" && subject == "" {
+		return status.Error(codes.InvalidArgument, "subject is required with class_id")
+	}
+	if term == "" {
+		return status.Error(codes.InvalidArgument, "term is required")
+	}
+	return nil
+}
+
+// gradingScale returns the grading scale that has been set, or the default one
+func (s *Server) gradingScale(ctx context.Context) (*pb.GradingScale, error) {
+	scale, err := s.Extended.GetGradingScaleFromDB(ctx)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if scale == nil {
+		return &pb.GradingScale{Bands: defaultGradingScale}, nil
+	}
+	return scale, nil
+}
+
+// courseCredits returns the credits of the courses, keyed by course ID
+func (s *Server) courseCredits(ctx context.Context, courseIds []string) (map[string]int32, error) {
+	var objIds []primitive.ObjectID
+	for _, id := range courseIds {
+		if objId, err := primitive.ObjectIDFromHex(id); err == nil {
+			objIds = append(objIds, objId)
+		}
+	}
+	credits := map[string]int32{}
+	if len(objIds) == 0 {
+		return credits, nil
+	}
+	courses, err := s.Extended.GetCoursesFromDB(ctx, nil, bson.M{"_id": bson.M{"$in": objIds}})
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	for _, course := range courses {
+		credits[course.Id] = course.Credits
+	}
+	return credits, nil
+}
+
+// checkCourseStudents fails when one of the students is not enrolled in a section of the course in the term
+func (s *Server) checkCourseStudents(ctx context.Context, courseId, term string, studentIds []string) error {
+	sections, err := s.Extended.GetCourseSectionsFromDB(ctx, nil, bson.M{"course_id": courseId, "term": term})
+	if err != nil {
+		return repositoryError(err)
+	}
+	// A term without sections leaves every student unenrolled, the empty list matches no enrollment
+	sectionIds := []string{}
+	for _, section := range sections {
+		sectionIds = append(sectionIds, section.Id)
+	}
+
+	enrollments, err := s.Extended.GetEnrollmentsFromDB(ctx, nil, bson.M{
+		"student_id": bson.M{"$in": studentIds},
+		"section_id": bson.M{"$in": sectionIds},
+		"status":     pb.EnrollmentStatus_ENROLLED.String(),
+	})
+	if err != nil {
+		return repositoryError(err)
+	}
+	for _, id := range studentIds {
+		if !slices.ContainsFunc(enrollments, func(enrollment *pb.Enrollment) bool { return enrollment.StudentId == id }) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("student %s is not enrolled in course %s in term %s", id, courseId, term))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	pb "ClassConnectRPC/proto/gen"
+	"math"
+	"testing"
+)
+
+// gradedAssessment is an assessment of a gradebook together with the score the student got on it
+type gradedAssessment struct {
+	classId, subject, courseId, category string
+	score, maxScore                      float64
+}
+
+func TestGradeSubjects(t *testing.T) {
+	cases := []struct {
+		name     string
+		graded   []gradedAssessment
+		weights  []*pb.CategoryWeights
+		credits  map[string]int32
+		subjects []*pb.SubjectGrade
+		gpa      float64
+	}{
+		{
+			name: "without weights every category counts the same",
+			graded: []gradedAssessment{
+				{classId: "class", subject: "math", category: "homework", score: 8, maxScore: 10},
+				{classId: "class", subject: "math", category: "exams", score: 45, maxScore: 50},
+			},
+			subjects: []*pb.SubjectGrade{{ClassId: "class", Subject: "math", Average: 85, Letter: "B", GradePoints: 3, Credits: 1}},
+			gpa:      3,
+		},
+		{
+			name: "categories without a weight count 0 once a weight is set",
+			graded: []gradedAssessment{
+				{classId: "class", subject: "math", category: "homework", score: 2, maxScore: 10},
+				{classId: "class", subject: "math", category: "quizzes", score: 1, maxScore: 10},
+				{classId: "class", subject: "math", category: "exams", score: 46, maxScore: 50},
+			},
+			weights:  []*pb.CategoryWeights{{ClassId: "class", Subject: "math", Weights: map[string]float64{"exams": 2, "quizzes": 0}}},
+			subjects: []*pb.SubjectGrade{{ClassId: "class", Subject: "math", Average: 92, Letter: "A", GradePoints: 4, Credits: 1}},
+			gpa:      4,
+		},
+		{
+			name: "weights of another gradebook do not apply",
+			graded: []gradedAssessment{
+				{classId: "class", subject: "math", category: "homework", score: 7, maxScore: 10},
+				{classId: "class", subject: "math", category: "exams", score: 9, maxScore: 10},
+			},
+			weights:  []*pb.CategoryWeights{{ClassId: "class", Subject: "art", Weights: map[string]float64{"exams": 1}}},
+			subjects: []*pb.SubjectGrade{{ClassId: "class", Subject: "math", Average: 80, Letter: "B", GradePoints: 3, Credits: 1}},
+			gpa:      3,
+		},
+		{
+			name: "a gradebook whose categories all weigh 0 is left out",
+			graded: []gradedAssessment{
+				{classId: "class", subject: "math", category: "homework", score: 10, maxScore: 10},
+			},
+			weights: []*pb.CategoryWeights{{ClassId: "class", Subject: "math", Weights: map[string]float64{"homework": 0}}},
+			gpa:     0,
+		},
+		{
+			name: "a course without credits is graded but does not count towards the GPA",
+			graded: []gradedAssessment{
+				{courseId: "free", category: "exams", score: 10, maxScore: 10},
+				{classId: "class", subject: "math", category: "exams", score: 7, maxScore: 10},
+			},
+			credits: map[string]int32{"free": 0},
+			subjects: []*pb.SubjectGrade{
+				{CourseId: "free", Average: 100, Letter: "A", GradePoints: 4, Credits: 0},
+				{ClassId: "class", Subject: "math", Average: 70, Letter: "C", GradePoints: 2, Credits: 1},
+			},
+			gpa: 2,
+		},
+		{
+			name: "only courses without credits leave the GPA at 0",
+			graded: []gradedAssessment{
+				{courseId: "free", category: "exams", score: 10, maxScore: 10},
+			},
+			credits:  map[string]int32{"free": 0},
+			subjects: []*pb.SubjectGrade{{CourseId: "free", Average: 100, Letter: "A", GradePoints: 4, Credits: 0}},
+			gpa:      0,
+		},
+		{
+			name: "the GPA weighs courses by their credits",
+			graded: []gradedAssessment{
+				{courseId: "major", category: "exams", score: 95, maxScore: 100},
+				{courseId: "minor", category: "exams", score: 75, maxScore: 100},
+			},
+			credits: map[string]int32{"major": 3, "minor": 1},
+			subjects: []*pb.SubjectGrade{
+				{CourseId: "major", Average: 95, Letter: "A", GradePoints: 4, Credits: 3},
+				{CourseId: "minor", Average: 75, Letter: "C", GradePoints: 2, Credits: 1},
+			},
+			gpa: 3.5,
+		},
+		{
+			name: "an average on the minimum of a band gets that band",
+			graded: []gradedAssessment{
+				{classId: "class", subject: "a", category: "exams", score: 90, maxScore: 100},
+				{classId: "class", subject: "d", category: "exams", score: 60, maxScore: 100},
+				{classId: "class", subject: "f", category: "exams", score: 0, maxScore: 100},
+			},
+			subjects: []*pb.SubjectGrade{
+				{ClassId: "class", Subject: "a", Average: 90, Letter: "A", GradePoints: 4, Credits: 1},
+				{ClassId: "class", Subject: "d", Average: 60, Letter: "D", GradePoints: 1, Credits: 1},
+				{ClassId: "class", Subject: "f", Average: 0, Letter: "F", GradePoints: 0, Credits: 1},
+			},
+			gpa: 5.0 / 3,
+		},
+		{
+			name: "an average just below the minimum of a band gets the band below",
+			graded: []gradedAssessment{
+				{classId: "class", subject: "b", category: "exams", score: 8999, maxScore: 10000},
+				{classId: "class", subject: "f", category: "exams", score: 5999, maxScore: 10000},
+			},
+			subjects: []*pb.SubjectGrade{
+				{ClassId: "class", Subject: "b", Average: 89.99, Letter: "B", GradePoints: 3, Credits: 1},
+				{ClassId: "class", Subject: "f", Average: 59.99, Letter: "F", GradePoints: 0, Credits: 1},
+			},
+			gpa: 1.5,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assessments := map[string]*pb.Assessment{}
+			var scores []*pb.Score
+			for i, graded := range c.graded {
+				id := string(rune('a' + i))
+				assessments[id] = &pb.Assessment{Id: id, ClassId: graded.classId, Subject: graded.subject, CourseId: graded.courseId, Category: graded.category, MaxScore: graded.maxScore}
+				scores = append(scores, &pb.Score{AssessmentId: id, Score: graded.score})
+			}
+
+			subjects, gpa := gradeSubjects(assessments, scores, c.weights, c.credits, defaultGradingScale)
+			if len(subjects) != len(c.subjects) {
+				t.Fatalf("expected %d subjects, got %v", len(c.subjects), subjects)
+			}
+			for i, want := range c.subjects {
+				got := subjects[i]
+				if got.ClassId != want.ClassId || got.Subject != want.Subject || got.CourseId != want.CourseId {
+					t.Errorf("expected subject %d to be %s/%s/%s, got %s/%s/%s", i, want.ClassId, want.Subject, want.CourseId, got.ClassId, got.Subject, got.CourseId)
+				}
+				if !closeTo(got.Average, want.Average) || got.Letter != want.Letter || got.GradePoints != want.GradePoints || got.Credits != want.Credits {
+					t.Errorf("expected subject %d to average %v (%s, %v points, %d credits), got %v (%s, %v points, %d credits)",
+						i, want.Average, want.Letter, want.GradePoints, want.Credits, got.Average, got.Letter, got.GradePoints, got.Credits)
+				}
+			}
+			if !closeTo(gpa, c.gpa) {
+				t.Errorf("expected a GPA of %v, got %v", c.gpa, gpa)
+			}
+		})
+	}
+}
+
+func closeTo(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
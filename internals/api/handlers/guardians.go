@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Server) AddGuardians(ctx context.Context, req *pb.AddGuardiansRequest) (*pb.AddGuardiansResponse, error) {
+	var studentIds []string
+	for _, guardian := range req.GetGuardians() {
+		if guardian.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if guardian.FirstName == "" || guardian.LastName == "" {
+			return nil, status.Error(codes.InvalidArgument, "first_name and last_name are required")
+		}
+		if guardian.Phone == "" && guardian.Email == "" {
+			return nil, status.Error(codes.InvalidArgument, "phone or email is required")
+		}
+		// student_ids are filled in from students, which also carry the relationship to each student
+		if len(guardian.StudentIds) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "guardians are linked to students with students")
+		}
+		err := validateGuardian(guardian)
+		if err != nil {
+			return nil, err
+		}
+		linked := map[string]bool{}
+		for _, student := range guardian.Students {
+			err := validateGuardianStudent(student.StudentId, student.Relationship, student.EmergencyPriority, guardian.Phone)
+			if err != nil {
+				return nil, err
+			}
+			if linked[student.StudentId] {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("student %s is linked twice", student.StudentId))
+			}
+			linked[student.StudentId] = true
+			studentIds = append(studentIds, student.StudentId)
+		}
+	}
+
+	err := s.checkStudentsExist(ctx, studentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedGuardians, results, err := s.Extended.AddGuardiansToDb(ctx, req.GetGuardians(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddGuardiansResponse{Guardians: addedGuardians, Results: results}, nil
+}
+
+func (s *Server) GetGuardians(ctx context.Context, req *pb.GetGuardiansRequest) (*pb.Guardians, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Guardian, &models.Guardian{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	containsAll(filters, "students.student_id", req.GetGuardian().GetStudentIds())
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	guardians, err := s.Extended.GetGuardiansFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.Guardians{Guardians: guardians}, nil
+}
+
+func (s *Server) UpdateGuardians(ctx context.Context, req *pb.UpdateGuardiansRequest) (*pb.Guardians, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Guardian{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if slices.Contains(req.GetUpdateMask().GetPaths(), "student_ids") || slices.Contains(req.GetUpdateMask().GetPaths(), "students") {
+		return nil, status.Error(codes.InvalidArgument, "students are changed with LinkGuardians and UnlinkGuardians")
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	for _, guardian := range req.GetGuardians() {
+		if len(guardian.StudentIds) > 0 || len(guardian.Students) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "students are changed with LinkGuardians and UnlinkGuardians")
+		}
+		for field, value := range map[string]string{"first_name": guardian.FirstName, "last_name": guardian.LastName} {
+			if slices.Contains(paths, field) && value == "" {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s cannot be cleared", field))
+			}
+		}
+		err := validateGuardian(guardian)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updatedGuardians, err := s.Extended.ModifyGuardiansInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Guardians{Guardians: updatedGuardians}, nil
+}
+
+func (s *Server) DeleteGuardians(ctx context.Context, req *pb.DeleteGuardiansRequest) (*pb.DeleteGuardiansConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteGuardiansFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteGuardiansConfirmation{
+		Status:     "Guardians successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) LinkGuardians(ctx context.Context, req *pb.GuardianLinksRequest) (*pb.Guardians, error) {
+	studentIds, err := validateGuardianLinks(req.GetLinks())
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkStudentsExist(ctx, studentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emergency contacts need a phone number, which is looked up for the guardians made one
+	var emergencyIds []primitive.ObjectID
+	for _, link := range req.GetLinks() {
+		if link.EmergencyPriority > 0 {
+			objId, _ := primitive.ObjectIDFromHex(link.GuardianId)
+			emergencyIds = append(emergencyIds, objId)
+		}
+	}
+	phones := map[string]string{}
+	if len(emergencyIds) > 0 {
+		guardians, err := s.Extended.GetGuardiansFromDB(ctx, nil, excludeDeleted(bson.M{"_id": bson.M{"$in": emergencyIds}}, false))
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		for _, guardian := range guardians {
+			phones[guardian.Id] = guardian.Phone
+		}
+	}
+	for _, link := range req.GetLinks() {
+		err := validateGuardianStudent(link.StudentId, link.Relationship, link.EmergencyPriority, phones[link.GuardianId])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	guardians, err := s.Extended.ChangeGuardianLinksInDB(ctx, req.GetLinks(), true, req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Guardians{Guardians: guardians}, nil
+}
+
+func (s *Server) UnlinkGuardians(ctx context.Context, req *pb.GuardianLinksRequest) (*pb.Guardians, error) {
+	// Students are not looked up, so guardians can still be unlinked from deleted students
+	_, err := validateGuardianLinks(req.GetLinks())
+	if err != nil {
+		return nil, err
+	}
+
+	guardians, err := s.Extended.ChangeGuardianLinksInDB(ctx, req.GetLinks(), false, req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Guardians{Guardians: guardians}, nil
+}
+
+// validateGuardian checks the fields of a guardian that have to be well formed whenever they are set
+func validateGuardian(guardian *pb.Guardian) error {
+	if guardian.Email != "" {
+		address, err := mail.ParseAddress(guardian.Email)
+		if err != nil || address.Address != guardian.Email {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid email address: %s", guardian.Email))
+		}
+	}
+	return nil
+}
+
+// validateGuardianStudent checks a link of a guardian with the given phone number to a student
+func validateGuardianStudent(studentId, relationship string, emergencyPriority int32, phone string) error {
+	if !primitive.IsValidObjectID(studentId) {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid student ID: %s", studentId))
+	}
+	if relationship == "" {
+		return status.Error(codes.InvalidArgument, "every link to a student needs a relationship")
+	}
+	if emergencyPriority < 0 {
+		return status.Error(codes.InvalidArgument, "emergency_priority cannot be negative")
+	}
+	// An emergency contact has to be reachable right away
+	if emergencyPriority > 0 && phone == "" {
+		return status.Error(codes.InvalidArgument, "an emergency contact needs a phone number")
+	}
+	return nil
+}
+
+// validateGuardianLinks checks that every link names a guardian and a student and returns the IDs of the students
+func validateGuardianLinks(links []*pb.GuardianLink) ([]string, error) {
+	if len(links) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "links are required")
+	}
+	var studentIds []string
+	for _, link := range links {
+		if !primitive.IsValidObjectID(link.GuardianId) || !primitive.IsValidObjectID(link.StudentId) {
+			return nil, status.Error(codes.InvalidArgument, "every link needs a valid guardian_id and student_id")
+		}
+		studentIds = append(studentIds, link.StudentId)
+	}
+	return studentIds, nil
+}
+
+// attachGuardians fills in the guardians of the students, emergency contacts first in order of their priority
+// Every student gets the guardian with only the link to that student, the guardian's other students are not shown
+func (s *Server) attachGuardians(ctx context.Context, students []*pb.Student) error {
+	var studentIds []string
+	for _, student := range students {
+		studentIds = append(studentIds, student.Id)
+	}
+	if len(studentIds) == 0 {
+		return nil
+	}
+
+	guardians, err := s.Extended.GetGuardiansFromDB(ctx, bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}}, excludeDeleted(bson.M{"students.student_id": bson.M{"$in": studentIds}}, false))
+	if err != nil {
+		return repositoryError(err)
+	}
+
+	for _, student := range students {
+		for _, guardian := range guardians {
+			i := slices.IndexFunc(guardian.Students, func(link *pb.GuardianStudent) bool { return link.StudentId == student.Id })
+			if i < 0 {
+				continue
+			}
+			own := proto.Clone(guardian).(*pb.Guardian)
+			own.Students = []*pb.GuardianStudent{own.Students[i]}
+			own.StudentIds = []string{student.Id}
+			student.Guardians = append(student.Guardians, own)
+		}
+		sort.SliceStable(student.Guardians, func(i, j int) bool {
+			first, second := student.Guardians[i].Students[0].EmergencyPriority, student.Guardians[j].Students[0].EmergencyPriority
+			if first == 0 || second == 0 {
+				return first != 0 && second == 0
+			}
+			return first < second
+		})
+	}
+	return nil
+}
@@ -1,7 +1,13 @@
 package handlers
 
 import (
+	"ClassConnectRPC/internals/api/interceptors"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/cache"
 	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 
@@ -9,6 +15,12 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func buildFilterForModel(object interface{}, model interface{}) (bson.M, error) {
@@ -18,6 +30,10 @@ func buildFilterForModel(object interface{}, model interface{}) (bson.M, error)
 	modelType := modelVal.Type()
 
 	reqVal := reflect.ValueOf(object).Elem()
+	// A request without a filter object matches everything
+	if !reqVal.IsValid() {
+		return filter, nil
+	}
 	reqType := reqVal.Type()
 
 	for i := 0; i < reqVal.NumField(); i++ {
@@ -26,7 +42,7 @@ func buildFilterForModel(object interface{}, model interface{}) (bson.M, error)
 
 		if fieldVal.IsValid() && !fieldVal.IsZero() {
 			modelField := modelVal.FieldByName(fieldName)
-			if modelField.IsValid() && modelField.CanSet() {
+			if modelField.IsValid() && modelField.CanSet() && fieldVal.Type().AssignableTo(modelField.Type()) {
 				modelField.Set(fieldVal)
 			}
 		}
@@ -50,7 +66,7 @@ func buildFilterForModel(object interface{}, model interface{}) (bson.M, error)
 				}
 				filter[bsonTag] = objId
 			} else {
-				filter[bsonTag] = fieldVal.Interface().(string)
+				filter[bsonTag] = fieldVal.Interface()
 			}
 
 		}
@@ -59,6 +75,73 @@ func buildFilterForModel(object interface{}, model interface{}) (bson.M, error)
 	return filter, nil
 }
 
+// addTimestampFilters adds the time range filters of a request to the filter
+func addTimestampFilters(filter bson.M, timeFilters []*pb.TimestampFilter) (bson.M, error) {
+	for _, timeFilter := range timeFilters {
+		if !filterableTimestamps[timeFilter.GetField()] {
+			return nil, fmt.Errorf("cannot filter by time on field: %s", timeFilter.GetField())
+		}
+
+		timeRange := bson.M{}
+		if timeFilter.GetFrom() != nil {
+			timeRange["$gte"] = timeFilter.GetFrom().AsTime()
+		}
+		if timeFilter.GetTo() != nil {
+			timeRange["$lt"] = timeFilter.GetTo().AsTime()
+		}
+		if len(timeRange) > 0 {
+			filter[timeFilter.GetField()] = timeRange
+		}
+	}
+	return filter, nil
+}
+
+// excludeDeleted limits a filter to records that have not been soft deleted unless they were asked for
+// A time range filter on deleted_at asks for deleted records as well
+func excludeDeleted(filter bson.M, includeDeleted bool) bson.M {
+	_, filtersDeletedAt := filter["deleted_at"]
+	if !includeDeleted && !filtersDeletedAt {
+		filter["deleted_at"] = bson.M{"$exists": false}
+	}
+	return filter
+}
+
+// actorFromContext returns the ID of the authenticated user making the request
+func actorFromContext(ctx context.Context) string {
+	userId, _ := ctx.Value(interceptors.ContextKey("userId")).(string)
+	return userId
+}
+
+// cacheBypass makes the reads of a request skip the cache when the client sent "cache-control: no-cache"
+func cacheBypass(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	for _, value := range md.Get("cache-control") {
+		if strings.Contains(strings.ToLower(value), "no-cache") {
+			return cache.WithoutCache(ctx)
+		}
+	}
+	return ctx
+}
+
+// parseObjectIds converts the IDs of a request into mongodb object IDs
+func parseObjectIds(ids []string) ([]primitive.ObjectID, error) {
+	var objIds []primitive.ObjectID
+	for _, id := range ids {
+		if id == "" {
+			return nil, errors.New("ID field cannot be empty")
+		}
+		objId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID: %s", id)
+		}
+		objIds = append(objIds, objId)
+	}
+	return objIds, nil
+}
+
 func buildSortOptions(sortFields []*pb.SortField) bson.D {
 	var sortOptions bson.D
 
@@ -73,3 +156,105 @@ func buildSortOptions(sortFields []*pb.SortField) bson.D {
 
 	return sortOptions
 }
+
+// Fields of every entity that are maintained by the server and cannot be named in an update mask
+var readOnlyPaths = map[string]bool{
+	"id":                  true,
+	"version":             true,
+	"deleted_at":          true,
+	"deleted_by":          true,
+	"created_at":          true,
+	"updated_at":          true,
+	"created_by":          true,
+	"updated_by":          true,
+	"password_changed_at": true,
+}
+
+// Timestamp fields that the Get requests can filter on by time range
+var filterableTimestamps = map[string]bool{
+	"created_at":          true,
+	"updated_at":          true,
+	"deleted_at":          true,
+	"password_changed_at": true,
+}
+
+// validateUpdateMask checks that every path of the mask names an updatable field of the given message
+func validateUpdateMask(mask *fieldmaskpb.FieldMask, message proto.Message) error {
+	for _, path := range mask.GetPaths() {
+		if readOnlyPaths[path] {
+			return fmt.Errorf("%s cannot be updated", path)
+		}
+		_, err := fieldmaskpb.New(message, path)
+		if err != nil {
+			return fmt.Errorf("unknown field path: %s", path)
+		}
+	}
+	return nil
+}
+
+// repositoryError converts an error from the repository layer into a gRPC status error
+// Version conflicts become FAILED_PRECONDITION with the current record attached as a status detail
+// and unique index violations become ALREADY_EXISTS, operations the backend does not offer become UNIMPLEMENTED
+func repositoryError(err error) error {
+	var conflict *repositories.VersionConflictError
+	if errors.As(err, &conflict) {
+		st := status.New(codes.FailedPrecondition, conflict.Error())
+		withDetails, detailsErr := st.WithDetails(protoadapt.MessageV1Of(conflict.Current))
+		if detailsErr != nil {
+			return st.Err()
+		}
+		return withDetails.Err()
+	}
+
+	var duplicate *repositories.DuplicateKeyError
+	if errors.As(err, &duplicate) {
+		return status.Error(codes.AlreadyExists, duplicate.Error())
+	}
+
+	var notFound *repositories.NotFoundError
+	if errors.As(err, &notFound) {
+		return status.Error(codes.NotFound, notFound.Error())
+	}
+
+	var legalHold *repositories.LegalHoldError
+	if errors.As(err, &legalHold) {
+		return status.Error(codes.FailedPrecondition, legalHold.Error())
+	}
+
+	var referenced *repositories.ReferencedError
+	if errors.As(err, &referenced) {
+		return status.Error(codes.FailedPrecondition, referenced.Error())
+	}
+
+	var closed *repositories.EnrollmentClosedError
+	if errors.As(err, &closed) {
+		return status.Error(codes.FailedPrecondition, closed.Error())
+	}
+
+	var finalized *repositories.TermFinalizedError
+	if errors.As(err, &finalized) {
+		return status.Error(codes.FailedPrecondition, finalized.Error())
+	}
+
+	var doubleBooked *repositories.DoubleBookingError
+	if errors.As(err, &doubleBooked) {
+		return status.Error(codes.FailedPrecondition, doubleBooked.Error())
+	}
+
+	var full *repositories.ClassFullError
+	if errors.As(err, &full) {
+		return status.Error(codes.FailedPrecondition, full.Error())
+	}
+
+	var graded *repositories.SubmissionGradedError
+	if errors.As(err, &graded) {
+		return status.Error(codes.FailedPrecondition, graded.Error())
+	}
+
+	var belowScores *repositories.MaxScoreBelowScoresError
+	if errors.As(err, &belowScores) {
+		return status.Error(codes.FailedPrecondition, belowScores.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
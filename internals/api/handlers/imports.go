@@ -0,0 +1,435 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Longest NDJSON line accepted by an import
+const maxImportLineSize = 1 << 20
+
+// Most rows accepted by an import, every row is held in memory until the whole file is validated
+const maxImportRows = 50000
+
+var errTooManyRows = fmt.Errorf("the file has more than %d rows, split it into smaller imports", maxImportRows)
+
+// importRow is a row of an import file with its values keyed by field name
+type importRow struct {
+	line   int
+	values map[string]string
+}
+
+// importTarget describes how the rows of an import are turned into records of one entity
+type importTarget[P proto.Message] struct {
+	entity     string
+	newMessage func() P
+	// required lists the fields every row has to fill
+	required []string
+	add      func(ctx context.Context, messages []P) ([]*pb.BulkItemResult, error)
+	// byEmail returns the records with the given emails that have not been deleted
+	byEmail func(ctx context.Context, emails []string) ([]P, error)
+	update  func(ctx context.Context, message P, paths []string) error
+	// classes checks the class of every message, ids holds the ID of the record a message updates and is empty for new records
+	// It is left nil for entities that do not belong to a class
+	classes func(ctx context.Context, messages []P, ids []string) ([]error, error)
+}
+
+func (s *Server) ImportStudents(stream grpc.ClientStreamingServer[pb.ImportChunk, pb.ImportSummary]) error {
+	return runImport(stream, importTarget[*pb.Student]{
+		entity:     "student",
+		newMessage: func() *pb.Student { return &pb.Student{} },
+		required:   []string{"first_name", "last_name", "class_id"},
+		add: func(ctx context.Context, students []*pb.Student) ([]*pb.BulkItemResult, error) {
+			_, results, err := s.Repo.AddStudentsToDb(ctx, students, false, false, actorFromContext(ctx))
+			return results, err
+		},
+		byEmail: func(ctx context.Context, emails []string) ([]*pb.Student, error) {
+			return s.Repo.GetStudentsFromDB(ctx, nil, excludeDeleted(bson.M{"email": bson.M{"$in": emails}}, false), repositories.Page{})
+		},
+		update: func(ctx context.Context, student *pb.Student, paths []string) error {
+			_, err := s.Repo.ModifyStudentsInDB(ctx, &pb.UpdateStudentsRequest{Students: []*pb.Student{student}, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}}, actorFromContext(ctx))
+			return err
+		},
+		classes: func(ctx context.Context, students []*pb.Student, ids []string) ([]error, error) {
+			classIds := make([]string, len(students))
+			for i, student := range students {
+				classIds[i] = student.ClassId
+			}
+			return s.classErrors(ctx, classIds, ids, true)
+		},
+	})
+}
+
+func (s *Server) ImportTeachers(stream grpc.ClientStreamingServer[pb.ImportChunk, pb.ImportSummary]) error {
+	return runImport(stream, importTarget[*pb.Teacher]{
+		entity:     "teacher",
+		newMessage: func() *pb.Teacher { return &pb.Teacher{} },
+		required:   []string{"first_name", "last_name", "subject"},
+		add: func(ctx context.Context, teachers []*pb.Teacher) ([]*pb.BulkItemResult, error) {
+			_, results, err := s.Repo.AddTeachersToDb(ctx, teachers, false, false, actorFromContext(ctx))
+			return results, err
+		},
+		byEmail: func(ctx context.Context, emails []string) ([]*pb.Teacher, error) {
+			return s.Repo.GetTeachersFromDB(ctx, nil, excludeDeleted(bson.M{"email": bson.M{"$in": emails}}, false))
+		},
+		update: func(ctx context.Context, teacher *pb.Teacher, paths []string) error {
+			_, err := s.Repo.ModifyTeachersInDB(ctx, &pb.UpdateTeachersRequest{Teachers: []*pb.Teacher{teacher}, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}}, actorFromContext(ctx))
+			return err
+		},
+	})
+}
+
+// runImport reads the streamed file, validates every row and writes the valid ones according to the mode of the import
+func runImport[P proto.Message](stream grpc.ClientStreamingServer[pb.ImportChunk, pb.ImportSummary], target importTarget[P]) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "The import is empty")
+	}
+	if err != nil {
+		return err
+	}
+
+	data := importData(stream, first)
+	// Closing the reader also stops the goroutine feeding it when the file is rejected early
+	defer data.Close()
+
+	rows, rowErrors, err := readImportRows(data, first.GetFormat(), first.GetHeaderMapping())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	summary := &pb.ImportSummary{TotalRows: int32(len(rows)), DryRun: first.GetDryRun()}
+	failedLines := map[int32]bool{}
+	for _, rowErr := range rowErrors {
+		failedLines[rowErr.Line] = true
+	}
+	fail := func(line int, field, message string) {
+		rowErrors = append(rowErrors, &pb.ImportRowError{Line: int32(line), Field: field, Message: message})
+		failedLines[int32(line)] = true
+	}
+
+	// Validate the rows and build their messages
+	type parsedRow struct {
+		line    int
+		message P
+		paths   []string
+		email   string
+	}
+	var parsed []parsedRow
+	seenEmails := map[string]int{}
+	for _, row := range rows {
+		message := target.newMessage()
+		paths, ok := fillMessage(message, row, target.required, fail)
+		if !ok {
+			continue
+		}
+
+		email := strings.TrimSpace(row.values["email"])
+		if first.GetMode() == pb.ImportMode_UPSERT_BY_EMAIL && email == "" {
+			fail(row.line, "email", "email is required to upsert by email")
+			continue
+		}
+		if email != "" {
+			if line, seen := seenEmails[email]; seen {
+				fail(row.line, "email", fmt.Sprintf("email already used on line %d of the import", line))
+				continue
+			}
+			seenEmails[email] = row.line
+		}
+		parsed = append(parsed, parsedRow{line: row.line, message: message, paths: paths, email: email})
+	}
+
+	// Look up which of the emails already belong to a record
+	existing := map[string]P{}
+	if len(seenEmails) > 0 {
+		emails := make([]string, 0, len(seenEmails))
+		for email := range seenEmails {
+			emails = append(emails, email)
+		}
+		records, err := target.byEmail(ctx, emails)
+		if err != nil {
+			return repositoryError(err)
+		}
+		for _, record := range records {
+			existing[stringField(record, "email")] = record
+		}
+	}
+
+	// Every row of an entity that belongs to a class has to name an existing class with room for it
+	checked := parsed
+	if target.classes != nil {
+		messages := make([]P, len(parsed))
+		ids := make([]string, len(parsed))
+		for i, row := range parsed {
+			messages[i] = row.message
+			if current, found := existing[row.email]; found {
+				ids[i] = stringField(current, "id")
+			}
+		}
+		problems, err := target.classes(ctx, messages, ids)
+		if err != nil {
+			return err
+		}
+		checked = nil
+		for i, row := range parsed {
+			if problems[i] != nil {
+				fail(row.line, "class_id", status.Convert(problems[i]).Message())
+				continue
+			}
+			checked = append(checked, row)
+		}
+	}
+
+	var inserts []parsedRow
+	for _, row := range checked {
+		current, found := existing[row.email]
+		if !found {
+			inserts = append(inserts, row)
+			continue
+		}
+		if first.GetMode() != pb.ImportMode_UPSERT_BY_EMAIL {
+			fail(row.line, "email", fmt.Sprintf("a %s with this email already exists", target.entity))
+			continue
+		}
+
+		if summary.DryRun {
+			summary.Updated++
+			continue
+		}
+		// Update the stored record at the version that was read, so concurrent edits are not overwritten
+		copyField(row.message, current, "id")
+		copyField(row.message, current, "version")
+		err := target.update(ctx, row.message, row.paths)
+		if err != nil {
+			fail(row.line, "", status.Convert(repositoryError(err)).Message())
+			continue
+		}
+		summary.Updated++
+	}
+
+	if summary.DryRun {
+		summary.Inserted = int32(len(inserts))
+	} else if len(inserts) > 0 {
+		messages := make([]P, len(inserts))
+		for i, row := range inserts {
+			messages[i] = row.message
+		}
+		results, err := target.add(ctx, messages)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		for _, result := range results {
+			if result.ErrorCode != 0 {
+				fail(inserts[result.Index].line, "", result.ErrorMessage)
+				continue
+			}
+			summary.Inserted++
+		}
+	}
+
+	sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].Line < rowErrors[j].Line })
+	summary.Failed = int32(len(failedLines))
+	summary.Errors = rowErrors
+	return stream.SendAndClose(summary)
+}
+
+// importData joins the data of the streamed chunks into a single reader
+func importData(stream grpc.ClientStreamingServer[pb.ImportChunk, pb.ImportSummary], first *pb.ImportChunk) *io.PipeReader {
+	reader, writer := io.Pipe()
+	go func() {
+		chunk := first
+		for {
+			_, err := writer.Write(chunk.GetData())
+			if err != nil {
+				return
+			}
+			chunk, err = stream.Recv()
+			if err == io.EOF {
+				writer.Close()
+				return
+			}
+			if err != nil {
+				writer.CloseWithError(err)
+				return
+			}
+		}
+	}()
+	return reader
+}
+
+// readImportRows parses the import file into rows keyed by field name
+// Rows that cannot be parsed are reported as row errors, an error is only returned when the file as a whole is unusable
+func readImportRows(data io.Reader, format pb.ImportFormat, mapping map[string]string) ([]importRow, []*pb.ImportRowError, error) {
+	fieldFor := func(header string) string {
+		header = strings.TrimSpace(header)
+		if field, ok := mapping[header]; ok {
+			return field
+		}
+		return header
+	}
+
+	var rows []importRow
+	var rowErrors []*pb.ImportRowError
+
+	if format == pb.ImportFormat_NDJSON {
+		scanner := bufio.NewScanner(data)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+		line := 0
+		for scanner.Scan() {
+			line++
+			if strings.TrimSpace(scanner.Text()) == "" {
+				continue
+			}
+			if len(rows) == maxImportRows {
+				return nil, nil, errTooManyRows
+			}
+
+			var object map[string]interface{}
+			err := json.Unmarshal(scanner.Bytes(), &object)
+			if err != nil {
+				rowErrors = append(rowErrors, &pb.ImportRowError{Line: int32(line), Message: "invalid JSON: " + err.Error()})
+				rows = append(rows, importRow{line: line})
+				continue
+			}
+
+			row := importRow{line: line, values: map[string]string{}}
+			for key, value := range object {
+				text, ok := value.(string)
+				if !ok && value != nil {
+					rowErrors = append(rowErrors, &pb.ImportRowError{Line: int32(line), Field: fieldFor(key), Message: "value must be a string"})
+					row.values = nil
+					break
+				}
+				row.values[fieldFor(key)] = text
+			}
+			rows = append(rows, row)
+		}
+		if scanner.Err() != nil {
+			return nil, nil, fmt.Errorf("reading line %d: %w", line+1, scanner.Err())
+		}
+		return rows, rowErrors, nil
+	}
+
+	reader := csv.NewReader(data)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("the CSV file has no header")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading the CSV header: %w", err)
+	}
+	fields := make([]string, len(header))
+	for i, column := range header {
+		fields[i] = fieldFor(strings.TrimPrefix(column, "\ufeff"))
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if len(rows) == maxImportRows {
+			return nil, nil, errTooManyRows
+		}
+
+		// FieldPos only works on a record that parsed, a parse error knows its own line
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			if !errors.Is(err, csv.ErrFieldCount) {
+				return nil, nil, fmt.Errorf("reading the CSV file: %w", err)
+			}
+			rowErrors = append(rowErrors, &pb.ImportRowError{Line: int32(parseErr.Line), Message: fmt.Sprintf("expected %d columns, got %d", len(fields), len(record))})
+			rows = append(rows, importRow{line: parseErr.Line})
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading the CSV file: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		row := importRow{line: line, values: map[string]string{}}
+		for i, value := range record {
+			row.values[fields[i]] = value
+		}
+		rows = append(rows, row)
+	}
+	return rows, rowErrors, nil
+}
+
+// fillMessage validates a row and copies its values into the message, returning the fields it set
+func fillMessage(message proto.Message, row importRow, required []string, fail func(line int, field, message string)) ([]string, bool) {
+	// Rows that could not be parsed were already reported
+	if row.values == nil {
+		return nil, false
+	}
+
+	ok := true
+	var paths []string
+	fields := message.ProtoReflect().Descriptor().Fields()
+	for field, value := range row.values {
+		value = strings.TrimSpace(value)
+		descriptor := fields.ByName(protoreflect.Name(field))
+		if descriptor == nil || descriptor.Kind() != protoreflect.StringKind || readOnlyPaths[field] {
+			fail(row.line, field, "unknown or read-only field")
+			ok = false
+			continue
+		}
+		if value == "" {
+			continue
+		}
+		if field == "email" {
+			address, err := mail.ParseAddress(value)
+			if err != nil || address.Address != value {
+				fail(row.line, field, "invalid email address")
+				ok = false
+				continue
+			}
+		}
+		setField(message, field, value)
+		paths = append(paths, field)
+	}
+
+	for _, field := range required {
+		if strings.TrimSpace(row.values[field]) == "" {
+			fail(row.line, field, field+" is required")
+			ok = false
+		}
+	}
+	return paths, ok
+}
+
+func setField(message proto.Message, field, value string) {
+	reflection := message.ProtoReflect()
+	reflection.Set(reflection.Descriptor().Fields().ByName(protoreflect.Name(field)), protoreflect.ValueOfString(value))
+}
+
+func copyField(dst, src proto.Message, field string) {
+	descriptor := src.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(field))
+	dst.ProtoReflect().Set(descriptor, src.ProtoReflect().Get(descriptor))
+}
+
+func stringField(message proto.Message, field string) string {
+	reflection := message.ProtoReflect()
+	return reflection.Get(reflection.Descriptor().Fields().ByName(protoreflect.Name(field))).String()
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ExportPersonData(ctx context.Context, req *pb.PersonRef) (*pb.PersonDataBundle, error) {
+	err := s.validatePersonRef(req)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := s.Repo.GetPersonDataFromDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return bundle, nil
+}
+
+func (s *Server) ErasePerson(ctx context.Context, req *pb.ErasePersonRequest) (*pb.ErasePersonResponse, error) {
+	err := s.validatePersonRef(req.GetPerson())
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := pb.ErasureMode_name[int32(req.GetMode())]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown erasure mode")
+	}
+
+	// The erasure takes the student ID off the submissions, so their files are looked up before it
+	var submissions []*pb.Submission
+	if req.GetPerson().GetType() == pb.PersonType_STUDENT && s.Extended != nil && s.Blobs != nil {
+		submissions, err = s.Extended.GetSubmissionsFromDB(ctx, nil, bson.M{"student_id": req.GetPerson().GetId()})
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+	}
+
+	response, err := s.Repo.ErasePersonInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	if len(submissions) > 0 {
+		response.FilesDeleted = s.eraseSubmissionBlobs(ctx, submissions)
+		err = s.Extended.RecordErasedFilesInDB(ctx, response.AuditId, response.FilesDeleted)
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+	}
+	return response, nil
+}
+
+func (s *Server) PlaceLegalHold(ctx context.Context, req *pb.LegalHoldRequest) (*pb.LegalHold, error) {
+	err := s.validatePersonRef(req.GetPerson())
+	if err != nil {
+		return nil, err
+	}
+
+	hold, err := s.Repo.AddLegalHoldToDb(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return hold, nil
+}
+
+func (s *Server) ReleaseLegalHold(ctx context.Context, req *pb.PersonRef) (*pb.Confirmation, error) {
+	err := s.validatePersonRef(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.DeleteLegalHoldFromDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.Confirmation{Confirmation: true}, nil
+}
+
+func (s *Server) GetLegalHolds(ctx context.Context, req *pb.EmptyRequest) (*pb.LegalHolds, error) {
+	holds, err := s.Repo.GetLegalHoldsFromDB(ctx)
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.LegalHolds{LegalHolds: holds}, nil
+}
+
+// validatePersonRef checks the person of a privacy request, guardians are only kept by the mongodb backend
+func (s *Server) validatePersonRef(person *pb.PersonRef) error {
+	if person == nil {
+		return status.Error(codes.InvalidArgument, "person is required")
+	}
+	if _, ok := pb.PersonType_name[int32(person.GetType())]; !ok {
+		return status.Error(codes.InvalidArgument, "unknown person type")
+	}
+	if !primitive.IsValidObjectID(person.GetId()) {
+		return status.Error(codes.InvalidArgument, "invalid person ID")
+	}
+	if person.GetType() == pb.PersonType_GUARDIAN && s.Extended == nil {
+		return status.Error(codes.Unimplemented, "guardians need the mongodb storage backend")
+	}
+	return nil
+}
+
+// eraseSubmissionBlobs deletes the files of the submissions of an erased student and returns how many were deleted
+// A file shared by checksum is kept while a submission of someone who was not erased has the checksum.
+// Failures are logged and left out of the count, the audit record then shows the files that are left
+func (s *Server) eraseSubmissionBlobs(ctx context.Context, submissions []*pb.Submission) int64 {
+	var deleted int64
+	for _, submission := range submissions {
+		err := s.Blobs.Delete(ctx, submissionBlobKey(submission.Id, submission.SubmittedAt.AsTime()))
+		if err != nil {
+			utils.ErrorHandler(err, "Error deleting a blob")
+			continue
+		}
+
+		if submission.Sha256 != "" {
+			sharing, err := s.Extended.GetSubmissionsFromDB(ctx, nil, bson.M{"sha256": submission.Sha256, "student_id": bson.M{"$ne": mongodb.ErasedValue}})
+			if err != nil {
+				utils.ErrorHandler(err, "Error checking the submissions sharing a file")
+				continue
+			}
+			if len(sharing) == 0 {
+				err = s.Blobs.Delete(ctx, sharedSubmissionBlobKey(submission.Sha256))
+				if err != nil {
+					utils.ErrorHandler(err, "Error deleting a blob")
+					continue
+				}
+			}
+		}
+		deleted++
+	}
+	return deleted
+}
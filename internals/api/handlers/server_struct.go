@@ -1,9 +1,35 @@
 package handlers
 
-import pb "ClassConnectRPC/proto/gen"
+import (
+	"ClassConnectRPC/internals/blobstore"
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"time"
+)
 
 type Server struct {
 	pb.UnimplementedTeachersServiceServer
 	pb.UnimplementedStudentsSerciesServer
 	pb.UnimplementedExecsServiceServer
+	pb.UnimplementedPrivacyServiceServer
+	pb.UnimplementedClassesServiceServer
+	pb.UnimplementedCoursesServiceServer
+	pb.UnimplementedAttendanceServiceServer
+	pb.UnimplementedGradesServiceServer
+	pb.UnimplementedTimetableServiceServer
+	pb.UnimplementedAssignmentsServiceServer
+	pb.UnimplementedAnnouncementsServiceServer
+	pb.UnimplementedGuardiansServiceServer
+	pb.UnimplementedAcademicYearsServiceServer
+
+	// Repo is the storage backend selected at startup
+	Repo repositories.Repository
+	// Extended is the same backend when it provides the services beyond the core ones, and nil otherwise
+	Extended repositories.ExtendedRepository
+	// Blobs keeps the files of submissions
+	Blobs blobstore.Store
+	// MaxSubmissionSize is the largest file in bytes a submission may upload, 0 means no limit
+	MaxSubmissionSize int64
+	// SchoolLocation is the timezone whose calendar days attendance sessions fall on, nil means UTC
+	SchoolLocation *time.Location
 }
@@ -2,62 +2,114 @@ package handlers
 
 import (
 	"ClassConnectRPC/internals/models"
-	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/internals/repositories"
 	pb "ClassConnectRPC/proto/gen"
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s *Server) AddStudents(ctx context.Context, req *pb.Students) (*pb.Students, error) {
+func (s *Server) AddStudents(ctx context.Context, req *pb.AddStudentsRequest) (*pb.AddStudentsResponse, error) {
+	var classIds []string
 	for _, student := range req.GetStudents() {
 		if student.Id != "" {
 			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
 		}
+		if len(student.Guardians) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "guardians are linked with GuardiansService")
+		}
+		classIds = append(classIds, student.ClassId)
 	}
 
-	addedStudents, err := mongodb.AddStudentsToDb(ctx, req.GetStudents())
+	err := s.checkClasses(ctx, classIds, nil, true)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+
+	addedStudents, results, err := s.Repo.AddStudentsToDb(ctx, req.GetStudents(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
 	}
 
-	return &pb.Students{Students: addedStudents}, nil
+	return &pb.AddStudentsResponse{Students: addedStudents, Results: results}, nil
 }
 
 func (s *Server) GetStudents(ctx context.Context, req *pb.GetStudentsRequest) (*pb.Students, error) {
+	if req.GetIncludeGuardians() && s.Extended == nil {
+		return nil, status.Error(codes.Unimplemented, "include_guardians needs the mongodb storage backend")
+	}
+
 	// Getting all the filters
 	filters, err := buildFilterForModel(req.Student, &models.Student{})
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Adding the time range filters
+	filters, err = addTimestampFilters(filters, req.GetTimeFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Getting all the sorting options
 	sortOptions := buildSortOptions(req.SortBy)
 
 	// Querying the database
-	students, err := mongodb.GetStudentsFromDB(ctx, sortOptions, filters)
+	students, err := s.Repo.GetStudentsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()), repositories.Page{Number: req.GetPageNumber(), Size: req.GetPageSize()})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if req.GetIncludeGuardians() {
+		err = s.attachGuardians(ctx, students)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb.Students{Students: students}, nil
 
 }
 
-func (s *Server) UpdateStudents(ctx context.Context, req *pb.Students) (*pb.Students, error) {
-	updatedStudents, err := mongodb.ModifyStudentsInDB(ctx, req)
+func (s *Server) UpdateStudents(ctx context.Context, req *pb.UpdateStudentsRequest) (*pb.Students, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Student{})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if slices.Contains(req.GetUpdateMask().GetPaths(), "guardians") || slices.ContainsFunc(req.GetStudents(), func(student *pb.Student) bool { return len(student.Guardians) > 0 }) {
+		return nil, status.Error(codes.InvalidArgument, "guardians are linked with GuardiansService")
+	}
+
+	if writesField(req.GetUpdateMask(), "class_id") {
+		var classIds, studentIds []string
+		for _, student := range req.GetStudents() {
+			classIds = append(classIds, student.ClassId)
+			studentIds = append(studentIds, student.Id)
+		}
+		err = s.checkClasses(ctx, classIds, studentIds, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updatedStudents, err := s.Repo.ModifyStudentsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
 	}
 	return &pb.Students{Students: updatedStudents}, nil
 }
 
-func (s *Server) DeleteStudents(ctx context.Context, req *pb.StudentIds) (*pb.DeleteStudentsConfirmation, error) {
+func (s *Server) DeleteStudents(ctx context.Context, req *pb.DeleteStudentsRequest) (*pb.DeleteStudentsConfirmation, error) {
 	ids := req.GetIds()
 	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
 	for _, v := range ids {
 		if v.Id == "" {
 			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
@@ -67,11 +119,12 @@ func (s *Server) DeleteStudents(ctx context.Context, req *pb.StudentIds) (*pb.De
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
 	}
 
-	deletedIds, err := mongodb.DeleteStudentsFromDB(ctx, objectIdsToDelete)
+	deletedIds, err := s.Repo.DeleteStudentsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repositoryError(err)
 	}
 
 	return &pb.DeleteStudentsConfirmation{
@@ -79,3 +132,46 @@ func (s *Server) DeleteStudents(ctx context.Context, req *pb.StudentIds) (*pb.De
 		DeletedIds: deletedIds,
 	}, nil
 }
+
+func (s *Server) RestoreStudents(ctx context.Context, req *pb.StudentIds) (*pb.RestoreStudentsConfirmation, error) {
+	var ids []string
+	for _, v := range req.GetIds() {
+		ids = append(ids, v.Id)
+	}
+
+	objIds, err := parseObjectIds(ids)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	restoredIds, err := s.Repo.RestoreStudentsInDB(ctx, objIds, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.RestoreStudentsConfirmation{
+		Status:      "Students successfully restored",
+		RestoredIds: restoredIds,
+	}, nil
+}
+
+func (s *Server) WatchStudents(req *pb.WatchStudentsRequest, stream grpc.ServerStreamingServer[pb.StudentEvent]) error {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Filter, &models.Student{})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.Repo.WatchStudentsInDB(stream.Context(), filters, req.GetResumeToken(), func(changeType pb.ChangeType, student *pb.Student, resumeToken string, occurredAt time.Time) error {
+		return stream.Send(&pb.StudentEvent{
+			Type:        changeType,
+			Student:     student,
+			ResumeToken: resumeToken,
+			OccurredAt:  timestamppb.New(occurredAt),
+		})
+	})
+	if err != nil {
+		return repositoryError(err)
+	}
+	return nil
+}
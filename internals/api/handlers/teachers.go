@@ -2,29 +2,31 @@ package handlers
 
 import (
 	"ClassConnectRPC/internals/models"
-	"ClassConnectRPC/internals/repositories/mongodb"
 	pb "ClassConnectRPC/proto/gen"
 	"context"
 	"errors"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s *Server) AddTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teachers, error) {
+func (s *Server) AddTeachers(ctx context.Context, req *pb.AddTeachersRequest) (*pb.AddTeachersResponse, error) {
 	for _, teacher := range req.GetTeachers() {
 		if teacher.Id != "" {
 			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
 		}
 	}
 
-	addedTeachers, err := mongodb.AddTeachersToDb(ctx, req.GetTeachers())
+	addedTeachers, results, err := s.Repo.AddTeachersToDb(ctx, req.GetTeachers(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repositoryError(err)
 	}
 
-	return &pb.Teachers{Teachers: addedTeachers}, nil
+	return &pb.AddTeachersResponse{Teachers: addedTeachers, Results: results}, nil
 }
 
 func (s *Server) GetTeachers(ctx context.Context, req *pb.GetTeachersRequest) (*pb.Teachers, error) {
@@ -34,11 +36,17 @@ func (s *Server) GetTeachers(ctx context.Context, req *pb.GetTeachersRequest) (*
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Adding the time range filters
+	filters, err = addTimestampFilters(filters, req.GetTimeFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Getting all the sorting options
 	sortOptions := buildSortOptions(req.SortBy)
 
 	// Querying the database
-	teachers, err := mongodb.GetTeachersFromDB(ctx, sortOptions, filters)
+	teachers, err := s.Repo.GetTeachersFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -47,17 +55,23 @@ func (s *Server) GetTeachers(ctx context.Context, req *pb.GetTeachersRequest) (*
 
 }
 
-func (s *Server) UpdateTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teachers, error) {
-	updatedTeachers, err := mongodb.ModifyTeachersInDB(ctx, req)
+func (s *Server) UpdateTeachers(ctx context.Context, req *pb.UpdateTeachersRequest) (*pb.Teachers, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.Teacher{})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updatedTeachers, err := s.Repo.ModifyTeachersInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
 	}
 	return &pb.Teachers{Teachers: updatedTeachers}, nil
 }
 
-func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.DeleteTeachersConfirmation, error) {
+func (s *Server) DeleteTeachers(ctx context.Context, req *pb.DeleteTeachersRequest) (*pb.DeleteTeachersConfirmation, error) {
 	ids := req.GetIds()
 	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
 	for _, v := range ids {
 		if v.Id == "" {
 			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
@@ -67,11 +81,12 @@ func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.De
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
 	}
 
-	deletedIds, err := mongodb.DeleteTeachersFromDB(ctx, objectIdsToDelete)
+	deletedIds, err := s.Repo.DeleteTeachersFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, repositoryError(err)
 	}
 
 	return &pb.DeleteTeachersConfirmation{
@@ -80,10 +95,32 @@ func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.De
 	}, nil
 }
 
-func (s *Server) GetStudentsByClassTeacher(ctx context.Context, req *pb.TeacherId) (*pb.Students, error) {
+func (s *Server) RestoreTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.RestoreTeachersConfirmation, error) {
+	var ids []string
+	for _, v := range req.GetIds() {
+		ids = append(ids, v.Id)
+	}
+
+	objIds, err := parseObjectIds(ids)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	restoredIds, err := s.Repo.RestoreTeachersInDB(ctx, objIds, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.RestoreTeachersConfirmation{
+		Status:      "Teachers successfully restored",
+		RestoredIds: restoredIds,
+	}, nil
+}
+
+func (s *Server) GetStudentsByClassTeacher(ctx context.Context, req *pb.ClassTeacherRequest) (*pb.Students, error) {
 	teacherId := req.GetId()
 
-	students, err := mongodb.GetStudentsByTeacherIdFromDB(ctx, teacherId)
+	students, err := s.Repo.GetStudentsByTeacherIdFromDB(cacheBypass(ctx), teacherId, req.GetSubject())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -91,10 +128,10 @@ func (s *Server) GetStudentsByClassTeacher(ctx context.Context, req *pb.TeacherI
 	return &pb.Students{Students: students}, nil
 }
 
-func (s *Server) GetStudentCountByClassTeacher(ctx context.Context, req *pb.TeacherId) (*pb.StudentCount, error) {
+func (s *Server) GetStudentCountByClassTeacher(ctx context.Context, req *pb.ClassTeacherRequest) (*pb.StudentCount, error) {
 	teacherId := req.GetId()
 
-	count, err := mongodb.GetStudentCountByTeacherIdFromDB(ctx, teacherId)
+	count, err := s.Repo.GetStudentCountByTeacherIdFromDB(cacheBypass(ctx), teacherId, req.GetSubject())
 	if err != nil {
 		return nil, err
 	}
@@ -102,3 +139,24 @@ func (s *Server) GetStudentCountByClassTeacher(ctx context.Context, req *pb.Teac
 	return &pb.StudentCount{Status: true, StudentCount: int32(count)}, nil
 
 }
+
+func (s *Server) WatchTeachers(req *pb.WatchTeachersRequest, stream grpc.ServerStreamingServer[pb.TeacherEvent]) error {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Filter, &models.Teacher{})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.Repo.WatchTeachersInDB(stream.Context(), filters, req.GetResumeToken(), func(changeType pb.ChangeType, teacher *pb.Teacher, resumeToken string, occurredAt time.Time) error {
+		return stream.Send(&pb.TeacherEvent{
+			Type:        changeType,
+			Teacher:     teacher,
+			ResumeToken: resumeToken,
+			OccurredAt:  timestamppb.New(occurredAt),
+		})
+	})
+	if err != nil {
+		return repositoryError(err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) AddTeachingAssignments(ctx context.Context, req *pb.AddTeachingAssignmentsRequest) (*pb.AddTeachingAssignmentsResponse, error) {
+	var teacherIds, classIds []string
+	for _, assignment := range req.GetAssignments() {
+		if assignment.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if assignment.TeacherId == "" || assignment.ClassId == "" {
+			return nil, status.Error(codes.InvalidArgument, "teacher_id and class_id are required")
+		}
+		if _, ok := pb.TeachingRole_name[int32(assignment.Role)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown role: %d", assignment.Role))
+		}
+		if assignment.Subject == "" && assignment.Role != pb.TeachingRole_HOMEROOM_TEACHER {
+			return nil, status.Error(codes.InvalidArgument, "subject is required for subject teachers")
+		}
+		teacherIds = append(teacherIds, assignment.TeacherId)
+		classIds = append(classIds, assignment.ClassId)
+	}
+
+	err := s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkClasses(ctx, classIds, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkAssignmentsUnique(ctx, req.GetAssignments())
+	if err != nil {
+		return nil, err
+	}
+
+	addedAssignments, results, err := s.Repo.AddTeachingAssignmentsToDb(ctx, req.GetAssignments(), req.GetOrdered(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddTeachingAssignmentsResponse{Assignments: addedAssignments, Results: results}, nil
+}
+
+func (s *Server) GetTeachingAssignments(ctx context.Context, req *pb.GetTeachingAssignmentsRequest) (*pb.TeachingAssignments, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Assignment, &models.TeachingAssignment{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	assignments, err := s.Repo.GetTeachingAssignmentsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.TeachingAssignments{Assignments: assignments}, nil
+}
+
+func (s *Server) DeleteTeachingAssignments(ctx context.Context, req *pb.DeleteTeachingAssignmentsRequest) (*pb.DeleteTeachingAssignmentsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Repo.DeleteTeachingAssignmentsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteTeachingAssignmentsConfirmation{
+		Status:     "Teaching assignments successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+// checkAssignmentsUnique fails when a teacher would be assigned to the same class and subject twice,
+// either within the request or next to an assignment that is not deleted
+func (s *Server) checkAssignmentsUnique(ctx context.Context, assignments []*pb.TeachingAssignment) error {
+	key := func(assignment *pb.TeachingAssignment) string {
+		return assignment.TeacherId + "/" + assignment.ClassId + "/" + assignment.Subject
+	}
+
+	var teacherIds []string
+	for _, assignment := range assignments {
+		teacherIds = append(teacherIds, assignment.TeacherId)
+	}
+	existing, err := s.Repo.GetTeachingAssignmentsFromDB(ctx, nil, excludeDeleted(bson.M{"teacher_id": bson.M{"$in": teacherIds}}, false))
+	if err != nil {
+		return repositoryError(err)
+	}
+
+	taken := map[string]bool{}
+	for _, assignment := range existing {
+		taken[key(assignment)] = true
+	}
+	for _, assignment := range assignments {
+		if taken[key(assignment)] {
+			return status.Error(codes.AlreadyExists, fmt.Sprintf("teacher %s is already assigned to class %s for subject %q", assignment.TeacherId, assignment.ClassId, assignment.Subject))
+		}
+		taken[key(assignment)] = true
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"ClassConnectRPC/internals/models"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) AddTimetableSlots(ctx context.Context, req *pb.AddTimetableSlotsRequest) (*pb.AddTimetableSlotsResponse, error) {
+	var classIds, teacherIds []string
+	for _, slot := range req.GetSlots() {
+		if slot.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "Request is in incorrect format: non-empty ID field is not allowed")
+		}
+		if slot.ClassId == "" || slot.TeacherId == "" || slot.Subject == "" {
+			return nil, status.Error(codes.InvalidArgument, "class_id, teacher_id and subject are required")
+		}
+		err := validateSlotTime(slot.Day, slot.Period)
+		if err != nil {
+			return nil, err
+		}
+		classIds = append(classIds, slot.ClassId)
+		teacherIds = append(teacherIds, slot.TeacherId)
+	}
+
+	err := s.checkClasses(ctx, classIds, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+
+	addedSlots, results, err := s.Extended.AddTimetableSlotsToDb(ctx, req.GetSlots(), req.GetAtomic(), actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.AddTimetableSlotsResponse{Slots: addedSlots, Results: results}, nil
+}
+
+func (s *Server) GetTimetableSlots(ctx context.Context, req *pb.GetTimetableSlotsRequest) (*pb.TimetableSlots, error) {
+	// Getting all the filters
+	filters, err := buildFilterForModel(req.Slot, &models.TimetableSlot{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Monday is the zero day, so the day cannot tell an unset filter apart and only counts together with a period
+	if req.GetSlot().GetPeriod() != 0 {
+		filters["day"] = req.GetSlot().GetDay().String()
+	}
+
+	// Getting all the sorting options
+	sortOptions := buildSortOptions(req.SortBy)
+
+	// Querying the database
+	slots, err := s.Extended.GetTimetableSlotsFromDB(ctx, sortOptions, excludeDeleted(filters, req.GetIncludeDeleted()))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.TimetableSlots{Slots: slots}, nil
+}
+
+func (s *Server) UpdateTimetableSlots(ctx context.Context, req *pb.UpdateTimetableSlotsRequest) (*pb.TimetableSlots, error) {
+	err := validateUpdateMask(req.GetUpdateMask(), &pb.TimetableSlot{})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	var classIds, teacherIds []string
+	for _, slot := range req.GetSlots() {
+		for field, value := range map[string]string{"class_id": slot.ClassId, "teacher_id": slot.TeacherId, "subject": slot.Subject} {
+			if slices.Contains(paths, field) && value == "" {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s cannot be cleared", field))
+			}
+		}
+		if slot.Period < 0 || (slot.Period == 0 && slices.Contains(paths, "period")) {
+			return nil, status.Error(codes.InvalidArgument, "period has to be at least 1")
+		}
+		if _, ok := pb.Weekday_name[int32(slot.Day)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown day: %d", slot.Day))
+		}
+		if writesField(req.GetUpdateMask(), "class_id") {
+			classIds = append(classIds, slot.ClassId)
+		}
+		if writesField(req.GetUpdateMask(), "teacher_id") {
+			teacherIds = append(teacherIds, slot.TeacherId)
+		}
+	}
+
+	err = s.checkClasses(ctx, classIds, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkTeachersExist(ctx, teacherIds)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedSlots, err := s.Extended.ModifyTimetableSlotsInDB(ctx, req, actorFromContext(ctx))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	return &pb.TimetableSlots{Slots: updatedSlots}, nil
+}
+
+func (s *Server) DeleteTimetableSlots(ctx context.Context, req *pb.DeleteTimetableSlotsRequest) (*pb.DeleteTimetableSlotsConfirmation, error) {
+	ids := req.GetIds()
+	var objectIdsToDelete []primitive.ObjectID
+	var expectedVersions []int64
+	for _, v := range ids {
+		if v.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, errors.New("ID field cannot be empty").Error())
+		}
+		objId, err := primitive.ObjectIDFromHex(v.Id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		objectIdsToDelete = append(objectIdsToDelete, objId)
+		expectedVersions = append(expectedVersions, v.Version)
+	}
+
+	deletedIds, err := s.Extended.DeleteTimetableSlotsFromDB(ctx, objectIdsToDelete, expectedVersions, actorFromContext(ctx), req.GetAtomic())
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+
+	return &pb.DeleteTimetableSlotsConfirmation{
+		Status:     "Timetable slots successfully deleted",
+		DeletedIds: deletedIds,
+	}, nil
+}
+
+func (s *Server) GetTeacherTimetable(ctx context.Context, req *pb.TeacherId) (*pb.TimetableSlots, error) {
+	objIds, err := parseObjectIds([]string{req.GetId()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	teachers, err := s.Repo.GetTeachersFromDB(ctx, nil, excludeDeleted(bson.M{"_id": objIds[0]}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	if len(teachers) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no teacher found with ID: %s", req.GetId()))
+	}
+
+	slots, err := s.Extended.GetTimetableSlotsFromDB(ctx, nil, excludeDeleted(bson.M{"teacher_id": req.GetId()}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	sortWeek(slots)
+	return &pb.TimetableSlots{Slots: slots}, nil
+}
+
+func (s *Server) GetClassTimetable(ctx context.Context, req *pb.ClassId) (*pb.TimetableSlots, error) {
+	_, err := parseObjectIds([]string{req.GetId()})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	classes, err := s.classesById(ctx, []string{req.GetId()})
+	if err != nil {
+		return nil, err
+	}
+	if classes[req.GetId()] == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no class found with ID: %s", req.GetId()))
+	}
+
+	slots, err := s.Extended.GetTimetableSlotsFromDB(ctx, nil, excludeDeleted(bson.M{"class_id": req.GetId()}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	sortWeek(slots)
+	return &pb.TimetableSlots{Slots: slots}, nil
+}
+
+func (s *Server) GetFreeResources(ctx context.Context, req *pb.FreeResourcesRequest) (*pb.FreeResources, error) {
+	err := validateSlotTime(req.GetDay(), req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	booked, err := s.Extended.GetTimetableSlotsFromDB(ctx, nil, excludeDeleted(bson.M{"day": req.GetDay().String(), "period": req.GetPeriod()}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	busyTeachers := map[string]bool{}
+	busyRooms := map[string]bool{}
+	for _, slot := range booked {
+		busyTeachers[slot.TeacherId] = true
+		busyRooms[slot.Room] = true
+	}
+
+	filters := bson.M{}
+	if req.GetSubject() != "" {
+		// Teachers teach their main subject and the subjects of their teaching assignments
+		assignments, err := s.Repo.GetTeachingAssignmentsFromDB(ctx, nil, excludeDeleted(bson.M{"subject": req.GetSubject()}, false))
+		if err != nil {
+			return nil, repositoryError(err)
+		}
+		// Never nil, mongodb rejects a nil list in $in where an empty one matches no teacher
+		assignedIds := []primitive.ObjectID{}
+		for _, assignment := range assignments {
+			if objId, err := primitive.ObjectIDFromHex(assignment.TeacherId); err == nil {
+				assignedIds = append(assignedIds, objId)
+			}
+		}
+		filters["$or"] = bson.A{bson.M{"subject": req.GetSubject()}, bson.M{"_id": bson.M{"$in": assignedIds}}}
+	}
+	teachers, err := s.Repo.GetTeachersFromDB(ctx, bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}}, excludeDeleted(filters, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	teachers = slices.DeleteFunc(teachers, func(teacher *pb.Teacher) bool { return busyTeachers[teacher.Id] })
+
+	// The rooms of the school are the ones the timetable uses
+	slots, err := s.Extended.GetTimetableSlotsFromDB(ctx, nil, excludeDeleted(bson.M{"room": bson.M{"$exists": true}}, false))
+	if err != nil {
+		return nil, repositoryError(err)
+	}
+	var rooms []string
+	for _, slot := range slots {
+		if slot.Room != "" && !busyRooms[slot.Room] && !slices.Contains(rooms, slot.Room) {
+			rooms = append(rooms, slot.Room)
+		}
+	}
+	sort.Strings(rooms)
+
+	return &pb.FreeResources{Teachers: teachers, Rooms: rooms}, nil
+}
+
+// validateSlotTime checks that the day is a weekday and the period starts at 1
+func validateSlotTime(day pb.Weekday, period int32) error {
+	if _, ok := pb.Weekday_name[int32(day)]; !ok {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("unknown day: %d", day))
+	}
+	if period < 1 {
+		return status.Error(codes.InvalidArgument, "period has to be at least 1")
+	}
+	return nil
+}
+
+// sortWeek orders slots by day of the week and then by period
+func sortWeek(slots []*pb.TimetableSlot) {
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].Day != slots[j].Day {
+			return slots[i].Day < slots[j].Day
+		}
+		return slots[i].Period < slots[j].Period
+	})
+}
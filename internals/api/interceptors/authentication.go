@@ -17,6 +17,34 @@ import (
 type ContextKey string
 
 func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	newCtx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(newCtx, req)
+}
+
+// AuthenticationStreamInterceptor applies the same checks as AuthenticationInterceptor to streaming RPCs
+func AuthenticationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	newCtx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: newCtx})
+}
+
+// authenticatedStream replaces the context of a stream with the one carrying the claims of the token
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate validates the token of the request and returns a context carrying its claims
+func authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
 
 	// Skip some rpcs
 	skipMethods := map[string]bool{
@@ -24,8 +52,8 @@ func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.
 		"/main.ExecsService/AddExecs": true,
 	}
 
-	if skipMethods[info.FullMethod] {
-		return handler(ctx, req)
+	if skipMethods[fullMethod] {
+		return ctx, nil
 	}
 
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -109,5 +137,5 @@ func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.
 	newCtx = context.WithValue(newCtx, ContextKey("username"), username)
 	newCtx = context.WithValue(newCtx, ContextKey("expiresAt"), expiresAtInt)
 
-	return handler(newCtx, req)
+	return newCtx, nil
 }
@@ -41,19 +41,42 @@ func (rl *rateLimiter) resetVisitorCount() {
 }
 
 func (rl *rateLimiter) RateLimitingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	err := rl.allow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+
+}
+
+// RateLimitingStreamInterceptor counts opening a stream as a single request
+func (rl *rateLimiter) RateLimitingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := rl.allow(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+// allow counts a request of the calling client and fails once the client is over the limit
+func (rl *rateLimiter) allow(ctx context.Context) error {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "Unable to get client IP")
+		return status.Error(codes.Unauthenticated, "Unable to get client IP")
 	}
 
 	visitorIP := p.Addr.String()
+
+	rl.mu.Lock()
 	rl.visitors[visitorIP]++
-	log.Printf("Visiter count from IP: %s: %d\n", visitorIP, rl.visitors[visitorIP])
+	count := rl.visitors[visitorIP]
+	rl.mu.Unlock()
+	log.Printf("Visiter count from IP: %s: %d\n", visitorIP, count)
 
-	if rl.visitors[visitorIP] > rl.limit {
-		return nil, status.Error(codes.ResourceExhausted, "Too many requests")
+	if count > rl.limit {
+		return status.Error(codes.ResourceExhausted, "Too many requests")
 	}
-
-	return handler(ctx, req)
-
+	return nil
 }
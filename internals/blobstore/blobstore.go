@@ -0,0 +1,29 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when no blob is stored under a key
+var ErrNotFound = errors.New("blob not found")
+
+// Store keeps uploaded files by key, Local is the default implementation
+type Store interface {
+	// Create starts a new blob, nothing is stored until the writer is committed
+	Create(ctx context.Context) (Writer, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, deleting a key without a blob is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// Writer receives the content of a new blob
+// The key is only given to Commit, so a blob can be stored under a key worked out from its content
+type Writer interface {
+	io.Writer
+	// Commit stores the content under the key, replacing the blob already stored under it
+	Commit(key string) error
+	// Abort discards the content, it does nothing once the writer was committed
+	Abort() error
+}
@@ -0,0 +1,109 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Local stores every blob as a file below a directory, keys are paths relative to it
+type Local struct {
+	dir string
+}
+
+var _ Store = (*Local)(nil)
+
+func NewLocal(dir string) (*Local, error) {
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, err
+	}
+	return &Local{dir: dir}, nil
+}
+
+// path returns the file of a key, keys may not leave the directory
+func (l *Local) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid blob key: %s", key)
+	}
+	return filepath.Join(l.dir, key), nil
+}
+
+func (l *Local) Create(ctx context.Context) (Writer, error) {
+	// The content goes to a temporary file in the same directory, so committing it is a rename
+	file, err := os.CreateTemp(l.dir, ".upload-*")
+	if err != nil {
+		return nil, err
+	}
+	return &localWriter{store: l, file: file}, nil
+}
+
+func (l *Local) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+type localWriter struct {
+	store *Local
+	file  *os.File
+	done  bool
+}
+
+func (w *localWriter) Write(p []byte) (int, error) {
+	return w.file.Write(p)
+}
+
+func (w *localWriter) Commit(key string) error {
+	path, err := w.store.path(key)
+	if err != nil {
+		w.Abort()
+		return err
+	}
+	// Make sure the content is on disk before it shows up under the key
+	err = w.file.Sync()
+	if err == nil {
+		err = w.file.Close()
+	}
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(path), 0o750)
+	}
+	if err == nil {
+		err = os.Rename(w.file.Name(), path)
+	}
+	if err != nil {
+		w.Abort()
+		return err
+	}
+	w.done = true
+	return nil
+}
+
+func (w *localWriter) Abort() error {
+	if w.done {
+		return nil
+	}
+	w.done = true
+	w.file.Close()
+	return os.Remove(w.file.Name())
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+type AcademicYear struct {
+	Id        string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Name      string    `protobuf:"name,omitempty" bson:"name,omitempty"`
+	StartDate time.Time `protobuf:"start_date,omitempty" bson:"start_date,omitempty"`
+	EndDate   time.Time `protobuf:"end_date,omitempty" bson:"end_date,omitempty"`
+	Version   int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type Term struct {
+	Id             string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AcademicYearId string    `protobuf:"academic_year_id,omitempty" bson:"academic_year_id,omitempty"`
+	Name           string    `protobuf:"name,omitempty" bson:"name,omitempty"`
+	StartDate      time.Time `protobuf:"start_date,omitempty" bson:"start_date,omitempty"`
+	EndDate        time.Time `protobuf:"end_date,omitempty" bson:"end_date,omitempty"`
+	Version        int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt      time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy      string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt      time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt      time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy      string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy      string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type ClassMembership struct {
+	Id             string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId      string `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	ClassId        string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	AcademicYearId string `protobuf:"academic_year_id,omitempty" bson:"academic_year_id,omitempty"`
+	Outcome        string `protobuf:"outcome,omitempty" bson:"outcome,omitempty"`
+	ToClassId      string `protobuf:"to_class_id,omitempty" bson:"to_class_id,omitempty"`
+	PromotionId    string `protobuf:"promotion_id,omitempty" bson:"promotion_id,omitempty"`
+	// StudentVersion is the version the promotion left the student at, undoing the promotion needs it unchanged
+	StudentVersion int64     `bson:"student_version,omitempty"`
+	CreatedAt      time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	CreatedBy      string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+}
+
+type Promotion struct {
+	Id                 string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Grade              int32     `protobuf:"grade,omitempty" bson:"grade,omitempty"`
+	FromAcademicYearId string    `protobuf:"from_academic_year_id,omitempty" bson:"from_academic_year_id,omitempty"`
+	ToAcademicYearId   string    `protobuf:"to_academic_year_id,omitempty" bson:"to_academic_year_id,omitempty"`
+	CreatedAt          time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	CreatedBy          string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UndoneAt           time.Time `protobuf:"undone_at,omitempty" bson:"undone_at,omitempty"`
+	UndoneBy           string    `protobuf:"undone_by,omitempty" bson:"undone_by,omitempty"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+type Announcement struct {
+	Id         string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Title      string    `protobuf:"title,omitempty" bson:"title,omitempty"`
+	Body       string    `protobuf:"body,omitempty" bson:"body,omitempty"`
+	Audience   string    `protobuf:"audience,omitempty" bson:"audience,omitempty"`
+	Grade      int32     `protobuf:"grade,omitempty" bson:"grade,omitempty"`
+	ClassIds   []string  `protobuf:"class_ids,omitempty" bson:"class_ids,omitempty"`
+	StudentIds []string  `protobuf:"student_ids,omitempty" bson:"student_ids,omitempty"`
+	TeacherId  string    `protobuf:"teacher_id,omitempty" bson:"teacher_id,omitempty"`
+	ExpiresAt  time.Time `protobuf:"expires_at,omitempty" bson:"expires_at,omitempty"`
+	Version    int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt  time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy  string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt  time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt  time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy  string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy  string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type ReadReceipt struct {
+	Id             string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AnnouncementId string    `protobuf:"announcement_id,omitempty" bson:"announcement_id,omitempty"`
+	ReaderType     string    `protobuf:"reader_type,omitempty" bson:"reader_type,omitempty"`
+	ReaderId       string    `protobuf:"reader_id,omitempty" bson:"reader_id,omitempty"`
+	ReadAt         time.Time `protobuf:"read_at,omitempty" bson:"read_at,omitempty"`
+}
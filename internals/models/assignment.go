@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+type Assignment struct {
+	Id          string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	ClassId     string    `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	Subject     string    `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	TeacherId   string    `protobuf:"teacher_id,omitempty" bson:"teacher_id,omitempty"`
+	Title       string    `protobuf:"title,omitempty" bson:"title,omitempty"`
+	Description string    `protobuf:"description,omitempty" bson:"description,omitempty"`
+	DueAt       time.Time `protobuf:"due_at,omitempty" bson:"due_at,omitempty"`
+	MaxScore    float64   `protobuf:"max_score,omitempty" bson:"max_score,omitempty"`
+	Version     int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt   time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy   string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt   time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt   time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy   string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy   string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type Submission struct {
+	Id           string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AssignmentId string    `protobuf:"assignment_id,omitempty" bson:"assignment_id,omitempty"`
+	StudentId    string    `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	FileName     string    `protobuf:"file_name,omitempty" bson:"file_name,omitempty"`
+	ContentType  string    `protobuf:"content_type,omitempty" bson:"content_type,omitempty"`
+	Size         int64     `protobuf:"size,omitempty" bson:"size,omitempty"`
+	Sha256       string    `protobuf:"sha256,omitempty" bson:"sha256,omitempty"`
+	SubmittedAt  time.Time `protobuf:"submitted_at,omitempty" bson:"submitted_at,omitempty"`
+	Late         bool      `protobuf:"late,omitempty" bson:"late"`
+	Feedback     string    `protobuf:"feedback,omitempty" bson:"feedback,omitempty"`
+	// Scores of 0 are stored like any other once the submission is graded
+	Score     float64   `protobuf:"score,omitempty" bson:"score"`
+	GradedAt  time.Time `protobuf:"graded_at,omitempty" bson:"graded_at,omitempty"`
+	GradedBy  string    `protobuf:"graded_by,omitempty" bson:"graded_by,omitempty"`
+	Version   int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	CreatedAt time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+type AttendanceRecord struct {
+	Id          string                 `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId   string                 `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	ClassId     string                 `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	SessionDate time.Time              `protobuf:"session_date,omitempty" bson:"session_date,omitempty"`
+	Session     string                 `protobuf:"session,omitempty" bson:"session,omitempty"`
+	Status      string                 `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Note        string                 `protobuf:"note,omitempty" bson:"note,omitempty"`
+	Corrections []AttendanceCorrection `protobuf:"corrections,omitempty" bson:"corrections,omitempty"`
+	Version     int64                  `protobuf:"version,omitempty" bson:"version,omitempty"`
+	CreatedAt   time.Time              `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt   time.Time              `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy   string                 `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy   string                 `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type AttendanceCorrection struct {
+	PreviousStatus string    `protobuf:"previous_status,omitempty" bson:"previous_status,omitempty"`
+	Status         string    `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Reason         string    `protobuf:"reason,omitempty" bson:"reason,omitempty"`
+	CorrectedAt    time.Time `protobuf:"corrected_at,omitempty" bson:"corrected_at,omitempty"`
+	CorrectedBy    string    `protobuf:"corrected_by,omitempty" bson:"corrected_by,omitempty"`
+}
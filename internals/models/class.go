@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+type Class struct {
+	Id                string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Grade             int32     `protobuf:"grade,omitempty" bson:"grade,omitempty"`
+	Section           string    `protobuf:"section,omitempty" bson:"section,omitempty"`
+	AcademicYearId    string    `protobuf:"academic_year_id,omitempty" bson:"academic_year_id,omitempty"`
+	HomeroomTeacherId string    `protobuf:"homeroom_teacher_id,omitempty" bson:"homeroom_teacher_id,omitempty"`
+	Capacity          int32     `protobuf:"capacity,omitempty" bson:"capacity,omitempty"`
+	Version           int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt         time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy         string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt         time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt         time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy         string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy         string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+type Course struct {
+	Id              string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Code            string    `protobuf:"code,omitempty" bson:"code,omitempty"`
+	Name            string    `protobuf:"name,omitempty" bson:"name,omitempty"`
+	Credits         int32     `protobuf:"credits,omitempty" bson:"credits,omitempty"`
+	Subject         string    `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	TeacherIds      []string  `protobuf:"teacher_ids,omitempty" bson:"teacher_ids,omitempty"`
+	PrerequisiteIds []string  `protobuf:"prerequisite_ids,omitempty" bson:"prerequisite_ids,omitempty"`
+	Version         int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt       time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy       string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt       time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt       time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy       string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy       string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type CourseSection struct {
+	Id                 string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	CourseId           string    `protobuf:"course_id,omitempty" bson:"course_id,omitempty"`
+	Name               string    `protobuf:"name,omitempty" bson:"name,omitempty"`
+	Term               string    `protobuf:"term,omitempty" bson:"term,omitempty"`
+	StartsAt           time.Time `protobuf:"starts_at,omitempty" bson:"starts_at,omitempty"`
+	EndsAt             time.Time `protobuf:"ends_at,omitempty" bson:"ends_at,omitempty"`
+	EnrollmentClosesAt time.Time `protobuf:"enrollment_closes_at,omitempty" bson:"enrollment_closes_at,omitempty"`
+	Capacity           int32     `protobuf:"capacity,omitempty" bson:"capacity,omitempty"`
+	Enrolled           int32     `protobuf:"enrolled,omitempty" bson:"enrolled,omitempty"`
+	TeacherIds         []string  `protobuf:"teacher_ids,omitempty" bson:"teacher_ids,omitempty"`
+	Version            int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt          time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy          string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt          time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt          time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy          string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy          string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type Enrollment struct {
+	Id         string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId  string    `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	SectionId  string    `protobuf:"section_id,omitempty" bson:"section_id,omitempty"`
+	CourseId   string    `protobuf:"course_id,omitempty" bson:"course_id,omitempty"`
+	Status     string    `protobuf:"status,omitempty" bson:"status,omitempty"`
+	EnrolledAt time.Time `protobuf:"enrolled_at,omitempty" bson:"enrolled_at,omitempty"`
+	DroppedAt  time.Time `protobuf:"dropped_at,omitempty" bson:"dropped_at,omitempty"`
+	Version    int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	CreatedAt  time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt  time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy  string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy  string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+type Assessment struct {
+	Id        string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	ClassId   string    `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	Subject   string    `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	CourseId  string    `protobuf:"course_id,omitempty" bson:"course_id,omitempty"`
+	Term      string    `protobuf:"term,omitempty" bson:"term,omitempty"`
+	Title     string    `protobuf:"title,omitempty" bson:"title,omitempty"`
+	Type      string    `protobuf:"type,omitempty" bson:"type,omitempty"`
+	MaxScore  float64   `protobuf:"max_score,omitempty" bson:"max_score,omitempty"`
+	Category  string    `protobuf:"category,omitempty" bson:"category,omitempty"`
+	DueAt     time.Time `protobuf:"due_at,omitempty" bson:"due_at,omitempty"`
+	Version   int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type Score struct {
+	Id           string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AssessmentId string `protobuf:"assessment_id,omitempty" bson:"assessment_id,omitempty"`
+	StudentId    string `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	// Scores of 0 are stored like any other
+	Score     float64       `protobuf:"score,omitempty" bson:"score"`
+	Comment   string        `protobuf:"comment,omitempty" bson:"comment,omitempty"`
+	Changes   []ScoreChange `protobuf:"changes,omitempty" bson:"changes,omitempty"`
+	Version   int64         `protobuf:"version,omitempty" bson:"version,omitempty"`
+	CreatedAt time.Time     `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt time.Time     `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy string        `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy string        `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type ScoreChange struct {
+	PreviousScore float64   `protobuf:"previous_score,omitempty" bson:"previous_score"`
+	Score         float64   `protobuf:"score,omitempty" bson:"score"`
+	Reason        string    `protobuf:"reason,omitempty" bson:"reason,omitempty"`
+	ChangedAt     time.Time `protobuf:"changed_at,omitempty" bson:"changed_at,omitempty"`
+	ChangedBy     string    `protobuf:"changed_by,omitempty" bson:"changed_by,omitempty"`
+}
+
+// The gradebook fields are always stored so a gradebook is matched exactly
+type CategoryWeights struct {
+	ClassId   string             `protobuf:"class_id,omitempty" bson:"class_id"`
+	Subject   string             `protobuf:"subject,omitempty" bson:"subject"`
+	CourseId  string             `protobuf:"course_id,omitempty" bson:"course_id"`
+	Term      string             `protobuf:"term,omitempty" bson:"term"`
+	Weights   map[string]float64 `protobuf:"weights,omitempty" bson:"weights,omitempty"`
+	UpdatedAt time.Time          `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	UpdatedBy string             `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type GradingScale struct {
+	Bands     []GradeBand `protobuf:"bands,omitempty" bson:"bands,omitempty"`
+	UpdatedAt time.Time   `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	UpdatedBy string      `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type GradeBand struct {
+	Letter        string  `protobuf:"letter,omitempty" bson:"letter,omitempty"`
+	MinPercentage float64 `protobuf:"min_percentage,omitempty" bson:"min_percentage"`
+	GradePoints   float64 `protobuf:"grade_points,omitempty" bson:"grade_points"`
+}
+
+type TermLock struct {
+	Term        string    `protobuf:"term,omitempty" bson:"term,omitempty"`
+	FinalizedAt time.Time `protobuf:"finalized_at,omitempty" bson:"finalized_at,omitempty"`
+	FinalizedBy string    `protobuf:"finalized_by,omitempty" bson:"finalized_by,omitempty"`
+}
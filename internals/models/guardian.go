@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type Guardian struct {
+	Id           string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	FirstName    string `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
+	LastName     string `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
+	Phone        string `protobuf:"phone,omitempty" bson:"phone,omitempty"`
+	Email        string `protobuf:"email,omitempty" bson:"email,omitempty"`
+	Address      string `protobuf:"address,omitempty" bson:"address,omitempty"`
+	CustodyNotes string `protobuf:"custody_notes,omitempty" bson:"custody_notes,omitempty"`
+	// Students holds the links to students, the student IDs of the guardian are worked out from them
+	Students  []GuardianStudent `protobuf:"students,omitempty" bson:"students,omitempty"`
+	Version   int64             `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt time.Time         `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string            `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt time.Time         `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt time.Time         `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy string            `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy string            `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
+
+type GuardianStudent struct {
+	StudentId         string `protobuf:"student_id,omitempty" bson:"student_id"`
+	Relationship      string `protobuf:"relationship,omitempty" bson:"relationship,omitempty"`
+	EmergencyPriority int32  `protobuf:"emergency_priority,omitempty" bson:"emergency_priority,omitempty"`
+}
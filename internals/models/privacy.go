@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// LegalHold keeps a person from being erased, PersonType is student, teacher or exec
+type LegalHold struct {
+	Id         string    `bson:"_id,omitempty"`
+	PersonType string    `bson:"person_type"`
+	PersonId   string    `bson:"person_id"`
+	Reason     string    `bson:"reason,omitempty"`
+	PlacedBy   string    `bson:"placed_by,omitempty"`
+	PlacedAt   time.Time `bson:"placed_at"`
+}
+
+// PrivacyAudit records a privacy request that was carried out, it only holds IDs and never the personal data itself
+type PrivacyAudit struct {
+	Id                   string    `bson:"_id,omitempty"`
+	Action               string    `bson:"action"`
+	PersonType           string    `bson:"person_type"`
+	PersonId             string    `bson:"person_id"`
+	Mode                 string    `bson:"mode,omitempty"`
+	Reason               string    `bson:"reason,omitempty"`
+	Actor                string    `bson:"actor,omitempty"`
+	At                   time.Time `bson:"at"`
+	RecordsExported      int64     `bson:"records_exported,omitempty"`
+	RecordsAnonymized    int64     `bson:"records_anonymized,omitempty"`
+	RecordsDeleted       int64     `bson:"records_deleted,omitempty"`
+	ReferencesAnonymized int64     `bson:"references_anonymized,omitempty"`
+	FilesDeleted         int64     `bson:"files_deleted,omitempty"`
+}
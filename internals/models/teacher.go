@@ -1,10 +1,18 @@
 package models
 
+import "time"
+
 type Teacher struct {
-	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
-	FirstName string `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
-	LastName  string `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
-	Email     string `protobuf:"email,omitempty" bson:"email,omitempty"`
-	Class     string `protobuf:"class,omitempty" bson:"class,omitempty"`
-	Subject   string `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	Id        string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	FirstName string    `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
+	LastName  string    `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
+	Email     string    `protobuf:"email,omitempty" bson:"email,omitempty"`
+	Subject   string    `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	Version   int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
 }
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type TeachingAssignment struct {
+	Id        string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	TeacherId string    `protobuf:"teacher_id,omitempty" bson:"teacher_id,omitempty"`
+	ClassId   string    `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	Subject   string    `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	Role      string    `protobuf:"role,omitempty" bson:"role,omitempty"`
+	Version   int64     `protobuf:"version,omitempty" bson:"version,omitempty"`
+	DeletedAt time.Time `protobuf:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+	DeletedBy string    `protobuf:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	CreatedAt time.Time `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt time.Time `protobuf:"updated_at,omitempty" bson:"updated_at,omitempty"`
+	CreatedBy string    `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+	UpdatedBy string    `protobuf:"updated_by,omitempty" bson:"updated_by,omitempty"`
+}
@@ -0,0 +1,219 @@
+package cache
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+)
+
+type bypassKey struct{}
+
+// WithoutCache marks a context so that its reads go straight to the backend
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+func bypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassKey{}).(bool)
+	return bypass
+}
+
+// Stats counts how the cached reads were served
+type Stats struct {
+	Hits          int64
+	Misses        int64
+	Bypasses      int64
+	Invalidations int64
+	Entries       int64
+}
+
+// Repository caches the reads of the students of a teacher in front of another repository
+// Every write to students, teachers, classes or teaching assignments can change those results, so each one clears the cache
+type Repository struct {
+	repositories.Repository
+	cache Cache
+
+	// generation is bumped by every invalidation, a read only stores its result if no write happened while it ran
+	generation    atomic.Int64
+	hits          atomic.Int64
+	misses        atomic.Int64
+	bypasses      atomic.Int64
+	invalidations atomic.Int64
+}
+
+func New(next repositories.Repository, cache Cache) *Repository {
+	return &Repository{Repository: next, cache: cache}
+}
+
+// Extended caches the reads of a repositories.ExtendedRepository, the writes of the extensions that move students clear the cache as well
+type Extended struct {
+	*Repository
+	repositories.Extensions
+}
+
+func NewExtended(next repositories.ExtendedRepository, cache Cache) *Extended {
+	return &Extended{Repository: New(next, cache), Extensions: next}
+}
+
+func (r *Repository) Stats() Stats {
+	return Stats{
+		Hits:          r.hits.Load(),
+		Misses:        r.misses.Load(),
+		Bypasses:      r.bypasses.Load(),
+		Invalidations: r.invalidations.Load(),
+		Entries:       int64(r.cache.Len()),
+	}
+}
+
+func (r *Repository) invalidate() {
+	r.generation.Add(1)
+	r.cache.Clear()
+	r.invalidations.Add(1)
+}
+
+// cached returns the value stored under key, or loads it and stores it when it is not there yet
+func cached[T any](ctx context.Context, r *Repository, key string, load func() (T, error)) (T, error) {
+	if bypassed(ctx) {
+		r.bypasses.Add(1)
+		return load()
+	}
+
+	if value, ok := r.cache.Get(key); ok {
+		r.hits.Add(1)
+		return value.(T), nil
+	}
+	r.misses.Add(1)
+
+	generation := r.generation.Load()
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	if r.generation.Load() == generation {
+		r.cache.Set(key, value)
+	}
+	return value, nil
+}
+
+// cloneStudents copies cached messages so callers cannot change what is stored
+func cloneStudents(students []*pb.Student) []*pb.Student {
+	clones := make([]*pb.Student, len(students))
+	for i, student := range students {
+		clones[i] = proto.Clone(student).(*pb.Student)
+	}
+	return clones
+}
+
+func (r *Repository) GetStudentsByTeacherIdFromDB(ctx context.Context, teacherId, subject string) ([]*pb.Student, error) {
+	students, err := cached(ctx, r, "students-by-teacher:"+teacherId+":"+subject, func() ([]*pb.Student, error) {
+		return r.Repository.GetStudentsByTeacherIdFromDB(ctx, teacherId, subject)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneStudents(students), nil
+}
+
+func (r *Repository) GetStudentCountByTeacherIdFromDB(ctx context.Context, teacherId, subject string) (int64, error) {
+	return cached(ctx, r, "student-count-by-teacher:"+teacherId+":"+subject, func() (int64, error) {
+		return r.Repository.GetStudentCountByTeacherIdFromDB(ctx, teacherId, subject)
+	})
+}
+
+// The writes below invalidate even when they fail, since a failed batch may still have changed some records
+
+func (r *Repository) AddStudentsToDb(ctx context.Context, students []*pb.Student, ordered, atomic bool, actor string) ([]*pb.Student, []*pb.BulkItemResult, error) {
+	defer r.invalidate()
+	return r.Repository.AddStudentsToDb(ctx, students, ordered, atomic, actor)
+}
+
+func (r *Repository) ModifyStudentsInDB(ctx context.Context, req *pb.UpdateStudentsRequest, actor string) ([]*pb.Student, error) {
+	defer r.invalidate()
+	return r.Repository.ModifyStudentsInDB(ctx, req, actor)
+}
+
+func (r *Repository) DeleteStudentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	defer r.invalidate()
+	return r.Repository.DeleteStudentsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (r *Repository) RestoreStudentsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	defer r.invalidate()
+	return r.Repository.RestoreStudentsInDB(ctx, objIds, actor)
+}
+
+func (r *Repository) AddTeachersToDb(ctx context.Context, teachers []*pb.Teacher, ordered, atomic bool, actor string) ([]*pb.Teacher, []*pb.BulkItemResult, error) {
+	defer r.invalidate()
+	return r.Repository.AddTeachersToDb(ctx, teachers, ordered, atomic, actor)
+}
+
+func (r *Repository) ModifyTeachersInDB(ctx context.Context, req *pb.UpdateTeachersRequest, actor string) ([]*pb.Teacher, error) {
+	defer r.invalidate()
+	return r.Repository.ModifyTeachersInDB(ctx, req, actor)
+}
+
+func (r *Repository) DeleteTeachersFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	defer r.invalidate()
+	return r.Repository.DeleteTeachersFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (r *Repository) RestoreTeachersInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	defer r.invalidate()
+	return r.Repository.RestoreTeachersInDB(ctx, objIds, actor)
+}
+
+func (r *Repository) AddClassesToDb(ctx context.Context, classes []*pb.Class, ordered, atomic bool, actor string) ([]*pb.Class, []*pb.BulkItemResult, error) {
+	defer r.invalidate()
+	return r.Repository.AddClassesToDb(ctx, classes, ordered, atomic, actor)
+}
+
+func (r *Repository) ModifyClassesInDB(ctx context.Context, req *pb.UpdateClassesRequest, actor string) ([]*pb.Class, error) {
+	defer r.invalidate()
+	return r.Repository.ModifyClassesInDB(ctx, req, actor)
+}
+
+func (r *Repository) DeleteClassesFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	defer r.invalidate()
+	return r.Repository.DeleteClassesFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (r *Repository) RestoreClassesInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	defer r.invalidate()
+	return r.Repository.RestoreClassesInDB(ctx, objIds, actor)
+}
+
+func (r *Repository) AddTeachingAssignmentsToDb(ctx context.Context, assignments []*pb.TeachingAssignment, ordered, atomic bool, actor string) ([]*pb.TeachingAssignment, []*pb.BulkItemResult, error) {
+	defer r.invalidate()
+	return r.Repository.AddTeachingAssignmentsToDb(ctx, assignments, ordered, atomic, actor)
+}
+
+func (r *Repository) DeleteTeachingAssignmentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	defer r.invalidate()
+	return r.Repository.DeleteTeachingAssignmentsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (r *Repository) ErasePersonInDB(ctx context.Context, req *pb.ErasePersonRequest, actor string) (*pb.ErasePersonResponse, error) {
+	defer r.invalidate()
+	return r.Repository.ErasePersonInDB(ctx, req, actor)
+}
+
+// A purge removes students and teaching assignments for good, so the rosters read before it are dropped as well
+func (r *Repository) PurgeDeletedRecordsFromDB(ctx context.Context, cutoff time.Time) error {
+	defer r.invalidate()
+	return r.Repository.PurgeDeletedRecordsFromDB(ctx, cutoff)
+}
+
+func (r *Extended) PromoteStudentsInDB(ctx context.Context, promotion *pb.Promotion, memberships []*pb.ClassMembership, actor string) (*pb.Promotion, []*pb.ClassMembership, error) {
+	defer r.invalidate()
+	return r.Extensions.PromoteStudentsInDB(ctx, promotion, memberships, actor)
+}
+
+func (r *Extended) UndoPromotionInDB(ctx context.Context, objId primitive.ObjectID, actor string) (*pb.Promotion, []*pb.ClassMembership, error) {
+	defer r.invalidate()
+	return r.Extensions.UndoPromotionInDB(ctx, objId, actor)
+}
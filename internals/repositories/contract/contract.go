@@ -0,0 +1,828 @@
+// Package contract holds the behaviour every repositories.Repository has to share, so the backends can be checked against each other
+// The cases run against every backend in contract_test.go
+package contract
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Case is a single check of the contract, it returns an error describing the first broken expectation
+type Case struct {
+	Name string
+	Run  func(ctx context.Context, repo repositories.Repository) error
+}
+
+// Cases lists every check of the contract
+// The cases create their own records with unique names, so they can run against a database that already holds data.
+// Cases of the extended services return ErrNotProvided on backends without them
+func Cases() []Case {
+	return []Case{
+		{"add and get students", addAndGet},
+		{"filter, sort and paginate students", filterSortPaginate},
+		{"ordered and unordered inserts report every item", bulkInsertResults},
+		{"unique fields are reported as duplicates", duplicateKeys},
+		{"updates bump versions and detect conflicts", versionedUpdates},
+		{"update masks set and clear fields", updateMasks},
+		{"soft delete hides records until restored", softDeleteAndRestore},
+		{"emails of deleted records can be used again", reuseDeletedEmail},
+		{"watching streams changes and resumes after a token", watchAndResume},
+		{"watchers learn when a record leaves their filter", watchLeaving},
+		{"students of a teacher", studentsByTeacher},
+		{"classes in use are not deleted", classesInUse},
+		{"classes seat no more students than their capacity", classCapacity},
+		{"teachers hold an assignment once and lose it when deleted", teacherAssignments},
+		{"erasing a person anonymizes their references and respects legal holds", eraseAndHold},
+		{"deleted records of people under legal hold survive a purge", purgeKeepsHeld},
+		{"exec passwords are hashed and deactivation sticks", execAccounts},
+		{"course sections enroll no more students than their capacity", enrollmentCapacity},
+		{"a student has one score per assessment", scoreUniqueness},
+		{"promotions move students all or nothing and can be undone", promotion},
+	}
+}
+
+// unique returns a value no other run of the suite uses
+func unique(prefix string) string {
+	return fmt.Sprintf("%s-%s", prefix, primitive.NewObjectID().Hex())
+}
+
+func addStudents(ctx context.Context, repo repositories.Repository, students ...*pb.Student) ([]*pb.Student, error) {
+	added, results, err := repo.AddStudentsToDb(ctx, students, true, false, "contract")
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if result.ErrorCode != 0 {
+			return nil, fmt.Errorf("adding student %d failed: %s", result.Index, result.ErrorMessage)
+		}
+	}
+	return added, nil
+}
+
+// addClasses adds classes without a capacity to seat the students of a case in and returns their ids
+func addClasses(ctx context.Context, repo repositories.Repository, count int) ([]string, error) {
+	classes := make([]*pb.Class, count)
+	year := unique("year")
+	for i := range classes {
+		classes[i] = &pb.Class{Grade: int32(i + 1), Section: "A", AcademicYearId: year}
+	}
+	added, _, err := repo.AddClassesToDb(ctx, classes, true, false, "contract")
+	if err != nil {
+		return nil, err
+	}
+	if len(added) != count {
+		return nil, fmt.Errorf("expected %d added classes, got %d", count, len(added))
+	}
+	var ids []string
+	for _, class := range added {
+		ids = append(ids, class.Id)
+	}
+	return ids, nil
+}
+
+func getStudents(ctx context.Context, repo repositories.Repository, sortOptions bson.D, filter bson.M, page repositories.Page) ([]*pb.Student, error) {
+	return repo.GetStudentsFromDB(ctx, sortOptions, filter, page)
+}
+
+func objectIds(ids ...string) []primitive.ObjectID {
+	var objIds []primitive.ObjectID
+	for _, id := range ids {
+		objId, _ := primitive.ObjectIDFromHex(id)
+		objIds = append(objIds, objId)
+	}
+	return objIds
+}
+
+func addAndGet(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+	classId := classIds[0]
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Ada", LastName: "Lovelace", ClassId: classId})
+	if err != nil {
+		return err
+	}
+	if len(added) != 1 || added[0].Id == "" {
+		return fmt.Errorf("expected one added student with an id, got %v", added)
+	}
+	if added[0].Version != 1 || added[0].CreatedBy != "contract" || added[0].CreatedAt == nil {
+		return fmt.Errorf("expected version 1 and creation metadata, got %v", added[0])
+	}
+
+	found, err := getStudents(ctx, repo, nil, bson.M{"class_id": classId}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if len(found) != 1 || found[0].Id != added[0].Id || found[0].FirstName != "Ada" {
+		return fmt.Errorf("expected to find the added student, got %v", found)
+	}
+	return nil
+}
+
+func filterSortPaginate(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 2)
+	if err != nil {
+		return err
+	}
+	classId := classIds[0]
+	_, err = addStudents(ctx, repo,
+		&pb.Student{FirstName: "Carol", ClassId: classId},
+		&pb.Student{FirstName: "Alice", ClassId: classId},
+		&pb.Student{FirstName: "Bob", ClassId: classId},
+		&pb.Student{FirstName: "Alice", ClassId: classIds[1]},
+	)
+	if err != nil {
+		return err
+	}
+
+	sorted, err := getStudents(ctx, repo, bson.D{{Key: "first_name", Value: -1}}, bson.M{"class_id": classId}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if names := firstNames(sorted); fmt.Sprint(names) != "[Carol Bob Alice]" {
+		return fmt.Errorf("expected descending order [Carol Bob Alice], got %v", names)
+	}
+
+	page, err := getStudents(ctx, repo, bson.D{{Key: "first_name", Value: 1}}, bson.M{"class_id": classId}, repositories.Page{Number: 2, Size: 2})
+	if err != nil {
+		return err
+	}
+	if names := firstNames(page); fmt.Sprint(names) != "[Carol]" {
+		return fmt.Errorf("expected the second page to hold [Carol], got %v", names)
+	}
+
+	filtered, err := getStudents(ctx, repo, nil, bson.M{"class_id": classId, "first_name": "Alice"}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if len(filtered) != 1 {
+		return fmt.Errorf("expected one Alice in the class, got %d", len(filtered))
+	}
+
+	future := time.Now().Add(time.Hour)
+	ranged, err := getStudents(ctx, repo, nil, bson.M{"class_id": classId, "created_at": bson.M{"$gte": future}}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if len(ranged) != 0 {
+		return fmt.Errorf("expected no students created in the future, got %d", len(ranged))
+	}
+	return nil
+}
+
+func firstNames(students []*pb.Student) []string {
+	var names []string
+	for _, student := range students {
+		names = append(names, student.FirstName)
+	}
+	return names
+}
+
+func bulkInsertResults(ctx context.Context, repo repositories.Repository) error {
+	email := unique("mail") + "@example.com"
+	batch := func() []*pb.Student {
+		return []*pb.Student{
+			{FirstName: "First", Email: email},
+			{FirstName: "Second", Email: email},
+			{FirstName: "Third", Email: unique("mail") + "@example.com"},
+		}
+	}
+
+	_, results, err := repo.AddStudentsToDb(ctx, batch(), true, false, "contract")
+	if err != nil {
+		return err
+	}
+	if len(results) != 3 || results[0].ErrorCode != 0 || results[1].ErrorCode != int32(codes.AlreadyExists) || results[2].ErrorCode != int32(codes.Aborted) {
+		return fmt.Errorf("unexpected ordered insert results: %v", results)
+	}
+
+	email = unique("mail") + "@example.com"
+	_, results, err = repo.AddStudentsToDb(ctx, batch(), false, false, "contract")
+	if err != nil {
+		return err
+	}
+	if len(results) != 3 || results[0].ErrorCode != 0 || results[1].ErrorCode != int32(codes.AlreadyExists) || results[2].ErrorCode != 0 {
+		return fmt.Errorf("unexpected unordered insert results: %v", results)
+	}
+	return nil
+}
+
+func duplicateKeys(ctx context.Context, repo repositories.Repository) error {
+	username := unique("user")
+	_, results, err := repo.AddExecsToDb(ctx, []*pb.Exec{{Username: username, Password: "secret"}, {Username: username, Password: "secret"}}, false, false, "contract")
+	if err != nil {
+		return err
+	}
+	if len(results) != 2 || results[1].ErrorCode != int32(codes.AlreadyExists) {
+		return fmt.Errorf("expected the second exec to be a duplicate, got %v", results)
+	}
+
+	other, _, err := repo.AddExecsToDb(ctx, []*pb.Exec{{Username: unique("user"), Password: "secret"}}, true, false, "contract")
+	if err != nil || len(other) != 1 {
+		return fmt.Errorf("adding a second exec failed: %v", err)
+	}
+	_, err = repo.ModifyExecsInDB(ctx, &pb.UpdateExecsRequest{Execs: []*pb.Exec{{Id: other[0].Id, Username: username}}}, "contract")
+	var duplicate *repositories.DuplicateKeyError
+	if !errors.As(err, &duplicate) || duplicate.Field != "username" {
+		return fmt.Errorf("expected a duplicate username error, got %v", err)
+	}
+	return nil
+}
+
+func versionedUpdates(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Grace", ClassId: classIds[0]})
+	if err != nil {
+		return err
+	}
+	student := added[0]
+
+	updated, err := repo.ModifyStudentsInDB(ctx, &pb.UpdateStudentsRequest{Students: []*pb.Student{{Id: student.Id, Version: 1, LastName: "Hopper"}}}, "editor")
+	if err != nil {
+		return err
+	}
+	if len(updated) != 1 || updated[0].Version != 2 || updated[0].LastName != "Hopper" || updated[0].FirstName != "Grace" || updated[0].UpdatedBy != "editor" {
+		return fmt.Errorf("expected the stored student at version 2, got %v", updated)
+	}
+
+	_, err = repo.ModifyStudentsInDB(ctx, &pb.UpdateStudentsRequest{Students: []*pb.Student{{Id: student.Id, Version: 1, LastName: "Stale"}}}, "editor")
+	var conflict *repositories.VersionConflictError
+	if !errors.As(err, &conflict) {
+		return fmt.Errorf("expected a version conflict, got %v", err)
+	}
+	if current, ok := conflict.Current.(*pb.Student); !ok || current.Version != 2 {
+		return fmt.Errorf("expected the conflict to carry the current student, got %v", conflict.Current)
+	}
+
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(student.Id), []int64{1}, "editor", false)
+	if !errors.As(err, &conflict) {
+		return fmt.Errorf("expected a version conflict on delete, got %v", err)
+	}
+	return nil
+}
+
+func updateMasks(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Alan", LastName: "Turing", Email: unique("mail") + "@example.com", ClassId: classIds[0]})
+	if err != nil {
+		return err
+	}
+
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"email", "last_name"}}
+	updated, err := repo.ModifyStudentsInDB(ctx, &pb.UpdateStudentsRequest{Students: []*pb.Student{{Id: added[0].Id, LastName: "M. Turing"}}, UpdateMask: mask}, "editor")
+	if err != nil {
+		return err
+	}
+	if len(updated) != 1 || updated[0].Email != "" || updated[0].LastName != "M. Turing" || updated[0].FirstName != "Alan" {
+		return fmt.Errorf("expected the email cleared and the last name set, got %v", updated)
+	}
+
+	mask = &fieldmaskpb.FieldMask{Paths: []string{"version"}}
+	_, err = repo.ModifyStudentsInDB(ctx, &pb.UpdateStudentsRequest{Students: []*pb.Student{{Id: added[0].Id, Version: 9}}, UpdateMask: mask}, "editor")
+	if err == nil {
+		return errors.New("expected updating a read-only field to fail")
+	}
+	return nil
+}
+
+func softDeleteAndRestore(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+	classId := classIds[0]
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Hidden", ClassId: classId})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(added[0].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+
+	visible, err := getStudents(ctx, repo, nil, bson.M{"class_id": classId, "deleted_at": bson.M{"$exists": false}}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if len(visible) != 0 {
+		return fmt.Errorf("expected the deleted student to be hidden, got %v", visible)
+	}
+
+	all, err := getStudents(ctx, repo, nil, bson.M{"class_id": classId}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if len(all) != 1 || all[0].DeletedBy != "remover" || all[0].DeletedAt == nil {
+		return fmt.Errorf("expected the deleted student with deletion metadata, got %v", all)
+	}
+
+	restored, err := repo.RestoreStudentsInDB(ctx, objectIds(added[0].Id), "restorer")
+	if err != nil {
+		return err
+	}
+	if len(restored) != 1 || restored[0] != added[0].Id {
+		return fmt.Errorf("expected the student to be restored, got %v", restored)
+	}
+
+	visible, err = getStudents(ctx, repo, nil, bson.M{"class_id": classId, "deleted_at": bson.M{"$exists": false}}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if len(visible) != 1 || visible[0].Version != 3 {
+		return fmt.Errorf("expected the restored student at version 3, got %v", visible)
+	}
+	return nil
+}
+
+func reuseDeletedEmail(ctx context.Context, repo repositories.Repository) error {
+	email := unique("reused") + "@example.com"
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Former", Email: email})
+	if err != nil {
+		return err
+	}
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(added[0].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+
+	_, err = addStudents(ctx, repo, &pb.Student{FirstName: "Current", Email: email})
+	if err != nil {
+		return fmt.Errorf("expected the email of the deleted student to be free: %w", err)
+	}
+
+	_, err = repo.RestoreStudentsInDB(ctx, objectIds(added[0].Id), "restorer")
+	var duplicate *repositories.DuplicateKeyError
+	if !errors.As(err, &duplicate) || duplicate.Field != "email" {
+		return fmt.Errorf("expected restoring the student to report the taken email, got %v", err)
+	}
+
+	_, err = repo.RestoreStudentsInDB(ctx, objectIds(primitive.NewObjectID().Hex()), "restorer")
+	var notFound *repositories.NotFoundError
+	if !errors.As(err, &notFound) {
+		return fmt.Errorf("expected restoring a student that is not deleted to report it as not found, got %v", err)
+	}
+	return nil
+}
+
+// studentChange is a change received by a watch
+type studentChange struct {
+	changeType pb.ChangeType
+	student    *pb.Student
+	token      string
+}
+
+// watchStudents watches the students matching the filter in the background, the watch ends with ctx
+func watchStudents(ctx context.Context, repo repositories.Repository, filter bson.M, resumeToken string) <-chan studentChange {
+	changes := make(chan studentChange, 16)
+	go repo.WatchStudentsInDB(ctx, filter, resumeToken, func(changeType pb.ChangeType, student *pb.Student, token string, occurredAt time.Time) error {
+		select {
+		case changes <- studentChange{changeType, student, token}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	return changes
+}
+
+// nextChange waits for the next change of a watch
+func nextChange(ctx context.Context, changes <-chan studentChange) (studentChange, error) {
+	select {
+	case change := <-changes:
+		return change, nil
+	case <-ctx.Done():
+		return studentChange{}, errors.New("timed out waiting for a change")
+	}
+}
+
+func watchAndResume(ctx context.Context, repo repositories.Repository) error {
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+
+	classIds, err := addClasses(ctx, repo, 2)
+	if err != nil {
+		return err
+	}
+	classId := classIds[0]
+	changes := watchStudents(ctx, repo, bson.M{"class_id": classId}, "")
+	// The watch has to be open before the writes it should see
+	time.Sleep(time.Second)
+
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Watched", ClassId: classId}, &pb.Student{FirstName: "Elsewhere", ClassId: classIds[1]})
+	if err != nil {
+		return err
+	}
+	_, err = repo.ModifyStudentsInDB(ctx, &pb.UpdateStudentsRequest{Students: []*pb.Student{{Id: added[0].Id, LastName: "Changed"}}}, "contract")
+	if err != nil {
+		return err
+	}
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(added[0].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+
+	var received []studentChange
+	for _, expected := range []pb.ChangeType{pb.ChangeType_CREATED, pb.ChangeType_UPDATED, pb.ChangeType_DELETED} {
+		change, err := nextChange(ctx, changes)
+		if err != nil {
+			return fmt.Errorf("expected a %s change: %w", expected, err)
+		}
+		if change.changeType != expected || change.student.GetId() != added[0].Id || change.token == "" {
+			return fmt.Errorf("expected a %s change of the watched student with a resume token, got %v %v", expected, change.changeType, change.student)
+		}
+		received = append(received, change)
+	}
+
+	// Resuming after the create sees the changes that followed it
+	resumed := watchStudents(ctx, repo, bson.M{"class_id": classId}, received[0].token)
+	change, err := nextChange(ctx, resumed)
+	if err != nil {
+		return fmt.Errorf("expected the resumed watch to continue: %w", err)
+	}
+	if change.changeType != pb.ChangeType_UPDATED || change.student.GetId() != added[0].Id {
+		return fmt.Errorf("expected the resumed watch to continue with the update, got %v %v", change.changeType, change.student)
+	}
+	return nil
+}
+
+func watchLeaving(ctx context.Context, repo repositories.Repository) error {
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+
+	classIds, err := addClasses(ctx, repo, 3)
+	if err != nil {
+		return err
+	}
+	changes := watchStudents(ctx, repo, bson.M{"class_id": classIds[0]}, "")
+	// The watch has to be open before the writes it should see
+	time.Sleep(time.Second)
+
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Leaving", ClassId: classIds[0]}, &pb.Student{FirstName: "Elsewhere", ClassId: classIds[1]})
+	if err != nil {
+		return err
+	}
+	// Backends may read the record when its change is sent, so the student is only moved once the watch saw it
+	change, err := nextChange(ctx, changes)
+	if err != nil || change.changeType != pb.ChangeType_CREATED || change.student.GetId() != added[0].Id {
+		return fmt.Errorf("expected the student to be created in the watched class, got %v %v %v", change.changeType, change.student, err)
+	}
+
+	// A student moving between two other classes never matched the watch
+	for _, student := range []*pb.Student{{Id: added[1].Id, ClassId: classIds[2]}, {Id: added[0].Id, ClassId: classIds[1]}} {
+		_, err = repo.ModifyStudentsInDB(ctx, &pb.UpdateStudentsRequest{Students: []*pb.Student{student}}, "contract")
+		if err != nil {
+			return err
+		}
+	}
+	change, err = nextChange(ctx, changes)
+	if err != nil {
+		return fmt.Errorf("expected the student to leave the watched class: %w", err)
+	}
+	if change.changeType != pb.ChangeType_LEFT || change.student.GetId() != added[0].Id || change.student.GetClassId() != "" {
+		return fmt.Errorf("expected a LEFT change with only the ID of the student, got %v %v", change.changeType, change.student)
+	}
+	return nil
+}
+
+func studentsByTeacher(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 2)
+	if err != nil {
+		return err
+	}
+	physicsClass, chemistryClass := classIds[0], classIds[1]
+	teachers, _, err := repo.AddTeachersToDb(ctx, []*pb.Teacher{{FirstName: "Marie", Subject: "Physics"}, {FirstName: "Pierre", Subject: "Physics"}}, true, false, "contract")
+	if err != nil || len(teachers) != 2 {
+		return fmt.Errorf("adding teachers failed: %v", err)
+	}
+	teacherId, coTeacherId := teachers[0].Id, teachers[1].Id
+
+	assignments, _, err := repo.AddTeachingAssignmentsToDb(ctx, []*pb.TeachingAssignment{
+		{TeacherId: teacherId, ClassId: physicsClass, Subject: "Physics", Role: pb.TeachingRole_HOMEROOM_TEACHER},
+		{TeacherId: teacherId, ClassId: chemistryClass, Subject: "Chemistry"},
+		{TeacherId: coTeacherId, ClassId: physicsClass, Subject: "Physics"},
+	}, true, false, "contract")
+	if err != nil || len(assignments) != 3 {
+		return fmt.Errorf("adding teaching assignments failed: %v", err)
+	}
+
+	stored, err := repo.GetTeachingAssignmentsFromDB(ctx, nil, bson.M{"teacher_id": teacherId, "class_id": physicsClass})
+	if err != nil {
+		return err
+	}
+	if len(stored) != 1 || stored[0].Role != pb.TeachingRole_HOMEROOM_TEACHER || stored[0].Version != 1 {
+		return fmt.Errorf("expected the stored homeroom assignment, got %v", stored)
+	}
+
+	_, err = addStudents(ctx, repo, &pb.Student{FirstName: "One", ClassId: physicsClass}, &pb.Student{FirstName: "Two", ClassId: physicsClass}, &pb.Student{FirstName: "Three", ClassId: chemistryClass})
+	if err != nil {
+		return err
+	}
+
+	for _, expected := range []struct {
+		teacherId string
+		subject   string
+		students  int
+	}{
+		{teacherId, "", 3},
+		{teacherId, "Physics", 2},
+		{teacherId, "Chemistry", 1},
+		{teacherId, "Biology", 0},
+		{coTeacherId, "", 2},
+	} {
+		students, err := repo.GetStudentsByTeacherIdFromDB(ctx, expected.teacherId, expected.subject)
+		if err != nil {
+			return err
+		}
+		count, err := repo.GetStudentCountByTeacherIdFromDB(ctx, expected.teacherId, expected.subject)
+		if err != nil {
+			return err
+		}
+		if len(students) != expected.students || count != int64(expected.students) {
+			return fmt.Errorf("expected %d students of teacher %s in subject %q, got %d listed and %d counted", expected.students, expected.teacherId, expected.subject, len(students), count)
+		}
+	}
+
+	// Removing an assignment takes its class off the roster
+	_, err = repo.DeleteTeachingAssignmentsFromDB(ctx, objectIds(assignments[1].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+	count, err := repo.GetStudentCountByTeacherIdFromDB(ctx, teacherId, "")
+	if err != nil {
+		return err
+	}
+	if count != 2 {
+		return fmt.Errorf("expected two students once the chemistry assignment is removed, got %d", count)
+	}
+	return nil
+}
+
+func classesInUse(ctx context.Context, repo repositories.Repository) error {
+	classes, _, err := repo.AddClassesToDb(ctx, []*pb.Class{{Grade: 9, Section: "A", AcademicYearId: unique("year"), Capacity: 30}}, true, false, "contract")
+	if err != nil || len(classes) != 1 {
+		return fmt.Errorf("adding a class failed: %v", err)
+	}
+	class := classes[0]
+	if class.Grade != 9 || class.Capacity != 30 || class.Version != 1 {
+		return fmt.Errorf("expected the stored class, got %v", class)
+	}
+
+	_, results, err := repo.AddClassesToDb(ctx, []*pb.Class{{Grade: 9, Section: "A", AcademicYearId: class.AcademicYearId}}, true, false, "contract")
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 || results[0].ErrorCode != int32(codes.AlreadyExists) {
+		return fmt.Errorf("expected a second 9A of the same year to be a duplicate, got %v", results)
+	}
+
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Member", ClassId: class.Id})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.DeleteClassesFromDB(ctx, objectIds(class.Id), nil, "remover", false)
+	var referenced *repositories.ReferencedError
+	if !errors.As(err, &referenced) || referenced.Id != class.Id {
+		return fmt.Errorf("expected the class in use to be kept, got %v", err)
+	}
+
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(added[0].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+	_, err = repo.DeleteClassesFromDB(ctx, objectIds(class.Id), nil, "remover", false)
+	if err != nil {
+		return fmt.Errorf("expected the class without students to be deleted, got %v", err)
+	}
+	return nil
+}
+
+func classCapacity(ctx context.Context, repo repositories.Repository) error {
+	classes, _, err := repo.AddClassesToDb(ctx, []*pb.Class{{Grade: 5, Section: "A", AcademicYearId: unique("year"), Capacity: 2}}, true, false, "contract")
+	if err != nil || len(classes) != 1 {
+		return fmt.Errorf("adding a class failed: %v", err)
+	}
+	classId := classes[0].Id
+	others, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+
+	added, results, err := repo.AddStudentsToDb(ctx, []*pb.Student{{FirstName: "First", ClassId: classId}, {FirstName: "Second", ClassId: classId}, {FirstName: "Third", ClassId: classId}}, false, false, "contract")
+	if err != nil {
+		return err
+	}
+	if len(added) != 2 || len(results) != 3 || results[2].ErrorCode != int32(codes.FailedPrecondition) {
+		return fmt.Errorf("expected the third student not to fit, got %v", results)
+	}
+
+	moved, err := addStudents(ctx, repo, &pb.Student{FirstName: "Mover", ClassId: others[0]})
+	if err != nil {
+		return err
+	}
+	move := &pb.UpdateStudentsRequest{Students: []*pb.Student{{Id: moved[0].Id, ClassId: classId}}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"class_id"}}}
+	_, err = repo.ModifyStudentsInDB(ctx, move, "contract")
+	var full *repositories.ClassFullError
+	if !errors.As(err, &full) || full.ClassId != classId {
+		return fmt.Errorf("expected moving a student into the full class to fail, got %v", err)
+	}
+
+	// A seat freed by a deleted student is not held for them
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(added[0].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+	_, err = repo.ModifyStudentsInDB(ctx, move, "contract")
+	if err != nil {
+		return fmt.Errorf("expected the student to take the free seat, got %v", err)
+	}
+	_, err = repo.RestoreStudentsInDB(ctx, objectIds(added[0].Id), "restorer")
+	if !errors.As(err, &full) {
+		return fmt.Errorf("expected restoring a student into the full class to fail, got %v", err)
+	}
+	return nil
+}
+
+func teacherAssignments(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+	teachers, _, err := repo.AddTeachersToDb(ctx, []*pb.Teacher{{FirstName: "Emmy", Subject: "Algebra"}}, true, false, "contract")
+	if err != nil || len(teachers) != 1 {
+		return fmt.Errorf("adding a teacher failed: %v", err)
+	}
+	assignment := &pb.TeachingAssignment{TeacherId: teachers[0].Id, ClassId: classIds[0], Subject: "Algebra"}
+
+	_, results, err := repo.AddTeachingAssignmentsToDb(ctx, []*pb.TeachingAssignment{assignment, assignment}, false, false, "contract")
+	if err != nil {
+		return err
+	}
+	if len(results) != 2 || results[0].ErrorCode != 0 || results[1].ErrorCode != int32(codes.AlreadyExists) {
+		return fmt.Errorf("expected the second copy of the assignment to be a duplicate, got %v", results)
+	}
+
+	_, err = repo.DeleteTeachersFromDB(ctx, objectIds(teachers[0].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+	active, err := repo.GetTeachingAssignmentsFromDB(ctx, nil, bson.M{"teacher_id": teachers[0].Id, "deleted_at": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+	if len(active) != 0 {
+		return fmt.Errorf("expected the assignments of the deleted teacher to be ended, got %v", active)
+	}
+	_, err = repo.DeleteClassesFromDB(ctx, objectIds(classIds[0]), nil, "remover", false)
+	if err != nil {
+		return fmt.Errorf("expected the class of the deleted teacher to be deleted, got %v", err)
+	}
+	return nil
+}
+
+func execAccounts(ctx context.Context, repo repositories.Repository) error {
+	username := unique("user")
+	added, _, err := repo.AddExecsToDb(ctx, []*pb.Exec{{Username: username, Password: "first-secret", Role: "admin"}}, true, false, "contract")
+	if err != nil || len(added) != 1 {
+		return fmt.Errorf("adding an exec failed: %v", err)
+	}
+
+	exec, err := repo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+	if exec.Password == "first-secret" || exec.Password == "" {
+		return errors.New("expected the stored password to be hashed")
+	}
+
+	_, err = repo.UpdateUserInDB(ctx, &pb.UpdatePasswordRequest{Id: exec.Id, CurrentPassword: "wrong", NewPassword: "second-secret"}, exec.Id)
+	if err == nil {
+		return errors.New("expected a wrong current password to be rejected")
+	}
+	_, err = repo.UpdateUserInDB(ctx, &pb.UpdatePasswordRequest{Id: exec.Id, CurrentPassword: "first-secret", NewPassword: "second-secret"}, exec.Id)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.DeactivateUserInDB(ctx, objectIds(exec.Id), "contract")
+	if err != nil {
+		return err
+	}
+	exec, err = repo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+	if !exec.InactiveStatus || exec.PasswordChangedAt.IsZero() {
+		return fmt.Errorf("expected an inactive exec with a password change time, got %v", exec)
+	}
+	return nil
+}
+
+func eraseAndHold(ctx context.Context, repo repositories.Repository) error {
+	teachers, _, err := repo.AddTeachersToDb(ctx, []*pb.Teacher{{FirstName: "Grace", LastName: "Hopper", Email: unique("erased") + "@example.com"}}, true, false, "contract")
+	if err != nil || len(teachers) != 1 {
+		return fmt.Errorf("adding a teacher failed: %v", err)
+	}
+	teacher := &pb.PersonRef{Type: pb.PersonType_TEACHER, Id: teachers[0].Id}
+	classes, _, err := repo.AddClassesToDb(ctx, []*pb.Class{{Grade: 5, Section: "B", AcademicYearId: unique("year"), HomeroomTeacherId: teacher.Id}}, true, false, "contract")
+	if err != nil || len(classes) != 1 {
+		return fmt.Errorf("adding a class failed: %v", err)
+	}
+
+	bundle, err := repo.GetPersonDataFromDB(ctx, teacher, "contract")
+	if err != nil {
+		return err
+	}
+	if len(bundle.Records) != 2 || bundle.Records[0].Id != teacher.Id || bundle.Records[1].Id != classes[0].Id {
+		return fmt.Errorf("expected the teacher and their class in the export, got %v", bundle.Records)
+	}
+
+	_, err = repo.AddLegalHoldToDb(ctx, &pb.LegalHoldRequest{Person: teacher, Reason: "inquiry"}, "contract")
+	if err != nil {
+		return err
+	}
+	_, err = repo.ErasePersonInDB(ctx, &pb.ErasePersonRequest{Person: teacher, Mode: pb.ErasureMode_ANONYMIZE}, "contract")
+	var held *repositories.LegalHoldError
+	if !errors.As(err, &held) || held.Reason != "inquiry" {
+		return fmt.Errorf("expected the held teacher to be kept, got %v", err)
+	}
+
+	err = repo.DeleteLegalHoldFromDB(ctx, teacher, "contract")
+	if err != nil {
+		return err
+	}
+	response, err := repo.ErasePersonInDB(ctx, &pb.ErasePersonRequest{Person: teacher, Mode: pb.ErasureMode_ANONYMIZE}, "contract")
+	if err != nil {
+		return err
+	}
+	if response.RecordsAnonymized != 1 || response.ReferencesAnonymized != 1 || response.AuditId == "" {
+		return fmt.Errorf("expected the teacher and their class to be anonymized, got %v", response)
+	}
+
+	erased, err := repo.GetTeachersFromDB(ctx, nil, bson.M{"_id": objectIds(teacher.Id)[0]})
+	if err != nil {
+		return err
+	}
+	if len(erased) != 1 || erased[0].FirstName != "Erased" || erased[0].Email == teachers[0].Email {
+		return fmt.Errorf("expected the teacher to be anonymized, got %v", erased)
+	}
+	class, err := repo.GetClassesFromDB(ctx, nil, bson.M{"_id": objectIds(classes[0].Id)[0]})
+	if err != nil {
+		return err
+	}
+	if len(class) != 1 || class[0].HomeroomTeacherId != "erased" {
+		return fmt.Errorf("expected the homeroom teacher of the class to be erased, got %v", class)
+	}
+	return nil
+}
+
+func purgeKeepsHeld(ctx context.Context, repo repositories.Repository) error {
+	classIds, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+	classId := classIds[0]
+	added, err := addStudents(ctx, repo, &pb.Student{FirstName: "Held", ClassId: classId}, &pb.Student{FirstName: "Released", ClassId: classId})
+	if err != nil {
+		return err
+	}
+	_, err = repo.AddLegalHoldToDb(ctx, &pb.LegalHoldRequest{Person: &pb.PersonRef{Type: pb.PersonType_STUDENT, Id: added[0].Id}}, "contract")
+	if err != nil {
+		return err
+	}
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(added[0].Id, added[1].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+
+	err = repo.PurgeDeletedRecordsFromDB(ctx, time.Now().Add(time.Second))
+	if err != nil {
+		return err
+	}
+
+	remaining, err := getStudents(ctx, repo, nil, bson.M{"class_id": classId}, repositories.Page{})
+	if err != nil {
+		return err
+	}
+	if len(remaining) != 1 || remaining[0].Id != added[0].Id {
+		return fmt.Errorf("expected only the held student to survive the purge, got %v", remaining)
+	}
+	return nil
+}
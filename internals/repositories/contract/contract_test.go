@@ -0,0 +1,70 @@
+package contract_test
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/contract"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/internals/repositories/sqlite"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestContract runs every case against every backend
+// The sqlite backend runs against a fresh temporary database, the mongodb backend only runs when CONTRACT_MONGODB_URI
+// is set, and writes records to the school database of that server, so only point it at a scratch server
+func TestContract(t *testing.T) {
+	backends := []struct {
+		name string
+		open func(t *testing.T) repositories.Repository
+	}{
+		{"sqlite", openSqlite},
+		{"mongodb", openMongodb},
+	}
+
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			repo := backend.open(t)
+			for _, c := range contract.Cases() {
+				t.Run(c.Name, func(t *testing.T) {
+					err := c.Run(context.Background(), repo)
+					if errors.Is(err, contract.ErrNotProvided) {
+						t.Skip(err)
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+				})
+			}
+		})
+	}
+}
+
+func openSqlite(t *testing.T) repositories.Repository {
+	repo, err := sqlite.Open(context.Background(), filepath.Join(t.TempDir(), "school.db"))
+	if err != nil {
+		t.Fatalf("Error opening the sqlite backend: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+func openMongodb(t *testing.T) repositories.Repository {
+	uri := os.Getenv("CONTRACT_MONGODB_URI")
+	if uri == "" {
+		t.Skip("CONTRACT_MONGODB_URI is not set")
+	}
+	t.Setenv("MONGODB_URI", uri)
+
+	err := mongodb.EnsureIndexes(context.Background())
+	if err != nil {
+		t.Fatalf("Error creating the mongodb indexes: %v", err)
+	}
+	err = mongodb.EnablePreImages(context.Background())
+	if err != nil {
+		t.Fatalf("Error keeping the documents before changes: %v", err)
+	}
+	return mongodb.Repository{}
+}
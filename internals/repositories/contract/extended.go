@@ -0,0 +1,273 @@
+package contract
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrNotProvided is returned by the cases of services the backend does not provide, the suite skips them
+var ErrNotProvided = errors.New("the backend does not provide the services of this case")
+
+// extended returns the repository as an ExtendedRepository, or ErrNotProvided when the backend has no extended services
+func extended(repo repositories.Repository) (repositories.ExtendedRepository, error) {
+	ext, ok := repo.(repositories.ExtendedRepository)
+	if !ok {
+		return nil, ErrNotProvided
+	}
+	return ext, nil
+}
+
+// resultCodes returns the error code of every result, 0 for the items that succeeded
+func resultCodes(results []*pb.BulkItemResult) []codes.Code {
+	var errorCodes []codes.Code
+	for _, result := range results {
+		errorCodes = append(errorCodes, codes.Code(result.ErrorCode))
+	}
+	return errorCodes
+}
+
+func enrollmentCapacity(ctx context.Context, repo repositories.Repository) error {
+	ext, err := extended(repo)
+	if err != nil {
+		return err
+	}
+	courses, _, err := ext.AddCoursesToDb(ctx, []*pb.Course{{Code: unique("course"), Name: "Chemistry", Credits: 3}}, true, false, "contract")
+	if err != nil || len(courses) != 1 {
+		return fmt.Errorf("adding a course failed: %v", err)
+	}
+	sections, _, err := ext.AddCourseSectionsToDb(ctx, []*pb.CourseSection{{CourseId: courses[0].Id, Name: "A", Term: unique("term"), Capacity: 2}}, true, false, "contract")
+	if err != nil || len(sections) != 1 {
+		return fmt.Errorf("adding a section failed: %v", err)
+	}
+	sectionId := sections[0].Id
+	students, err := addStudents(ctx, repo, &pb.Student{FirstName: "First"}, &pb.Student{FirstName: "Second"}, &pb.Student{FirstName: "Third"})
+	if err != nil {
+		return err
+	}
+	first, second, third := students[0].Id, students[1].Id, students[2].Id
+
+	enrolled, results, err := ext.EnrollStudentsInDB(ctx, sectionId, []string{first, second, second, third}, "contract")
+	if err != nil {
+		return err
+	}
+	got := resultCodes(results)
+	if len(enrolled) != 2 || len(got) != 4 || got[0] != codes.OK || got[1] != codes.OK || got[2] != codes.AlreadyExists || got[3] != codes.FailedPrecondition {
+		return fmt.Errorf("expected the second student once and the third not to fit, got %v", got)
+	}
+
+	// A dropped student frees their seat
+	_, err = ext.DropStudentsInDB(ctx, sectionId, []string{first}, "contract")
+	if err != nil {
+		return err
+	}
+	_, results, err = ext.EnrollStudentsInDB(ctx, sectionId, []string{third}, "contract")
+	if err != nil {
+		return err
+	}
+	if got := resultCodes(results); len(got) != 1 || got[0] != codes.OK {
+		return fmt.Errorf("expected the third student to take the free seat, got %v", got)
+	}
+
+	found, err := ext.GetCourseSectionsFromDB(ctx, nil, bson.M{"_id": objectIds(sectionId)[0]})
+	if err != nil {
+		return err
+	}
+	if len(found) != 1 || found[0].Enrolled != 2 {
+		return fmt.Errorf("expected the section to count 2 enrolled students, got %v", found)
+	}
+	current, err := ext.GetEnrollmentsFromDB(ctx, nil, bson.M{"section_id": sectionId, "status": pb.EnrollmentStatus_ENROLLED.String()})
+	if err != nil {
+		return err
+	}
+	if len(current) != 2 {
+		return fmt.Errorf("expected 2 current enrollments, got %v", current)
+	}
+	return nil
+}
+
+func scoreUniqueness(ctx context.Context, repo repositories.Repository) error {
+	ext, err := extended(repo)
+	if err != nil {
+		return err
+	}
+	classIds, err := addClasses(ctx, repo, 1)
+	if err != nil {
+		return err
+	}
+	assessments, _, err := ext.AddAssessmentsToDb(ctx, []*pb.Assessment{{ClassId: classIds[0], Subject: "Algebra", Term: unique("term"), Title: "Quiz", MaxScore: 10}}, true, false, "contract")
+	if err != nil || len(assessments) != 1 {
+		return fmt.Errorf("adding an assessment failed: %v", err)
+	}
+	assessmentId := assessments[0].Id
+	students, err := addStudents(ctx, repo, &pb.Student{FirstName: "Scored", ClassId: classIds[0]}, &pb.Student{FirstName: "Raced", ClassId: classIds[0]})
+	if err != nil {
+		return err
+	}
+	studentId, racedId := students[0].Id, students[1].Id
+
+	// A student listed twice changes the score recorded for the first entry
+	_, results, err := ext.RecordScoresInDB(ctx, assessmentId, []*pb.ScoreEntry{{StudentId: studentId, Score: 7}, {StudentId: studentId, Score: 8, Reason: "recounted"}}, "contract")
+	if err != nil {
+		return err
+	}
+	if got := resultCodes(results); len(got) != 2 || got[0] != codes.OK || got[1] != codes.OK || results[0].Id != results[1].Id {
+		return fmt.Errorf("expected both entries to write the same score, got %v", results)
+	}
+
+	_, results, err = ext.RecordScoresInDB(ctx, assessmentId, []*pb.ScoreEntry{{StudentId: studentId, Score: 9}, {StudentId: studentId, Score: 9, Reason: "late", Version: 1}}, "contract")
+	if err != nil {
+		return err
+	}
+	if got := resultCodes(results); len(got) != 2 || got[0] != codes.InvalidArgument || got[1] != codes.FailedPrecondition {
+		return fmt.Errorf("expected a change without a reason and a stale version to be rejected, got %v", got)
+	}
+
+	scores, err := ext.GetScoresFromDB(ctx, nil, bson.M{"assessment_id": assessmentId, "student_id": studentId})
+	if err != nil {
+		return err
+	}
+	if len(scores) != 1 || scores[0].Score != 8 || scores[0].Version != 2 || len(scores[0].Changes) != 1 {
+		return fmt.Errorf("expected one score of 8 with a single change, got %v", scores)
+	}
+
+	// Two teachers recording the same score at once still leave a single score
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, errs[i] = ext.RecordScoresInDB(ctx, assessmentId, []*pb.ScoreEntry{{StudentId: racedId, Score: 5}}, "contract")
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		var duplicate *repositories.DuplicateKeyError
+		if err != nil && !errors.As(err, &duplicate) {
+			return fmt.Errorf("expected concurrent recordings to succeed or be duplicates, got %v", err)
+		}
+	}
+	scores, err = ext.GetScoresFromDB(ctx, nil, bson.M{"assessment_id": assessmentId, "student_id": racedId})
+	if err != nil {
+		return err
+	}
+	if len(scores) != 1 {
+		return fmt.Errorf("expected concurrent recordings to leave one score, got %v", scores)
+	}
+	return nil
+}
+
+func promotion(ctx context.Context, repo repositories.Repository) error {
+	ext, err := extended(repo)
+	if err != nil {
+		return err
+	}
+	fromYear, toYear := unique("year"), unique("year")
+	classes, _, err := repo.AddClassesToDb(ctx, []*pb.Class{
+		{Grade: 3, Section: "A", AcademicYearId: fromYear, Capacity: 2},
+		{Grade: 4, Section: "A", AcademicYearId: toYear, Capacity: 1},
+		{Grade: 4, Section: "B", AcademicYearId: toYear, Capacity: 2},
+	}, true, false, "contract")
+	if err != nil || len(classes) != 3 {
+		return fmt.Errorf("adding classes failed: %v", err)
+	}
+	fromId, smallId, roomyId := classes[0].Id, classes[1].Id, classes[2].Id
+	students, err := addStudents(ctx, repo, &pb.Student{FirstName: "Ann", ClassId: fromId}, &pb.Student{FirstName: "Ben", ClassId: fromId})
+	if err != nil {
+		return err
+	}
+
+	memberships := func(toClassId string) []*pb.ClassMembership {
+		var list []*pb.ClassMembership
+		for _, student := range students {
+			list = append(list, &pb.ClassMembership{StudentId: student.Id, ClassId: fromId, AcademicYearId: fromYear, Outcome: pb.PromotionOutcome_PROMOTED, ToClassId: toClassId})
+		}
+		return list
+	}
+	inClass := func(classId string) error {
+		found, err := getStudents(ctx, repo, nil, bson.M{"class_id": classId, "deleted_at": bson.M{"$exists": false}}, repositories.Page{})
+		if err != nil {
+			return err
+		}
+		if len(found) != len(students) {
+			return fmt.Errorf("expected the students in class %s, got %v", classId, found)
+		}
+		return nil
+	}
+	promotion := &pb.Promotion{Grade: 3, FromAcademicYearId: fromYear, ToAcademicYearId: toYear}
+
+	// A promotion that does not fit moves nobody
+	_, _, err = ext.PromoteStudentsInDB(ctx, promotion, memberships(smallId), "contract")
+	var full *repositories.ClassFullError
+	if !errors.As(err, &full) || full.ClassId != smallId {
+		return fmt.Errorf("expected promoting two students into a class for one to fail, got %v", err)
+	}
+	err = inClass(fromId)
+	if err != nil {
+		return err
+	}
+
+	promoted, recorded, err := ext.PromoteStudentsInDB(ctx, promotion, memberships(roomyId), "contract")
+	if err != nil {
+		return err
+	}
+	if len(recorded) != 2 {
+		return fmt.Errorf("expected a membership per student, got %v", recorded)
+	}
+	err = inClass(roomyId)
+	if err != nil {
+		return err
+	}
+
+	// The students cannot return to a class that filled up in the meantime
+	newcomers, err := addStudents(ctx, repo, &pb.Student{FirstName: "Cleo", ClassId: fromId}, &pb.Student{FirstName: "Dan", ClassId: fromId})
+	if err != nil {
+		return err
+	}
+	promotionId := objectIds(promoted.Id)[0]
+	_, _, err = ext.UndoPromotionInDB(ctx, promotionId, "contract")
+	if !errors.As(err, &full) || full.ClassId != fromId {
+		return fmt.Errorf("expected undoing the promotion into the full class to fail, got %v", err)
+	}
+	err = inClass(roomyId)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.DeleteStudentsFromDB(ctx, objectIds(newcomers[0].Id, newcomers[1].Id), nil, "remover", false)
+	if err != nil {
+		return err
+	}
+	_, undone, err := ext.UndoPromotionInDB(ctx, promotionId, "contract")
+	if err != nil {
+		return err
+	}
+	if len(undone) != 2 {
+		return fmt.Errorf("expected both students to be moved back, got %v", undone)
+	}
+	err = inClass(fromId)
+	if err != nil {
+		return err
+	}
+	left, err := ext.GetClassMembershipsFromDB(ctx, nil, bson.M{"promotion_id": promoted.Id})
+	if err != nil {
+		return err
+	}
+	if len(left) != 0 {
+		return fmt.Errorf("expected the memberships of the undone promotion to be removed, got %v", left)
+	}
+
+	_, _, err = ext.UndoPromotionInDB(ctx, promotionId, "contract")
+	var notFound *repositories.NotFoundError
+	if !errors.As(err, &notFound) {
+		return fmt.Errorf("expected undoing the promotion again to fail, got %v", err)
+	}
+	return nil
+}
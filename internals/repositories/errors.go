@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// VersionConflictError is returned when the version supplied with an update or delete no longer matches the stored
+// document, Current holds the document as it is stored now
+type VersionConflictError struct {
+	Id      string
+	Current proto.Message
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("version conflict: the record with ID %s was modified by someone else", e.Id)
+}
+
+// DuplicateKeyError is returned when a write would break a unique index, Field names the conflicting field
+type DuplicateKeyError struct {
+	Field string
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("a record with the same %s already exists", e.Field)
+}
+
+// NotFoundError is returned when a record an operation depends on does not exist
+type NotFoundError struct {
+	Entity string
+	Id     string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("no %s found with ID: %s", e.Entity, e.Id)
+}
+
+// LegalHoldError is returned when a person under legal hold would be erased
+type LegalHoldError struct {
+	Reason string
+}
+
+func (e *LegalHoldError) Error() string {
+	if e.Reason == "" {
+		return "the person is under legal hold"
+	}
+	return fmt.Sprintf("the person is under legal hold: %s", e.Reason)
+}
+
+// ReferencedError is returned when a record that other records still point to would be deleted
+type ReferencedError struct {
+	Entity       string
+	Id           string
+	ReferencedBy string
+}
+
+func (e *ReferencedError) Error() string {
+	return fmt.Sprintf("the %s with ID %s is still referenced by %s", e.Entity, e.Id, e.ReferencedBy)
+}
+
+// EnrollmentClosedError is returned when students enroll in or drop a course section after it closed for that
+type EnrollmentClosedError struct {
+	SectionId string
+	ClosedAt  time.Time
+}
+
+func (e *EnrollmentClosedError) Error() string {
+	return fmt.Sprintf("the course section with ID %s closed at %s", e.SectionId, e.ClosedAt.Format(time.RFC3339))
+}
+
+// TermFinalizedError is returned when assessments, scores or category weights of a finalized term would change
+type TermFinalizedError struct {
+	Term        string
+	FinalizedAt time.Time
+}
+
+func (e *TermFinalizedError) Error() string {
+	return fmt.Sprintf("term %s was finalized at %s, its grades are locked", e.Term, e.FinalizedAt.Format(time.RFC3339))
+}
+
+// DoubleBookingError is returned when a timetable slot books a teacher, class or room already booked in the same period
+type DoubleBookingError struct {
+	Day      string
+	Period   int32
+	Resource string
+	BookedBy string
+}
+
+func (e *DoubleBookingError) Error() string {
+	return fmt.Sprintf("the %s is already booked on %s in period %d by the slot with ID %s", e.Resource, e.Day, e.Period, e.BookedBy)
+}
+
+// SubmissionGradedError is returned when a file is uploaded for a submission that already has feedback
+type SubmissionGradedError struct {
+	Id       string
+	GradedAt time.Time
+}
+
+func (e *SubmissionGradedError) Error() string {
+	return fmt.Sprintf("the submission with ID %s was graded at %s, its file can no longer be replaced", e.Id, e.GradedAt.Format(time.RFC3339))
+}
+
+// ClassFullError is returned when a write would seat more students in a class than its capacity
+type ClassFullError struct {
+	ClassId  string
+	Capacity int32
+}
+
+func (e *ClassFullError) Error() string {
+	return fmt.Sprintf("class %s is full, it takes %d students", e.ClassId, e.Capacity)
+}
+
+// MaxScoreBelowScoresError is returned when the max score of an assessment would drop below a score recorded for it
+type MaxScoreBelowScoresError struct {
+	AssessmentId string
+	MaxScore     float64
+	Score        float64
+}
+
+func (e *MaxScoreBelowScoresError) Error() string {
+	return fmt.Sprintf("assessment %s has a recorded score of %g, its max_score cannot drop to %g", e.AssessmentId, e.Score, e.MaxScore)
+}
@@ -0,0 +1,537 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	registerIndexes("academic_years",
+		mongo.IndexModel{Keys: bson.D{{Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerIndexes("terms",
+		mongo.IndexModel{Keys: bson.D{{Key: "academic_year_id", Value: 1}, {Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerIndexes("class_memberships",
+		// A promotion records the class a student leaves once per academic year. Concurrent promotions of a student
+		// already conflict on the student, the index also keeps two promotions of different grades from both recording the year
+		mongo.IndexModel{Keys: bson.D{{Key: "student_id", Value: 1}, {Key: "academic_year_id", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(unerased)},
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "promotion_id", Value: 1}}},
+	)
+	registerKeyedPersonReferences(pb.PersonType_STUDENT, "class_memberships", "student_id")
+	registerPersonReferences(pb.PersonType_EXEC, "class_memberships", "created_by")
+	registerPersonReferences(pb.PersonType_EXEC, "promotions", "created_by", "undone_by")
+}
+
+func MapModelAcademicYearToPbAcademicYear(academicYearModel *models.AcademicYear) *pb.AcademicYear {
+	return MapModelToPb(academicYearModel, func() *pb.AcademicYear { return &pb.AcademicYear{} })
+}
+
+func MapPbAcademicYearToModelAcademicYear(pbAcademicYear *pb.AcademicYear) *models.AcademicYear {
+	return MapPbToModel(pbAcademicYear, func() *models.AcademicYear { return &models.AcademicYear{} })
+}
+
+func AddAcademicYearsToDb(ctx context.Context, academicYearsFromReq []*pb.AcademicYear, ordered, atomic bool, actor string) ([]*pb.AcademicYear, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newAcademicYears := make([]*models.AcademicYear, len(academicYearsFromReq))
+	for i, pbAcademicYear := range academicYearsFromReq {
+		modelAcademicYear := MapPbAcademicYearToModelAcademicYear(pbAcademicYear)
+		modelAcademicYear.Version = 1
+		modelAcademicYear.CreatedAt = now
+		modelAcademicYear.UpdatedAt = now
+		modelAcademicYear.CreatedBy = actor
+		modelAcademicYear.UpdatedBy = actor
+		newAcademicYears[i] = modelAcademicYear
+	}
+
+	results, err := addEntities(ctx, client, "academic_years", newAcademicYears, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedAcademicYears []*pb.AcademicYear
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newAcademicYears[i].Id = result.Id
+		addedAcademicYears = append(addedAcademicYears, MapModelAcademicYearToPbAcademicYear(newAcademicYears[i]))
+	}
+	return addedAcademicYears, results, nil
+}
+
+func GetAcademicYearsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.AcademicYear, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("academic_years")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	academicYears, err := decodeEntities(ctx, cursor, func() *pb.AcademicYear { return &pb.AcademicYear{} }, func() *models.AcademicYear { return &models.AcademicYear{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return academicYears, nil
+}
+
+func ModifyAcademicYearsInDB(ctx context.Context, req *pb.UpdateAcademicYearsRequest, actor string) ([]*pb.AcademicYear, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("academic_years")
+
+	var updatedAcademicYears []*pb.AcademicYear
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedAcademicYears = nil
+		for _, academicYear := range req.AcademicYears {
+			if academicYear.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelAcademicYear := MapPbAcademicYearToModelAcademicYear(academicYear)
+			objId, err := primitive.ObjectIDFromHex(academicYear.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			updatedModel, err := updateEntity(ctx, coll, objId, modelAcademicYear, updatePaths(academicYear, req.GetUpdateMask().GetPaths()), academicYear.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "academic year", MapModelAcademicYearToPbAcademicYear)
+			}
+			if mongo.IsDuplicateKeyError(err) {
+				return asDuplicateKeyError(err)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating academic year with ID: %s", academicYear.Id))
+			}
+
+			updatedAcademicYears = append(updatedAcademicYears, MapModelAcademicYearToPbAcademicYear(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no academic years were updated")
+		}
+		return nil, err
+	}
+	return updatedAcademicYears, nil
+}
+
+func DeleteAcademicYearsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("academic_years")
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, coll, objectIdsToDelete, expectedVersions, actor, "academic year", MapModelAcademicYearToPbAcademicYear)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No academic years were deleted")
+		}
+
+		// Rolling back the transaction leaves every academic year in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all academic years were found, no academic years were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+func MapModelTermToPbTerm(termModel *models.Term) *pb.Term {
+	return MapModelToPb(termModel, func() *pb.Term { return &pb.Term{} })
+}
+
+func MapPbTermToModelTerm(pbTerm *pb.Term) *models.Term {
+	return MapPbToModel(pbTerm, func() *models.Term { return &models.Term{} })
+}
+
+func AddTermsToDb(ctx context.Context, termsFromReq []*pb.Term, ordered, atomic bool, actor string) ([]*pb.Term, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newTerms := make([]*models.Term, len(termsFromReq))
+	for i, pbTerm := range termsFromReq {
+		modelTerm := MapPbTermToModelTerm(pbTerm)
+		modelTerm.Version = 1
+		modelTerm.CreatedAt = now
+		modelTerm.UpdatedAt = now
+		modelTerm.CreatedBy = actor
+		modelTerm.UpdatedBy = actor
+		newTerms[i] = modelTerm
+	}
+
+	results, err := addEntities(ctx, client, "terms", newTerms, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedTerms []*pb.Term
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newTerms[i].Id = result.Id
+		addedTerms = append(addedTerms, MapModelTermToPbTerm(newTerms[i]))
+	}
+	return addedTerms, results, nil
+}
+
+func GetTermsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Term, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("terms")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	terms, err := decodeEntities(ctx, cursor, func() *pb.Term { return &pb.Term{} }, func() *models.Term { return &models.Term{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return terms, nil
+}
+
+func ModifyTermsInDB(ctx context.Context, req *pb.UpdateTermsRequest, actor string) ([]*pb.Term, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("terms")
+
+	var updatedTerms []*pb.Term
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedTerms = nil
+		for _, term := range req.Terms {
+			if term.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelTerm := MapPbTermToModelTerm(term)
+			objId, err := primitive.ObjectIDFromHex(term.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			updatedModel, err := updateEntity(ctx, coll, objId, modelTerm, updatePaths(term, req.GetUpdateMask().GetPaths()), term.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "term", MapModelTermToPbTerm)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating term with ID: %s", term.Id))
+			}
+
+			updatedTerms = append(updatedTerms, MapModelTermToPbTerm(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no terms were updated")
+		}
+		return nil, err
+	}
+	return updatedTerms, nil
+}
+
+func DeleteTermsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("terms")
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, coll, objectIdsToDelete, expectedVersions, actor, "term", MapModelTermToPbTerm)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No terms were deleted")
+		}
+
+		// Rolling back the transaction leaves every term in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all terms were found, no terms were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+func MapModelClassMembershipToPbClassMembership(membershipModel *models.ClassMembership) *pb.ClassMembership {
+	return MapModelToPb(membershipModel, func() *pb.ClassMembership { return &pb.ClassMembership{} })
+}
+
+func MapModelPromotionToPbPromotion(promotionModel *models.Promotion) *pb.Promotion {
+	return MapModelToPb(promotionModel, func() *pb.Promotion { return &pb.Promotion{} })
+}
+
+func GetClassMembershipsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.ClassMembership, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("class_memberships")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	memberships, err := decodeEntities(ctx, cursor, func() *pb.ClassMembership { return &pb.ClassMembership{} }, func() *models.ClassMembership { return &models.ClassMembership{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return memberships, nil
+}
+
+// PromoteStudentsInDB moves every student of the memberships from its class to its next class in a single transaction
+// A student who is no longer in the class the membership names fails the whole promotion with a NotFoundError,
+// a class the students take over its capacity with a ClassFullError
+func PromoteStudentsInDB(ctx context.Context, promotion *pb.Promotion, memberships []*pb.ClassMembership, actor string) (*pb.Promotion, []*pb.ClassMembership, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	var promotionModel *models.Promotion
+	var recorded []*pb.ClassMembership
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		recorded = nil
+		now := time.Now()
+		promotionModel = MapPbToModel(promotion, func() *models.Promotion { return &models.Promotion{} })
+		promotionModel.CreatedAt = now
+		promotionModel.CreatedBy = actor
+		result, err := db.Collection("promotions").InsertOne(ctx, promotionModel)
+		if err != nil {
+			return utils.ErrorHandler(err, "Error recording the promotion")
+		}
+		promotionModel.Id = result.InsertedID.(primitive.ObjectID).Hex()
+
+		for _, membership := range memberships {
+			objId, err := primitive.ObjectIDFromHex(membership.StudentId)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			set := bson.M{"updated_at": now, "updated_by": actor}
+			update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+			if membership.ToClassId != "" {
+				set["class_id"] = membership.ToClassId
+			} else {
+				update["$unset"] = bson.M{"class_id": ""}
+			}
+			var student models.Student
+			err = db.Collection("students").FindOneAndUpdate(ctx, notDeleted(bson.M{"_id": objId, "class_id": membership.ClassId}), update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&student)
+			if err == mongo.ErrNoDocuments {
+				return &repositories.NotFoundError{Entity: fmt.Sprintf("student in class %s", membership.ClassId), Id: membership.StudentId}
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error promoting student with ID: %s", membership.StudentId))
+			}
+
+			membershipModel := MapPbToModel(membership, func() *models.ClassMembership { return &models.ClassMembership{} })
+			membershipModel.PromotionId = promotionModel.Id
+			membershipModel.StudentVersion = student.Version
+			membershipModel.CreatedAt = now
+			membershipModel.CreatedBy = actor
+			result, err := db.Collection("class_memberships").InsertOne(ctx, membershipModel)
+			if mongo.IsDuplicateKeyError(err) {
+				return asDuplicateKeyError(err)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, "Error recording the class membership")
+			}
+			membershipModel.Id = result.InsertedID.(primitive.ObjectID).Hex()
+			recorded = append(recorded, MapModelClassMembershipToPbClassMembership(membershipModel))
+		}
+
+		// The students are seated by now, so every class they moved into is checked once
+		var toClassIds []string
+		for _, membership := range memberships {
+			if !slices.Contains(toClassIds, membership.ToClassId) {
+				toClassIds = append(toClassIds, membership.ToClassId)
+			}
+		}
+		for _, classId := range toClassIds {
+			err := claimSeat(ctx, db, classId)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil, err
+		}
+		return nil, nil, rolledBack(err, "no students were promoted")
+	}
+	return MapModelPromotionToPbPromotion(promotionModel), recorded, nil
+}
+
+// UndoPromotionInDB moves the students of a promotion back to their classes and removes the memberships it recorded
+// Students changed since the promotion fail the undo with a VersionConflictError, so no change is overwritten,
+// a class the students return to that has filled up in the meantime with a ClassFullError
+func UndoPromotionInDB(ctx context.Context, objId primitive.ObjectID, actor string) (*pb.Promotion, []*pb.ClassMembership, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+	students := db.Collection("students")
+
+	var undonePromotion *pb.Promotion
+	var undone []*pb.ClassMembership
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		undone = nil
+		now := time.Now()
+		var promotion models.Promotion
+		update := bson.M{"$set": bson.M{"undone_at": now, "undone_by": actor}}
+		err := db.Collection("promotions").FindOneAndUpdate(ctx, bson.M{"_id": objId, "undone_at": bson.M{"$exists": false}}, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&promotion)
+		if err == mongo.ErrNoDocuments {
+			return &repositories.NotFoundError{Entity: "promotion that has not been undone", Id: objId.Hex()}
+		}
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		var memberships []*models.ClassMembership
+		err = findAll(ctx, db.Collection("class_memberships"), bson.M{"promotion_id": objId.Hex()}, &memberships)
+		if err != nil {
+			return err
+		}
+		var classIds []string
+		for _, membership := range memberships {
+			// Erased students no longer have an ID to move back
+			studentId, err := primitive.ObjectIDFromHex(membership.StudentId)
+			if err != nil {
+				continue
+			}
+			back := bson.M{
+				"$set": bson.M{"class_id": membership.ClassId, "updated_at": now, "updated_by": actor},
+				"$inc": bson.M{"version": 1},
+			}
+			result, err := students.UpdateOne(ctx, bson.M{"_id": studentId, "version": membership.StudentVersion}, back)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error moving back student with ID: %s", membership.StudentId))
+			}
+			if result.MatchedCount == 0 {
+				return missingOrConflict(ctx, students, studentId, "student", MapModelStudentToPbStudent)
+			}
+			undone = append(undone, MapModelClassMembershipToPbClassMembership(membership))
+			if !slices.Contains(classIds, membership.ClassId) {
+				classIds = append(classIds, membership.ClassId)
+			}
+		}
+		for _, classId := range classIds {
+			err := claimSeat(ctx, db, classId)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = db.Collection("class_memberships").DeleteMany(ctx, bson.M{"promotion_id": objId.Hex()})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error removing the class memberships")
+		}
+		undonePromotion = MapModelPromotionToPbPromotion(&promotion)
+		return nil
+	})
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil, err
+		}
+		return nil, nil, rolledBack(err, "the promotion was not undone")
+	}
+	return undonePromotion, undone, nil
+}
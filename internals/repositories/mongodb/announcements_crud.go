@@ -0,0 +1,247 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	registerIndexes("announcements",
+		mongo.IndexModel{Keys: bson.D{{Key: "audience", Value: 1}, {Key: "created_at", Value: -1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "class_ids", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "student_ids", Value: 1}}},
+	)
+	registerIndexes("read_receipts",
+		// Marking an announcement read upserts the receipt of the reader, without a unique index two concurrent
+		// upserts can both miss and insert it, with one the loser is retried by mongodb and finds the winner's receipt
+		mongo.IndexModel{Keys: bson.D{{Key: "announcement_id", Value: 1}, {Key: "reader_type", Value: 1}, {Key: "reader_id", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(unerased)},
+		mongo.IndexModel{Keys: bson.D{{Key: "reader_id", Value: 1}}},
+	)
+	registerPersonReferences(pb.PersonType_TEACHER, "announcements", "teacher_id")
+	registerPersonListReferences(pb.PersonType_STUDENT, "announcements", "student_ids")
+	for _, personType := range []pb.PersonType{pb.PersonType_STUDENT, pb.PersonType_TEACHER, pb.PersonType_EXEC} {
+		registerKeyedPersonReferences(personType, "read_receipts", "reader_id")
+	}
+}
+
+func MapModelAnnouncementToPbAnnouncement(announcementModel *models.Announcement) *pb.Announcement {
+	return MapModelToPb(announcementModel, func() *pb.Announcement { return &pb.Announcement{} })
+}
+
+func MapPbAnnouncementToModelAnnouncement(pbAnnouncement *pb.Announcement) *models.Announcement {
+	return MapPbToModel(pbAnnouncement, func() *models.Announcement { return &models.Announcement{} })
+}
+
+func MapModelReadReceiptToPbReadReceipt(receiptModel *models.ReadReceipt) *pb.ReadReceipt {
+	return MapModelToPb(receiptModel, func() *pb.ReadReceipt { return &pb.ReadReceipt{} })
+}
+
+func AddAnnouncementsToDb(ctx context.Context, announcementsFromReq []*pb.Announcement, ordered, atomic bool, actor string) ([]*pb.Announcement, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newAnnouncements := make([]*models.Announcement, len(announcementsFromReq))
+	for i, pbAnnouncement := range announcementsFromReq {
+		modelAnnouncement := MapPbAnnouncementToModelAnnouncement(pbAnnouncement)
+		modelAnnouncement.Version = 1
+		modelAnnouncement.CreatedAt = now
+		modelAnnouncement.UpdatedAt = now
+		modelAnnouncement.CreatedBy = actor
+		modelAnnouncement.UpdatedBy = actor
+		newAnnouncements[i] = modelAnnouncement
+	}
+
+	results, err := addEntities(ctx, client, "announcements", newAnnouncements, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedAnnouncements []*pb.Announcement
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newAnnouncements[i].Id = result.Id
+		addedAnnouncements = append(addedAnnouncements, MapModelAnnouncementToPbAnnouncement(newAnnouncements[i]))
+	}
+	return addedAnnouncements, results, nil
+}
+
+func GetAnnouncementsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Announcement, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("announcements")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	announcements, err := decodeEntities(ctx, cursor, func() *pb.Announcement { return &pb.Announcement{} }, func() *models.Announcement { return &models.Announcement{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return announcements, nil
+}
+
+func ModifyAnnouncementsInDB(ctx context.Context, req *pb.UpdateAnnouncementsRequest, actor string) ([]*pb.Announcement, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("announcements")
+
+	var updatedAnnouncements []*pb.Announcement
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedAnnouncements = nil
+		for _, announcement := range req.Announcements {
+			if announcement.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelAnnouncement := MapPbAnnouncementToModelAnnouncement(announcement)
+			objId, err := primitive.ObjectIDFromHex(announcement.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			updatedModel, err := updateEntity(ctx, coll, objId, modelAnnouncement, updatePaths(announcement, req.GetUpdateMask().GetPaths()), announcement.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "announcement", MapModelAnnouncementToPbAnnouncement)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating announcement with ID: %s", announcement.Id))
+			}
+
+			updatedAnnouncements = append(updatedAnnouncements, MapModelAnnouncementToPbAnnouncement(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no announcements were updated")
+		}
+		return nil, err
+	}
+	return updatedAnnouncements, nil
+}
+
+func DeleteAnnouncementsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("announcements")
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, coll, objectIdsToDelete, expectedVersions, actor, "announcement", MapModelAnnouncementToPbAnnouncement)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No announcements were deleted")
+		}
+
+		// Rolling back the transaction leaves every announcement in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all announcements were found, no announcements were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+func WatchAnnouncementsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Announcement, string, time.Time) error) error {
+	return watchEntities(ctx, "announcements", filter, resumeToken, MapModelAnnouncementToPbAnnouncement, send)
+}
+
+// MarkAnnouncementsReadInDB records that the reader read the announcements and returns the receipts of the reader for them
+// An announcement read before keeps the time it was first read
+func MarkAnnouncementsReadInDB(ctx context.Context, readerType pb.PersonType, readerId string, announcementIds []string) ([]*pb.ReadReceipt, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("read_receipts")
+
+	now := time.Now()
+	for _, announcementId := range announcementIds {
+		filter := bson.M{"announcement_id": announcementId, "reader_type": readerType.String(), "reader_id": readerId}
+		_, err := coll.UpdateOne(ctx, filter, bson.M{"$setOnInsert": bson.M{"read_at": now}}, options.Update().SetUpsert(true))
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error recording the read receipt")
+		}
+	}
+
+	var receipts []*models.ReadReceipt
+	err = findAll(ctx, coll, bson.M{"announcement_id": bson.M{"$in": announcementIds}, "reader_type": readerType.String(), "reader_id": readerId}, &receipts)
+	if err != nil {
+		return nil, err
+	}
+	var pbReceipts []*pb.ReadReceipt
+	for _, receipt := range receipts {
+		pbReceipts = append(pbReceipts, MapModelReadReceiptToPbReadReceipt(receipt))
+	}
+	return pbReceipts, nil
+}
+
+func GetReadReceiptsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.ReadReceipt, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("read_receipts")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	receipts, err := decodeEntities(ctx, cursor, func() *pb.ReadReceipt { return &pb.ReadReceipt{} }, func() *models.ReadReceipt { return &models.ReadReceipt{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return receipts, nil
+}
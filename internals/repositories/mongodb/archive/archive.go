@@ -0,0 +1,174 @@
+package archive
+
+import (
+	"ClassConnectRPC/internals/repositories/mongodb/migrations"
+	"ClassConnectRPC/pkg/utils"
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// formatVersion is bumped whenever the layout of an archive changes
+	formatVersion = 1
+	manifestName  = "manifest.json"
+)
+
+// Collections that only hold state of a running process and are not worth restoring
+var skippedCollections = map[string]bool{
+	"schema_migrations_lock": true,
+}
+
+// Manifest describes the content of an archive, it is stored as its last entry
+type Manifest struct {
+	FormatVersion int                `json:"format_version"`
+	Database      string             `json:"database"`
+	CreatedAt     time.Time          `json:"created_at"`
+	SchemaVersion int                `json:"schema_version"`
+	Collections   []CollectionRecord `json:"collections"`
+}
+
+// CollectionRecord describes the documents and index definitions of a collection in an archive
+// The checksums are hex encoded SHA-256 sums of the uncompressed entries
+type CollectionRecord struct {
+	Name            string `json:"name"`
+	Documents       int64  `json:"documents"`
+	DocumentsSHA256 string `json:"documents_sha256"`
+	Indexes         int64  `json:"indexes"`
+	IndexesSHA256   string `json:"indexes_sha256"`
+}
+
+// Every collection is stored as two entries holding its BSON documents back to back, like mongodump does
+func documentsEntry(collection string) string { return "collections/" + collection + ".bson" }
+func indexesEntry(collection string) string   { return "collections/" + collection + ".indexes.bson" }
+
+// Create writes a compressed archive of every collection of the database to w
+// The documents are read from a single snapshot, so the archive is consistent even while the server keeps writing
+// Snapshot reads need mongodb to run as a replica set, and the server only keeps a snapshot for
+// minSnapshotHistoryWindowInSeconds, 300 by default. An archive that takes longer fails with a message saying so
+func Create(ctx context.Context, client *mongo.Client, database string, w io.Writer) (*Manifest, error) {
+	db := client.Database(database)
+
+	names, err := db.ListCollectionNames(ctx, bson.M{"type": "collection"})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error listing the collections")
+	}
+	sort.Strings(names)
+
+	session, err := client.StartSession(options.Session().SetSnapshot(true))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Unable to start a snapshot session")
+	}
+	defer session.EndSession(ctx)
+	snapshotCtx := mongo.NewSessionContext(ctx, session)
+
+	schemaVersion, err := migrations.NewRunner(db).CurrentVersion(snapshotCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		FormatVersion: formatVersion,
+		Database:      database,
+		CreatedAt:     time.Now().UTC(),
+		SchemaVersion: schemaVersion,
+	}
+
+	zw := zip.NewWriter(w)
+	for _, name := range names {
+		if skippedCollections[name] || strings.HasPrefix(name, "system.") || strings.HasPrefix(name, stagingPrefix) || strings.HasPrefix(name, previousPrefix) {
+			continue
+		}
+
+		record := CollectionRecord{Name: name}
+
+		// Index definitions are not part of the snapshot, they rarely change and are created idempotently anyway
+		cursor, err := db.Collection(name).Indexes().List(ctx)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Error listing the indexes of %s", name))
+		}
+		record.Indexes, record.IndexesSHA256, err = writeEntry(ctx, zw, indexesEntry(name), cursor)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Error archiving the indexes of %s", name))
+		}
+
+		cursor, err = db.Collection(name).Find(snapshotCtx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+		if err != nil {
+			return nil, utils.ErrorHandler(snapshotError(err), fmt.Sprintf("Error reading %s", name))
+		}
+		record.Documents, record.DocumentsSHA256, err = writeEntry(snapshotCtx, zw, documentsEntry(name), cursor)
+		if err != nil {
+			return nil, utils.ErrorHandler(snapshotError(err), fmt.Sprintf("Error archiving %s", name))
+		}
+
+		log.Printf("Archived %d documents and %d indexes of %s\n", record.Documents, record.Indexes, name)
+		manifest.Collections = append(manifest.Collections, record)
+	}
+
+	entry, err := zw.Create(manifestName)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error writing the manifest")
+	}
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(manifest)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error writing the manifest")
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error finishing the archive")
+	}
+	return manifest, nil
+}
+
+// Error code of a read from a snapshot the server no longer keeps
+const snapshotTooOld = 239
+
+// snapshotError explains a read that outlived the snapshot history of the server
+func snapshotError(err error) error {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(snapshotTooOld) {
+		return fmt.Errorf("the archive took longer than the server keeps its snapshot, raise minSnapshotHistoryWindowInSeconds (300 seconds by default) on the server for the backup: %w", err)
+	}
+	return err
+}
+
+// writeEntry copies every document of the cursor into a new entry and returns how many were written with their checksum
+func writeEntry(ctx context.Context, zw *zip.Writer, name string, cursor *mongo.Cursor) (int64, string, error) {
+	defer cursor.Close(ctx)
+
+	entry, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return 0, "", err
+	}
+
+	hash := sha256.New()
+	out := io.MultiWriter(entry, hash)
+	var count int64
+	for cursor.Next(ctx) {
+		_, err := out.Write(cursor.Current)
+		if err != nil {
+			return 0, "", err
+		}
+		count++
+	}
+	if err := cursor.Err(); err != nil {
+		return 0, "", err
+	}
+	return count, hex.EncodeToString(hash.Sum(nil)), nil
+}
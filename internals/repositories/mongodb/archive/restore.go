@@ -0,0 +1,379 @@
+package archive
+
+import (
+	"ClassConnectRPC/internals/repositories/mongodb/migrations"
+	"ClassConnectRPC/pkg/utils"
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// Largest document mongodb accepts
+	maxDocumentSize = 16 * 1024 * 1024
+
+	insertBatchSize = 1000
+)
+
+// Verify checks the documents, counts and checksums of every entry of the archive against its manifest without writing anything
+func Verify(path string) (*Manifest, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Unable to open the archive")
+	}
+	defer zr.Close()
+
+	manifest, err := readManifest(&zr.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range manifest.Collections {
+		err := readEntry(&zr.Reader, documentsEntry(record.Name), record.Documents, record.DocumentsSHA256, nil)
+		if err != nil {
+			return nil, err
+		}
+		err = readEntry(&zr.Reader, indexesEntry(record.Name), record.Indexes, record.IndexesSHA256, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// Restore loads an archive into the given database, which may differ from the one it was taken of
+// The archive is verified in full before anything is written, and collections that already hold documents
+// are only replaced when drop is set
+// With drop, every collection is first restored next to the live one and only renamed into place once all of them
+// were restored. The live collections are set aside while the restored ones are renamed in, and renamed back when
+// a rename fails, so a failed restore leaves the current data as it was
+func Restore(ctx context.Context, client *mongo.Client, path, database string, drop bool) (*Manifest, error) {
+	manifest, err := Verify(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Documents of a newer schema could be rewritten by the wrong code, older ones are brought up to date by migrate up
+	known := 0
+	if all := migrations.All(); len(all) > 0 {
+		known = all[len(all)-1].Version
+	}
+	if manifest.SchemaVersion > known {
+		return nil, fmt.Errorf("archive has schema version %d but this build only knows migrations up to %d", manifest.SchemaVersion, known)
+	}
+
+	db := client.Database(database)
+	if !drop {
+		for _, record := range manifest.Collections {
+			count, err := db.Collection(record.Name).CountDocuments(ctx, bson.M{}, options.Count().SetLimit(1))
+			if err != nil {
+				return nil, utils.ErrorHandler(err, fmt.Sprintf("Error checking %s", record.Name))
+			}
+			if count > 0 {
+				return nil, fmt.Errorf("collection %s of database %s is not empty, restore with drop to replace it", record.Name, database)
+			}
+		}
+	}
+
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Unable to open the archive")
+	}
+	defer zr.Close()
+
+	target := func(name string) string { return name }
+	if drop {
+		target = stagingName
+		err := dropStaging(ctx, db, manifest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, record := range manifest.Collections {
+		err := restoreCollection(ctx, &zr.Reader, db, target(record.Name), record)
+		if err != nil {
+			if drop {
+				dropStaging(ctx, db, manifest)
+			}
+			return nil, err
+		}
+		log.Printf("Restored %d documents and %d indexes of %s\n", record.Documents, record.Indexes, record.Name)
+	}
+
+	if drop {
+		err := swapCollections(ctx, client, db, manifest)
+		if err != nil {
+			dropStaging(ctx, db, manifest)
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// Restored collections are staged under this prefix until every one of them was restored
+const stagingPrefix = "restore_staging."
+
+func stagingName(collection string) string { return stagingPrefix + collection }
+
+// dropStaging removes the staged collections of the archive, including those left behind by an earlier failed restore
+func dropStaging(ctx context.Context, db *mongo.Database, manifest *Manifest) error {
+	for _, record := range manifest.Collections {
+		err := db.Collection(stagingName(record.Name)).Drop(ctx)
+		if err != nil {
+			return utils.ErrorHandler(err, fmt.Sprintf("Error dropping the staged copy of %s", record.Name))
+		}
+	}
+	return nil
+}
+
+// The live collections are set aside under this prefix while the restored ones are renamed in
+const previousPrefix = "restore_previous."
+
+func previousName(collection string) string { return previousPrefix + collection }
+
+// Error code of a command on a collection that does not exist
+const namespaceNotFound = 26
+
+// swapped is a live collection the restored copy was renamed over, existed tells whether there was one to set aside
+type swapped struct {
+	name    string
+	existed bool
+}
+
+// swapCollections renames the staged copies over the live collections, setting the live ones aside first
+// When a rename fails, the collections swapped so far get their live data back and the failure is returned
+// Once every collection was swapped, the live collections that were set aside are dropped
+func swapCollections(ctx context.Context, client *mongo.Client, db *mongo.Database, manifest *Manifest) error {
+	var done []swapped
+	for _, record := range manifest.Collections {
+		current := swapped{name: record.Name}
+		err := renameCollection(ctx, client, db.Name(), record.Name, previousName(record.Name))
+		var commandErr mongo.CommandError
+		if errors.As(err, &commandErr) && commandErr.Code == namespaceNotFound {
+			err = nil
+		} else if err == nil {
+			current.existed = true
+		}
+		if err == nil {
+			err = renameCollection(ctx, client, db.Name(), stagingName(record.Name), record.Name)
+			if err != nil && current.existed {
+				// The restored copy is not in place, so the live collection goes straight back
+				err = errors.Join(err, renameCollection(ctx, client, db.Name(), previousName(record.Name), record.Name))
+			}
+		}
+		if err != nil {
+			err = utils.ErrorHandler(err, fmt.Sprintf("Error replacing %s with its restored copy", record.Name))
+			return errors.Join(err, rollBackSwaps(ctx, client, db, done))
+		}
+		done = append(done, current)
+	}
+
+	for _, collection := range done {
+		err := db.Collection(previousName(collection.name)).Drop(ctx)
+		if err != nil {
+			return utils.ErrorHandler(err, fmt.Sprintf("Error dropping the replaced copy of %s", collection.name))
+		}
+	}
+	return nil
+}
+
+// rollBackSwaps puts the live collections that were set aside back in place of their restored copies, last swap first
+func rollBackSwaps(ctx context.Context, client *mongo.Client, db *mongo.Database, done []swapped) error {
+	var errs []error
+	for i := len(done) - 1; i >= 0; i-- {
+		collection := done[i]
+		var err error
+		if collection.existed {
+			err = renameCollection(ctx, client, db.Name(), previousName(collection.name), collection.name)
+		} else {
+			err = db.Collection(collection.name).Drop(ctx)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rolling back %s failed, its data before the restore is kept in %s: %w", collection.name, previousName(collection.name), err))
+			continue
+		}
+		log.Printf("Rolled back %s to its data before the restore\n", collection.name)
+	}
+	return errors.Join(errs...)
+}
+
+// renameCollection renames a collection of the database, replacing the target, which is a single step for mongodb
+func renameCollection(ctx context.Context, client *mongo.Client, database, from, to string) error {
+	return client.Database("admin").RunCommand(ctx, bson.D{
+		{Key: "renameCollection", Value: database + "." + from},
+		{Key: "to", Value: database + "." + to},
+		{Key: "dropTarget", Value: true},
+	}).Err()
+}
+
+// restoreCollection writes the documents and indexes of an archived collection into the named collection
+func restoreCollection(ctx context.Context, zr *zip.Reader, db *mongo.Database, name string, record CollectionRecord) error {
+	err := restoreDocuments(ctx, zr, db.Collection(name), record)
+	if err != nil {
+		return err
+	}
+	return restoreIndexes(ctx, zr, db, name, record)
+}
+
+func restoreDocuments(ctx context.Context, zr *zip.Reader, coll *mongo.Collection, record CollectionRecord) error {
+	var batch []interface{}
+	insert := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		_, err := coll.InsertMany(ctx, batch)
+		batch = batch[:0]
+		return err
+	}
+
+	err := readEntry(zr, documentsEntry(record.Name), record.Documents, record.DocumentsSHA256, func(document bson.Raw) error {
+		batch = append(batch, document)
+		if len(batch) < insertBatchSize {
+			return nil
+		}
+		return insert()
+	})
+	if err == nil {
+		err = insert()
+	}
+	if err != nil {
+		return utils.ErrorHandler(err, fmt.Sprintf("Error restoring the documents of %s", record.Name))
+	}
+	return nil
+}
+
+// restoreIndexes recreates the archived index definitions, the _id index always exists already
+func restoreIndexes(ctx context.Context, zr *zip.Reader, db *mongo.Database, name string, record CollectionRecord) error {
+	var specs bson.A
+	err := readEntry(zr, indexesEntry(record.Name), record.Indexes, record.IndexesSHA256, func(document bson.Raw) error {
+		var spec bson.D
+		err := bson.Unmarshal(document, &spec)
+		if err != nil {
+			return err
+		}
+
+		var cleaned bson.D
+		for _, element := range spec {
+			// The index version and namespace belong to the source server
+			if element.Key != "v" && element.Key != "ns" {
+				cleaned = append(cleaned, element)
+			}
+		}
+		if name, _ := document.Lookup("name").StringValueOK(); name != "_id_" {
+			specs = append(specs, cleaned)
+		}
+		return nil
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, fmt.Sprintf("Error reading the indexes of %s", record.Name))
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+
+	err = db.RunCommand(ctx, bson.D{{Key: "createIndexes", Value: name}, {Key: "indexes", Value: specs}}).Err()
+	if err != nil {
+		return utils.ErrorHandler(err, fmt.Sprintf("Error restoring the indexes of %s", record.Name))
+	}
+	return nil
+}
+
+func readManifest(zr *zip.Reader) (*Manifest, error) {
+	file, err := zr.Open(manifestName)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "The archive has no manifest")
+	}
+	defer file.Close()
+
+	var manifest Manifest
+	err = json.NewDecoder(file).Decode(&manifest)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "The manifest of the archive is invalid")
+	}
+	if manifest.FormatVersion != formatVersion {
+		return nil, fmt.Errorf("archive format %d is not supported, expected %d", manifest.FormatVersion, formatVersion)
+	}
+	return &manifest, nil
+}
+
+// readEntry reads the documents of an entry one at a time, handing each to fn when it is set
+// The entry must hold exactly the expected number of valid documents and match the checksum
+func readEntry(zr *zip.Reader, name string, expectedCount int64, expectedSum string, fn func(bson.Raw) error) error {
+	file, err := zr.Open(name)
+	if err != nil {
+		return fmt.Errorf("archive entry %s is missing", name)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	in := io.TeeReader(file, hash)
+	var count int64
+	for {
+		document, err := readDocument(in)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("archive entry %s is corrupt: %w", name, err)
+		}
+		count++
+
+		if fn != nil {
+			err := fn(document)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if count != expectedCount {
+		return fmt.Errorf("archive entry %s holds %d documents, the manifest lists %d", name, count, expectedCount)
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != expectedSum {
+		return fmt.Errorf("archive entry %s does not match its checksum", name)
+	}
+	return nil
+}
+
+// readDocument reads the next BSON document of r, io.EOF is only returned between documents
+func readDocument(r io.Reader) (bson.Raw, error) {
+	var header [4]byte
+	_, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return nil, err
+	}
+
+	size := binary.LittleEndian.Uint32(header[:])
+	if size < 5 || size > maxDocumentSize {
+		return nil, fmt.Errorf("invalid document size %d", size)
+	}
+
+	document := make([]byte, size)
+	copy(document, header[:])
+	_, err = io.ReadFull(r, document[4:])
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	raw := bson.Raw(document)
+	err = raw.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
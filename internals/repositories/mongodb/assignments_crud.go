@@ -0,0 +1,342 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	registerIndexes("assignments",
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}, {Key: "due_at", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "teacher_id", Value: 1}}},
+	)
+	// A student has a single submission per assignment, two first uploads at once cannot both create one.
+	// The checksum index finds the submissions sharing a file stored before files were kept per submission
+	registerIndexes("submissions",
+		mongo.IndexModel{Keys: bson.D{{Key: "assignment_id", Value: 1}, {Key: "student_id", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(unerased)},
+		mongo.IndexModel{Keys: bson.D{{Key: "student_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "sha256", Value: 1}}},
+	)
+	registerPersonReferences(pb.PersonType_TEACHER, "assignments", "teacher_id")
+	registerKeyedPersonReferences(pb.PersonType_STUDENT, "submissions", "student_id")
+	registerPersonReferences(pb.PersonType_EXEC, "submissions", "created_by", "updated_by", "graded_by")
+}
+
+func MapModelAssignmentToPbAssignment(assignmentModel *models.Assignment) *pb.Assignment {
+	return MapModelToPb(assignmentModel, func() *pb.Assignment { return &pb.Assignment{} })
+}
+
+func MapPbAssignmentToModelAssignment(pbAssignment *pb.Assignment) *models.Assignment {
+	return MapPbToModel(pbAssignment, func() *models.Assignment { return &models.Assignment{} })
+}
+
+func MapModelSubmissionToPbSubmission(submissionModel *models.Submission) *pb.Submission {
+	return MapModelToPb(submissionModel, func() *pb.Submission { return &pb.Submission{} })
+}
+
+func AddAssignmentsToDb(ctx context.Context, assignmentsFromReq []*pb.Assignment, ordered, atomic bool, actor string) ([]*pb.Assignment, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newAssignments := make([]*models.Assignment, len(assignmentsFromReq))
+	for i, pbAssignment := range assignmentsFromReq {
+		modelAssignment := MapPbAssignmentToModelAssignment(pbAssignment)
+		modelAssignment.Version = 1
+		modelAssignment.CreatedAt = now
+		modelAssignment.UpdatedAt = now
+		modelAssignment.CreatedBy = actor
+		modelAssignment.UpdatedBy = actor
+		newAssignments[i] = modelAssignment
+	}
+
+	results, err := addEntities(ctx, client, "assignments", newAssignments, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedAssignments []*pb.Assignment
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newAssignments[i].Id = result.Id
+		addedAssignments = append(addedAssignments, MapModelAssignmentToPbAssignment(newAssignments[i]))
+	}
+	return addedAssignments, results, nil
+}
+
+func GetAssignmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Assignment, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("assignments")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	assignments, err := decodeEntities(ctx, cursor, func() *pb.Assignment { return &pb.Assignment{} }, func() *models.Assignment { return &models.Assignment{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return assignments, nil
+}
+
+func ModifyAssignmentsInDB(ctx context.Context, req *pb.UpdateAssignmentsRequest, actor string) ([]*pb.Assignment, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("assignments")
+
+	var updatedAssignments []*pb.Assignment
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedAssignments = nil
+		for _, assignment := range req.Assignments {
+			if assignment.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelAssignment := MapPbAssignmentToModelAssignment(assignment)
+			objId, err := primitive.ObjectIDFromHex(assignment.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			updatedModel, err := updateEntity(ctx, coll, objId, modelAssignment, updatePaths(assignment, req.GetUpdateMask().GetPaths()), assignment.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "assignment", MapModelAssignmentToPbAssignment)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating assignment with ID: %s", assignment.Id))
+			}
+
+			updatedAssignments = append(updatedAssignments, MapModelAssignmentToPbAssignment(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no assignments were updated")
+		}
+		return nil, err
+	}
+	return updatedAssignments, nil
+}
+
+func DeleteAssignmentsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("assignments")
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, coll, objectIdsToDelete, expectedVersions, actor, "assignment", MapModelAssignmentToPbAssignment)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No assignments were deleted")
+		}
+
+		// Rolling back the transaction leaves every assignment in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all assignments were found, no assignments were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// SaveSubmissionInDB stores the file of a student's submission, replacing the file submitted before
+// The submission keeps the ID it comes with, which is the ID of the student's submission when there is one already,
+// and its submitted_at. The submission as it was before is returned so the blob of the replaced file can be removed,
+// it is nil for a first submission
+func SaveSubmissionInDB(ctx context.Context, submission *pb.Submission, actor string) (*pb.Submission, *pb.Submission, error) {
+	objId, err := primitive.ObjectIDFromHex(submission.Id)
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("submissions")
+
+	var saved *models.Submission
+	var replaced *pb.Submission
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		replaced = nil
+
+		now := time.Now()
+		submittedAt := submission.SubmittedAt.AsTime()
+		var current models.Submission
+		err := coll.FindOne(ctx, bson.M{"assignment_id": submission.AssignmentId, "student_id": submission.StudentId}).Decode(&current)
+		if err == mongo.ErrNoDocuments {
+			saved = &models.Submission{
+				AssignmentId: submission.AssignmentId,
+				StudentId:    submission.StudentId,
+				FileName:     submission.FileName,
+				ContentType:  submission.ContentType,
+				Size:         submission.Size,
+				Sha256:       submission.Sha256,
+				SubmittedAt:  submittedAt,
+				Late:         submission.Late,
+				Version:      1,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+				CreatedBy:    actor,
+				UpdatedBy:    actor,
+			}
+			// The upsert inserts the submission under the ID its file was stored for
+			_, err := coll.UpdateOne(ctx, bson.M{"_id": objId}, bson.M{"$setOnInsert": saved}, options.Update().SetUpsert(true))
+			if mongo.IsDuplicateKeyError(err) {
+				return asDuplicateKeyError(err)
+			}
+			if err != nil {
+				return err
+			}
+			saved.Id = submission.Id
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// Another upload created the submission after the file was stored for a new one
+		if current.Id != submission.Id {
+			return &repositories.VersionConflictError{Id: current.Id, Current: MapModelSubmissionToPbSubmission(&current)}
+		}
+		if !current.GradedAt.IsZero() {
+			return &repositories.SubmissionGradedError{Id: current.Id, GradedAt: current.GradedAt}
+		}
+
+		saved = new(models.Submission)
+		err = coll.FindOneAndUpdate(ctx, bson.M{"_id": objId, "version": current.Version}, bson.M{
+			"$set": bson.M{
+				"file_name":    submission.FileName,
+				"content_type": submission.ContentType,
+				"size":         submission.Size,
+				"sha256":       submission.Sha256,
+				"submitted_at": submittedAt,
+				"late":         submission.Late,
+				"updated_at":   now,
+				"updated_by":   actor,
+			},
+			"$inc": bson.M{"version": 1},
+		}, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(saved)
+		if err != nil {
+			return err
+		}
+		replaced = MapModelSubmissionToPbSubmission(&current)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, rolledBack(err, "the submission was not saved")
+	}
+	return MapModelSubmissionToPbSubmission(saved), replaced, nil
+}
+
+func GetSubmissionsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Submission, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("submissions")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	submissions, err := decodeEntities(ctx, cursor, func() *pb.Submission { return &pb.Submission{} }, func() *models.Submission { return &models.Submission{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return submissions, nil
+}
+
+// RecordFeedbackInDB records the feedback and score of a submission, recording it again replaces them
+func RecordFeedbackInDB(ctx context.Context, feedback *pb.SubmissionFeedback, actor string) (*pb.Submission, error) {
+	objId, err := primitive.ObjectIDFromHex(feedback.Id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("submissions")
+
+	filter := bson.M{"_id": objId}
+	if feedback.Version != 0 {
+		filter["version"] = feedback.Version
+	}
+	now := time.Now()
+	updated := new(models.Submission)
+	err = coll.FindOneAndUpdate(ctx, filter, bson.M{
+		"$set": bson.M{
+			"feedback":   feedback.Feedback,
+			"score":      feedback.Score,
+			"graded_at":  now,
+			"graded_by":  actor,
+			"updated_at": now,
+			"updated_by": actor,
+		},
+		"$inc": bson.M{"version": 1},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(updated)
+	if err == mongo.ErrNoDocuments {
+		return nil, missingOrConflict(ctx, coll, objId, "submission", MapModelSubmissionToPbSubmission)
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error recording the feedback")
+	}
+	return MapModelSubmissionToPbSubmission(updated), nil
+}
@@ -0,0 +1,280 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"fmt"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+)
+
+func init() {
+	registerIndexes("attendance",
+		// A student has one record per session, concurrent recordings of the same session cannot both insert it
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}, {Key: "session_date", Value: 1}, {Key: "session", Value: 1}, {Key: "student_id", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(unerased)},
+		mongo.IndexModel{Keys: bson.D{{Key: "student_id", Value: 1}, {Key: "session_date", Value: 1}}},
+	)
+	registerKeyedPersonReferences(pb.PersonType_STUDENT, "attendance", "student_id")
+	registerPersonReferences(pb.PersonType_EXEC, "attendance", "created_by", "updated_by")
+}
+
+func MapModelAttendanceRecordToPbAttendanceRecord(recordModel *models.AttendanceRecord) *pb.AttendanceRecord {
+	record := MapModelToPb(recordModel, func() *pb.AttendanceRecord { return &pb.AttendanceRecord{} })
+	for i := range recordModel.Corrections {
+		record.Corrections = append(record.Corrections, MapModelToPb(&recordModel.Corrections[i], func() *pb.AttendanceCorrection { return &pb.AttendanceCorrection{} }))
+	}
+	return record
+}
+
+// RecordAttendanceInDB records the marks of one session of a class and reports the outcome for every mark by its index
+// Students whose attendance of the session was already recorded are rejected, their record has to be corrected instead
+func RecordAttendanceInDB(ctx context.Context, classId string, sessionDate time.Time, session string, marks []*pb.AttendanceMark, actor string) ([]*pb.AttendanceRecord, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("attendance")
+
+	var records []*pb.AttendanceRecord
+	var results []*pb.BulkItemResult
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		records = nil
+		results = make([]*pb.BulkItemResult, len(marks))
+
+		var studentIds []string
+		for _, mark := range marks {
+			studentIds = append(studentIds, mark.StudentId)
+		}
+		var existing []models.AttendanceRecord
+		err := findAll(ctx, coll, bson.M{"class_id": classId, "session_date": sessionDate, "session": session, "student_id": bson.M{"$in": studentIds}}, &existing)
+		if err != nil {
+			return err
+		}
+		recorded := map[string]bool{}
+		for _, record := range existing {
+			recorded[record.StudentId] = true
+		}
+
+		now := time.Now()
+		var accepted []*models.AttendanceRecord
+		var acceptedIndexes []int
+		for i, mark := range marks {
+			if recorded[mark.StudentId] {
+				results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(codes.AlreadyExists), ErrorMessage: fmt.Sprintf("attendance of student %s in this session is already recorded", mark.StudentId)}
+				continue
+			}
+			// A student listed twice is only recorded once
+			recorded[mark.StudentId] = true
+			accepted = append(accepted, &models.AttendanceRecord{
+				StudentId:   mark.StudentId,
+				ClassId:     classId,
+				SessionDate: sessionDate,
+				Session:     session,
+				Status:      mark.Status.String(),
+				Note:        mark.Note,
+				Version:     1,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				CreatedBy:   actor,
+				UpdatedBy:   actor,
+			})
+			acceptedIndexes = append(acceptedIndexes, i)
+		}
+		if len(accepted) == 0 {
+			return nil
+		}
+
+		docs := make([]interface{}, len(accepted))
+		for i, record := range accepted {
+			docs[i] = record
+		}
+		inserted, err := coll.InsertMany(ctx, docs)
+		// A concurrent recording of the session committed after the records were looked up
+		if mongo.IsDuplicateKeyError(err) {
+			return asDuplicateKeyError(err)
+		}
+		if err != nil {
+			return utils.ErrorHandler(err, "Error recording the attendance")
+		}
+		for i, id := range inserted.InsertedIDs {
+			accepted[i].Id = id.(primitive.ObjectID).Hex()
+			results[acceptedIndexes[i]] = &pb.BulkItemResult{Index: int32(acceptedIndexes[i]), Id: accepted[i].Id}
+			records = append(records, MapModelAttendanceRecordToPbAttendanceRecord(accepted[i]))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, results, nil
+}
+
+// CorrectAttendanceInDB changes the status of records and appends the change to their corrections
+func CorrectAttendanceInDB(ctx context.Context, corrections []*pb.AttendanceRecordCorrection, atomic bool, actor string) ([]*pb.AttendanceRecord, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("attendance")
+
+	var corrected []*pb.AttendanceRecord
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		corrected = nil
+		for _, correction := range corrections {
+			objId, err := primitive.ObjectIDFromHex(correction.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			var current models.AttendanceRecord
+			err = coll.FindOne(ctx, bson.M{"_id": objId}).Decode(&current)
+			if err == mongo.ErrNoDocuments {
+				return &repositories.NotFoundError{Entity: "attendance record", Id: correction.Id}
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			if correction.Version != 0 && correction.Version != current.Version {
+				return &repositories.VersionConflictError{Id: correction.Id, Current: MapModelAttendanceRecordToPbAttendanceRecord(&current)}
+			}
+
+			now := time.Now()
+			change := models.AttendanceCorrection{
+				PreviousStatus: current.Status,
+				Status:         correction.Status.String(),
+				Reason:         correction.Reason,
+				CorrectedAt:    now,
+				CorrectedBy:    actor,
+			}
+			// Filtering by the version read above keeps a concurrent correction from being overwritten
+			var updated models.AttendanceRecord
+			err = coll.FindOneAndUpdate(ctx,
+				bson.M{"_id": objId, "version": current.Version},
+				bson.M{
+					"$set":  bson.M{"status": change.Status, "updated_at": now, "updated_by": actor},
+					"$push": bson.M{"corrections": change},
+					"$inc":  bson.M{"version": 1},
+				},
+				options.FindOneAndUpdate().SetReturnDocument(options.After),
+			).Decode(&updated)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "attendance record", MapModelAttendanceRecordToPbAttendanceRecord)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error correcting attendance record with ID: %s", correction.Id))
+			}
+			corrected = append(corrected, MapModelAttendanceRecordToPbAttendanceRecord(&updated))
+		}
+		return nil
+	})
+	if err != nil {
+		if atomic {
+			return nil, rolledBack(err, "no attendance records were corrected")
+		}
+		return nil, err
+	}
+	return corrected, nil
+}
+
+func GetAttendanceFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.AttendanceRecord, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("attendance")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var records []*pb.AttendanceRecord
+	for cursor.Next(ctx) {
+		var record models.AttendanceRecord
+		err := cursor.Decode(&record)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		records = append(records, MapModelAttendanceRecordToPbAttendanceRecord(&record))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return records, nil
+}
+
+// GetAttendanceCountsFromDB counts the matching records of every student by status, ordered by student ID
+// Only the counts are filled in, the percentage is left to the caller
+func GetAttendanceCountsFromDB(ctx context.Context, filters bson.M) ([]*pb.AttendanceSummary, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	countStatus := func(status pb.AttendanceStatus) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$status", status.String()}}, 1, 0}}}
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filters}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      "$student_id",
+			"sessions": bson.M{"$sum": 1},
+			"present":  countStatus(pb.AttendanceStatus_PRESENT),
+			"absent":   countStatus(pb.AttendanceStatus_ABSENT),
+			"late":     countStatus(pb.AttendanceStatus_LATE),
+			"excused":  countStatus(pb.AttendanceStatus_EXCUSED),
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+	cursor, err := client.Database("school").Collection("attendance").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var counts []struct {
+		StudentId string `bson:"_id"`
+		Sessions  int64  `bson:"sessions"`
+		Present   int64  `bson:"present"`
+		Absent    int64  `bson:"absent"`
+		Late      int64  `bson:"late"`
+		Excused   int64  `bson:"excused"`
+	}
+	err = cursor.All(ctx, &counts)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	summaries := make([]*pb.AttendanceSummary, len(counts))
+	for i, count := range counts {
+		summaries[i] = &pb.AttendanceSummary{
+			StudentId: count.StudentId,
+			Sessions:  count.Sessions,
+			Present:   count.Present,
+			Absent:    count.Absent,
+			Late:      count.Late,
+			Excused:   count.Excused,
+		}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,245 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections whose records belong to a class through their class_id field
+var classMemberCollections = []string{"students", "teaching_assignments", "class_memberships"}
+
+func init() {
+	registerIndexes("classes",
+		// A grade has every section only once per academic year
+		mongo.IndexModel{Keys: bson.D{{Key: "academic_year_id", Value: 1}, {Key: "grade", Value: 1}, {Key: "section", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerPersonReferences(pb.PersonType_TEACHER, "classes", "homeroom_teacher_id")
+}
+
+func MapModelClassToPbClass(classModel *models.Class) *pb.Class {
+	return MapModelToPb(classModel, func() *pb.Class { return &pb.Class{} })
+}
+
+func MapPbClassToModelClass(pbClass *pb.Class) *models.Class {
+	return MapPbToModel(pbClass, func() *models.Class { return &models.Class{} })
+}
+
+// claimSeat fails with a ClassFullError when the class holds more active students than its capacity
+// It runs in the transaction that seated the students, after they were written, so they are part of the count.
+// Counting alone would let two transactions each see one free seat and both take it, so the class itself is
+// written as well, which makes concurrent transactions seating students in it conflict and the retried one count again
+func claimSeat(ctx context.Context, db *mongo.Database, classId string) error {
+	if classId == "" {
+		return nil
+	}
+	objId, err := primitive.ObjectIDFromHex(classId)
+	if err != nil {
+		return &repositories.NotFoundError{Entity: "class", Id: classId}
+	}
+
+	var class models.Class
+	err = db.Collection("classes").FindOneAndUpdate(ctx, notDeleted(bson.M{"_id": objId}), bson.M{"$set": bson.M{"seats_claimed_at": time.Now()}}).Decode(&class)
+	if err == mongo.ErrNoDocuments {
+		return &repositories.NotFoundError{Entity: "class", Id: classId}
+	}
+	if err != nil {
+		return err
+	}
+	if class.Capacity <= 0 {
+		return nil
+	}
+
+	seated, err := db.Collection("students").CountDocuments(ctx, notDeleted(bson.M{"class_id": classId}))
+	if err != nil {
+		return err
+	}
+	if seated > int64(class.Capacity) {
+		return &repositories.ClassFullError{ClassId: classId, Capacity: class.Capacity}
+	}
+	return nil
+}
+
+func AddClassesToDb(ctx context.Context, classesFromReq []*pb.Class, ordered, atomic bool, actor string) ([]*pb.Class, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newClasses := make([]*models.Class, len(classesFromReq))
+	for i, pbClass := range classesFromReq {
+		modelClass := MapPbClassToModelClass(pbClass)
+		modelClass.Version = 1
+		modelClass.CreatedAt = now
+		modelClass.UpdatedAt = now
+		modelClass.CreatedBy = actor
+		modelClass.UpdatedBy = actor
+		newClasses[i] = modelClass
+	}
+
+	results, err := addEntities(ctx, client, "classes", newClasses, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedClasses []*pb.Class
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newClasses[i].Id = result.Id
+		addedClasses = append(addedClasses, MapModelClassToPbClass(newClasses[i]))
+	}
+	return addedClasses, results, nil
+}
+
+func GetClassesFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Class, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("classes")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	classes, err := decodeEntities(ctx, cursor, func() *pb.Class { return &pb.Class{} }, func() *models.Class { return &models.Class{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return classes, nil
+}
+
+func ModifyClassesInDB(ctx context.Context, req *pb.UpdateClassesRequest, actor string) ([]*pb.Class, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	var updatedClasses []*pb.Class
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedClasses = nil
+		for _, class := range req.Classes {
+			if class.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelClass := MapPbClassToModelClass(class)
+			objId, err := primitive.ObjectIDFromHex(class.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			coll := client.Database("school").Collection("classes")
+			updatedModel, err := updateEntity(ctx, coll, objId, modelClass, req.GetUpdateMask().GetPaths(), class.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "class", MapModelClassToPbClass)
+			}
+			if mongo.IsDuplicateKeyError(err) {
+				return asDuplicateKeyError(err)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating class with ID: %s", class.Id))
+			}
+
+			updatedClasses = append(updatedClasses, MapModelClassToPbClass(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no classes were updated")
+		}
+		return nil, err
+	}
+	return updatedClasses, nil
+}
+
+func DeleteClassesFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		err := checkClassesUnreferenced(ctx, client, objectIdsToDelete)
+		if err != nil {
+			return err
+		}
+
+		deletedCount, err := softDeleteEntities(ctx, client.Database("school").Collection("classes"), objectIdsToDelete, expectedVersions, actor, "class", MapModelClassToPbClass)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No classes were deleted")
+		}
+
+		// Rolling back the transaction leaves every class in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all classes were found, no classes were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// checkClassesUnreferenced fails with a ReferencedError when a student or teaching assignment that is not deleted still belongs to one of the classes
+func checkClassesUnreferenced(ctx context.Context, client *mongo.Client, objIds []primitive.ObjectID) error {
+	ids := make([]string, len(objIds))
+	for i, objId := range objIds {
+		ids[i] = objId.Hex()
+	}
+
+	for _, collection := range classMemberCollections {
+		var member struct {
+			ClassId string `bson:"class_id"`
+		}
+		err := client.Database("school").Collection(collection).FindOne(ctx, notDeleted(bson.M{"class_id": bson.M{"$in": ids}})).Decode(&member)
+		if err == mongo.ErrNoDocuments {
+			continue
+		}
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		return &repositories.ReferencedError{Entity: "class", Id: member.ClassId, ReferencedBy: collection}
+	}
+	return nil
+}
+
+func RestoreClassesInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return restoreEntities(ctx, "classes", "class", objIds, actor, nil)
+}
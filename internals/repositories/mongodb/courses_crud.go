@@ -0,0 +1,645 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+)
+
+func init() {
+	registerIndexes("courses",
+		mongo.IndexModel{Keys: bson.D{{Key: "code", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerIndexes("course_sections",
+		// A course has every section name only once per term
+		mongo.IndexModel{Keys: bson.D{{Key: "course_id", Value: 1}, {Key: "term", Value: 1}, {Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerIndexes("enrollments",
+		mongo.IndexModel{Keys: bson.D{{Key: "section_id", Value: 1}, {Key: "status", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "student_id", Value: 1}, {Key: "course_id", Value: 1}}},
+	)
+	registerPersonListReferences(pb.PersonType_TEACHER, "courses", "teacher_ids")
+	registerPersonListReferences(pb.PersonType_TEACHER, "course_sections", "teacher_ids")
+	registerPersonReferences(pb.PersonType_STUDENT, "enrollments", "student_id")
+	registerPersonReferences(pb.PersonType_EXEC, "enrollments", "created_by", "updated_by")
+}
+
+func MapModelCourseToPbCourse(courseModel *models.Course) *pb.Course {
+	return MapModelToPb(courseModel, func() *pb.Course { return &pb.Course{} })
+}
+
+func MapPbCourseToModelCourse(pbCourse *pb.Course) *models.Course {
+	return MapPbToModel(pbCourse, func() *models.Course { return &models.Course{} })
+}
+
+func MapModelCourseSectionToPbCourseSection(sectionModel *models.CourseSection) *pb.CourseSection {
+	return MapModelToPb(sectionModel, func() *pb.CourseSection { return &pb.CourseSection{} })
+}
+
+func MapPbCourseSectionToModelCourseSection(pbSection *pb.CourseSection) *models.CourseSection {
+	return MapPbToModel(pbSection, func() *models.CourseSection { return &models.CourseSection{} })
+}
+
+func MapModelEnrollmentToPbEnrollment(enrollmentModel *models.Enrollment) *pb.Enrollment {
+	return MapModelToPb(enrollmentModel, func() *pb.Enrollment { return &pb.Enrollment{} })
+}
+
+func AddCoursesToDb(ctx context.Context, coursesFromReq []*pb.Course, ordered, atomic bool, actor string) ([]*pb.Course, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newCourses := make([]*models.Course, len(coursesFromReq))
+	for i, pbCourse := range coursesFromReq {
+		modelCourse := MapPbCourseToModelCourse(pbCourse)
+		modelCourse.Version = 1
+		modelCourse.CreatedAt = now
+		modelCourse.UpdatedAt = now
+		modelCourse.CreatedBy = actor
+		modelCourse.UpdatedBy = actor
+		newCourses[i] = modelCourse
+	}
+
+	results, err := addEntities(ctx, client, "courses", newCourses, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedCourses []*pb.Course
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newCourses[i].Id = result.Id
+		addedCourses = append(addedCourses, MapModelCourseToPbCourse(newCourses[i]))
+	}
+	return addedCourses, results, nil
+}
+
+func GetCoursesFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Course, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("courses")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	courses, err := decodeEntities(ctx, cursor, func() *pb.Course { return &pb.Course{} }, func() *models.Course { return &models.Course{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return courses, nil
+}
+
+func ModifyCoursesInDB(ctx context.Context, req *pb.UpdateCoursesRequest, actor string) ([]*pb.Course, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	var updatedCourses []*pb.Course
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedCourses = nil
+		for _, course := range req.Courses {
+			if course.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelCourse := MapPbCourseToModelCourse(course)
+			objId, err := primitive.ObjectIDFromHex(course.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			coll := client.Database("school").Collection("courses")
+			updatedModel, err := updateEntity(ctx, coll, objId, modelCourse, req.GetUpdateMask().GetPaths(), course.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "course", MapModelCourseToPbCourse)
+			}
+			if mongo.IsDuplicateKeyError(err) {
+				return asDuplicateKeyError(err)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating course with ID: %s", course.Id))
+			}
+
+			updatedCourses = append(updatedCourses, MapModelCourseToPbCourse(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no courses were updated")
+		}
+		return nil, err
+	}
+	return updatedCourses, nil
+}
+
+func DeleteCoursesFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		err := checkCoursesUnreferenced(ctx, client, objectIdsToDelete)
+		if err != nil {
+			return err
+		}
+
+		deletedCount, err := softDeleteEntities(ctx, client.Database("school").Collection("courses"), objectIdsToDelete, expectedVersions, actor, "course", MapModelCourseToPbCourse)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No courses were deleted")
+		}
+
+		// Rolling back the transaction leaves every course in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all courses were found, no courses were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// checkCoursesUnreferenced fails with a ReferencedError when a section or a course outside the batch that is not deleted still points to one of the courses
+func checkCoursesUnreferenced(ctx context.Context, client *mongo.Client, objIds []primitive.ObjectID) error {
+	ids := make([]string, len(objIds))
+	for i, objId := range objIds {
+		ids[i] = objId.Hex()
+	}
+	db := client.Database("school")
+
+	var section models.CourseSection
+	err := db.Collection("course_sections").FindOne(ctx, notDeleted(bson.M{"course_id": bson.M{"$in": ids}})).Decode(&section)
+	if err == nil {
+		return &repositories.ReferencedError{Entity: "course", Id: section.CourseId, ReferencedBy: "course_sections"}
+	}
+	if err != mongo.ErrNoDocuments {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	var dependent models.Course
+	err = db.Collection("courses").FindOne(ctx, notDeleted(bson.M{"prerequisite_ids": bson.M{"$in": ids}, "_id": bson.M{"$nin": objIds}})).Decode(&dependent)
+	if err == nil {
+		for _, id := range dependent.PrerequisiteIds {
+			for _, deleted := range ids {
+				if id == deleted {
+					return &repositories.ReferencedError{Entity: "course", Id: id, ReferencedBy: "courses"}
+				}
+			}
+		}
+	}
+	if err != nil && err != mongo.ErrNoDocuments {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return nil
+}
+
+func AddCourseSectionsToDb(ctx context.Context, sectionsFromReq []*pb.CourseSection, ordered, atomic bool, actor string) ([]*pb.CourseSection, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newSections := make([]*models.CourseSection, len(sectionsFromReq))
+	for i, pbSection := range sectionsFromReq {
+		modelSection := MapPbCourseSectionToModelCourseSection(pbSection)
+		// Sections start out empty, enrollments keep the count up to date
+		modelSection.Enrolled = 0
+		modelSection.Version = 1
+		modelSection.CreatedAt = now
+		modelSection.UpdatedAt = now
+		modelSection.CreatedBy = actor
+		modelSection.UpdatedBy = actor
+		newSections[i] = modelSection
+	}
+
+	results, err := addEntities(ctx, client, "course_sections", newSections, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedSections []*pb.CourseSection
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newSections[i].Id = result.Id
+		addedSections = append(addedSections, MapModelCourseSectionToPbCourseSection(newSections[i]))
+	}
+	return addedSections, results, nil
+}
+
+func GetCourseSectionsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.CourseSection, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("course_sections")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	sections, err := decodeEntities(ctx, cursor, func() *pb.CourseSection { return &pb.CourseSection{} }, func() *models.CourseSection { return &models.CourseSection{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return sections, nil
+}
+
+func DeleteCourseSectionsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("course_sections")
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		// Sections with enrolled students are kept, the students have to be dropped first
+		var section models.CourseSection
+		err := coll.FindOne(ctx, notDeleted(bson.M{"_id": bson.M{"$in": objectIdsToDelete}, "enrolled": bson.M{"$gt": 0}})).Decode(&section)
+		if err == nil {
+			return &repositories.ReferencedError{Entity: "course section", Id: section.Id, ReferencedBy: "enrollments"}
+		}
+		if err != mongo.ErrNoDocuments {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		deletedCount, err := softDeleteEntities(ctx, coll, objectIdsToDelete, expectedVersions, actor, "course section", MapModelCourseSectionToPbCourseSection)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No course sections were deleted")
+		}
+
+		// Rolling back the transaction leaves every section in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all course sections were found, no course sections were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// EnrollStudentsInDB enrolls the students in the section and reports the outcome for every student by their index
+// A student is rejected when already enrolled in the course this term, when a prerequisite has not been completed or when
+// the section is full. Everything runs in a single transaction, the enrolled count of the section serialises concurrent enrollments
+func EnrollStudentsInDB(ctx context.Context, sectionId string, studentIds []string, actor string) ([]*pb.Enrollment, []*pb.BulkItemResult, error) {
+	objId, err := primitive.ObjectIDFromHex(sectionId)
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	var enrollments []*pb.Enrollment
+	var results []*pb.BulkItemResult
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		enrollments = nil
+		results = make([]*pb.BulkItemResult, len(studentIds))
+
+		section, course, err := sectionWithCourse(ctx, db, objId)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		closesAt := section.EnrollmentClosesAt
+		if closesAt.IsZero() {
+			closesAt = section.EndsAt
+		}
+		if !closesAt.IsZero() && now.After(closesAt) {
+			return &repositories.EnrollmentClosedError{SectionId: sectionId, ClosedAt: closesAt}
+		}
+
+		// Students already enrolled in any section of the course this term
+		termSections, err := db.Collection("course_sections").Distinct(ctx, "_id", bson.M{"course_id": section.CourseId, "term": section.Term})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		var current []models.Enrollment
+		err = findAll(ctx, db.Collection("enrollments"), bson.M{
+			"student_id": bson.M{"$in": studentIds},
+			"section_id": bson.M{"$in": hexIds(termSections)},
+			"status":     pb.EnrollmentStatus_ENROLLED.String(),
+		}, &current)
+		if err != nil {
+			return err
+		}
+		enrolled := map[string]bool{}
+		for _, enrollment := range current {
+			enrolled[enrollment.StudentId] = true
+		}
+
+		completed, err := completedCourses(ctx, db, studentIds, course.PrerequisiteIds, now)
+		if err != nil {
+			return err
+		}
+
+		var accepted []*models.Enrollment
+		var acceptedIndexes []int
+		seats := section.Enrolled
+		for i, studentId := range studentIds {
+			missing := ""
+			for _, prerequisite := range course.PrerequisiteIds {
+				if !completed[studentId][prerequisite] {
+					missing = prerequisite
+					break
+				}
+			}
+
+			switch {
+			case enrolled[studentId]:
+				results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(codes.AlreadyExists), ErrorMessage: fmt.Sprintf("the student is already enrolled in course %s in term %s", course.Code, section.Term)}
+			case missing != "":
+				results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(codes.FailedPrecondition), ErrorMessage: fmt.Sprintf("prerequisite course %s has not been completed", missing)}
+			case section.Capacity > 0 && seats >= section.Capacity:
+				results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(codes.FailedPrecondition), ErrorMessage: fmt.Sprintf("the section is full, it takes %d students", section.Capacity)}
+			default:
+				seats++
+				// A student listed twice is only enrolled once
+				enrolled[studentId] = true
+				accepted = append(accepted, &models.Enrollment{
+					StudentId:  studentId,
+					SectionId:  sectionId,
+					CourseId:   section.CourseId,
+					Status:     pb.EnrollmentStatus_ENROLLED.String(),
+					EnrolledAt: now,
+					Version:    1,
+					CreatedAt:  now,
+					UpdatedAt:  now,
+					CreatedBy:  actor,
+					UpdatedBy:  actor,
+				})
+				acceptedIndexes = append(acceptedIndexes, i)
+			}
+		}
+		if len(accepted) == 0 {
+			return nil
+		}
+
+		docs := make([]interface{}, len(accepted))
+		for i, enrollment := range accepted {
+			docs[i] = enrollment
+		}
+		inserted, err := db.Collection("enrollments").InsertMany(ctx, docs)
+		if err != nil {
+			return utils.ErrorHandler(err, "Error enrolling the students")
+		}
+		for i, id := range inserted.InsertedIDs {
+			accepted[i].Id = id.(primitive.ObjectID).Hex()
+			results[acceptedIndexes[i]] = &pb.BulkItemResult{Index: int32(acceptedIndexes[i]), Id: accepted[i].Id}
+			enrollments = append(enrollments, MapModelEnrollmentToPbEnrollment(accepted[i]))
+		}
+
+		_, err = db.Collection("course_sections").UpdateOne(ctx, bson.M{"_id": objId}, bson.M{"$inc": bson.M{"enrolled": len(accepted)}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error updating the enrolled count")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return enrollments, results, nil
+}
+
+// DropStudentsInDB drops the current enrollment of the students in the section, which is possible until the section ends
+func DropStudentsInDB(ctx context.Context, sectionId string, studentIds []string, actor string) ([]*pb.Enrollment, error) {
+	objId, err := primitive.ObjectIDFromHex(sectionId)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	var dropped []*pb.Enrollment
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		dropped = nil
+
+		section, _, err := sectionWithCourse(ctx, db, objId)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if !section.EndsAt.IsZero() && now.After(section.EndsAt) {
+			return &repositories.EnrollmentClosedError{SectionId: sectionId, ClosedAt: section.EndsAt}
+		}
+
+		filter := bson.M{"section_id": sectionId, "student_id": bson.M{"$in": studentIds}, "status": pb.EnrollmentStatus_ENROLLED.String()}
+		var current []*models.Enrollment
+		err = findAll(ctx, db.Collection("enrollments"), filter, &current)
+		if err != nil {
+			return err
+		}
+		if len(current) == 0 {
+			return &repositories.NotFoundError{Entity: "enrollment in the course section", Id: sectionId}
+		}
+
+		_, err = db.Collection("enrollments").UpdateMany(ctx, filter, bson.M{
+			"$set": bson.M{"status": pb.EnrollmentStatus_DROPPED.String(), "dropped_at": now, "updated_at": now, "updated_by": actor},
+			"$inc": bson.M{"version": 1},
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error dropping the students")
+		}
+		_, err = db.Collection("course_sections").UpdateOne(ctx, bson.M{"_id": objId}, bson.M{"$inc": bson.M{"enrolled": -len(current)}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error updating the enrolled count")
+		}
+
+		for _, enrollment := range current {
+			enrollment.Status = pb.EnrollmentStatus_DROPPED.String()
+			enrollment.DroppedAt = now
+			enrollment.UpdatedAt = now
+			enrollment.UpdatedBy = actor
+			enrollment.Version++
+			dropped = append(dropped, MapModelEnrollmentToPbEnrollment(enrollment))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dropped, nil
+}
+
+func GetEnrollmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Enrollment, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("enrollments")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	enrollments, err := decodeEntities(ctx, cursor, func() *pb.Enrollment { return &pb.Enrollment{} }, func() *models.Enrollment { return &models.Enrollment{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return enrollments, nil
+}
+
+// sectionWithCourse reads a section that is not deleted together with its course
+func sectionWithCourse(ctx context.Context, db *mongo.Database, objId primitive.ObjectID) (*models.CourseSection, *models.Course, error) {
+	var section models.CourseSection
+	err := db.Collection("course_sections").FindOne(ctx, notDeleted(bson.M{"_id": objId})).Decode(&section)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil, &repositories.NotFoundError{Entity: "course section", Id: objId.Hex()}
+	}
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	courseId, err := primitive.ObjectIDFromHex(section.CourseId)
+	if err != nil {
+		return nil, nil, &repositories.NotFoundError{Entity: "course", Id: section.CourseId}
+	}
+	var course models.Course
+	err = db.Collection("courses").FindOne(ctx, notDeleted(bson.M{"_id": courseId})).Decode(&course)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil, &repositories.NotFoundError{Entity: "course", Id: section.CourseId}
+	}
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return &section, &course, nil
+}
+
+// completedCourses returns which of the courses every student has completed, keyed by student and course ID
+// A course is completed by staying enrolled in a section of it until the section ended
+func completedCourses(ctx context.Context, db *mongo.Database, studentIds, courseIds []string, now time.Time) (map[string]map[string]bool, error) {
+	completed := map[string]map[string]bool{}
+	if len(courseIds) == 0 {
+		return completed, nil
+	}
+
+	ended, err := db.Collection("course_sections").Distinct(ctx, "_id", bson.M{"course_id": bson.M{"$in": courseIds}, "ends_at": bson.M{"$lt": now}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	var enrollments []models.Enrollment
+	err = findAll(ctx, db.Collection("enrollments"), bson.M{
+		"student_id": bson.M{"$in": studentIds},
+		"section_id": bson.M{"$in": hexIds(ended)},
+		"status":     pb.EnrollmentStatus_ENROLLED.String(),
+	}, &enrollments)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, enrollment := range enrollments {
+		if completed[enrollment.StudentId] == nil {
+			completed[enrollment.StudentId] = map[string]bool{}
+		}
+		completed[enrollment.StudentId][enrollment.CourseId] = true
+	}
+	return completed, nil
+}
+
+// findAll decodes every document matching the filter into results
+func findAll(ctx context.Context, coll *mongo.Collection, filter bson.M, results interface{}) error {
+	cursor, err := coll.Find(ctx, filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	err = cursor.All(ctx, results)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return nil
+}
+
+// hexIds turns the ObjectIDs returned by Distinct into their hex strings
+func hexIds(values []interface{}) []string {
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		if objId, ok := value.(primitive.ObjectID); ok {
+			ids = append(ids, objId.Hex())
+		}
+	}
+	return ids
+}
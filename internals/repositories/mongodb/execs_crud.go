@@ -15,22 +15,35 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func AddExecsToDb(ctx context.Context, execsFromRequest []*pb.Exec) ([]*pb.Exec, error) {
+func init() {
+	registerIndexes("execs",
+		mongo.IndexModel{Keys: bson.D{{Key: "username", Value: 1}}, Options: options.Index().SetUnique(true).SetSparse(true)},
+		mongo.IndexModel{Keys: bson.D{{Key: "email", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(activeEmail)},
+	)
+}
+
+func AddExecsToDb(ctx context.Context, execsFromRequest []*pb.Exec, ordered, atomic bool, actor string) ([]*pb.Exec, []*pb.BulkItemResult, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
 	}
 	defer client.Disconnect(ctx)
 
+	now := time.Now()
 	newExecs := make([]*models.Exec, len(execsFromRequest))
 	for i, pbExec := range execsFromRequest {
 		modelExec := MapPbExecToModelExec(pbExec)
+		modelExec.Version = 1
+		modelExec.CreatedAt = now
+		modelExec.UpdatedAt = now
+		modelExec.CreatedBy = actor
+		modelExec.UpdatedBy = actor
 
 		// Hash the password before storing
 		if modelExec.Password != "" {
 			hashedPassword, err := utils.HashPassword(modelExec.Password)
 			if err != nil {
-				return nil, utils.ErrorHandler(err, "Error hashing password")
+				return nil, nil, utils.ErrorHandler(err, "Error hashing password")
 			}
 			modelExec.Password = hashedPassword
 		}
@@ -38,23 +51,20 @@ func AddExecsToDb(ctx context.Context, execsFromRequest []*pb.Exec) ([]*pb.Exec,
 		newExecs[i] = modelExec
 	}
 
-	var addedExecs []*pb.Exec
-	for _, exec := range newExecs {
-		res, err := client.Database("school").Collection("execs").InsertOne(ctx, exec)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Error inserting data into mongodb")
-		}
+	results, err := addEntities(ctx, client, "execs", newExecs, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		objectId, ok := res.InsertedID.(primitive.ObjectID)
-		if ok {
-			exec.Id = objectId.Hex()
+	var addedExecs []*pb.Exec
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
 		}
-
-		pbExec := MapModelExecToPbExec(exec)
-
-		addedExecs = append(addedExecs, pbExec)
+		newExecs[i].Id = result.Id
+		addedExecs = append(addedExecs, MapModelExecToPbExec(newExecs[i]))
 	}
-	return addedExecs, nil
+	return addedExecs, results, nil
 }
 
 func GetExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Exec, error) {
@@ -83,7 +93,28 @@ func GetExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]
 	return execs, nil
 }
 
-func ModifyExecsInDB(ctx context.Context, req *pb.Execs) ([]*pb.Exec, error) {
+func StreamExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Exec) error) error {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("execs")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	return streamEntities(ctx, cursor, func() *pb.Exec { return &pb.Exec{} }, func() *models.Exec { return &models.Exec{} }, fn)
+}
+
+func ModifyExecsInDB(ctx context.Context, req *pb.UpdateExecsRequest, actor string) ([]*pb.Exec, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error connecting to the database")
@@ -91,68 +122,82 @@ func ModifyExecsInDB(ctx context.Context, req *pb.Execs) ([]*pb.Exec, error) {
 	defer client.Disconnect(ctx)
 
 	var updatedExecs []*pb.Exec
-	for _, exec := range req.Execs {
-		if exec.Id == "" {
-			return nil, utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
-		}
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedExecs = nil
+		for _, exec := range req.Execs {
+			if exec.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
 
-		modelExec := MapPbExecToModelExec(exec)
+			modelExec := MapPbExecToModelExec(exec)
 
-		// Hash the password if it's being updated
-		if modelExec.Password != "" {
-			hashedPassword, err := utils.HashPassword(modelExec.Password)
-			if err != nil {
-				return nil, utils.ErrorHandler(err, "Error hashing password")
+			// Hash the password if it's being updated
+			if modelExec.Password != "" {
+				hashedPassword, err := utils.HashPassword(modelExec.Password)
+				if err != nil {
+					return utils.ErrorHandler(err, "Error hashing password")
+				}
+				modelExec.Password = hashedPassword
 			}
-			modelExec.Password = hashedPassword
-		}
 
-		objId, err := primitive.ObjectIDFromHex(exec.Id)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid ID")
-		}
+			objId, err := primitive.ObjectIDFromHex(exec.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
 
-		// Convert modelExec into a BSON document before updating the database
-		modelDoc, err := bson.Marshal(modelExec)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
-		}
+			coll := client.Database("school").Collection("execs")
+			updatedModel, err := updateEntity(ctx, coll, objId, modelExec, req.GetUpdateMask().GetPaths(), exec.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "exec", MapModelExecToPbExec)
+			}
+			if mongo.IsDuplicateKeyError(err) {
+				return asDuplicateKeyError(err)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating exec with ID: %s", exec.Id))
+			}
 
-		var updateDoc bson.M
-		err = bson.Unmarshal(modelDoc, &updateDoc)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
+			// Respond with the stored document rather than an echo of the request
+			updatedExec := MapModelExecToPbExec(updatedModel)
+			updatedExecs = append(updatedExecs, updatedExec)
 		}
-
-		// Remove the '_id' field from 'updateDoc' since we are not meant to update the id
-		delete(updateDoc, "_id")
-
-		_, err = client.Database("school").Collection("execs").UpdateOne(ctx, bson.M{"_id": objId}, bson.M{"$set": updateDoc})
-		if err != nil {
-			return nil, utils.ErrorHandler(err, fmt.Sprintf("Error updating exec with ID: %s", exec.Id))
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no execs were updated")
 		}
-
-		updatedExec := MapModelExecToPbExec(modelExec)
-		updatedExecs = append(updatedExecs, updatedExec)
+		return nil, err
 	}
 	return updatedExecs, nil
 }
 
-func DeleteExecsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID) ([]string, error) {
+func DeleteExecsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
 	defer client.Disconnect(ctx)
 
-	filter := bson.M{"_id": bson.M{"$in": objectIdsToDelete}}
-	result, err := client.Database("school").Collection("execs").DeleteMany(ctx, filter)
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal error")
-	}
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, client.Database("school").Collection("execs"), objectIdsToDelete, expectedVersions, actor, "exec", MapModelExecToPbExec)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No execs were deleted")
+		}
 
-	if result.DeletedCount == 0 {
-		return nil, utils.ErrorHandler(err, "No execs were deleted")
+		// Rolling back the transaction leaves every exec in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all execs were found, no execs were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	var deletedIds []string
@@ -169,7 +214,7 @@ func GetUserByUsername(ctx context.Context, username string) (*models.Exec, erro
 	}
 	defer client.Disconnect(ctx)
 
-	filter := bson.M{"username": username}
+	filter := notDeleted(bson.M{"username": username})
 
 	var exec models.Exec
 	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&exec)
@@ -182,7 +227,7 @@ func GetUserByUsername(ctx context.Context, username string) (*models.Exec, erro
 	return &exec, nil
 }
 
-func UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest) (*models.Exec, error) {
+func UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest, actor string) (*models.Exec, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error connecting to the database")
@@ -195,7 +240,7 @@ func UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest) (*models
 	}
 
 	var exec models.Exec
-	err = client.Database("school").Collection("execs").FindOne(ctx, bson.M{"_id": objId}).Decode(&exec)
+	err = client.Database("school").Collection("execs").FindOne(ctx, notDeleted(bson.M{"_id": objId})).Decode(&exec)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "User not found")
 	}
@@ -210,11 +255,15 @@ func UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest) (*models
 		return nil, utils.ErrorHandler(err, "Unable to hash the password")
 	}
 
+	now := time.Now()
 	update := bson.M{
 		"$set": bson.M{
 			"password":            newHashedPassword,
-			"password_changed_at": time.Now().Format(time.RFC3339),
+			"password_changed_at": now,
+			"updated_at":          now,
+			"updated_by":          actor,
 		},
+		"$inc": bson.M{"version": 1},
 	}
 	_, err = client.Database("school").Collection("execs").UpdateOne(ctx, bson.M{"_id": objId}, update)
 	if err != nil {
@@ -223,15 +272,18 @@ func UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest) (*models
 	return &exec, nil
 }
 
-func DeactivateUserInDB(ctx context.Context, objIds []primitive.ObjectID) (*pb.Confirmation, error) {
+func DeactivateUserInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) (*pb.Confirmation, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error connecting to the database")
 	}
 	defer client.Disconnect(ctx)
 
-	update := bson.M{"$set": bson.M{"inactive_status": true}}
-	_, err = client.Database("school").Collection("execs").UpdateMany(ctx, bson.M{"_id": bson.M{"$in": objIds}}, update)
+	update := bson.M{
+		"$set": bson.M{"inactive_status": true, "updated_at": time.Now(), "updated_by": actor},
+		"$inc": bson.M{"version": 1},
+	}
+	_, err = client.Database("school").Collection("execs").UpdateMany(ctx, notDeleted(bson.M{"_id": bson.M{"$in": objIds}}), update)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
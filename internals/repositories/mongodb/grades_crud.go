@@ -0,0 +1,596 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	termLocksCollection     = "term_locks"
+	gradingScalesCollection = "grading_scales"
+
+	// gradingScaleId is the ID of the single grading scale of the school
+	gradingScaleId = "default"
+)
+
+func init() {
+	registerIndexes("assessments",
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}, {Key: "subject", Value: 1}, {Key: "term", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "course_id", Value: 1}, {Key: "term", Value: 1}}},
+	)
+	// A student has one score per assessment, which is changed afterwards, so two teachers recording it at once cannot
+	// both insert it
+	registerIndexes("scores",
+		mongo.IndexModel{Keys: bson.D{{Key: "assessment_id", Value: 1}, {Key: "student_id", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(unerased)},
+		mongo.IndexModel{Keys: bson.D{{Key: "student_id", Value: 1}}},
+	)
+	registerIndexes("category_weights",
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}, {Key: "subject", Value: 1}, {Key: "course_id", Value: 1}, {Key: "term", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerIndexes(termLocksCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "term", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerKeyedPersonReferences(pb.PersonType_STUDENT, "scores", "student_id")
+	registerPersonReferences(pb.PersonType_EXEC, "scores", "created_by", "updated_by")
+	registerPersonReferences(pb.PersonType_EXEC, "category_weights", "updated_by")
+	registerPersonReferences(pb.PersonType_EXEC, gradingScalesCollection, "updated_by")
+	registerPersonReferences(pb.PersonType_EXEC, termLocksCollection, "finalized_by")
+}
+
+func MapModelAssessmentToPbAssessment(assessmentModel *models.Assessment) *pb.Assessment {
+	return MapModelToPb(assessmentModel, func() *pb.Assessment { return &pb.Assessment{} })
+}
+
+func MapPbAssessmentToModelAssessment(pbAssessment *pb.Assessment) *models.Assessment {
+	return MapPbToModel(pbAssessment, func() *models.Assessment { return &models.Assessment{} })
+}
+
+func MapModelScoreToPbScore(scoreModel *models.Score) *pb.Score {
+	score := MapModelToPb(scoreModel, func() *pb.Score { return &pb.Score{} })
+	for i := range scoreModel.Changes {
+		score.Changes = append(score.Changes, MapModelToPb(&scoreModel.Changes[i], func() *pb.ScoreChange { return &pb.ScoreChange{} }))
+	}
+	return score
+}
+
+func MapModelGradingScaleToPbGradingScale(scaleModel *models.GradingScale) *pb.GradingScale {
+	scale := MapModelToPb(scaleModel, func() *pb.GradingScale { return &pb.GradingScale{} })
+	for i := range scaleModel.Bands {
+		scale.Bands = append(scale.Bands, MapModelToPb(&scaleModel.Bands[i], func() *pb.GradeBand { return &pb.GradeBand{} }))
+	}
+	return scale
+}
+
+// finalizedTerms matches the lock documents of finalized terms, open terms may have one that writes were checked against
+var finalizedTerms = bson.M{"finalized_at": bson.M{"$exists": true}}
+
+// checkTermOpen fails with a TermFinalizedError when one of the terms has been finalized
+// Reading the lock alone would let a write and the finalization of its term both go through, so the lock document of
+// every term is written as well, which makes the transaction conflict with FinalizeTermInDB finalizing the term
+func checkTermOpen(ctx context.Context, db *mongo.Database, terms ...string) error {
+	coll := db.Collection(termLocksCollection)
+	now := time.Now()
+	for _, term := range terms {
+		if term == "" {
+			continue
+		}
+		var lock models.TermLock
+		err := coll.FindOneAndUpdate(ctx, bson.M{"term": term}, bson.M{"$set": bson.M{"checked_at": now}}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)).Decode(&lock)
+		if err != nil {
+			return err
+		}
+		if !lock.FinalizedAt.IsZero() {
+			return &repositories.TermFinalizedError{Term: lock.Term, FinalizedAt: lock.FinalizedAt}
+		}
+	}
+	return nil
+}
+
+func AddAssessmentsToDb(ctx context.Context, assessmentsFromReq []*pb.Assessment, ordered, atomic bool, actor string) ([]*pb.Assessment, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	now := time.Now()
+	newAssessments := make([]*models.Assessment, len(assessmentsFromReq))
+	for i, pbAssessment := range assessmentsFromReq {
+		modelAssessment := MapPbAssessmentToModelAssessment(pbAssessment)
+		modelAssessment.Version = 1
+		modelAssessment.CreatedAt = now
+		modelAssessment.UpdatedAt = now
+		modelAssessment.CreatedBy = actor
+		modelAssessment.UpdatedBy = actor
+		newAssessments[i] = modelAssessment
+	}
+
+	// The term is checked in the transaction adding the assessment, so it cannot be finalized in between
+	results, err := addEntities(ctx, client, "assessments", newAssessments, ordered, atomic, func(ctx context.Context, assessment *models.Assessment) error {
+		return checkTermOpen(ctx, db, assessment.Term)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedAssessments []*pb.Assessment
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newAssessments[i].Id = result.Id
+		addedAssessments = append(addedAssessments, MapModelAssessmentToPbAssessment(newAssessments[i]))
+	}
+	return addedAssessments, results, nil
+}
+
+func GetAssessmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Assessment, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("assessments")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	assessments, err := decodeEntities(ctx, cursor, func() *pb.Assessment { return &pb.Assessment{} }, func() *models.Assessment { return &models.Assessment{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return assessments, nil
+}
+
+func ModifyAssessmentsInDB(ctx context.Context, req *pb.UpdateAssessmentsRequest, actor string) ([]*pb.Assessment, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	var updatedAssessments []*pb.Assessment
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedAssessments = nil
+		for _, assessment := range req.Assessments {
+			if assessment.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelAssessment := MapPbAssessmentToModelAssessment(assessment)
+			objId, err := primitive.ObjectIDFromHex(assessment.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			coll := db.Collection("assessments")
+			// Neither the term the assessment is in nor the one it moves to may be finalized
+			var current models.Assessment
+			err = coll.FindOne(ctx, notDeleted(bson.M{"_id": objId})).Decode(&current)
+			if err == mongo.ErrNoDocuments {
+				return &repositories.NotFoundError{Entity: "assessment", Id: assessment.Id}
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			err = checkTermOpen(ctx, db, current.Term, assessment.Term)
+			if err != nil {
+				return err
+			}
+
+			paths := updatePaths(assessment, req.GetUpdateMask().GetPaths())
+			// Recorded scores stay within the max score, the term check above keeps new ones from coming in meanwhile
+			if slices.Contains(paths, "max_score") {
+				var above models.Score
+				err = db.Collection("scores").FindOne(ctx, bson.M{"assessment_id": assessment.Id, "score": bson.M{"$gt": assessment.MaxScore}}).Decode(&above)
+				if err == nil {
+					return &repositories.MaxScoreBelowScoresError{AssessmentId: assessment.Id, MaxScore: assessment.MaxScore, Score: above.Score}
+				}
+				if err != mongo.ErrNoDocuments {
+					return err
+				}
+			}
+
+			updatedModel, err := updateEntity(ctx, coll, objId, modelAssessment, paths, assessment.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "assessment", MapModelAssessmentToPbAssessment)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating assessment with ID: %s", assessment.Id))
+			}
+
+			updatedAssessments = append(updatedAssessments, MapModelAssessmentToPbAssessment(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no assessments were updated")
+		}
+		return nil, err
+	}
+	return updatedAssessments, nil
+}
+
+func DeleteAssessmentsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		terms, err := db.Collection("assessments").Distinct(ctx, "term", bson.M{"_id": bson.M{"$in": objectIdsToDelete}})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		var names []string
+		for _, term := range terms {
+			if name, ok := term.(string); ok {
+				names = append(names, name)
+			}
+		}
+		err = checkTermOpen(ctx, db, names...)
+		if err != nil {
+			return err
+		}
+
+		deletedCount, err := softDeleteEntities(ctx, db.Collection("assessments"), objectIdsToDelete, expectedVersions, actor, "assessment", MapModelAssessmentToPbAssessment)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No assessments were deleted")
+		}
+
+		// Rolling back the transaction leaves every assessment in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all assessments were found, no assessments were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// RecordScoresInDB records new scores and changes recorded ones, reporting the outcome for every entry by its index
+// Changing a score needs a reason and is kept in the changes of the score, entries with a stale version are rejected
+func RecordScoresInDB(ctx context.Context, assessmentId string, entries []*pb.ScoreEntry, actor string) ([]*pb.Score, []*pb.BulkItemResult, error) {
+	objId, err := primitive.ObjectIDFromHex(assessmentId)
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+	coll := db.Collection("scores")
+
+	var scores []*pb.Score
+	var results []*pb.BulkItemResult
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		scores = nil
+		results = make([]*pb.BulkItemResult, len(entries))
+
+		var assessment models.Assessment
+		err := db.Collection("assessments").FindOne(ctx, notDeleted(bson.M{"_id": objId})).Decode(&assessment)
+		if err == mongo.ErrNoDocuments {
+			return &repositories.NotFoundError{Entity: "assessment", Id: assessmentId}
+		}
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		err = checkTermOpen(ctx, db, assessment.Term)
+		if err != nil {
+			return err
+		}
+
+		var studentIds []string
+		for _, entry := range entries {
+			studentIds = append(studentIds, entry.StudentId)
+		}
+		var existing []*models.Score
+		err = findAll(ctx, coll, bson.M{"assessment_id": assessmentId, "student_id": bson.M{"$in": studentIds}}, &existing)
+		if err != nil {
+			return err
+		}
+		recorded := map[string]*models.Score{}
+		for _, score := range existing {
+			recorded[score.StudentId] = score
+		}
+
+		now := time.Now()
+		for i, entry := range entries {
+			// The max score may have changed since the handler checked it
+			if entry.Score > assessment.MaxScore {
+				results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(codes.InvalidArgument), ErrorMessage: fmt.Sprintf("the score has to be between 0 and %g", assessment.MaxScore)}
+				continue
+			}
+			current, ok := recorded[entry.StudentId]
+			if !ok {
+				score := &models.Score{
+					AssessmentId: assessmentId,
+					StudentId:    entry.StudentId,
+					Score:        entry.Score,
+					Comment:      entry.Comment,
+					Version:      1,
+					CreatedAt:    now,
+					UpdatedAt:    now,
+					CreatedBy:    actor,
+					UpdatedBy:    actor,
+				}
+				result, err := coll.InsertOne(ctx, score)
+				if mongo.IsDuplicateKeyError(err) {
+					return asDuplicateKeyError(err)
+				}
+				if err != nil {
+					return err
+				}
+				score.Id = result.InsertedID.(primitive.ObjectID).Hex()
+				// A student listed twice changes the score recorded just now
+				recorded[entry.StudentId] = score
+				results[i] = &pb.BulkItemResult{Index: int32(i), Id: score.Id}
+				scores = append(scores, MapModelScoreToPbScore(score))
+				continue
+			}
+
+			if entry.Version != 0 && entry.Version != current.Version {
+				results[i] = &pb.BulkItemResult{Index: int32(i), Id: current.Id, ErrorCode: int32(codes.FailedPrecondition), ErrorMessage: fmt.Sprintf("version conflict: the score is at version %d", current.Version)}
+				continue
+			}
+			set := bson.M{"comment": entry.Comment, "updated_at": now, "updated_by": actor}
+			update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+			if entry.Score != current.Score {
+				if entry.Reason == "" {
+					results[i] = &pb.BulkItemResult{Index: int32(i), Id: current.Id, ErrorCode: int32(codes.InvalidArgument), ErrorMessage: "reason is required when changing a recorded score"}
+					continue
+				}
+				change := models.ScoreChange{PreviousScore: current.Score, Score: entry.Score, Reason: entry.Reason, ChangedAt: now, ChangedBy: actor}
+				set["score"] = entry.Score
+				update["$push"] = bson.M{"changes": change}
+				current.Changes = append(current.Changes, change)
+			}
+
+			currentId, err := primitive.ObjectIDFromHex(current.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			_, err = coll.UpdateOne(ctx, bson.M{"_id": currentId, "version": current.Version}, update)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error changing the score")
+			}
+			current.Score = entry.Score
+			current.Comment = entry.Comment
+			current.UpdatedAt = now
+			current.UpdatedBy = actor
+			current.Version++
+			results[i] = &pb.BulkItemResult{Index: int32(i), Id: current.Id}
+			scores = append(scores, MapModelScoreToPbScore(current))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return scores, results, nil
+}
+
+func GetScoresFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Score, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := client.Database("school").Collection("scores").Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var scores []*pb.Score
+	for cursor.Next(ctx) {
+		var score models.Score
+		err := cursor.Decode(&score)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		scores = append(scores, MapModelScoreToPbScore(&score))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return scores, nil
+}
+
+// SetCategoryWeightsInDB replaces the category weights of a gradebook in a term
+func SetCategoryWeightsInDB(ctx context.Context, weights *pb.CategoryWeights, actor string) (*pb.CategoryWeights, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	model := MapPbToModel(weights, func() *models.CategoryWeights { return &models.CategoryWeights{} })
+	model.UpdatedAt = time.Now()
+	model.UpdatedBy = actor
+	filter := bson.M{"class_id": model.ClassId, "subject": model.Subject, "course_id": model.CourseId, "term": model.Term}
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		err := checkTermOpen(ctx, db, weights.Term)
+		if err != nil {
+			return err
+		}
+		_, err = db.Collection("category_weights").ReplaceOne(ctx, filter, model, options.Replace().SetUpsert(true))
+		return err
+	})
+	if err != nil {
+		return nil, rolledBack(err, "the category weights were not set")
+	}
+	return MapModelToPb(model, func() *pb.CategoryWeights { return &pb.CategoryWeights{} }), nil
+}
+
+func GetCategoryWeightsFromDB(ctx context.Context, filters bson.M) ([]*pb.CategoryWeights, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	var found []*models.CategoryWeights
+	err = findAll(ctx, client.Database("school").Collection("category_weights"), filters, &found)
+	if err != nil {
+		return nil, err
+	}
+	weights := make([]*pb.CategoryWeights, len(found))
+	for i, model := range found {
+		weights[i] = MapModelToPb(model, func() *pb.CategoryWeights { return &pb.CategoryWeights{} })
+	}
+	return weights, nil
+}
+
+// SetGradingScaleInDB replaces the grading scale of the school
+func SetGradingScaleInDB(ctx context.Context, scale *pb.GradingScale, actor string) (*pb.GradingScale, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	model := &models.GradingScale{UpdatedAt: time.Now(), UpdatedBy: actor}
+	for _, band := range scale.GetBands() {
+		model.Bands = append(model.Bands, models.GradeBand{Letter: band.Letter, MinPercentage: band.MinPercentage, GradePoints: band.GradePoints})
+	}
+	_, err = client.Database("school").Collection(gradingScalesCollection).ReplaceOne(ctx, bson.M{"_id": gradingScaleId}, model, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error setting the grading scale")
+	}
+	return MapModelGradingScaleToPbGradingScale(model), nil
+}
+
+// GetGradingScaleFromDB returns the grading scale of the school, nil while none has been set
+func GetGradingScaleFromDB(ctx context.Context) (*pb.GradingScale, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	var model models.GradingScale
+	err = client.Database("school").Collection(gradingScalesCollection).FindOne(ctx, bson.M{"_id": gradingScaleId}).Decode(&model)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return MapModelGradingScaleToPbGradingScale(&model), nil
+}
+
+func FinalizeTermInDB(ctx context.Context, term string, actor string) (*pb.TermLock, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection(termLocksCollection)
+
+	// The lock is read and written in one transaction, so writes checking the term in their own transactions
+	// conflict with it and either finish before the term is finalized or see it finalized when they are retried
+	lock := &models.TermLock{Term: term, FinalizedAt: time.Now(), FinalizedBy: actor}
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		var current models.TermLock
+		err := coll.FindOne(ctx, bson.M{"term": term}).Decode(&current)
+		if err == nil && !current.FinalizedAt.IsZero() {
+			return &repositories.DuplicateKeyError{Field: "term"}
+		}
+		if err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
+		_, err = coll.UpdateOne(ctx, bson.M{"term": term}, bson.M{"$set": bson.M{"finalized_at": lock.FinalizedAt, "finalized_by": lock.FinalizedBy}}, options.Update().SetUpsert(true))
+		return err
+	})
+	if err != nil {
+		return nil, rolledBack(err, "the term was not finalized")
+	}
+	return MapModelToPb(lock, func() *pb.TermLock { return &pb.TermLock{} }), nil
+}
+
+// ReopenTermInDB removes the lock of a finalized term and returns it
+func ReopenTermInDB(ctx context.Context, term string) (*pb.TermLock, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	var lock models.TermLock
+	err = client.Database("school").Collection(termLocksCollection).FindOneAndUpdate(ctx, bson.M{"term": term, "finalized_at": bson.M{"$exists": true}}, bson.M{"$unset": bson.M{"finalized_at": "", "finalized_by": ""}}).Decode(&lock)
+	if err == mongo.ErrNoDocuments {
+		return nil, &repositories.NotFoundError{Entity: "finalized term", Id: term}
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reopening the term")
+	}
+	return MapModelToPb(&lock, func() *pb.TermLock { return &pb.TermLock{} }), nil
+}
+
+func GetTermLocksFromDB(ctx context.Context) ([]*pb.TermLock, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	var found []*models.TermLock
+	err = findAll(ctx, client.Database("school").Collection(termLocksCollection), finalizedTerms, &found)
+	if err != nil {
+		return nil, err
+	}
+	locks := make([]*pb.TermLock, len(found))
+	for i, lock := range found {
+		locks[i] = MapModelToPb(lock, func() *pb.TermLock { return &pb.TermLock{} })
+	}
+	return locks, nil
+}
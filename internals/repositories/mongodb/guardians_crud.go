@@ -0,0 +1,266 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	registerIndexes("guardians",
+		mongo.IndexModel{Keys: bson.D{{Key: "students.student_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "last_name", Value: 1}, {Key: "first_name", Value: 1}}},
+	)
+	registerPersonListReferences(pb.PersonType_STUDENT, "guardians", "students.student_id")
+}
+
+func MapModelGuardianToPbGuardian(guardianModel *models.Guardian) *pb.Guardian {
+	guardian := MapModelToPb(guardianModel, func() *pb.Guardian { return &pb.Guardian{} })
+	for i := range guardianModel.Students {
+		guardian.Students = append(guardian.Students, MapModelToPb(&guardianModel.Students[i], func() *pb.GuardianStudent { return &pb.GuardianStudent{} }))
+		guardian.StudentIds = append(guardian.StudentIds, guardianModel.Students[i].StudentId)
+	}
+	return guardian
+}
+
+func MapPbGuardianToModelGuardian(pbGuardian *pb.Guardian) *models.Guardian {
+	guardian := MapPbToModel(pbGuardian, func() *models.Guardian { return &models.Guardian{} })
+	for _, student := range pbGuardian.Students {
+		guardian.Students = append(guardian.Students, *MapPbToModel(student, func() *models.GuardianStudent { return &models.GuardianStudent{} }))
+	}
+	return guardian
+}
+
+func AddGuardiansToDb(ctx context.Context, guardiansFromReq []*pb.Guardian, ordered, atomic bool, actor string) ([]*pb.Guardian, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newGuardians := make([]*models.Guardian, len(guardiansFromReq))
+	for i, pbGuardian := range guardiansFromReq {
+		modelGuardian := MapPbGuardianToModelGuardian(pbGuardian)
+		modelGuardian.Version = 1
+		modelGuardian.CreatedAt = now
+		modelGuardian.UpdatedAt = now
+		modelGuardian.CreatedBy = actor
+		modelGuardian.UpdatedBy = actor
+		newGuardians[i] = modelGuardian
+	}
+
+	results, err := addEntities(ctx, client, "guardians", newGuardians, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedGuardians []*pb.Guardian
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newGuardians[i].Id = result.Id
+		addedGuardians = append(addedGuardians, MapModelGuardianToPbGuardian(newGuardians[i]))
+	}
+	return addedGuardians, results, nil
+}
+
+func GetGuardiansFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Guardian, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("guardians")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var guardians []*pb.Guardian
+	for cursor.Next(ctx) {
+		var guardian models.Guardian
+		err := cursor.Decode(&guardian)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		guardians = append(guardians, MapModelGuardianToPbGuardian(&guardian))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return guardians, nil
+}
+
+func ModifyGuardiansInDB(ctx context.Context, req *pb.UpdateGuardiansRequest, actor string) ([]*pb.Guardian, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("guardians")
+
+	var updatedGuardians []*pb.Guardian
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedGuardians = nil
+		for _, guardian := range req.Guardians {
+			if guardian.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelGuardian := MapPbGuardianToModelGuardian(guardian)
+			objId, err := primitive.ObjectIDFromHex(guardian.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			updatedModel, err := updateEntity(ctx, coll, objId, modelGuardian, updatePaths(guardian, req.GetUpdateMask().GetPaths()), guardian.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "guardian", MapModelGuardianToPbGuardian)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating guardian with ID: %s", guardian.Id))
+			}
+
+			updatedGuardians = append(updatedGuardians, MapModelGuardianToPbGuardian(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no guardians were updated")
+		}
+		return nil, err
+	}
+	return updatedGuardians, nil
+}
+
+func DeleteGuardiansFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("guardians")
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, coll, objectIdsToDelete, expectedVersions, actor, "guardian", MapModelGuardianToPbGuardian)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No guardians were deleted")
+		}
+
+		// Rolling back the transaction leaves every guardian in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all guardians were found, no guardians were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// ChangeGuardianLinksInDB links guardians to students or unlinks them, and returns the guardians as they are afterwards
+// Linking a guardian to a student again replaces the relationship and emergency priority of the link
+func ChangeGuardianLinksInDB(ctx context.Context, links []*pb.GuardianLink, link, atomic bool, actor string) ([]*pb.Guardian, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("guardians")
+
+	var changedGuardians []*pb.Guardian
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		changedGuardians = nil
+		changed := map[string]int{}
+		for _, guardianLink := range links {
+			objId, err := primitive.ObjectIDFromHex(guardianLink.GuardianId)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			var update any = bson.M{
+				"$pull": bson.M{"students": bson.M{"student_id": guardianLink.StudentId}},
+				"$set":  bson.M{"updated_at": time.Now(), "updated_by": actor},
+				"$inc":  bson.M{"version": 1},
+			}
+			if link {
+				update = linkUpdate(guardianLink, actor)
+			}
+			var updated models.Guardian
+			err = coll.FindOneAndUpdate(ctx, notDeleted(bson.M{"_id": objId}), update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updated)
+			if err == mongo.ErrNoDocuments {
+				return &repositories.NotFoundError{Entity: "guardian", Id: guardianLink.GuardianId}
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating guardian with ID: %s", guardianLink.GuardianId))
+			}
+
+			// A guardian named in several links is returned once, as it is after the last of them
+			if i, ok := changed[updated.Id]; ok {
+				changedGuardians[i] = MapModelGuardianToPbGuardian(&updated)
+				continue
+			}
+			changed[updated.Id] = len(changedGuardians)
+			changedGuardians = append(changedGuardians, MapModelGuardianToPbGuardian(&updated))
+		}
+		return nil
+	})
+	if err != nil {
+		var notFound *repositories.NotFoundError
+		if atomic && !errors.As(err, &notFound) {
+			return nil, rolledBack(err, "no links were changed")
+		}
+		return nil, err
+	}
+	return changedGuardians, nil
+}
+
+// linkUpdate is the update pipeline linking a guardian to the student of the link
+// A link to the student the guardian already has is replaced where it is, otherwise the link is added at the end
+func linkUpdate(link *pb.GuardianLink, actor string) mongo.Pipeline {
+	// $literal keeps values starting with $ from being read as field paths
+	entry := bson.M{"$literal": models.GuardianStudent{StudentId: link.StudentId, Relationship: link.Relationship, EmergencyPriority: link.EmergencyPriority}}
+	students := bson.M{"$ifNull": bson.A{"$students", bson.A{}}}
+	return mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"students": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{link.StudentId, bson.M{"$map": bson.M{"input": students, "in": "$$this.student_id"}}}},
+			bson.M{"$map": bson.M{"input": students, "in": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$$this.student_id", link.StudentId}}, entry, "$$this"}}}},
+			bson.M{"$concatArrays": bson.A{students, bson.A{entry}}},
+		}},
+		"updated_at": time.Now(),
+		"updated_by": actor,
+		"version":    bson.M{"$add": bson.A{"$version", 1}},
+	}}}}
+}
@@ -2,14 +2,126 @@ package mongodb
 
 import (
 	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
 	"ClassConnectRPC/pkg/utils"
 	pb "ClassConnectRPC/proto/gen"
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Number of documents sent to mongodb in a single InsertMany round-trip
+const insertBatchSize = 1000
+
+// insertEntities inserts the given models in batches and reports the outcome of every item by its index
+// In ordered mode the insert stops at the first failure and every item after it is reported as not attempted
+// The returned error is only set when nothing could be reported at all
+func insertEntities[M any](ctx context.Context, coll *mongo.Collection, entities []*M, ordered bool) ([]*pb.BulkItemResult, error) {
+	results := make([]*pb.BulkItemResult, len(entities))
+	stopped := false
+
+	for start := 0; start < len(entities); start += insertBatchSize {
+		end := min(start+insertBatchSize, len(entities))
+
+		if stopped {
+			for i := start; i < end; i++ {
+				results[i] = notAttemptedResult(i)
+			}
+			continue
+		}
+
+		docs := make([]interface{}, 0, end-start)
+		for _, entity := range entities[start:end] {
+			docs = append(docs, entity)
+		}
+
+		res, err := coll.InsertMany(ctx, docs, options.InsertMany().SetOrdered(ordered))
+
+		failed := map[int]mongo.WriteError{}
+		if err != nil {
+			var bulkErr mongo.BulkWriteException
+			if !errors.As(err, &bulkErr) || len(bulkErr.WriteErrors) == 0 {
+				// The whole batch failed (e.g. lost connection), so nothing from here on was inserted
+				if start == 0 {
+					return nil, utils.ErrorHandler(err, "Error inserting data into mongodb")
+				}
+				utils.ErrorHandler(err, "Error inserting data into mongodb")
+				for i := start; i < end; i++ {
+					results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(codes.Internal), ErrorMessage: "Error inserting data into mongodb"}
+				}
+				stopped = true
+				continue
+			}
+			for _, writeErr := range bulkErr.WriteErrors {
+				failed[writeErr.Index] = writeErr.WriteError
+			}
+		}
+
+		// In ordered mode mongodb stops at the first write error, so nothing after it was attempted
+		firstFailure := end - start
+		if ordered {
+			for idx := range failed {
+				firstFailure = min(firstFailure, idx)
+			}
+		}
+
+		for offset := 0; offset < end-start; offset++ {
+			i := start + offset
+			if writeErr, ok := failed[offset]; ok {
+				code, message := writeErrorStatus(writeErr)
+				results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(code), ErrorMessage: message}
+				continue
+			}
+			if offset > firstFailure {
+				results[i] = notAttemptedResult(i)
+				continue
+			}
+
+			result := &pb.BulkItemResult{Index: int32(i)}
+			if res != nil && offset < len(res.InsertedIDs) {
+				if objectId, ok := res.InsertedIDs[offset].(primitive.ObjectID); ok {
+					result.Id = objectId.Hex()
+				}
+			}
+			results[i] = result
+		}
+
+		if len(failed) > 0 && ordered {
+			stopped = true
+		}
+	}
+	return results, nil
+}
+
+func notAttemptedResult(index int) *pb.BulkItemResult {
+	return &pb.BulkItemResult{
+		Index:        int32(index),
+		ErrorCode:    int32(codes.Aborted),
+		ErrorMessage: "Not attempted: an earlier item of the ordered insert failed",
+	}
+}
+
+// writeErrorStatus translates a mongodb write error into the gRPC code and message reported to the client
+func writeErrorStatus(writeErr mongo.WriteError) (codes.Code, string) {
+	if writeErr.HasErrorCode(11000) {
+		duplicate := &repositories.DuplicateKeyError{Field: duplicateKeyField(writeErr.Raw, writeErr.Message)}
+		return codes.AlreadyExists, duplicate.Error()
+	}
+	return codes.Internal, writeErr.Message
+}
+
 // This is a generic function which works for teachers, students and execs
 // Same can be implemented using interfaces instead of generics
 // General rule of thumb is to use generics when the return type is dependent on the function arguments
@@ -17,29 +129,89 @@ import (
 func decodeEntities[T any, M any](ctx context.Context, cursor *mongo.Cursor, newEntity func() *T, newModel func() *M) ([]*T, error) {
 	var entities []*T
 	for cursor.Next(ctx) {
-		model := newModel()
-		err := cursor.Decode(&model)
+		entity, err := decodeEntity(cursor, newEntity, newModel)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
+			return nil, err
 		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
 
-		entity := newEntity()
-		modelVal := reflect.ValueOf(model).Elem()
-		pbVal := reflect.ValueOf(entity).Elem()
+// streamEntities hands the documents of the cursor to fn one at a time, so the result is never held in memory as a whole
+// An error returned by fn stops the iteration and is returned as is
+func streamEntities[T any, M any](ctx context.Context, cursor *mongo.Cursor, newEntity func() *T, newModel func() *M, fn func(*T) error) error {
+	for cursor.Next(ctx) {
+		entity, err := decodeEntity(cursor, newEntity, newModel)
+		if err != nil {
+			return err
+		}
+		if err := fn(entity); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return nil
+}
 
-		for i := 0; i < modelVal.NumField(); i++ {
-			modelField := modelVal.Field(i)
-			modelFieldName := modelVal.Type().Field(i).Name
+func decodeEntity[T any, M any](cursor *mongo.Cursor, newEntity func() *T, newModel func() *M) (*T, error) {
+	model := newModel()
+	err := cursor.Decode(&model)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
 
-			pbField := pbVal.FieldByName(modelFieldName)
-			if pbField.IsValid() && pbField.CanSet() {
-				pbField.Set(modelField)
-			}
+	entity := newEntity()
+	modelVal := reflect.ValueOf(model).Elem()
+	pbVal := reflect.ValueOf(entity).Elem()
+
+	for i := 0; i < modelVal.NumField(); i++ {
+		modelField := modelVal.Field(i)
+		modelFieldName := modelVal.Type().Field(i).Name
+
+		pbField := pbVal.FieldByName(modelFieldName)
+		if pbField.IsValid() && pbField.CanSet() {
+			assignField(pbField, modelField)
+		}
+	}
+	return entity, nil
+}
+
+// assignField copies a field between a model and its protobuf message
+// Models keep timestamps as time.Time while the messages use google.protobuf.Timestamp, and enums as the name of their value,
+// so those are converted and fields of any other differing type are left untouched
+func assignField(dst, src reflect.Value) {
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return
+	}
+
+	// Enums are stored by name so the documents stay readable
+	if enum, ok := src.Interface().(protoreflect.Enum); ok && dst.Kind() == reflect.String {
+		if value := enum.Descriptor().Values().ByNumber(enum.Number()); value != nil {
+			dst.SetString(string(value.Name()))
 		}
+		return
+	}
+	if enum, ok := dst.Interface().(protoreflect.Enum); ok && src.Kind() == reflect.String {
+		if value := enum.Descriptor().Values().ByName(protoreflect.Name(src.String())); value != nil {
+			dst.SetInt(int64(value.Number()))
+		}
+		return
+	}
 
-		entities = append(entities, entity)
+	switch value := src.Interface().(type) {
+	case time.Time:
+		if dst.Type() == reflect.TypeOf(&timestamppb.Timestamp{}) && !value.IsZero() {
+			dst.Set(reflect.ValueOf(timestamppb.New(value)))
+		}
+	case *timestamppb.Timestamp:
+		if dst.Type() == reflect.TypeOf(time.Time{}) && value != nil {
+			dst.Set(reflect.ValueOf(value.AsTime()))
+		}
 	}
-	return entities, nil
 }
 
 func MapModelToPb[M any, P any](model *M, newPb func() *P) *P {
@@ -53,7 +225,7 @@ func MapModelToPb[M any, P any](model *M, newPb func() *P) *P {
 
 		pbField := pbVal.FieldByName(modelFieldType.Name)
 		if pbField.IsValid() && pbField.CanSet() {
-			pbField.Set(modelField)
+			assignField(pbField, modelField)
 		}
 
 	}
@@ -83,7 +255,7 @@ func MapPbToModel[P any, M any](pbStruct *P, newModel func() *M) *M {
 
 		modelField := modelVal.FieldByName(fieldName)
 		if modelField.IsValid() && modelField.CanSet() {
-			modelField.Set(pbField)
+			assignField(modelField, pbField)
 		}
 	}
 
@@ -101,3 +273,144 @@ func MapPbStudentToModelStudent(pbStudent *pb.Student) *models.Student {
 func MapPbExecToModelExec(pbExec *pb.Exec) *models.Exec {
 	return MapPbToModel(pbExec, func() *models.Exec { return &models.Exec{} })
 }
+
+// Fields maintained by the repository itself that an update request can never change
+var readOnlyFields = map[string]bool{
+	"_id":                 true,
+	"version":             true,
+	"deleted_at":          true,
+	"deleted_by":          true,
+	"created_at":          true,
+	"updated_at":          true,
+	"created_by":          true,
+	"updated_by":          true,
+	"password_changed_at": true,
+}
+
+// BuildUpdate builds the update document for a model
+// Without paths every non-empty field of the model is set, otherwise only the fields named by the paths change
+// and the ones left empty are removed from the document
+// The sqlite backend translates the same document into SQL, so both backends follow the same update rules
+func BuildUpdate(model interface{}, paths []string) (bson.M, error) {
+	if len(paths) == 0 {
+		// Convert the model into a BSON document before updating the database
+		modelDoc, err := bson.Marshal(model)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+
+		var updateDoc bson.M
+		err = bson.Unmarshal(modelDoc, &updateDoc)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+
+		// Remove the '_id' and the other read-only fields from 'updateDoc' since we are not meant to update them
+		for field := range readOnlyFields {
+			delete(updateDoc, field)
+		}
+		return bson.M{"$set": updateDoc}, nil
+	}
+
+	set := bson.M{}
+	unset := bson.M{}
+
+	modelVal := reflect.ValueOf(model).Elem()
+	modelType := modelVal.Type()
+	for _, path := range paths {
+		found := false
+		for i := 0; i < modelType.NumField(); i++ {
+			// Mask paths use the proto field names, which are the first part of the protobuf tag
+			protoName := strings.Split(modelType.Field(i).Tag.Get("protobuf"), ",")[0]
+			if protoName != path {
+				continue
+			}
+
+			bsonName := strings.Split(modelType.Field(i).Tag.Get("bson"), ",")[0]
+			if readOnlyFields[bsonName] {
+				return nil, utils.ErrorHandler(fmt.Errorf("%s cannot be updated", path), fmt.Sprintf("%s cannot be updated", path))
+			}
+
+			fieldVal := modelVal.Field(i)
+			if fieldVal.IsZero() {
+				unset[bsonName] = ""
+			} else {
+				set[bsonName] = fieldVal.Interface()
+			}
+			found = true
+			break
+		}
+		if !found {
+			return nil, utils.ErrorHandler(fmt.Errorf("unknown field path: %s", path), fmt.Sprintf("Unknown field path: %s", path))
+		}
+	}
+
+	update := bson.M{}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	return update, nil
+}
+
+// updatePaths returns the mask paths of an update, without a mask they are the populated fields of the message
+// Enums are stored by name, so a zero enum would otherwise be set and reset the stored value when left out
+func updatePaths(msg protoreflect.ProtoMessage, paths []string) []string {
+	if len(paths) > 0 {
+		return paths
+	}
+	msg.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		name := string(field.Name())
+		if name != "id" && !readOnlyFields[name] {
+			paths = append(paths, name)
+		}
+		return true
+	})
+	return paths
+}
+
+// updateEntity applies the update for a single model and returns the document as stored after the update
+// A non-zero expected version limits the update to the document with that version, every update bumps the version
+// and records when and by whom the document was last updated
+func updateEntity[M any](ctx context.Context, coll *mongo.Collection, objId primitive.ObjectID, model *M, paths []string, expectedVersion int64, actor string) (*M, error) {
+	update, err := BuildUpdate(model, paths)
+	if err != nil {
+		return nil, err
+	}
+	set, ok := update["$set"].(bson.M)
+	if !ok {
+		set = bson.M{}
+		update["$set"] = set
+	}
+	set["updated_at"] = time.Now()
+	set["updated_by"] = actor
+	update["$inc"] = bson.M{"version": 1}
+
+	filter := notDeleted(bson.M{"_id": objId})
+	if expectedVersion != 0 {
+		filter["version"] = expectedVersion
+	}
+
+	updated := new(M)
+	err = coll.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(updated)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// missingOrConflict explains why a write filtered by id and version matched nothing
+// Either the document does not exist or its version differs, in which case the current document is returned with the error
+func missingOrConflict[M any, P proto.Message](ctx context.Context, coll *mongo.Collection, objId primitive.ObjectID, entity string, toPb func(*M) P) error {
+	current := new(M)
+	err := coll.FindOne(ctx, notDeleted(bson.M{"_id": objId})).Decode(current)
+	if err == mongo.ErrNoDocuments {
+		return utils.ErrorHandler(err, fmt.Sprintf("No %s found with ID: %s", entity, objId.Hex()))
+	}
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return &repositories.VersionConflictError{Id: objId.Hex(), Current: toPb(current)}
+}
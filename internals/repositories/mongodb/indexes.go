@@ -0,0 +1,126 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Indexes declared by the repositories, keyed by collection name
+var indexRegistry = map[string][]mongo.IndexModel{}
+
+// activeEmail limits a unique email index to records that have an email and are not soft deleted
+// Partial indexes cannot test for a missing field, but a null match covers it
+var activeEmail = bson.M{"email": bson.M{"$exists": true}, "deleted_at": nil}
+
+// unerased limits a unique index to records that do not reference an erased person, see registerKeyedPersonReferences
+var unerased = bson.M{"erased_at": nil}
+
+// Error codes of an index that exists with the same name or keys but other options
+const (
+	indexOptionsConflict  = 85
+	indexKeySpecsConflict = 86
+)
+
+// registerIndexes declares indexes a repository relies on, they are created by EnsureIndexes at startup
+func registerIndexes(collection string, indexes ...mongo.IndexModel) {
+	indexRegistry[collection] = append(indexRegistry[collection], indexes...)
+}
+
+// EnsureIndexes creates every registered index that does not exist yet
+// Creating an index that already exists with the same options is a no-op in mongodb
+func EnsureIndexes(ctx context.Context) error {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	for collection, indexes := range indexRegistry {
+		coll := client.Database("school").Collection(collection)
+		var names []string
+		for _, index := range indexes {
+			name, err := ensureIndex(ctx, coll, index)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error creating indexes on %s", collection))
+			}
+			names = append(names, name)
+		}
+		log.Printf("Ensured indexes on %s: %v\n", collection, names)
+	}
+	return nil
+}
+
+// ensureIndex creates an index, an index on the same keys that was created with other options is replaced
+func ensureIndex(ctx context.Context, coll *mongo.Collection, index mongo.IndexModel) (string, error) {
+	name, err := coll.Indexes().CreateOne(ctx, index)
+	var commandErr mongo.CommandError
+	if !errors.As(err, &commandErr) || (commandErr.Code != indexOptionsConflict && commandErr.Code != indexKeySpecsConflict) {
+		return name, err
+	}
+
+	keys, err := bson.Marshal(index.Keys)
+	if err != nil {
+		return "", err
+	}
+	specs, err := coll.Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, spec := range specs {
+		if bytes.Equal(spec.KeysDocument, keys) {
+			_, err := coll.Indexes().DropOne(ctx, spec.Name)
+			if err != nil {
+				return "", err
+			}
+			log.Printf("Dropped index %s on %s to recreate it with new options\n", spec.Name, coll.Name())
+		}
+	}
+	return coll.Indexes().CreateOne(ctx, index)
+}
+
+// Matches the field in messages like "E11000 duplicate key error collection: school.execs index: username_1 dup key: { username: "jdoe" }"
+var dupKeyPattern = regexp.MustCompile(`dup key: \{ "?([\w.]+)"?:`)
+
+// duplicateKeyField finds the field that caused a duplicate key error
+// Newer servers report it in keyValue, older ones only mention it in the message
+func duplicateKeyField(raw bson.Raw, message string) string {
+	if keyValue, ok := raw.Lookup("keyValue").DocumentOK(); ok {
+		elements, err := keyValue.Elements()
+		if err == nil && len(elements) > 0 {
+			return elements[0].Key()
+		}
+	}
+
+	match := dupKeyPattern.FindStringSubmatch(message)
+	if len(match) == 2 {
+		return match[1]
+	}
+	return "key"
+}
+
+// asDuplicateKeyError converts a duplicate key error returned by a single document write into a DuplicateKeyError
+// Any other error is returned unchanged
+func asDuplicateKeyError(err error) error {
+	if !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) {
+		return &repositories.DuplicateKeyError{Field: duplicateKeyField(commandErr.Raw, commandErr.Message)}
+	}
+	var writeErr mongo.WriteException
+	if errors.As(err, &writeErr) && len(writeErr.WriteErrors) > 0 {
+		return &repositories.DuplicateKeyError{Field: duplicateKeyField(writeErr.WriteErrors[0].Raw, writeErr.WriteErrors[0].Message)}
+	}
+	return &repositories.DuplicateKeyError{Field: duplicateKeyField(nil, err.Error())}
+}
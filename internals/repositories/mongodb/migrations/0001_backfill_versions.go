@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Records created before versioning was introduced have no version, which makes them skip optimistic concurrency checks
+func init() {
+	register(Migration{
+		Version: 1,
+		Name:    "backfill_versions",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			for _, collection := range []string{"students", "teachers", "execs"} {
+				_, err := db.Collection(collection).UpdateMany(ctx, bson.M{"version": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"version": 1}})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Execs stored password_changed_at and user_created_at as RFC3339 strings, they become proper dates and
+// user_created_at is renamed to created_at
+func init() {
+	register(Migration{
+		Version: 2,
+		Name:    "exec_timestamps_to_dates",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			execs := db.Collection("execs")
+			_, err := execs.UpdateMany(ctx, bson.M{"password_changed_at": bson.M{"$type": "string"}}, mongo.Pipeline{
+				{{Key: "$set", Value: bson.M{"password_changed_at": bson.M{"$dateFromString": bson.M{"dateString": "$password_changed_at", "onError": "$$REMOVE"}}}}},
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = execs.UpdateMany(ctx, bson.M{"user_created_at": bson.M{"$type": "string"}}, mongo.Pipeline{
+				{{Key: "$set", Value: bson.M{"created_at": bson.M{"$dateFromString": bson.M{"dateString": "$user_created_at", "onError": "$$REMOVE"}}}}},
+				{{Key: "$unset", Value: "user_created_at"}},
+			})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			execs := db.Collection("execs")
+			_, err := execs.UpdateMany(ctx, bson.M{"password_changed_at": bson.M{"$type": "date"}}, mongo.Pipeline{
+				{{Key: "$set", Value: bson.M{"password_changed_at": bson.M{"$dateToString": bson.M{"date": "$password_changed_at", "format": "%Y-%m-%dT%H:%M:%SZ"}}}}},
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = execs.UpdateMany(ctx, bson.M{"created_at": bson.M{"$type": "date"}}, mongo.Pipeline{
+				{{Key: "$set", Value: bson.M{"user_created_at": bson.M{"$dateToString": bson.M{"date": "$created_at", "format": "%Y-%m-%dT%H:%M:%SZ"}}}}},
+				{{Key: "$unset", Value: "created_at"}},
+			})
+			return err
+		},
+	})
+}
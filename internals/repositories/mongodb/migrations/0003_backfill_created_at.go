@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Records created before the audit fields were introduced get the creation time embedded in their ObjectID
+// The backfilled values cannot be told apart from real ones afterwards, so there is no way back
+func init() {
+	register(Migration{
+		Version: 3,
+		Name:    "backfill_created_at",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			for _, collection := range []string{"students", "teachers", "execs"} {
+				_, err := db.Collection(collection).UpdateMany(ctx, bson.M{"created_at": bson.M{"$exists": false}}, mongo.Pipeline{
+					{{Key: "$set", Value: bson.M{"created_at": bson.M{"$toDate": "$_id"}, "updated_at": bson.M{"$ifNull": bson.A{"$updated_at", bson.M{"$toDate": "$_id"}}}}}},
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,201 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collections that stored the class as a free-form string
+var classMemberCollections = []string{"students", "teachers"}
+
+// Matches class strings such as "9A", "9 a" or "10-B"
+var classPattern = regexp.MustCompile(`^(\d{1,2})\s*-?\s*([A-Z]*)$`)
+
+// Students and teachers stored their class as a free-form string, which becomes a document of the classes collection
+// referenced by class_id. Strings that only differ in case or spacing end up in the same class, strings that do not
+// read as a grade and section keep the whole string as the section. The classes are placed in the current academic year
+// and the first teacher of a class becomes its homeroom teacher
+func init() {
+	register(Migration{
+		Version: 4,
+		Name:    "classes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			classes := db.Collection("classes")
+			academicYear := CurrentAcademicYear(time.Now())
+
+			// The old strings of every class, keyed by the normalised string
+			spellings := map[string][]string{}
+			for _, collection := range classMemberCollections {
+				values, err := db.Collection(collection).Distinct(ctx, "class", bson.M{"class": bson.M{"$type": "string", "$ne": ""}})
+				if err != nil {
+					return err
+				}
+				for _, value := range values {
+					class := value.(string)
+					key := NormaliseClass(class)
+					if key == "" {
+						continue
+					}
+					spellings[key] = append(spellings[key], class)
+				}
+			}
+
+			for key, classStrings := range spellings {
+				grade, section := ParseClass(key)
+				// Empty fields are left out of the documents, the same way the repositories store them
+				filter := bson.M{"academic_year": academicYear, "grade": grade, "section": section}
+				if grade == 0 {
+					filter["grade"] = bson.M{"$exists": false}
+				}
+				if section == "" {
+					filter["section"] = bson.M{"$exists": false}
+				}
+
+				// Running the migration again after a failure reuses the classes it already created
+				var class struct {
+					Id primitive.ObjectID `bson:"_id"`
+				}
+				err := classes.FindOne(ctx, filter).Decode(&class)
+				if err == mongo.ErrNoDocuments {
+					now := time.Now()
+					document := bson.M{"academic_year": academicYear, "grade": grade, "section": section, "version": 1, "created_at": now, "updated_at": now}
+					if grade == 0 {
+						delete(document, "grade")
+					}
+					if section == "" {
+						delete(document, "section")
+					}
+
+					var teacher struct {
+						Id primitive.ObjectID `bson:"_id"`
+					}
+					err = db.Collection("teachers").FindOne(ctx, bson.M{"class": bson.M{"$in": classStrings}, "deleted_at": bson.M{"$exists": false}}, options.FindOne().SetSort(bson.D{{Key: "_id", Value: 1}})).Decode(&teacher)
+					if err == nil {
+						document["homeroom_teacher_id"] = teacher.Id.Hex()
+					} else if err != mongo.ErrNoDocuments {
+						return err
+					}
+
+					result, err := classes.InsertOne(ctx, document)
+					if err != nil {
+						return err
+					}
+					class.Id = result.InsertedID.(primitive.ObjectID)
+				} else if err != nil {
+					return err
+				}
+
+				for _, collection := range classMemberCollections {
+					_, err := db.Collection(collection).UpdateMany(ctx, bson.M{"class": bson.M{"$in": classStrings}}, bson.M{
+						"$set":   bson.M{"class_id": class.Id.Hex()},
+						"$unset": bson.M{"class": ""},
+					})
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// The index on the old field is replaced by the one on class_id created at startup
+			for _, collection := range classMemberCollections {
+				err := dropIndex(ctx, db.Collection(collection), "class_1")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		// The classes are kept, students and teachers get the name of their class back as a string
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			cursor, err := db.Collection("classes").Find(ctx, bson.M{})
+			if err != nil {
+				return err
+			}
+			defer cursor.Close(ctx)
+
+			for cursor.Next(ctx) {
+				var class struct {
+					Id      primitive.ObjectID `bson:"_id"`
+					Grade   int32              `bson:"grade"`
+					Section string             `bson:"section"`
+				}
+				err := cursor.Decode(&class)
+				if err != nil {
+					return err
+				}
+
+				name := class.Section
+				if class.Grade > 0 {
+					name = fmt.Sprintf("%d%s", class.Grade, class.Section)
+				}
+				for _, collection := range classMemberCollections {
+					_, err := db.Collection(collection).UpdateMany(ctx, bson.M{"class_id": class.Id.Hex()}, bson.M{
+						"$set":   bson.M{"class": name},
+						"$unset": bson.M{"class_id": ""},
+					})
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if err := cursor.Err(); err != nil {
+				return err
+			}
+
+			for _, collection := range classMemberCollections {
+				err := dropIndex(ctx, db.Collection(collection), "class_id_1")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// NormaliseClass turns spellings of the same class such as "9a" and " 9A" into the same string
+// The sqlite backend converts its old class strings with the same rules
+func NormaliseClass(class string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(class), " "))
+}
+
+// ParseClass splits a normalised class string into its grade and section
+// Strings that are not a grade followed by a section are kept whole as the section with grade 0
+func ParseClass(class string) (int32, string) {
+	match := classPattern.FindStringSubmatch(class)
+	if match == nil {
+		return 0, class
+	}
+	grade, _ := strconv.Atoi(match[1])
+	return int32(grade), match[2]
+}
+
+// CurrentAcademicYear names the academic year that is running at the given time, years start in August
+func CurrentAcademicYear(now time.Time) string {
+	start := now.Year()
+	if now.Month() < time.August {
+		start--
+	}
+	return fmt.Sprintf("%d-%d", start, start+1)
+}
+
+// dropIndex drops an index by name, an index that does not exist counts as dropped
+func dropIndex(ctx context.Context, coll *mongo.Collection, name string) error {
+	_, err := coll.Indexes().DropOne(ctx, name)
+	var commandErr mongo.CommandError
+	if errors.As(err, &commandErr) && (commandErr.Code == 27 || commandErr.Code == 26) {
+		// IndexNotFound and NamespaceNotFound
+		return nil
+	}
+	return err
+}
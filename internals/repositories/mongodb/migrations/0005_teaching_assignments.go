@@ -0,0 +1,118 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Teachers referenced a single class by class_id, which becomes a teaching assignment of their subject in that class.
+// The assignment is a homeroom one when the teacher is the homeroom teacher of the class
+func init() {
+	register(Migration{
+		Version: 5,
+		Name:    "teaching_assignments",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			teachers := db.Collection("teachers")
+			assignments := db.Collection("teaching_assignments")
+
+			cursor, err := teachers.Find(ctx, bson.M{"class_id": bson.M{"$type": "string", "$ne": ""}})
+			if err != nil {
+				return err
+			}
+			defer cursor.Close(ctx)
+
+			for cursor.Next(ctx) {
+				var teacher struct {
+					Id      primitive.ObjectID `bson:"_id"`
+					ClassId string             `bson:"class_id"`
+					Subject string             `bson:"subject"`
+				}
+				err := cursor.Decode(&teacher)
+				if err != nil {
+					return err
+				}
+
+				// Running the migration again after a failure does not assign a teacher twice
+				filter := bson.M{"teacher_id": teacher.Id.Hex(), "class_id": teacher.ClassId}
+				count, err := assignments.CountDocuments(ctx, filter)
+				if err != nil {
+					return err
+				}
+				if count == 0 {
+					role := "SUBJECT_TEACHER"
+					classId, err := primitive.ObjectIDFromHex(teacher.ClassId)
+					if err == nil {
+						homeroom, err := db.Collection("classes").CountDocuments(ctx, bson.M{"_id": classId, "homeroom_teacher_id": teacher.Id.Hex()})
+						if err != nil {
+							return err
+						}
+						if homeroom > 0 {
+							role = "HOMEROOM_TEACHER"
+						}
+					}
+
+					now := time.Now()
+					document := bson.M{"teacher_id": teacher.Id.Hex(), "class_id": teacher.ClassId, "role": role, "version": 1, "created_at": now, "updated_at": now}
+					// Empty fields are left out of the documents, the same way the repositories store them
+					if teacher.Subject != "" {
+						document["subject"] = teacher.Subject
+					}
+					_, err = assignments.InsertOne(ctx, document)
+					if err != nil {
+						return err
+					}
+				}
+
+				_, err = teachers.UpdateOne(ctx, bson.M{"_id": teacher.Id}, bson.M{"$unset": bson.M{"class_id": ""}})
+				if err != nil {
+					return err
+				}
+			}
+			if err := cursor.Err(); err != nil {
+				return err
+			}
+
+			return dropIndex(ctx, teachers, "class_id_1")
+		},
+		// The assignments are kept, every teacher gets back the class of their homeroom assignment or else their oldest one
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			findOptions := options.Find().SetSort(bson.D{{Key: "role", Value: 1}, {Key: "_id", Value: 1}})
+			cursor, err := db.Collection("teaching_assignments").Find(ctx, bson.M{"deleted_at": bson.M{"$exists": false}}, findOptions)
+			if err != nil {
+				return err
+			}
+			defer cursor.Close(ctx)
+
+			restored := map[string]bool{}
+			for cursor.Next(ctx) {
+				var assignment struct {
+					TeacherId string `bson:"teacher_id"`
+					ClassId   string `bson:"class_id"`
+				}
+				err := cursor.Decode(&assignment)
+				if err != nil {
+					return err
+				}
+				if restored[assignment.TeacherId] {
+					continue
+				}
+				restored[assignment.TeacherId] = true
+
+				teacherId, err := primitive.ObjectIDFromHex(assignment.TeacherId)
+				if err != nil {
+					continue
+				}
+				_, err = db.Collection("teachers").UpdateOne(ctx, bson.M{"_id": teacherId}, bson.M{"$set": bson.M{"class_id": assignment.ClassId}})
+				if err != nil {
+					return err
+				}
+			}
+			return cursor.Err()
+		},
+	})
+}
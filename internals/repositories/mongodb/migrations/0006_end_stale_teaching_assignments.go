@@ -0,0 +1,91 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Teaching assignments outlived their deleted teachers and were only kept unique by the server, which let concurrent
+// requests assign a teacher to the same class and subject twice. The assignments of deleted teachers and every copy
+// but the oldest are ended, so the unique index on teacher, class and subject can be built.
+// Ended assignments cannot be told apart from ones deleted on purpose afterwards, so there is no way back
+func init() {
+	register(Migration{
+		Version: 6,
+		Name:    "end_stale_teaching_assignments",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			assignments := db.Collection("teaching_assignments")
+			active := bson.M{"deleted_at": bson.M{"$exists": false}}
+			end := func(filter bson.M) error {
+				now := time.Now()
+				_, err := assignments.UpdateMany(ctx, filter, bson.M{
+					"$set": bson.M{"deleted_at": now, "updated_at": now},
+					"$inc": bson.M{"version": 1},
+				})
+				return err
+			}
+
+			deletedTeachers, err := db.Collection("teachers").Distinct(ctx, "_id", bson.M{"deleted_at": bson.M{"$exists": true}})
+			if err != nil {
+				return err
+			}
+			var teacherIds []string
+			for _, id := range deletedTeachers {
+				if objId, ok := id.(primitive.ObjectID); ok {
+					teacherIds = append(teacherIds, objId.Hex())
+				}
+			}
+			if len(teacherIds) > 0 {
+				err = end(bson.M{"deleted_at": bson.M{"$exists": false}, "teacher_id": bson.M{"$in": teacherIds}})
+				if err != nil {
+					return err
+				}
+			}
+
+			// The oldest assignment of every teacher, class and subject comes first and is kept
+			findOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+			cursor, err := assignments.Find(ctx, active, findOptions)
+			if err != nil {
+				return err
+			}
+			defer cursor.Close(ctx)
+
+			seen := map[string]bool{}
+			var copies []primitive.ObjectID
+			for cursor.Next(ctx) {
+				var assignment struct {
+					Id        primitive.ObjectID `bson:"_id"`
+					TeacherId string             `bson:"teacher_id"`
+					ClassId   string             `bson:"class_id"`
+					Subject   string             `bson:"subject"`
+				}
+				err := cursor.Decode(&assignment)
+				if err != nil {
+					return err
+				}
+				// Erased teachers share one ID, their assignments are left to the unique index's erased filter
+				if assignment.TeacherId == "erased" {
+					continue
+				}
+				key := assignment.TeacherId + "/" + assignment.ClassId + "/" + assignment.Subject
+				if seen[key] {
+					copies = append(copies, assignment.Id)
+					continue
+				}
+				seen[key] = true
+			}
+			if err := cursor.Err(); err != nil {
+				return err
+			}
+			if len(copies) == 0 {
+				return nil
+			}
+			return end(bson.M{"_id": bson.M{"$in": copies}})
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Unique indexes on a person's ID leave out the records of erased people, which all share the erased ID and are
+// told apart by erased_at. People erased before that only had their ID replaced, so their records are marked now.
+// The marks cannot be told apart from later erasures, so there is no way back
+func init() {
+	register(Migration{
+		Version: 7,
+		Name:    "mark_erased_references",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			references := []struct{ collection, field string }{
+				{"teaching_assignments", "teacher_id"},
+				{"attendance", "student_id"},
+				{"read_receipts", "reader_id"},
+				{"class_memberships", "student_id"},
+				{"scores", "student_id"},
+				{"submissions", "student_id"},
+			}
+			now := time.Now()
+			for _, reference := range references {
+				_, err := db.Collection(reference.collection).UpdateMany(ctx,
+					bson.M{reference.field: "erased", "erased_at": bson.M{"$exists": false}},
+					bson.M{"$set": bson.M{"erased_at": now}},
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
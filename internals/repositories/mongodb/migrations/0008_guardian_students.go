@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Guardians kept one relationship and emergency priority for all their students next to the list of student IDs.
+// Every student becomes a link of its own in students, carrying the guardian's relationship and emergency priority.
+// Reverting keeps the relationship and emergency priority of the first link, as a guardian only had one of each
+func init() {
+	register(Migration{
+		Version: 8,
+		Name:    "guardian_students",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			guardians := db.Collection("guardians")
+			_, err := guardians.UpdateMany(ctx, bson.M{"student_ids": bson.M{"$exists": true}}, mongo.Pipeline{
+				{{Key: "$set", Value: bson.M{"students": bson.M{"$map": bson.M{
+					"input": "$student_ids",
+					"in": bson.M{
+						"student_id":         "$$this",
+						"relationship":       "$relationship",
+						"emergency_priority": "$emergency_priority",
+					},
+				}}}}},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = guardians.UpdateMany(ctx, bson.M{}, bson.M{"$unset": bson.M{"student_ids": "", "relationship": "", "emergency_priority": ""}})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			guardians := db.Collection("guardians")
+			_, err := guardians.UpdateMany(ctx, bson.M{"students.0": bson.M{"$exists": true}}, mongo.Pipeline{
+				{{Key: "$set", Value: bson.M{
+					"student_ids":        "$students.student_id",
+					"relationship":       bson.M{"$first": "$students.relationship"},
+					"emergency_priority": bson.M{"$first": "$students.emergency_priority"},
+				}}},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = guardians.UpdateMany(ctx, bson.M{}, bson.M{"$unset": bson.M{"students": ""}})
+			return err
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package migrations
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// The fields that named an academic year and the fields that reference it by ID instead
+var academicYearFields = []struct {
+	collection string
+	name       string
+	id         string
+}{
+	{"classes", "academic_year", "academic_year_id"},
+	{"class_memberships", "academic_year", "academic_year_id"},
+	{"promotions", "from_academic_year", "from_academic_year_id"},
+	{"promotions", "to_academic_year", "to_academic_year_id"},
+}
+
+// Matches the names CurrentAcademicYear gives, such as 2025-2026
+var academicYearPattern = regexp.MustCompile(`^(\d{4})-(\d{4})$`)
+
+// Classes, class memberships and promotions named their academic year, they now reference it by ID.
+// Names without an academic year, such as those migration 4 gave, get one. Its dates are derived from names like
+// 2025-2026 with the year starting in August, other names get an academic year without dates to be filled in.
+// Reverting names the academic years again and keeps the academic years that were added
+func init() {
+	register(Migration{
+		Version: 9,
+		Name:    "academic_year_ids",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			years := db.Collection("academic_years")
+			ids := map[string]string{}
+			for _, field := range academicYearFields {
+				names, err := db.Collection(field.collection).Distinct(ctx, field.name, bson.M{field.name: bson.M{"$type": "string"}})
+				if err != nil {
+					return err
+				}
+				for _, value := range names {
+					name := value.(string)
+					if _, seen := ids[name]; seen {
+						continue
+					}
+					id, err := academicYearId(ctx, years, name)
+					if err != nil {
+						return err
+					}
+					ids[name] = id
+				}
+			}
+
+			for _, field := range academicYearFields {
+				coll := db.Collection(field.collection)
+				for name, id := range ids {
+					_, err := coll.UpdateMany(ctx, bson.M{field.name: name}, bson.M{"$set": bson.M{field.id: id}, "$unset": bson.M{field.name: ""}})
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// The indexes on the names are replaced by the ones on the IDs created at startup
+			err := dropIndex(ctx, db.Collection("classes"), "academic_year_1_grade_1_section_1")
+			if err != nil {
+				return err
+			}
+			return dropIndex(ctx, db.Collection("class_memberships"), "student_id_1_academic_year_1")
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			cursor, err := db.Collection("academic_years").Find(ctx, bson.M{})
+			if err != nil {
+				return err
+			}
+			var years []struct {
+				Id   primitive.ObjectID `bson:"_id"`
+				Name string             `bson:"name"`
+			}
+			err = cursor.All(ctx, &years)
+			if err != nil {
+				return err
+			}
+
+			for _, field := range academicYearFields {
+				coll := db.Collection(field.collection)
+				for _, year := range years {
+					_, err := coll.UpdateMany(ctx, bson.M{field.id: year.Id.Hex()}, bson.M{"$set": bson.M{field.name: year.Name}, "$unset": bson.M{field.id: ""}})
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			err = dropIndex(ctx, db.Collection("classes"), "academic_year_id_1_grade_1_section_1")
+			if err != nil {
+				return err
+			}
+			return dropIndex(ctx, db.Collection("class_memberships"), "student_id_1_academic_year_id_1")
+		},
+	})
+}
+
+// academicYearId returns the ID of the academic year with the given name, adding the academic year when there is none
+func academicYearId(ctx context.Context, years *mongo.Collection, name string) (string, error) {
+	var year struct {
+		Id primitive.ObjectID `bson:"_id"`
+	}
+	err := years.FindOne(ctx, bson.M{"name": name}).Decode(&year)
+	if err == nil {
+		return year.Id.Hex(), nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return "", err
+	}
+
+	now := time.Now()
+	document := bson.M{"name": name, "version": 1, "created_at": now, "updated_at": now}
+	match := academicYearPattern.FindStringSubmatch(name)
+	if match != nil {
+		start, _ := strconv.Atoi(match[1])
+		end, _ := strconv.Atoi(match[2])
+		document["start_date"] = time.Date(start, time.August, 1, 0, 0, 0, 0, time.UTC)
+		document["end_date"] = time.Date(end, time.August, 1, 0, 0, 0, 0, time.UTC)
+	}
+	result, err := years.InsertOne(ctx, document)
+	if err != nil {
+		return "", err
+	}
+	return result.InsertedID.(primitive.ObjectID).Hex(), nil
+}
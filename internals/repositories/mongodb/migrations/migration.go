@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Migration is a numbered change to the stored documents
+// Down may be nil for migrations that cannot be reverted
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+	Down    func(ctx context.Context, db *mongo.Database) error
+}
+
+// Every known migration, registered by the numbered migration files of this package
+var registry = map[int]Migration{}
+
+// register adds a migration to the registry, every version can only be used once
+func register(migration Migration) {
+	if _, exists := registry[migration.Version]; exists {
+		panic(fmt.Sprintf("migration %d is registered twice", migration.Version))
+	}
+	registry[migration.Version] = migration
+}
+
+// All returns the registered migrations ordered by version
+func All() []Migration {
+	migrations := make([]Migration, 0, len(registry))
+	for _, migration := range registry {
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations
+}
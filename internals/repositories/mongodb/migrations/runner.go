@@ -0,0 +1,242 @@
+package migrations
+
+import (
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	migrationsCollection = "schema_migrations"
+	locksCollection      = "schema_migrations_lock"
+	lockId               = "migrations"
+
+	// A lock left behind by a crashed replica is taken over once it expires
+	lockTTL = 15 * time.Minute
+	// The lock is renewed this often while migrations run, so a long migration keeps it
+	lockRenewal = lockTTL / 3
+)
+
+// AppliedMigration is the record kept in the schema_migrations collection for every applied migration
+type AppliedMigration struct {
+	Version   int       `bson:"_id"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// Runner applies and reverts migrations against a database
+type Runner struct {
+	db     *mongo.Database
+	owner  string
+	DryRun bool
+}
+
+func NewRunner(db *mongo.Database) *Runner {
+	hostname, _ := os.Hostname()
+	return &Runner{
+		db:    db,
+		owner: fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}
+}
+
+// Applied returns the migrations recorded as applied, ordered by version
+func (r *Runner) Applied(ctx context.Context) ([]AppliedMigration, error) {
+	cursor, err := r.db.Collection(migrationsCollection).Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the applied migrations")
+	}
+	defer cursor.Close(ctx)
+
+	var applied []AppliedMigration
+	err = cursor.All(ctx, &applied)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the applied migrations")
+	}
+	return applied, nil
+}
+
+// CurrentVersion returns the version of the latest applied migration, 0 if none was applied
+func (r *Runner) CurrentVersion(ctx context.Context) (int, error) {
+	applied, err := r.Applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(applied) == 0 {
+		return 0, nil
+	}
+	return applied[len(applied)-1].Version, nil
+}
+
+// Up applies every pending migration up to and including the target version, a target of 0 applies all of them
+func (r *Runner) Up(ctx context.Context, target int) ([]Migration, error) {
+	var ran []Migration
+	err := r.withLock(ctx, func(ctx context.Context) error {
+		applied, err := r.appliedSet(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range All() {
+			if applied[migration.Version] || (target != 0 && migration.Version > target) {
+				continue
+			}
+
+			log.Printf("Applying migration %d %s\n", migration.Version, migration.Name)
+			ran = append(ran, migration)
+			if r.DryRun {
+				continue
+			}
+
+			err := migration.Up(ctx, r.db)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Migration %d %s failed", migration.Version, migration.Name))
+			}
+			_, err = r.db.Collection(migrationsCollection).InsertOne(ctx, AppliedMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			})
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error recording migration %d", migration.Version))
+			}
+		}
+		return nil
+	})
+	return ran, err
+}
+
+// Down reverts the given number of most recently applied migrations
+func (r *Runner) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var ran []Migration
+	err := r.withLock(ctx, func(ctx context.Context) error {
+		applied, err := r.Applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(applied) - 1; i >= 0 && len(ran) < steps; i-- {
+			migration, ok := registry[applied[i].Version]
+			if !ok {
+				return fmt.Errorf("migration %d is applied but unknown to this build", applied[i].Version)
+			}
+			if migration.Down == nil {
+				return fmt.Errorf("migration %d %s cannot be reverted", migration.Version, migration.Name)
+			}
+
+			log.Printf("Reverting migration %d %s\n", migration.Version, migration.Name)
+			ran = append(ran, migration)
+			if r.DryRun {
+				continue
+			}
+
+			err := migration.Down(ctx, r.db)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Reverting migration %d %s failed", migration.Version, migration.Name))
+			}
+			_, err = r.db.Collection(migrationsCollection).DeleteOne(ctx, bson.M{"_id": migration.Version})
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error removing the record of migration %d", migration.Version))
+			}
+		}
+		return nil
+	})
+	return ran, err
+}
+
+func (r *Runner) appliedSet(ctx context.Context) (map[int]bool, error) {
+	applied, err := r.Applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[int]bool, len(applied))
+	for _, migration := range applied {
+		set[migration.Version] = true
+	}
+	return set, nil
+}
+
+// withLock runs fn while holding the migrations lock, so only one replica migrates at a time
+// The lock is renewed while fn runs. Once a renewal finds the lock taken over, fn's context is cancelled and the run fails
+func (r *Runner) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	locks := r.db.Collection(locksCollection)
+	now := time.Now()
+
+	// Either create the lock or take over an expired one, the unique _id makes sure only one replica succeeds
+	_, err := locks.UpdateOne(ctx,
+		bson.M{"_id": lockId, "expires_at": bson.M{"$lt": now}},
+		bson.M{"$set": bson.M{"owner": r.owner, "expires_at": now.Add(lockTTL)}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.New("migrations are already running on another instance")
+	}
+	if err != nil {
+		return utils.ErrorHandler(err, "Error acquiring the migrations lock")
+	}
+
+	defer func() {
+		_, err := locks.DeleteOne(context.Background(), bson.M{"_id": lockId, "owner": r.owner})
+		if err != nil {
+			utils.ErrorHandler(err, "Error releasing the migrations lock")
+		}
+	}()
+
+	lockCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	heartbeat := make(chan error, 1)
+	go func() {
+		heartbeat <- r.renewLock(lockCtx, cancel, now.Add(lockTTL))
+	}()
+
+	err = fn(lockCtx)
+	cancel()
+	lost := <-heartbeat
+	if lost != nil {
+		return lost
+	}
+	return err
+}
+
+// renewLock pushes the expiry of the migrations lock back until ctx is done
+// It cancels the migrations and fails when the lock has another owner, or when it cannot be renewed before it expires
+func (r *Runner) renewLock(ctx context.Context, cancel context.CancelFunc, expires time.Time) error {
+	locks := r.db.Collection(locksCollection)
+	ticker := time.NewTicker(lockRenewal)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next := time.Now().Add(lockTTL)
+		result, err := locks.UpdateOne(ctx, bson.M{"_id": lockId, "owner": r.owner}, bson.M{"$set": bson.M{"expires_at": next}})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			// A failed renewal is retried while the lock still holds
+			if time.Now().Add(lockRenewal).Before(expires) {
+				log.Printf("Error renewing the migrations lock, retrying: %v\n", err)
+				continue
+			}
+			cancel()
+			return utils.ErrorHandler(err, "Error renewing the migrations lock before it expired")
+		}
+		if result.MatchedCount == 0 {
+			cancel()
+			return errors.New("the migrations lock was taken over by another instance, the migrations were stopped")
+		}
+		expires = next
+	}
+}
@@ -0,0 +1,458 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	legalHoldsCollection   = "legal_holds"
+	privacyAuditCollection = "privacy_audit"
+
+	// ErasedValue replaces the ID of an erased person wherever another record references it
+	ErasedValue = "erased"
+)
+
+// Collection holding the own record of every kind of person
+var personCollections = map[pb.PersonType]string{
+	pb.PersonType_STUDENT:  "students",
+	pb.PersonType_TEACHER:  "teachers",
+	pb.PersonType_EXEC:     "execs",
+	pb.PersonType_GUARDIAN: "guardians",
+}
+
+// Fields that are never handed out, not even to the person they belong to
+var credentialFields = []string{"password", "password_reset_token", "password_token_expires"}
+
+// personReference is a field of a collection that holds the ID of a person, or a list of IDs among which the person's is
+type personReference struct {
+	collection string
+	field      string
+	list       bool
+	// keyed fields are part of a unique key, see registerKeyedPersonReferences
+	keyed bool
+}
+
+// Fields referencing each kind of person outside of their own record
+var personReferences = map[pb.PersonType][]personReference{}
+
+// registerPersonReferences declares fields of a collection holding the ID of a kind of person, so privacy requests cover them
+func registerPersonReferences(personType pb.PersonType, collection string, fields ...string) {
+	for _, field := range fields {
+		personReferences[personType] = append(personReferences[personType], personReference{collection: collection, field: field})
+	}
+}
+
+// registerKeyedPersonReferences declares fields holding the ID of a kind of person that are part of a unique key
+// Erasing the person replaces the ID with ErasedValue, under which the records of every erased person would collide,
+// so the records are also marked with erased_at and the unique index leaves them out through the unerased filter
+func registerKeyedPersonReferences(personType pb.PersonType, collection string, fields ...string) {
+	for _, field := range fields {
+		personReferences[personType] = append(personReferences[personType], personReference{collection: collection, field: field, keyed: true})
+	}
+}
+
+// registerPersonListReferences declares fields of a collection holding a list of IDs of a kind of person
+// A field of the documents in a list is named as list.field
+func registerPersonListReferences(personType pb.PersonType, collection string, fields ...string) {
+	for _, field := range fields {
+		personReferences[personType] = append(personReferences[personType], personReference{collection: collection, field: field, list: true})
+	}
+}
+
+func init() {
+	// Every write records the ID of the exec who made it
+	for _, collection := range softDeleteCollections {
+		registerPersonReferences(pb.PersonType_EXEC, collection, "created_by", "updated_by", "deleted_by")
+	}
+
+	registerIndexes(legalHoldsCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "person_type", Value: 1}, {Key: "person_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+	)
+	registerIndexes(privacyAuditCollection,
+		mongo.IndexModel{Keys: bson.D{{Key: "person_type", Value: 1}, {Key: "person_id", Value: 1}}},
+	)
+}
+
+func personTypeName(personType pb.PersonType) string {
+	return strings.ToLower(personType.String())
+}
+
+func GetPersonDataFromDB(ctx context.Context, person *pb.PersonRef, actor string) (*pb.PersonDataBundle, error) {
+	objId, err := primitive.ObjectIDFromHex(person.GetId())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	bundle := &pb.PersonDataBundle{Person: person, GeneratedAt: timestamppb.Now()}
+	byKey := make(map[string]*pb.PersonRecord)
+	collect := func(collection string, filter bson.M, field string) error {
+		cursor, err := db.Collection(collection).Find(ctx, filter)
+		if err != nil {
+			return utils.ErrorHandler(err, fmt.Sprintf("Error reading %s", collection))
+		}
+		defer cursor.Close(ctx)
+
+		for cursor.Next(ctx) {
+			id := documentId(cursor.Current)
+			record, ok := byKey[collection+"/"+id]
+			if !ok {
+				document, err := PersonDocument(cursor.Current)
+				if err != nil {
+					return utils.ErrorHandler(err, "Internal error")
+				}
+				record = &pb.PersonRecord{Collection: collection, Id: id, Document: document}
+				byKey[collection+"/"+id] = record
+				bundle.Records = append(bundle.Records, record)
+			}
+			if field != "" {
+				record.Fields = append(record.Fields, field)
+			}
+		}
+		return cursor.Err()
+	}
+
+	err = collect(personCollections[person.GetType()], bson.M{"_id": objId}, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, reference := range personReferences[person.GetType()] {
+		err := collect(reference.collection, bson.M{reference.field: person.GetId()}, reference.field)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(bundle.Records) == 0 {
+		return nil, &repositories.NotFoundError{Entity: personTypeName(person.GetType()), Id: person.GetId()}
+	}
+
+	_, err = writePrivacyAudit(ctx, db, models.PrivacyAudit{
+		Action:          "export",
+		PersonType:      personTypeName(person.GetType()),
+		PersonId:        person.GetId(),
+		Actor:           actor,
+		RecordsExported: int64(len(bundle.Records)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// ErasePersonInDB anonymizes or deletes the own record of a person and anonymizes every reference to them
+// Everything including the audit record is written in a single transaction, and nothing is written for a person under legal hold
+func ErasePersonInDB(ctx context.Context, req *pb.ErasePersonRequest, actor string) (*pb.ErasePersonResponse, error) {
+	person := req.GetPerson()
+	objId, err := primitive.ObjectIDFromHex(person.GetId())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	typeName := personTypeName(person.GetType())
+	var response *pb.ErasePersonResponse
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		response = &pb.ErasePersonResponse{}
+
+		var hold models.LegalHold
+		err := db.Collection(legalHoldsCollection).FindOne(ctx, bson.M{"person_type": typeName, "person_id": person.GetId()}).Decode(&hold)
+		if err == nil {
+			return &repositories.LegalHoldError{Reason: hold.Reason}
+		}
+		if err != mongo.ErrNoDocuments {
+			return utils.ErrorHandler(err, "Error checking the legal holds")
+		}
+
+		own := db.Collection(personCollections[person.GetType()])
+		if req.GetMode() == pb.ErasureMode_DELETE {
+			result, err := own.DeleteOne(ctx, bson.M{"_id": objId})
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error deleting the %s", typeName))
+			}
+			response.RecordsDeleted = result.DeletedCount
+		} else {
+			result, err := own.UpdateOne(ctx, bson.M{"_id": objId}, AnonymizeUpdate(person, actor))
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error anonymizing the %s", typeName))
+			}
+			response.RecordsAnonymized = result.MatchedCount
+		}
+
+		for _, reference := range personReferences[person.GetType()] {
+			// Only the matching entry of a list is replaced
+			target := reference.field
+			if reference.list {
+				list, field, nested := strings.Cut(reference.field, ".")
+				target = list + ".$"
+				if nested {
+					target += "." + field
+				}
+			}
+			set := bson.M{target: ErasedValue}
+			if reference.keyed {
+				set["erased_at"] = time.Now()
+			}
+			result, err := db.Collection(reference.collection).UpdateMany(ctx,
+				bson.M{reference.field: person.GetId()},
+				bson.M{"$set": set},
+			)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error anonymizing the references in %s", reference.collection))
+			}
+			response.ReferencesAnonymized += result.ModifiedCount
+		}
+
+		if response.RecordsDeleted+response.RecordsAnonymized+response.ReferencesAnonymized == 0 {
+			return &repositories.NotFoundError{Entity: typeName, Id: person.GetId()}
+		}
+
+		response.AuditId, err = writePrivacyAudit(ctx, db, models.PrivacyAudit{
+			Action:               "erase",
+			PersonType:           typeName,
+			PersonId:             person.GetId(),
+			Mode:                 strings.ToLower(req.GetMode().String()),
+			Reason:               req.GetReason(),
+			Actor:                actor,
+			RecordsAnonymized:    response.RecordsAnonymized,
+			RecordsDeleted:       response.RecordsDeleted,
+			ReferencesAnonymized: response.ReferencesAnonymized,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// AnonymizeUpdate replaces the personal fields of a person's own record, the email stays unique per person
+// The sqlite backend applies the same update, so an erased record looks alike in both
+func AnonymizeUpdate(person *pb.PersonRef, actor string) bson.M {
+	now := time.Now()
+	set := bson.M{
+		"first_name": "Erased",
+		"last_name":  "Person",
+		"email":      fmt.Sprintf("erased-%s@erased.invalid", person.GetId()),
+		"updated_at": now,
+		"updated_by": actor,
+	}
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+
+	// Guardians are reached through their contact details, which are all personal
+	if person.GetType() == pb.PersonType_GUARDIAN {
+		update["$unset"] = bson.M{"phone": "", "address": "", "custody_notes": ""}
+	}
+	if person.GetType() == pb.PersonType_EXEC {
+		set["username"] = "erased-" + person.GetId()
+		set["inactive_status"] = true
+		unset := bson.M{"password_changed_at": ""}
+		for _, field := range credentialFields {
+			unset[field] = ""
+		}
+		update["$unset"] = unset
+	}
+	return update
+}
+
+func AddLegalHoldToDb(ctx context.Context, req *pb.LegalHoldRequest, actor string) (*pb.LegalHold, error) {
+	person := req.GetPerson()
+	objId, err := primitive.ObjectIDFromHex(person.GetId())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	typeName := personTypeName(person.GetType())
+	now := time.Now()
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		count, err := db.Collection(personCollections[person.GetType()]).CountDocuments(ctx, bson.M{"_id": objId})
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if count == 0 {
+			return &repositories.NotFoundError{Entity: typeName, Id: person.GetId()}
+		}
+
+		// Placing a hold again replaces its reason
+		_, err = db.Collection(legalHoldsCollection).UpdateOne(ctx,
+			bson.M{"person_type": typeName, "person_id": person.GetId()},
+			bson.M{"$set": bson.M{"reason": req.GetReason(), "placed_by": actor, "placed_at": now}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return utils.ErrorHandler(err, "Error placing the legal hold")
+		}
+
+		_, err = writePrivacyAudit(ctx, db, models.PrivacyAudit{
+			Action:     "legal_hold_placed",
+			PersonType: typeName,
+			PersonId:   person.GetId(),
+			Reason:     req.GetReason(),
+			Actor:      actor,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LegalHold{Person: person, Reason: req.GetReason(), PlacedBy: actor, PlacedAt: timestamppb.New(now)}, nil
+}
+
+func DeleteLegalHoldFromDB(ctx context.Context, person *pb.PersonRef, actor string) error {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	typeName := personTypeName(person.GetType())
+	return runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		result, err := db.Collection(legalHoldsCollection).DeleteOne(ctx, bson.M{"person_type": typeName, "person_id": person.GetId()})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error releasing the legal hold")
+		}
+		if result.DeletedCount == 0 {
+			return &repositories.NotFoundError{Entity: "legal hold", Id: person.GetId()}
+		}
+
+		_, err = writePrivacyAudit(ctx, db, models.PrivacyAudit{
+			Action:     "legal_hold_released",
+			PersonType: typeName,
+			PersonId:   person.GetId(),
+			Actor:      actor,
+		})
+		return err
+	})
+}
+
+func GetLegalHoldsFromDB(ctx context.Context) ([]*pb.LegalHold, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	cursor, err := client.Database("school").Collection(legalHoldsCollection).Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "placed_at", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	var holds []models.LegalHold
+	err = cursor.All(ctx, &holds)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	pbHolds := make([]*pb.LegalHold, len(holds))
+	for i, hold := range holds {
+		pbHolds[i] = &pb.LegalHold{
+			Person:   &pb.PersonRef{Type: pb.PersonType(pb.PersonType_value[strings.ToUpper(hold.PersonType)]), Id: hold.PersonId},
+			Reason:   hold.Reason,
+			PlacedBy: hold.PlacedBy,
+			PlacedAt: timestamppb.New(hold.PlacedAt),
+		}
+	}
+	return pbHolds, nil
+}
+
+// RecordErasedFilesInDB adds the number of submission files an erasure deleted to its audit record
+// The files live outside the database, so they are only deleted once the erasure was committed
+func RecordErasedFilesInDB(ctx context.Context, auditId string, filesDeleted int64) error {
+	objId, err := primitive.ObjectIDFromHex(auditId)
+	if err != nil {
+		return utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	client, err := CreateMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	result, err := client.Database("school").Collection(privacyAuditCollection).UpdateOne(ctx, bson.M{"_id": objId}, bson.M{"$set": bson.M{"files_deleted": filesDeleted}})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error updating the audit record")
+	}
+	if result.MatchedCount == 0 {
+		return &repositories.NotFoundError{Entity: "audit record", Id: auditId}
+	}
+	return nil
+}
+
+func writePrivacyAudit(ctx context.Context, db *mongo.Database, audit models.PrivacyAudit) (string, error) {
+	audit.At = time.Now()
+	result, err := db.Collection(privacyAuditCollection).InsertOne(ctx, audit)
+	if err != nil {
+		return "", utils.ErrorHandler(err, "Error writing the audit record")
+	}
+	if objId, ok := result.InsertedID.(primitive.ObjectID); ok {
+		return objId.Hex(), nil
+	}
+	return fmt.Sprint(result.InsertedID), nil
+}
+
+// documentId returns the _id of a document as a string
+func documentId(document bson.Raw) string {
+	value := document.Lookup("_id")
+	if objId, ok := value.ObjectIDOK(); ok {
+		return objId.Hex()
+	}
+	if id, ok := value.StringValueOK(); ok {
+		return id
+	}
+	return value.String()
+}
+
+// PersonDocument renders a stored document as relaxed extended JSON without its credentials
+func PersonDocument(raw bson.Raw) (string, error) {
+	var document bson.D
+	err := bson.Unmarshal(raw, &document)
+	if err != nil {
+		return "", err
+	}
+	document = slices.DeleteFunc(document, func(element bson.E) bool {
+		return slices.Contains(credentialFields, element.Key)
+	})
+
+	data, err := bson.MarshalExtJSON(document, false, false)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
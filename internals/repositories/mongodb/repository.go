@@ -0,0 +1,425 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository exposes the package level functions of this package as a repositories.Repository
+type Repository struct{}
+
+var _ repositories.ExtendedRepository = Repository{}
+
+func (Repository) AddStudentsToDb(ctx context.Context, students []*pb.Student, ordered, atomic bool, actor string) ([]*pb.Student, []*pb.BulkItemResult, error) {
+	return AddStudentsToDb(ctx, students, ordered, atomic, actor)
+}
+
+func (Repository) GetStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page repositories.Page) ([]*pb.Student, error) {
+	return GetStudentsFromDB(ctx, sortOptions, filters, page)
+}
+
+func (Repository) StreamStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page repositories.Page, fn func(*pb.Student) error) error {
+	return StreamStudentsFromDB(ctx, sortOptions, filters, page, fn)
+}
+
+func (Repository) ModifyStudentsInDB(ctx context.Context, req *pb.UpdateStudentsRequest, actor string) ([]*pb.Student, error) {
+	return ModifyStudentsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteStudentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteStudentsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) RestoreStudentsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return RestoreStudentsInDB(ctx, objIds, actor)
+}
+
+func (Repository) GetStudentsByTeacherIdFromDB(ctx context.Context, teacherId, subject string) ([]*pb.Student, error) {
+	return GetStudentsByTeacherIdFromDB(ctx, teacherId, subject)
+}
+
+func (Repository) GetStudentCountByTeacherIdFromDB(ctx context.Context, teacherId, subject string) (int64, error) {
+	return GetStudentCountByTeacherIdFromDB(ctx, teacherId, subject)
+}
+
+func (Repository) WatchStudentsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Student, string, time.Time) error) error {
+	return WatchStudentsInDB(ctx, filter, resumeToken, send)
+}
+
+func (Repository) AddTeachersToDb(ctx context.Context, teachers []*pb.Teacher, ordered, atomic bool, actor string) ([]*pb.Teacher, []*pb.BulkItemResult, error) {
+	return AddTeachersToDb(ctx, teachers, ordered, atomic, actor)
+}
+
+func (Repository) GetTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Teacher, error) {
+	return GetTeachersFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) StreamTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Teacher) error) error {
+	return StreamTeachersFromDB(ctx, sortOptions, filters, fn)
+}
+
+func (Repository) ModifyTeachersInDB(ctx context.Context, req *pb.UpdateTeachersRequest, actor string) ([]*pb.Teacher, error) {
+	return ModifyTeachersInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteTeachersFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteTeachersFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) RestoreTeachersInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return RestoreTeachersInDB(ctx, objIds, actor)
+}
+
+func (Repository) WatchTeachersInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Teacher, string, time.Time) error) error {
+	return WatchTeachersInDB(ctx, filter, resumeToken, send)
+}
+
+func (Repository) AddExecsToDb(ctx context.Context, execs []*pb.Exec, ordered, atomic bool, actor string) ([]*pb.Exec, []*pb.BulkItemResult, error) {
+	return AddExecsToDb(ctx, execs, ordered, atomic, actor)
+}
+
+func (Repository) GetExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Exec, error) {
+	return GetExecsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) StreamExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Exec) error) error {
+	return StreamExecsFromDB(ctx, sortOptions, filters, fn)
+}
+
+func (Repository) ModifyExecsInDB(ctx context.Context, req *pb.UpdateExecsRequest, actor string) ([]*pb.Exec, error) {
+	return ModifyExecsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteExecsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteExecsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) RestoreExecsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return RestoreExecsInDB(ctx, objIds, actor)
+}
+
+func (Repository) WatchExecsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Exec, string, time.Time) error) error {
+	return WatchExecsInDB(ctx, filter, resumeToken, send)
+}
+
+func (Repository) GetUserByUsername(ctx context.Context, username string) (*models.Exec, error) {
+	return GetUserByUsername(ctx, username)
+}
+
+func (Repository) UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest, actor string) (*models.Exec, error) {
+	return UpdateUserInDB(ctx, req, actor)
+}
+
+func (Repository) DeactivateUserInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) (*pb.Confirmation, error) {
+	return DeactivateUserInDB(ctx, objIds, actor)
+}
+
+func (Repository) AddClassesToDb(ctx context.Context, classes []*pb.Class, ordered, atomic bool, actor string) ([]*pb.Class, []*pb.BulkItemResult, error) {
+	return AddClassesToDb(ctx, classes, ordered, atomic, actor)
+}
+
+func (Repository) GetClassesFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Class, error) {
+	return GetClassesFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyClassesInDB(ctx context.Context, req *pb.UpdateClassesRequest, actor string) ([]*pb.Class, error) {
+	return ModifyClassesInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteClassesFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteClassesFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) RestoreClassesInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return RestoreClassesInDB(ctx, objIds, actor)
+}
+
+func (Repository) AddTeachingAssignmentsToDb(ctx context.Context, assignments []*pb.TeachingAssignment, ordered, atomic bool, actor string) ([]*pb.TeachingAssignment, []*pb.BulkItemResult, error) {
+	return AddTeachingAssignmentsToDb(ctx, assignments, ordered, atomic, actor)
+}
+
+func (Repository) GetTeachingAssignmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.TeachingAssignment, error) {
+	return GetTeachingAssignmentsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) DeleteTeachingAssignmentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteTeachingAssignmentsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) AddCoursesToDb(ctx context.Context, courses []*pb.Course, ordered, atomic bool, actor string) ([]*pb.Course, []*pb.BulkItemResult, error) {
+	return AddCoursesToDb(ctx, courses, ordered, atomic, actor)
+}
+
+func (Repository) GetCoursesFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Course, error) {
+	return GetCoursesFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyCoursesInDB(ctx context.Context, req *pb.UpdateCoursesRequest, actor string) ([]*pb.Course, error) {
+	return ModifyCoursesInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteCoursesFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteCoursesFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) AddCourseSectionsToDb(ctx context.Context, sections []*pb.CourseSection, ordered, atomic bool, actor string) ([]*pb.CourseSection, []*pb.BulkItemResult, error) {
+	return AddCourseSectionsToDb(ctx, sections, ordered, atomic, actor)
+}
+
+func (Repository) GetCourseSectionsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.CourseSection, error) {
+	return GetCourseSectionsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) DeleteCourseSectionsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteCourseSectionsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) EnrollStudentsInDB(ctx context.Context, sectionId string, studentIds []string, actor string) ([]*pb.Enrollment, []*pb.BulkItemResult, error) {
+	return EnrollStudentsInDB(ctx, sectionId, studentIds, actor)
+}
+
+func (Repository) DropStudentsInDB(ctx context.Context, sectionId string, studentIds []string, actor string) ([]*pb.Enrollment, error) {
+	return DropStudentsInDB(ctx, sectionId, studentIds, actor)
+}
+
+func (Repository) GetEnrollmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Enrollment, error) {
+	return GetEnrollmentsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) RecordAttendanceInDB(ctx context.Context, classId string, sessionDate time.Time, session string, marks []*pb.AttendanceMark, actor string) ([]*pb.AttendanceRecord, []*pb.BulkItemResult, error) {
+	return RecordAttendanceInDB(ctx, classId, sessionDate, session, marks, actor)
+}
+
+func (Repository) CorrectAttendanceInDB(ctx context.Context, corrections []*pb.AttendanceRecordCorrection, atomic bool, actor string) ([]*pb.AttendanceRecord, error) {
+	return CorrectAttendanceInDB(ctx, corrections, atomic, actor)
+}
+
+func (Repository) GetAttendanceFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.AttendanceRecord, error) {
+	return GetAttendanceFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) GetAttendanceCountsFromDB(ctx context.Context, filters bson.M) ([]*pb.AttendanceSummary, error) {
+	return GetAttendanceCountsFromDB(ctx, filters)
+}
+
+func (Repository) AddAssessmentsToDb(ctx context.Context, assessments []*pb.Assessment, ordered, atomic bool, actor string) ([]*pb.Assessment, []*pb.BulkItemResult, error) {
+	return AddAssessmentsToDb(ctx, assessments, ordered, atomic, actor)
+}
+
+func (Repository) GetAssessmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Assessment, error) {
+	return GetAssessmentsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyAssessmentsInDB(ctx context.Context, req *pb.UpdateAssessmentsRequest, actor string) ([]*pb.Assessment, error) {
+	return ModifyAssessmentsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteAssessmentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteAssessmentsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) RecordScoresInDB(ctx context.Context, assessmentId string, entries []*pb.ScoreEntry, actor string) ([]*pb.Score, []*pb.BulkItemResult, error) {
+	return RecordScoresInDB(ctx, assessmentId, entries, actor)
+}
+
+func (Repository) GetScoresFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Score, error) {
+	return GetScoresFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) SetCategoryWeightsInDB(ctx context.Context, weights *pb.CategoryWeights, actor string) (*pb.CategoryWeights, error) {
+	return SetCategoryWeightsInDB(ctx, weights, actor)
+}
+
+func (Repository) GetCategoryWeightsFromDB(ctx context.Context, filters bson.M) ([]*pb.CategoryWeights, error) {
+	return GetCategoryWeightsFromDB(ctx, filters)
+}
+
+func (Repository) SetGradingScaleInDB(ctx context.Context, scale *pb.GradingScale, actor string) (*pb.GradingScale, error) {
+	return SetGradingScaleInDB(ctx, scale, actor)
+}
+
+func (Repository) GetGradingScaleFromDB(ctx context.Context) (*pb.GradingScale, error) {
+	return GetGradingScaleFromDB(ctx)
+}
+
+func (Repository) FinalizeTermInDB(ctx context.Context, term string, actor string) (*pb.TermLock, error) {
+	return FinalizeTermInDB(ctx, term, actor)
+}
+
+func (Repository) ReopenTermInDB(ctx context.Context, term string) (*pb.TermLock, error) {
+	return ReopenTermInDB(ctx, term)
+}
+
+func (Repository) GetTermLocksFromDB(ctx context.Context) ([]*pb.TermLock, error) {
+	return GetTermLocksFromDB(ctx)
+}
+
+func (Repository) AddTimetableSlotsToDb(ctx context.Context, slots []*pb.TimetableSlot, atomic bool, actor string) ([]*pb.TimetableSlot, []*pb.BulkItemResult, error) {
+	return AddTimetableSlotsToDb(ctx, slots, atomic, actor)
+}
+
+func (Repository) GetTimetableSlotsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.TimetableSlot, error) {
+	return GetTimetableSlotsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyTimetableSlotsInDB(ctx context.Context, req *pb.UpdateTimetableSlotsRequest, actor string) ([]*pb.TimetableSlot, error) {
+	return ModifyTimetableSlotsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteTimetableSlotsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteTimetableSlotsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) AddAssignmentsToDb(ctx context.Context, assignments []*pb.Assignment, ordered, atomic bool, actor string) ([]*pb.Assignment, []*pb.BulkItemResult, error) {
+	return AddAssignmentsToDb(ctx, assignments, ordered, atomic, actor)
+}
+
+func (Repository) GetAssignmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Assignment, error) {
+	return GetAssignmentsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyAssignmentsInDB(ctx context.Context, req *pb.UpdateAssignmentsRequest, actor string) ([]*pb.Assignment, error) {
+	return ModifyAssignmentsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteAssignmentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteAssignmentsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) SaveSubmissionInDB(ctx context.Context, submission *pb.Submission, actor string) (*pb.Submission, *pb.Submission, error) {
+	return SaveSubmissionInDB(ctx, submission, actor)
+}
+
+func (Repository) GetSubmissionsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Submission, error) {
+	return GetSubmissionsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) RecordErasedFilesInDB(ctx context.Context, auditId string, filesDeleted int64) error {
+	return RecordErasedFilesInDB(ctx, auditId, filesDeleted)
+}
+
+func (Repository) RecordFeedbackInDB(ctx context.Context, feedback *pb.SubmissionFeedback, actor string) (*pb.Submission, error) {
+	return RecordFeedbackInDB(ctx, feedback, actor)
+}
+
+func (Repository) AddAnnouncementsToDb(ctx context.Context, announcements []*pb.Announcement, ordered, atomic bool, actor string) ([]*pb.Announcement, []*pb.BulkItemResult, error) {
+	return AddAnnouncementsToDb(ctx, announcements, ordered, atomic, actor)
+}
+
+func (Repository) GetAnnouncementsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Announcement, error) {
+	return GetAnnouncementsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyAnnouncementsInDB(ctx context.Context, req *pb.UpdateAnnouncementsRequest, actor string) ([]*pb.Announcement, error) {
+	return ModifyAnnouncementsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteAnnouncementsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteAnnouncementsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) WatchAnnouncementsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Announcement, string, time.Time) error) error {
+	return WatchAnnouncementsInDB(ctx, filter, resumeToken, send)
+}
+
+func (Repository) MarkAnnouncementsReadInDB(ctx context.Context, readerType pb.PersonType, readerId string, announcementIds []string) ([]*pb.ReadReceipt, error) {
+	return MarkAnnouncementsReadInDB(ctx, readerType, readerId, announcementIds)
+}
+
+func (Repository) GetReadReceiptsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.ReadReceipt, error) {
+	return GetReadReceiptsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) AddGuardiansToDb(ctx context.Context, guardians []*pb.Guardian, ordered, atomic bool, actor string) ([]*pb.Guardian, []*pb.BulkItemResult, error) {
+	return AddGuardiansToDb(ctx, guardians, ordered, atomic, actor)
+}
+
+func (Repository) GetGuardiansFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Guardian, error) {
+	return GetGuardiansFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyGuardiansInDB(ctx context.Context, req *pb.UpdateGuardiansRequest, actor string) ([]*pb.Guardian, error) {
+	return ModifyGuardiansInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteGuardiansFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteGuardiansFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) ChangeGuardianLinksInDB(ctx context.Context, links []*pb.GuardianLink, link, atomic bool, actor string) ([]*pb.Guardian, error) {
+	return ChangeGuardianLinksInDB(ctx, links, link, atomic, actor)
+}
+
+func (Repository) AddAcademicYearsToDb(ctx context.Context, academicYears []*pb.AcademicYear, ordered, atomic bool, actor string) ([]*pb.AcademicYear, []*pb.BulkItemResult, error) {
+	return AddAcademicYearsToDb(ctx, academicYears, ordered, atomic, actor)
+}
+
+func (Repository) GetAcademicYearsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.AcademicYear, error) {
+	return GetAcademicYearsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyAcademicYearsInDB(ctx context.Context, req *pb.UpdateAcademicYearsRequest, actor string) ([]*pb.AcademicYear, error) {
+	return ModifyAcademicYearsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteAcademicYearsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteAcademicYearsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) AddTermsToDb(ctx context.Context, terms []*pb.Term, ordered, atomic bool, actor string) ([]*pb.Term, []*pb.BulkItemResult, error) {
+	return AddTermsToDb(ctx, terms, ordered, atomic, actor)
+}
+
+func (Repository) GetTermsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Term, error) {
+	return GetTermsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) ModifyTermsInDB(ctx context.Context, req *pb.UpdateTermsRequest, actor string) ([]*pb.Term, error) {
+	return ModifyTermsInDB(ctx, req, actor)
+}
+
+func (Repository) DeleteTermsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return DeleteTermsFromDB(ctx, objIds, expectedVersions, actor, atomic)
+}
+
+func (Repository) GetClassMembershipsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.ClassMembership, error) {
+	return GetClassMembershipsFromDB(ctx, sortOptions, filters)
+}
+
+func (Repository) PromoteStudentsInDB(ctx context.Context, promotion *pb.Promotion, memberships []*pb.ClassMembership, actor string) (*pb.Promotion, []*pb.ClassMembership, error) {
+	return PromoteStudentsInDB(ctx, promotion, memberships, actor)
+}
+
+func (Repository) UndoPromotionInDB(ctx context.Context, objId primitive.ObjectID, actor string) (*pb.Promotion, []*pb.ClassMembership, error) {
+	return UndoPromotionInDB(ctx, objId, actor)
+}
+
+func (Repository) GetPersonDataFromDB(ctx context.Context, person *pb.PersonRef, actor string) (*pb.PersonDataBundle, error) {
+	return GetPersonDataFromDB(ctx, person, actor)
+}
+
+func (Repository) ErasePersonInDB(ctx context.Context, req *pb.ErasePersonRequest, actor string) (*pb.ErasePersonResponse, error) {
+	return ErasePersonInDB(ctx, req, actor)
+}
+
+func (Repository) AddLegalHoldToDb(ctx context.Context, req *pb.LegalHoldRequest, actor string) (*pb.LegalHold, error) {
+	return AddLegalHoldToDb(ctx, req, actor)
+}
+
+func (Repository) DeleteLegalHoldFromDB(ctx context.Context, person *pb.PersonRef, actor string) error {
+	return DeleteLegalHoldFromDB(ctx, person, actor)
+}
+
+func (Repository) GetLegalHoldsFromDB(ctx context.Context) ([]*pb.LegalHold, error) {
+	return GetLegalHoldsFromDB(ctx)
+}
+
+func (Repository) PurgeDeletedRecordsFromDB(ctx context.Context, cutoff time.Time) error {
+	return PurgeDeletedRecordsFromDB(ctx, cutoff)
+}
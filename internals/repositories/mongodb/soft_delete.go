@@ -0,0 +1,244 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+// Collections whose records are soft deleted and purged once the retention period has passed
+var softDeleteCollections = []string{"students", "teachers", "execs", "classes", "teaching_assignments", "courses", "course_sections", "assessments", "timetable_slots", "assignments", "announcements", "guardians", "academic_years", "terms"}
+
+// notDeleted restricts a filter to records that have not been soft deleted
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$exists": false}
+	return filter
+}
+
+// softDeleteEntities marks the records with the given ids as deleted by the actor and returns how many were marked
+// Ids with a non-zero expected version are only deleted if the stored version matches, otherwise a VersionConflictError is returned
+func softDeleteEntities[M any, P proto.Message](ctx context.Context, coll *mongo.Collection, objIds []primitive.ObjectID, expectedVersions []int64, actor string, entity string, toPb func(*M) P) (int64, error) {
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{"deleted_at": now, "deleted_by": actor, "updated_at": now, "updated_by": actor},
+		"$inc": bson.M{"version": 1},
+	}
+
+	var unversioned []primitive.ObjectID
+	var deleted int64
+	for i, objId := range objIds {
+		if i >= len(expectedVersions) || expectedVersions[i] == 0 {
+			unversioned = append(unversioned, objId)
+			continue
+		}
+
+		result, err := coll.UpdateOne(ctx, notDeleted(bson.M{"_id": objId, "version": expectedVersions[i]}), update)
+		if err != nil {
+			return deleted, utils.ErrorHandler(err, "Internal error")
+		}
+		if result.ModifiedCount == 0 {
+			return deleted, missingOrConflict(ctx, coll, objId, entity, toPb)
+		}
+		deleted += result.ModifiedCount
+	}
+
+	if len(unversioned) > 0 {
+		result, err := coll.UpdateMany(ctx, notDeleted(bson.M{"_id": bson.M{"$in": unversioned}}), update)
+		if err != nil {
+			return deleted, utils.ErrorHandler(err, "Internal error")
+		}
+		deleted += result.ModifiedCount
+	}
+	return deleted, nil
+}
+
+// restoreEntities clears the deletion marker of the soft deleted records with the given ids and returns the restored ids
+// A NotFoundError is returned when none of the records is deleted
+// check, when set, runs after the restore in the same transaction and rolls it back when it fails
+func restoreEntities(ctx context.Context, collection, entity string, objIds []primitive.ObjectID, actor string, check func(ctx context.Context, db *mongo.Database, restoredIds []primitive.ObjectID) error) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+	coll := db.Collection(collection)
+	filter := bson.M{"_id": bson.M{"$in": objIds}, "deleted_at": bson.M{"$exists": true}}
+
+	var restoredIds []primitive.ObjectID
+	err = runInTransaction(ctx, client, check != nil, func(ctx context.Context) error {
+		// Look up which of the records are actually deleted, so only those are reported back
+		cursor, err := coll.Find(ctx, filter)
+		if err != nil {
+			return err
+		}
+		var deleted []struct {
+			Id primitive.ObjectID `bson:"_id"`
+		}
+		err = cursor.All(ctx, &deleted)
+		if err != nil {
+			return err
+		}
+		if len(deleted) == 0 {
+			var ids []string
+			for _, objId := range objIds {
+				ids = append(ids, objId.Hex())
+			}
+			return &repositories.NotFoundError{Entity: "deleted " + entity, Id: strings.Join(ids, ", ")}
+		}
+
+		restoredIds = make([]primitive.ObjectID, 0, len(deleted))
+		for _, record := range deleted {
+			restoredIds = append(restoredIds, record.Id)
+		}
+
+		update := bson.M{
+			"$set":   bson.M{"updated_at": time.Now(), "updated_by": actor},
+			"$unset": bson.M{"deleted_at": "", "deleted_by": ""},
+			"$inc":   bson.M{"version": 1},
+		}
+		// The email of a deleted person may have been taken by someone else in the meantime
+		_, err = coll.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": restoredIds}}, update)
+		if mongo.IsDuplicateKeyError(err) {
+			return asDuplicateKeyError(err)
+		}
+		if err != nil {
+			return err
+		}
+		if check != nil {
+			return check(ctx, db, restoredIds)
+		}
+		return nil
+	})
+	var notFound *repositories.NotFoundError
+	var duplicate *repositories.DuplicateKeyError
+	var full *repositories.ClassFullError
+	if errors.As(err, &notFound) || errors.As(err, &duplicate) || errors.As(err, &full) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	var restored []string
+	for _, objId := range restoredIds {
+		restored = append(restored, objId.Hex())
+	}
+	return restored, nil
+}
+
+// claimRestoredSeats takes the seats of restored students back, they may have been given away in the meantime
+func claimRestoredSeats(ctx context.Context, db *mongo.Database, restoredIds []primitive.ObjectID) error {
+	classIds, err := db.Collection("students").Distinct(ctx, "class_id", bson.M{"_id": bson.M{"$in": restoredIds}})
+	if err != nil {
+		return err
+	}
+	for _, classId := range classIds {
+		id, _ := classId.(string)
+		err := claimSeat(ctx, db, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func RestoreStudentsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return restoreEntities(ctx, "students", "student", objIds, actor, claimRestoredSeats)
+}
+
+func RestoreTeachersInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return restoreEntities(ctx, "teachers", "teacher", objIds, actor, nil)
+}
+
+func RestoreExecsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return restoreEntities(ctx, "execs", "exec", objIds, actor, nil)
+}
+
+// PurgeDeletedRecordsFromDB permanently removes the records that were soft deleted before the cutoff
+// Records of people under legal hold and the records referencing them are kept until the hold is released
+func PurgeDeletedRecordsFromDB(ctx context.Context, cutoff time.Time) error {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	held, err := heldPersonIds(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	// A failing collection does not keep the others from being purged
+	var errs []error
+	for _, collection := range softDeleteCollections {
+		result, err := db.Collection(collection).DeleteMany(ctx, purgeFilter(collection, cutoff, held))
+		if err != nil {
+			errs = append(errs, utils.ErrorHandler(err, "Purge failed for "+collection))
+			continue
+		}
+		if result.DeletedCount > 0 {
+			log.Printf("Purged %d deleted records from %s\n", result.DeletedCount, collection)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// heldPersonIds returns the IDs of the people under legal hold by their type
+func heldPersonIds(ctx context.Context, db *mongo.Database) (map[pb.PersonType][]string, error) {
+	cursor, err := db.Collection(legalHoldsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the legal holds")
+	}
+	defer cursor.Close(ctx)
+
+	var holds []models.LegalHold
+	err = cursor.All(ctx, &holds)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the legal holds")
+	}
+
+	held := map[pb.PersonType][]string{}
+	for _, hold := range holds {
+		personType := pb.PersonType(pb.PersonType_value[strings.ToUpper(hold.PersonType)])
+		held[personType] = append(held[personType], hold.PersonId)
+	}
+	return held, nil
+}
+
+// purgeFilter matches the records of a collection that were soft deleted before the cutoff and are not held
+// A record is held when it is the own record of a held person or one of its person references holds their ID
+func purgeFilter(collection string, cutoff time.Time, held map[pb.PersonType][]string) bson.M {
+	conditions := bson.A{bson.M{"deleted_at": bson.M{"$lt": cutoff}}}
+	for personType, ids := range held {
+		if personCollections[personType] == collection {
+			objIds := []primitive.ObjectID{}
+			for _, id := range ids {
+				if objId, err := primitive.ObjectIDFromHex(id); err == nil {
+					objIds = append(objIds, objId)
+				}
+			}
+			conditions = append(conditions, bson.M{"_id": bson.M{"$nin": objIds}})
+		}
+		// $nin also skips lists holding one of the IDs
+		for _, reference := range personReferences[personType] {
+			if reference.collection == collection {
+				conditions = append(conditions, bson.M{reference.field: bson.M{"$nin": ids}})
+			}
+		}
+	}
+	return bson.M{"$and": conditions}
+}
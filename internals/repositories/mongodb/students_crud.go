@@ -2,11 +2,14 @@ package mongodb
 
 import (
 	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
 	"ClassConnectRPC/pkg/utils"
 	pb "ClassConnectRPC/proto/gen"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -14,39 +17,53 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func AddStudentsToDb(ctx context.Context, studentsFromReq []*pb.Student) ([]*pb.Student, error) {
+func init() {
+	registerIndexes("students",
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}}},
+		// Students without an email do not collide with each other, and the email of a soft deleted student can be used again
+		mongo.IndexModel{Keys: bson.D{{Key: "email", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(activeEmail)},
+	)
+}
+
+func AddStudentsToDb(ctx context.Context, studentsFromReq []*pb.Student, ordered, atomic bool, actor string) ([]*pb.Student, []*pb.BulkItemResult, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
 	}
 	defer client.Disconnect(ctx)
 
+	now := time.Now()
 	newStudents := make([]*models.Student, len(studentsFromReq))
 	for i, pbStudent := range studentsFromReq {
 		modelStudent := MapPbStudentToModelStudent(pbStudent)
+		modelStudent.Version = 1
+		modelStudent.CreatedAt = now
+		modelStudent.UpdatedAt = now
+		modelStudent.CreatedBy = actor
+		modelStudent.UpdatedBy = actor
 		newStudents[i] = modelStudent
 	}
 
-	var addedStudents []*pb.Student
-	for _, student := range newStudents {
-		res, err := client.Database("school").Collection("students").InsertOne(ctx, student)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Error inserting data into mongodb")
-		}
+	db := client.Database("school")
+	results, err := addEntities(ctx, client, "students", newStudents, ordered, atomic, func(ctx context.Context, student *models.Student) error {
+		return claimSeat(ctx, db, student.ClassId)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-		objectId, ok := res.InsertedID.(primitive.ObjectID)
-		if ok {
-			student.Id = objectId.Hex()
+	var addedStudents []*pb.Student
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
 		}
-
-		pbStudent := MapModelStudentToPbStudent(student)
-
-		addedStudents = append(addedStudents, pbStudent)
+		newStudents[i].Id = result.Id
+		addedStudents = append(addedStudents, MapModelStudentToPbStudent(newStudents[i]))
 	}
-	return addedStudents, nil
+	return addedStudents, results, nil
 }
 
-func GetStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Student, error) {
+func GetStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page repositories.Page) ([]*pb.Student, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error connecting to the database")
@@ -54,12 +71,14 @@ func GetStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M)
 	defer client.Disconnect(ctx)
 
 	coll := client.Database("school").Collection("students")
-	var cursor *mongo.Cursor
+	findOptions := options.Find()
 	if len(sortOptions) >= 1 {
-		cursor, err = coll.Find(ctx, filters, options.Find().SetSort(sortOptions))
-	} else {
-		cursor, err = coll.Find(ctx, filters)
+		findOptions.SetSort(sortOptions)
 	}
+	if page.Size > 0 {
+		findOptions.SetSkip(page.Skip()).SetLimit(int64(page.Size))
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
@@ -72,66 +91,127 @@ func GetStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M)
 	return students, nil
 }
 
-func ModifyStudentsInDB(ctx context.Context, req *pb.Students) ([]*pb.Student, error) {
+func StreamStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page repositories.Page, fn func(*pb.Student) error) error {
 	client, err := CreateMongoClient()
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+		return utils.ErrorHandler(err, "Error connecting to the database")
 	}
 	defer client.Disconnect(ctx)
 
-	var updatedStudents []*pb.Student
-	for _, student := range req.Students {
-		if student.Id == "" {
-			return nil, utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
-		}
-
-		modelStudent := MapPbStudentToModelStudent(student)
-		objId, err := primitive.ObjectIDFromHex(student.Id)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid ID")
-		}
+	coll := client.Database("school").Collection("students")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	if page.Size > 0 {
+		findOptions.SetSkip(page.Skip()).SetLimit(int64(page.Size))
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
 
-		// Convert modelStudent into a BSON document before updating the database
-		modelDoc, err := bson.Marshal(modelStudent)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
-		}
+	return streamEntities(ctx, cursor, func() *pb.Student { return &pb.Student{} }, func() *models.Student { return &models.Student{} }, fn)
+}
 
-		var updateDoc bson.M
-		err = bson.Unmarshal(modelDoc, &updateDoc)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
-		}
+func ModifyStudentsInDB(ctx context.Context, req *pb.UpdateStudentsRequest, actor string) ([]*pb.Student, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
 
-		// Remove the '_id' field from 'updateDoc' since we are not meant to update the id
-		delete(updateDoc, "_id")
+	// Students moving to another class take a seat in it, outside of an atomic batch every one of them does so in a transaction of its own
+	paths := req.GetUpdateMask().GetPaths()
+	seats := len(paths) == 0 || slices.Contains(paths, "class_id")
 
-		_, err = client.Database("school").Collection("students").UpdateOne(ctx, bson.M{"_id": objId}, bson.M{"$set": updateDoc})
-		if err != nil {
-			return nil, utils.ErrorHandler(err, fmt.Sprintf("Error updating student with ID: %s", student.Id))
+	var updatedStudents []*pb.Student
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedStudents = nil
+		for _, student := range req.Students {
+			if student.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelStudent := MapPbStudentToModelStudent(student)
+			objId, err := primitive.ObjectIDFromHex(student.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			var updatedModel *models.Student
+			err = runInTransaction(ctx, client, seats && !req.GetAtomic(), func(ctx context.Context) error {
+				coll := client.Database("school").Collection("students")
+				var err error
+				updatedModel, err = updateEntity(ctx, coll, objId, modelStudent, paths, student.Version, actor)
+				if err == mongo.ErrNoDocuments {
+					return missingOrConflict(ctx, coll, objId, "student", MapModelStudentToPbStudent)
+				}
+				if mongo.IsDuplicateKeyError(err) {
+					return asDuplicateKeyError(err)
+				}
+				if err != nil {
+					return err
+				}
+				if seats {
+					return claimSeat(ctx, client.Database("school"), updatedModel.ClassId)
+				}
+				return nil
+			})
+			if err != nil {
+				// An atomic batch gets the error as it is, so a transient one lets its transaction be retried
+				var conflict *repositories.VersionConflictError
+				var duplicate *repositories.DuplicateKeyError
+				var full *repositories.ClassFullError
+				var notFound *repositories.NotFoundError
+				if req.GetAtomic() || errors.As(err, &conflict) || errors.As(err, &duplicate) || errors.As(err, &full) || errors.As(err, &notFound) {
+					return err
+				}
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating student with ID: %s", student.Id))
+			}
+
+			// Respond with the stored document rather than an echo of the request
+			updatedStudent := MapModelStudentToPbStudent(updatedModel)
+			updatedStudents = append(updatedStudents, updatedStudent)
 		}
-
-		updatedStudent := MapModelStudentToPbStudent(modelStudent)
-		updatedStudents = append(updatedStudents, updatedStudent)
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no students were updated")
+		}
+		return nil, err
 	}
 	return updatedStudents, nil
 }
 
-func DeleteStudentsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID) ([]string, error) {
+func DeleteStudentsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
 	defer client.Disconnect(ctx)
 
-	filter := bson.M{"_id": bson.M{"$in": objectIdsToDelete}}
-	result, err := client.Database("school").Collection("students").DeleteMany(ctx, filter)
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal error")
-	}
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, client.Database("school").Collection("students"), objectIdsToDelete, expectedVersions, actor, "student", MapModelStudentToPbStudent)
+		if err != nil {
+			return err
+		}
 
-	if result.DeletedCount == 0 {
-		return nil, utils.ErrorHandler(err, "No students were deleted")
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No students were deleted")
+		}
+
+		// Rolling back the transaction leaves every student in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all students were found, no students were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	var deletedIds []string
@@ -140,29 +220,19 @@ func DeleteStudentsFromDB(ctx context.Context, objectIdsToDelete []primitive.Obj
 	}
 	return deletedIds, nil
 }
-
-func GetStudentsByTeacherIdFromDB(ctx context.Context, teacherId string) ([]*pb.Student, error) {
+func GetStudentsByTeacherIdFromDB(ctx context.Context, teacherId, subject string) ([]*pb.Student, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error connecting to the database")
 	}
 	defer client.Disconnect(ctx)
 
-	objId, err := primitive.ObjectIDFromHex(teacherId)
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Invalid ID")
-	}
-
-	var teacher models.Teacher
-	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objId}).Decode(&teacher)
+	classIds, err := teacherClassIds(ctx, client, teacherId, subject)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, utils.ErrorHandler(err, "Teacher with given ID not found")
-		}
-		return nil, utils.ErrorHandler(err, "Internal error")
+		return nil, err
 	}
 
-	cursor, err := client.Database("school").Collection("students").Find(ctx, bson.M{"class": teacher.Class})
+	cursor, err := client.Database("school").Collection("students").Find(ctx, notDeleted(bson.M{"class_id": bson.M{"$in": classIds}}))
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
@@ -176,25 +246,19 @@ func GetStudentsByTeacherIdFromDB(ctx context.Context, teacherId string) ([]*pb.
 	return students, nil
 }
 
-func GetStudentCountByTeacherIdFromDB(ctx context.Context, teacherId string) (int64, error) {
+func GetStudentCountByTeacherIdFromDB(ctx context.Context, teacherId, subject string) (int64, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return 0, utils.ErrorHandler(err, "Error connecting to the database")
 	}
 	defer client.Disconnect(ctx)
 
-	objId, err := primitive.ObjectIDFromHex(teacherId)
+	classIds, err := teacherClassIds(ctx, client, teacherId, subject)
 	if err != nil {
-		return 0, utils.ErrorHandler(err, "Invalid ID")
-	}
-
-	var teacher models.Teacher
-	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objId}).Decode(&teacher)
-	if err != nil {
-		return 0, utils.ErrorHandler(err, "Internal error")
+		return 0, err
 	}
 
-	count, err := client.Database("school").Collection("students").CountDocuments(ctx, bson.M{"class": teacher.Class})
+	count, err := client.Database("school").Collection("students").CountDocuments(ctx, notDeleted(bson.M{"class_id": bson.M{"$in": classIds}}))
 	if err != nil {
 		return 0, utils.ErrorHandler(err, "Internal error")
 	}
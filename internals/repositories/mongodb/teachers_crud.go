@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	pb "ClassConnectRPC/proto/gen"
 
@@ -15,36 +16,39 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func AddTeachersToDb(ctx context.Context, teachersFromReq []*pb.Teacher) ([]*pb.Teacher, error) {
+func AddTeachersToDb(ctx context.Context, teachersFromReq []*pb.Teacher, ordered, atomic bool, actor string) ([]*pb.Teacher, []*pb.BulkItemResult, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
 	}
 	defer client.Disconnect(ctx)
 
+	now := time.Now()
 	newTeachers := make([]*models.Teacher, len(teachersFromReq))
 	for i, pbTeacher := range teachersFromReq {
 		modelTeacher := MapPbTeacherToModelTeacher(pbTeacher)
+		modelTeacher.Version = 1
+		modelTeacher.CreatedAt = now
+		modelTeacher.UpdatedAt = now
+		modelTeacher.CreatedBy = actor
+		modelTeacher.UpdatedBy = actor
 		newTeachers[i] = modelTeacher
 	}
 
-	var addedTeachers []*pb.Teacher
-	for _, teacher := range newTeachers {
-		res, err := client.Database("school").Collection("teachers").InsertOne(ctx, teacher)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Error inserting data into mongodb")
-		}
+	results, err := addEntities(ctx, client, "teachers", newTeachers, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		objectId, ok := res.InsertedID.(primitive.ObjectID)
-		if ok {
-			teacher.Id = objectId.Hex()
+	var addedTeachers []*pb.Teacher
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
 		}
-
-		pbTeacher := MapModelTeacherToPbTeacher(teacher)
-
-		addedTeachers = append(addedTeachers, pbTeacher)
+		newTeachers[i].Id = result.Id
+		addedTeachers = append(addedTeachers, MapModelTeacherToPbTeacher(newTeachers[i]))
 	}
-	return addedTeachers, nil
+	return addedTeachers, results, nil
 }
 
 func GetTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Teacher, error) {
@@ -73,66 +77,106 @@ func GetTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M)
 	return teachers, nil
 }
 
-func ModifyTeachersInDB(ctx context.Context, req *pb.Teachers) ([]*pb.Teacher, error) {
+func StreamTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Teacher) error) error {
 	client, err := CreateMongoClient()
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+		return utils.ErrorHandler(err, "Error connecting to the database")
 	}
 	defer client.Disconnect(ctx)
 
-	var updatedTeachers []*pb.Teacher
-	for _, teacher := range req.Teachers {
-		if teacher.Id == "" {
-			return nil, utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
-		}
+	coll := client.Database("school").Collection("teachers")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
 
-		modelTeacher := MapPbTeacherToModelTeacher(teacher)
-		objId, err := primitive.ObjectIDFromHex(teacher.Id)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid ID")
-		}
+	return streamEntities(ctx, cursor, func() *pb.Teacher { return &pb.Teacher{} }, func() *models.Teacher { return &models.Teacher{} }, fn)
+}
 
-		// Convert modelTeacher into a BSON document before updating the database
-		modelDoc, err := bson.Marshal(modelTeacher)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
-		}
+func ModifyTeachersInDB(ctx context.Context, req *pb.UpdateTeachersRequest, actor string) ([]*pb.Teacher, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
 
-		var updateDoc bson.M
-		err = bson.Unmarshal(modelDoc, &updateDoc)
-		if err != nil {
-			return nil, utils.ErrorHandler(err, "Internal error")
+	var updatedTeachers []*pb.Teacher
+	err = runInTransaction(ctx, client, req.GetAtomic(), func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedTeachers = nil
+		for _, teacher := range req.Teachers {
+			if teacher.Id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+
+			modelTeacher := MapPbTeacherToModelTeacher(teacher)
+			objId, err := primitive.ObjectIDFromHex(teacher.Id)
+			if err != nil {
+				return utils.ErrorHandler(err, "Invalid ID")
+			}
+
+			coll := client.Database("school").Collection("teachers")
+			updatedModel, err := updateEntity(ctx, coll, objId, modelTeacher, req.GetUpdateMask().GetPaths(), teacher.Version, actor)
+			if err == mongo.ErrNoDocuments {
+				return missingOrConflict(ctx, coll, objId, "teacher", MapModelTeacherToPbTeacher)
+			}
+			if mongo.IsDuplicateKeyError(err) {
+				return asDuplicateKeyError(err)
+			}
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating teacher with ID: %s", teacher.Id))
+			}
+
+			// Respond with the stored document rather than an echo of the request
+			updatedTeacher := MapModelTeacherToPbTeacher(updatedModel)
+			updatedTeachers = append(updatedTeachers, updatedTeacher)
 		}
-
-		// Remove the '_id' field from 'updateDoc' since we are not meant to update the id
-		delete(updateDoc, "_id")
-
-		_, err = client.Database("school").Collection("teachers").UpdateOne(ctx, bson.M{"_id": objId}, bson.M{"$set": updateDoc})
-		if err != nil {
-			return nil, utils.ErrorHandler(err, fmt.Sprintf("Error updating teacher with ID: %s", teacher.Id))
+		return nil
+	})
+	if err != nil {
+		if req.GetAtomic() {
+			return nil, rolledBack(err, "no teachers were updated")
 		}
-
-		updatedTeacher := MapModelTeacherToPbTeacher(modelTeacher)
-		updatedTeachers = append(updatedTeachers, updatedTeacher)
+		return nil, err
 	}
 	return updatedTeachers, nil
 }
 
-func DeleteTeachersFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID) ([]string, error) {
+func DeleteTeachersFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
 	client, err := CreateMongoClient()
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal error")
 	}
 	defer client.Disconnect(ctx)
 
-	filter := bson.M{"_id": bson.M{"$in": objectIdsToDelete}}
-	result, err := client.Database("school").Collection("teachers").DeleteMany(ctx, filter)
-	if err != nil {
-		return nil, utils.ErrorHandler(err, "Internal error")
-	}
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, client.Database("school").Collection("teachers"), objectIdsToDelete, expectedVersions, actor, "teacher", MapModelTeacherToPbTeacher)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No teachers were deleted")
+		}
 
-	if result.DeletedCount == 0 {
-		return nil, utils.ErrorHandler(err, "No teachers were deleted")
+		// Rolling back the transaction leaves every teacher in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all teachers were found, no teachers were deleted")
+		}
+
+		err = endTeachingAssignments(ctx, client.Database("school"), objectIdsToDelete, actor)
+		if err != nil {
+			return utils.ErrorHandler(err, "Error ending the teaching assignments of the deleted teachers")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	var deletedIds []string
@@ -0,0 +1,177 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	registerIndexes("teaching_assignments",
+		mongo.IndexModel{Keys: bson.D{{Key: "teacher_id", Value: 1}, {Key: "subject", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}}},
+		// A teacher holds each class and subject only once, ended assignments do not count
+		mongo.IndexModel{Keys: bson.D{{Key: "teacher_id", Value: 1}, {Key: "class_id", Value: 1}, {Key: "subject", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"deleted_at": nil, "erased_at": nil})},
+	)
+	registerKeyedPersonReferences(pb.PersonType_TEACHER, "teaching_assignments", "teacher_id")
+}
+
+func MapModelTeachingAssignmentToPbTeachingAssignment(assignmentModel *models.TeachingAssignment) *pb.TeachingAssignment {
+	return MapModelToPb(assignmentModel, func() *pb.TeachingAssignment { return &pb.TeachingAssignment{} })
+}
+
+func MapPbTeachingAssignmentToModelTeachingAssignment(pbAssignment *pb.TeachingAssignment) *models.TeachingAssignment {
+	return MapPbToModel(pbAssignment, func() *models.TeachingAssignment { return &models.TeachingAssignment{} })
+}
+
+func AddTeachingAssignmentsToDb(ctx context.Context, assignmentsFromReq []*pb.TeachingAssignment, ordered, atomic bool, actor string) ([]*pb.TeachingAssignment, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+
+	now := time.Now()
+	newAssignments := make([]*models.TeachingAssignment, len(assignmentsFromReq))
+	for i, pbAssignment := range assignmentsFromReq {
+		modelAssignment := MapPbTeachingAssignmentToModelTeachingAssignment(pbAssignment)
+		modelAssignment.Version = 1
+		modelAssignment.CreatedAt = now
+		modelAssignment.UpdatedAt = now
+		modelAssignment.CreatedBy = actor
+		modelAssignment.UpdatedBy = actor
+		newAssignments[i] = modelAssignment
+	}
+
+	results, err := addEntities(ctx, client, "teaching_assignments", newAssignments, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedAssignments []*pb.TeachingAssignment
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		newAssignments[i].Id = result.Id
+		addedAssignments = append(addedAssignments, MapModelTeachingAssignmentToPbTeachingAssignment(newAssignments[i]))
+	}
+	return addedAssignments, results, nil
+}
+
+func GetTeachingAssignmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.TeachingAssignment, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("teaching_assignments")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	assignments, err := decodeEntities(ctx, cursor, func() *pb.TeachingAssignment { return &pb.TeachingAssignment{} }, func() *models.TeachingAssignment { return &models.TeachingAssignment{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return assignments, nil
+}
+
+func DeleteTeachingAssignmentsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, client.Database("school").Collection("teaching_assignments"), objectIdsToDelete, expectedVersions, actor, "teaching assignment", MapModelTeachingAssignmentToPbTeachingAssignment)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No teaching assignments were deleted")
+		}
+
+		// Rolling back the transaction leaves every assignment in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all teaching assignments were found, no teaching assignments were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// teacherClassIds returns the IDs of the classes a teacher that is not deleted is assigned to, limited to the subject when it is not empty
+func teacherClassIds(ctx context.Context, client *mongo.Client, teacherId, subject string) ([]string, error) {
+	objId, err := primitive.ObjectIDFromHex(teacherId)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	var teacher models.Teacher
+	err = client.Database("school").Collection("teachers").FindOne(ctx, notDeleted(bson.M{"_id": objId})).Decode(&teacher)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, utils.ErrorHandler(err, "Teacher with given ID not found")
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	filter := bson.M{"teacher_id": teacherId}
+	if subject != "" {
+		filter["subject"] = subject
+	}
+	values, err := client.Database("school").Collection("teaching_assignments").Distinct(ctx, "class_id", notDeleted(filter))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	classIds := make([]string, 0, len(values))
+	for _, value := range values {
+		if classId, ok := value.(string); ok {
+			classIds = append(classIds, classId)
+		}
+	}
+	return classIds, nil
+}
+
+// endTeachingAssignments soft deletes the assignments of deleted teachers, so they no longer hold on to their classes
+// Restoring a teacher does not bring them back, the teacher has to be assigned again
+func endTeachingAssignments(ctx context.Context, db *mongo.Database, teacherIds []primitive.ObjectID, actor string) error {
+	ids := make([]string, len(teacherIds))
+	for i, objId := range teacherIds {
+		ids[i] = objId.Hex()
+	}
+
+	now := time.Now()
+	_, err := db.Collection("teaching_assignments").UpdateMany(ctx, notDeleted(bson.M{"teacher_id": bson.M{"$in": ids}}), bson.M{
+		"$set": bson.M{"deleted_at": now, "deleted_by": actor, "updated_at": now, "updated_by": actor},
+		"$inc": bson.M{"version": 1},
+	})
+	return err
+}
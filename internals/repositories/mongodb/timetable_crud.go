@@ -0,0 +1,285 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "ClassConnectRPC/proto/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+)
+
+// timetablePeriodsCollection holds a document for every day and period booked, written by every booking of it
+const timetablePeriodsCollection = "timetable_periods"
+
+func init() {
+	// A teacher, class or room is booked once per period, which no index can enforce: the room is optional, erasing teachers
+	// replaces their ID and an update moves a slot before it can be checked. Every booking writes the document of its period
+	// instead, see bookPeriod, so concurrent bookings of a period conflict and the retried one sees the other's slot
+	registerIndexes("timetable_slots",
+		mongo.IndexModel{Keys: bson.D{{Key: "day", Value: 1}, {Key: "period", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "teacher_id", Value: 1}}},
+		mongo.IndexModel{Keys: bson.D{{Key: "class_id", Value: 1}}},
+	)
+	registerPersonReferences(pb.PersonType_TEACHER, "timetable_slots", "teacher_id")
+}
+
+func MapModelTimetableSlotToPbTimetableSlot(slotModel *models.TimetableSlot) *pb.TimetableSlot {
+	return MapModelToPb(slotModel, func() *pb.TimetableSlot { return &pb.TimetableSlot{} })
+}
+
+func MapPbTimetableSlotToModelTimetableSlot(pbSlot *pb.TimetableSlot) *models.TimetableSlot {
+	return MapPbToModel(pbSlot, func() *models.TimetableSlot { return &models.TimetableSlot{} })
+}
+
+// bookPeriod fails with a DoubleBookingError when another slot books the teacher, class or room of the slot in its period
+// Every booking writes the document of the period, so concurrent transactions booking it conflict and are retried one after the other
+func bookPeriod(ctx context.Context, db *mongo.Database, slot *models.TimetableSlot) error {
+	_, err := db.Collection(timetablePeriodsCollection).UpdateOne(ctx,
+		bson.M{"_id": fmt.Sprintf("%s/%d", slot.Day, slot.Period)},
+		bson.M{"$inc": bson.M{"bookings": 1}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	booked := bson.A{bson.M{"teacher_id": slot.TeacherId}, bson.M{"class_id": slot.ClassId}}
+	if slot.Room != "" {
+		booked = append(booked, bson.M{"room": slot.Room})
+	}
+	filter := notDeleted(bson.M{"day": slot.Day, "period": slot.Period, "$or": booked})
+	if slot.Id != "" {
+		objId, err := primitive.ObjectIDFromHex(slot.Id)
+		if err != nil {
+			return utils.ErrorHandler(err, "Invalid ID")
+		}
+		filter["_id"] = bson.M{"$ne": objId}
+	}
+
+	var other models.TimetableSlot
+	err = db.Collection("timetable_slots").FindOne(ctx, filter).Decode(&other)
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+
+	resource := fmt.Sprintf("room %s", slot.Room)
+	if other.TeacherId == slot.TeacherId {
+		resource = fmt.Sprintf("teacher %s", slot.TeacherId)
+	} else if other.ClassId == slot.ClassId {
+		resource = fmt.Sprintf("class %s", slot.ClassId)
+	}
+	return &repositories.DoubleBookingError{Day: slot.Day, Period: slot.Period, Resource: resource, BookedBy: other.Id}
+}
+
+// AddTimetableSlotsToDb adds the slots in a single transaction, reporting the outcome for every slot by its index
+// Slots double-booking a teacher, class or room are rejected, in atomic mode a rejected slot rolls back the others
+func AddTimetableSlotsToDb(ctx context.Context, slotsFromReq []*pb.TimetableSlot, atomic bool, actor string) ([]*pb.TimetableSlot, []*pb.BulkItemResult, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, nil, utils.ErrorHandler(err, "Error connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+
+	var addedSlots []*pb.TimetableSlot
+	var results []*pb.BulkItemResult
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		addedSlots = nil
+		results = make([]*pb.BulkItemResult, len(slotsFromReq))
+
+		now := time.Now()
+		rejected := false
+		for i, pbSlot := range slotsFromReq {
+			modelSlot := MapPbTimetableSlotToModelTimetableSlot(pbSlot)
+			modelSlot.Version = 1
+			modelSlot.CreatedAt = now
+			modelSlot.UpdatedAt = now
+			modelSlot.CreatedBy = actor
+			modelSlot.UpdatedBy = actor
+
+			// Slots are booked one after the other, so a slot double-booking an earlier one of the request is rejected too
+			err := bookPeriod(ctx, db, modelSlot)
+			var doubleBooked *repositories.DoubleBookingError
+			if errors.As(err, &doubleBooked) {
+				results[i] = &pb.BulkItemResult{Index: int32(i), ErrorCode: int32(codes.FailedPrecondition), ErrorMessage: doubleBooked.Error()}
+				rejected = true
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			inserted, err := db.Collection("timetable_slots").InsertOne(ctx, modelSlot)
+			if err != nil {
+				return utils.ErrorHandler(err, "Error inserting data into mongodb")
+			}
+			modelSlot.Id = inserted.InsertedID.(primitive.ObjectID).Hex()
+			results[i] = &pb.BulkItemResult{Index: int32(i), Id: modelSlot.Id}
+			addedSlots = append(addedSlots, MapModelTimetableSlotToPbTimetableSlot(modelSlot))
+		}
+		if atomic && rejected {
+			return errBatchRolledBack
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRolledBack) {
+		for _, result := range results {
+			if result.ErrorCode == 0 {
+				result.Id = ""
+				result.ErrorCode = int32(codes.Aborted)
+				result.ErrorMessage = "Rolled back: another item of the atomic insert failed"
+			}
+		}
+		return nil, results, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return addedSlots, results, nil
+}
+
+func GetTimetableSlotsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.TimetableSlot, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("timetable_slots")
+	findOptions := options.Find()
+	if len(sortOptions) >= 1 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := coll.Find(ctx, filters, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer cursor.Close(ctx)
+
+	slots, err := decodeEntities(ctx, cursor, func() *pb.TimetableSlot { return &pb.TimetableSlot{} }, func() *models.TimetableSlot { return &models.TimetableSlot{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return slots, nil
+}
+
+// ModifyTimetableSlotsInDB updates the slots and books their period again, an update double-booking a teacher, class or room fails
+// Without atomic every slot is updated in a transaction of its own, stopping at the first slot that fails
+func ModifyTimetableSlotsInDB(ctx context.Context, req *pb.UpdateTimetableSlotsRequest, actor string) ([]*pb.TimetableSlot, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+	db := client.Database("school")
+	coll := db.Collection("timetable_slots")
+
+	updateSlot := func(ctx context.Context, slot *pb.TimetableSlot) (*pb.TimetableSlot, error) {
+		if slot.Id == "" {
+			return nil, utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+		}
+		objId, err := primitive.ObjectIDFromHex(slot.Id)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Invalid ID")
+		}
+
+		modelSlot := MapPbTimetableSlotToModelTimetableSlot(slot)
+		updatedModel, err := updateEntity(ctx, coll, objId, modelSlot, updatePaths(slot, req.GetUpdateMask().GetPaths()), slot.Version, actor)
+		if err == mongo.ErrNoDocuments {
+			return nil, missingOrConflict(ctx, coll, objId, "timetable slot", MapModelTimetableSlotToPbTimetableSlot)
+		}
+		if err != nil {
+			return nil, utils.ErrorHandler(err, fmt.Sprintf("Error updating timetable slot with ID: %s", slot.Id))
+		}
+
+		// The slot as stored after the update is checked, so fields left out of the update are taken into account
+		err = bookPeriod(ctx, db, updatedModel)
+		if err != nil {
+			return nil, err
+		}
+		return MapModelTimetableSlotToPbTimetableSlot(updatedModel), nil
+	}
+
+	var updatedSlots []*pb.TimetableSlot
+	if !req.GetAtomic() {
+		for _, slot := range req.Slots {
+			var updated *pb.TimetableSlot
+			err := runInTransaction(ctx, client, true, func(ctx context.Context) error {
+				var err error
+				updated, err = updateSlot(ctx, slot)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			updatedSlots = append(updatedSlots, updated)
+		}
+		return updatedSlots, nil
+	}
+
+	err = runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		// Start from scratch since a transaction may be retried
+		updatedSlots = nil
+		for _, slot := range req.Slots {
+			updated, err := updateSlot(ctx, slot)
+			if err != nil {
+				return err
+			}
+			updatedSlots = append(updatedSlots, updated)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, rolledBack(err, "no timetable slots were updated")
+	}
+	return updatedSlots, nil
+}
+
+func DeleteTimetableSlotsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer client.Disconnect(ctx)
+	coll := client.Database("school").Collection("timetable_slots")
+
+	err = runInTransaction(ctx, client, atomic, func(ctx context.Context) error {
+		deletedCount, err := softDeleteEntities(ctx, coll, objectIdsToDelete, expectedVersions, actor, "timetable slot", MapModelTimetableSlotToPbTimetableSlot)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, "No timetable slots were deleted")
+		}
+
+		// Rolling back the transaction leaves every slot in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objectIdsToDelete)) {
+			return utils.ErrorHandler(err, "Not all timetable slots were found, no timetable slots were deleted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objectIdsToDelete {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
@@ -0,0 +1,170 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+)
+
+// Returned from inside a transaction to roll back a batch in which at least one item failed
+var errBatchRolledBack = errors.New("batch rolled back")
+
+// runInTransaction runs fn inside a multi-document transaction when atomic is set, otherwise fn is run directly
+// Transactions need mongodb to run as a replica set and fn may be retried on transient errors, so it must not keep state between runs
+func runInTransaction(ctx context.Context, client *mongo.Client, atomic bool, fn func(ctx context.Context) error) error {
+	if !atomic {
+		return fn(ctx)
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return utils.ErrorHandler(err, "Unable to start a database session")
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
+
+// rolledBack annotates the error that aborted an atomic batch with what was rolled back
+// Typed errors are returned unchanged so the handlers can still map them to their status codes
+func rolledBack(err error, message string) error {
+	var conflict *repositories.VersionConflictError
+	var duplicate *repositories.DuplicateKeyError
+	var finalized *repositories.TermFinalizedError
+	var doubleBooked *repositories.DoubleBookingError
+	var full *repositories.ClassFullError
+	var notFound *repositories.NotFoundError
+	var belowScores *repositories.MaxScoreBelowScoresError
+	var graded *repositories.SubmissionGradedError
+	if errors.As(err, &conflict) || errors.As(err, &duplicate) || errors.As(err, &finalized) || errors.As(err, &doubleBooked) || errors.As(err, &full) || errors.As(err, &notFound) || errors.As(err, &belowScores) || errors.As(err, &graded) {
+		return err
+	}
+	return utils.ErrorHandler(err, fmt.Sprintf("%s: %s", err.Error(), message))
+}
+
+// addEntities inserts the models into the given collection and reports the outcome of every item
+// In atomic mode the items are inserted in a single transaction, so either all of them are added or none
+// check, when set, runs after an item was inserted in the same transaction and rolls the item back when it fails,
+// without a batch transaction every item is then inserted in a transaction of its own
+func addEntities[M any](ctx context.Context, client *mongo.Client, collection string, entities []*M, ordered, atomic bool, check func(ctx context.Context, entity *M) error) ([]*pb.BulkItemResult, error) {
+	coll := client.Database("school").Collection(collection)
+	if !atomic && check == nil {
+		return insertEntities(ctx, coll, entities, ordered)
+	}
+	if !atomic {
+		return addCheckedEntities(ctx, client, coll, entities, ordered, check)
+	}
+
+	var results []*pb.BulkItemResult
+	err := runInTransaction(ctx, client, true, func(ctx context.Context) error {
+		var err error
+		// A transaction is aborted by the first write error anyway, so atomic inserts are always ordered
+		results, err = insertEntities(ctx, coll, entities, true)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if result.ErrorCode != 0 {
+				return errBatchRolledBack
+			}
+		}
+		if check == nil {
+			return nil
+		}
+		for i, entity := range entities {
+			err := check(ctx, entity)
+			if err != nil {
+				// Other errors are returned as they are, so transient ones let the transaction be retried
+				result, ok := checkResult(i, err)
+				if !ok {
+					return err
+				}
+				results[i] = result
+				return errBatchRolledBack
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRolledBack) {
+		for _, result := range results {
+			if result.ErrorCode == 0 {
+				result.Id = ""
+				result.ErrorCode = int32(codes.Aborted)
+				result.ErrorMessage = "Rolled back: another item of the atomic insert failed"
+			}
+		}
+		return results, nil
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error inserting data into mongodb")
+	}
+	return results, nil
+}
+
+// addCheckedEntities inserts and checks every item in a transaction of its own
+func addCheckedEntities[M any](ctx context.Context, client *mongo.Client, coll *mongo.Collection, entities []*M, ordered bool, check func(ctx context.Context, entity *M) error) ([]*pb.BulkItemResult, error) {
+	results := make([]*pb.BulkItemResult, len(entities))
+	failed := false
+	for i, entity := range entities {
+		if failed && ordered {
+			results[i] = notAttemptedResult(i)
+			continue
+		}
+
+		var result *pb.BulkItemResult
+		err := runInTransaction(ctx, client, true, func(ctx context.Context) error {
+			inserted, err := insertEntities(ctx, coll, []*M{entity}, true)
+			if err != nil {
+				return err
+			}
+			result = inserted[0]
+			if result.ErrorCode != 0 {
+				return errBatchRolledBack
+			}
+			err = check(ctx, entity)
+			if err != nil {
+				failure, ok := checkResult(i, err)
+				if !ok {
+					return err
+				}
+				result = failure
+				return errBatchRolledBack
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errBatchRolledBack) {
+			if i == 0 {
+				return nil, utils.ErrorHandler(err, "Error inserting data into mongodb")
+			}
+			utils.ErrorHandler(err, "Error inserting data into mongodb")
+			result = &pb.BulkItemResult{ErrorCode: int32(codes.Internal), ErrorMessage: "Error inserting data into mongodb"}
+		}
+		result.Index = int32(i)
+		results[i] = result
+		failed = failed || result.ErrorCode != 0
+	}
+	return results, nil
+}
+
+// checkResult reports an item whose insert check failed with one of the typed errors a check returns
+func checkResult(index int, err error) (*pb.BulkItemResult, bool) {
+	var full *repositories.ClassFullError
+	var notFound *repositories.NotFoundError
+	var finalized *repositories.TermFinalizedError
+	switch {
+	case errors.As(err, &full), errors.As(err, &finalized):
+		return &pb.BulkItemResult{Index: int32(index), ErrorCode: int32(codes.FailedPrecondition), ErrorMessage: err.Error()}, true
+	case errors.As(err, &notFound):
+		return &pb.BulkItemResult{Index: int32(index), ErrorCode: int32(codes.NotFound), ErrorMessage: err.Error()}, true
+	}
+	return nil, false
+}
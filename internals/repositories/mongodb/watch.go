@@ -0,0 +1,194 @@
+package mongodb
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Error code of a command on a collection that does not exist
+const namespaceNotFound = 26
+
+// Collections that are watched, they keep the documents before a change so that watchers learn when a document leaves their filter
+var watchedCollections = []string{"students", "teachers", "execs", "announcements"}
+
+// changeStreamEvent holds the parts of a change stream event we care about
+type changeStreamEvent struct {
+	OperationType            string              `bson:"operationType"`
+	FullDocument             bson.Raw            `bson:"fullDocument"`
+	FullDocumentBeforeChange bson.Raw            `bson:"fullDocumentBeforeChange"`
+	DocumentKey              bson.Raw            `bson:"documentKey"`
+	ClusterTime              primitive.Timestamp `bson:"clusterTime"`
+	UpdateDescription        struct {
+		UpdatedFields bson.M `bson:"updatedFields"`
+	} `bson:"updateDescription"`
+}
+
+// EnablePreImages makes the watched collections keep the document before every change, which needs mongodb 6.0
+// Without it watchers are not told when an update makes a document leave their filter
+func EnablePreImages(ctx context.Context) error {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+	for _, collection := range watchedCollections {
+		command := bson.D{{Key: "collMod", Value: collection}, {Key: "changeStreamPreAndPostImages", Value: bson.M{"enabled": true}}}
+		err := db.RunCommand(ctx, command).Err()
+		var commandErr mongo.CommandError
+		if errors.As(err, &commandErr) && commandErr.Code == namespaceNotFound {
+			err = db.CreateCollection(ctx, collection, options.CreateCollection().SetChangeStreamPreAndPostImages(bson.M{"enabled": true}))
+		}
+		if err != nil {
+			return utils.ErrorHandler(err, fmt.Sprintf("Error keeping the documents before changes on %s", collection))
+		}
+	}
+	return nil
+}
+
+// watchEntities streams the changes of a collection to send until the context is cancelled or send fails
+// Only documents matching the filter are reported, and a non-empty resume token continues right after the event it belongs to
+// A permanently removed document can no longer be matched, so its removal reaches every watcher with only the ID set
+// An update after which a document that matched the filter no longer does is sent as LEFT with only the ID set
+// Change streams need mongodb to run as a replica set
+func watchEntities[M any, P any](ctx context.Context, collection string, filter bson.M, resumeToken string, toPb func(*M) P, send func(pb.ChangeType, P, string, time.Time) error) error {
+	client, err := CreateMongoClient()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error connecting to the database")
+	}
+	defer client.Disconnect(context.Background())
+	coll := client.Database("school").Collection(collection)
+
+	// Soft deletes and restores are updates, so the full document is looked up for those as well
+	match := fullDocumentFilter(filter, "fullDocument.")
+	match["operationType"] = bson.M{"$in": bson.A{"insert", "update", "replace"}}
+	branches := bson.A{match, bson.M{"operationType": "delete"}}
+	if len(filter) > 0 {
+		left := fullDocumentFilter(filter, "fullDocumentBeforeChange.")
+		left["operationType"] = bson.M{"$in": bson.A{"update", "replace"}}
+		branches = append(branches, left)
+	}
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{"$or": branches}}}}
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup).SetFullDocumentBeforeChange(options.WhenAvailable)
+	if resumeToken != "" {
+		opts.SetResumeAfter(bson.M{"_data": resumeToken})
+	}
+
+	stream, err := coll.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error opening the change stream")
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var event changeStreamEvent
+		err := stream.Decode(&event)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		document := event.FullDocument
+		if event.OperationType == "delete" {
+			document = event.DocumentKey
+		}
+		// The document may already be gone by the time an update is looked up
+		if len(document) == 0 {
+			continue
+		}
+
+		changeType := pb.ChangeType_UPDATED
+		if event.OperationType == "insert" {
+			changeType = pb.ChangeType_CREATED
+		} else if event.OperationType == "delete" {
+			changeType = pb.ChangeType_DELETED
+		} else if _, deleted := event.UpdateDescription.UpdatedFields["deleted_at"]; deleted {
+			changeType = pb.ChangeType_DELETED
+		}
+
+		// An update may only have matched through the document before it, the looked up document then decides
+		if len(event.FullDocumentBeforeChange) > 0 && len(filter) > 0 {
+			matches, err := coll.CountDocuments(ctx, bson.M{"$and": bson.A{filter, bson.M{"_id": event.DocumentKey.Lookup("_id")}}})
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			if matches == 0 {
+				changeType = pb.ChangeType_LEFT
+				document = event.DocumentKey
+			}
+		}
+
+		model := new(M)
+		err = bson.Unmarshal(document, model)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+
+		token, _ := stream.ResumeToken().Lookup("_data").StringValueOK()
+		err = send(changeType, toPb(model), token, time.Unix(int64(event.ClusterTime.T), 0))
+		if err != nil {
+			return err
+		}
+	}
+
+	// A cancelled context means the client went away, which is how a watch normally ends
+	if ctx.Err() != nil {
+		return nil
+	}
+	if stream.Err() != nil {
+		return utils.ErrorHandler(stream.Err(), "Change stream failed")
+	}
+	return nil
+}
+
+// fullDocumentFilter points the fields of a filter at a document of change stream events, also inside $or and $and
+func fullDocumentFilter(filter bson.M, prefix string) bson.M {
+	match := bson.M{}
+	for field, value := range filter {
+		conditions, ok := value.(bson.A)
+		if !ok || (field != "$or" && field != "$and") {
+			match[prefix+field] = value
+			continue
+		}
+		mapped := bson.A{}
+		for _, condition := range conditions {
+			if nested, ok := condition.(bson.M); ok {
+				condition = fullDocumentFilter(nested, prefix)
+			}
+			mapped = append(mapped, condition)
+		}
+		match[field] = mapped
+	}
+	return match
+}
+
+func WatchStudentsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Student, string, time.Time) error) error {
+	return watchEntities(ctx, "students", filter, resumeToken, MapModelStudentToPbStudent, send)
+}
+
+func WatchTeachersInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Teacher, string, time.Time) error) error {
+	return watchEntities(ctx, "teachers", filter, resumeToken, MapModelTeacherToPbTeacher, send)
+}
+
+// Credentials are never part of exec events
+func WatchExecsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Exec, string, time.Time) error) error {
+	return watchEntities(ctx, "execs", filter, resumeToken, func(exec *models.Exec) *pb.Exec {
+		exec.Password = ""
+		exec.PasswordResetToken = ""
+		return MapModelExecToPbExec(exec)
+	}, send)
+}
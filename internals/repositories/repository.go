@@ -0,0 +1,231 @@
+package repositories
+
+import (
+	"ClassConnectRPC/internals/models"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository is the storage backend used by the handlers, implemented by the mongodb and sqlite packages
+// Filters and sort options are expressed as mongodb documents, backends without mongodb translate them
+// The Stream methods hand every matching record to fn as it is read instead of loading the whole result
+type Repository interface {
+	AddStudentsToDb(ctx context.Context, students []*pb.Student, ordered, atomic bool, actor string) ([]*pb.Student, []*pb.BulkItemResult, error)
+	GetStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page Page) ([]*pb.Student, error)
+	StreamStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page Page, fn func(*pb.Student) error) error
+	ModifyStudentsInDB(ctx context.Context, req *pb.UpdateStudentsRequest, actor string) ([]*pb.Student, error)
+	DeleteStudentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+	RestoreStudentsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error)
+	// The students of a teacher are those of every class the teacher is assigned to, an empty subject counts every assignment
+	GetStudentsByTeacherIdFromDB(ctx context.Context, teacherId, subject string) ([]*pb.Student, error)
+	GetStudentCountByTeacherIdFromDB(ctx context.Context, teacherId, subject string) (int64, error)
+	WatchStudentsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Student, string, time.Time) error) error
+
+	AddTeachersToDb(ctx context.Context, teachers []*pb.Teacher, ordered, atomic bool, actor string) ([]*pb.Teacher, []*pb.BulkItemResult, error)
+	GetTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Teacher, error)
+	StreamTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Teacher) error) error
+	ModifyTeachersInDB(ctx context.Context, req *pb.UpdateTeachersRequest, actor string) ([]*pb.Teacher, error)
+	DeleteTeachersFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+	RestoreTeachersInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error)
+	WatchTeachersInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Teacher, string, time.Time) error) error
+
+	AddExecsToDb(ctx context.Context, execs []*pb.Exec, ordered, atomic bool, actor string) ([]*pb.Exec, []*pb.BulkItemResult, error)
+	GetExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Exec, error)
+	StreamExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Exec) error) error
+	ModifyExecsInDB(ctx context.Context, req *pb.UpdateExecsRequest, actor string) ([]*pb.Exec, error)
+	DeleteExecsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+	RestoreExecsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error)
+	WatchExecsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Exec, string, time.Time) error) error
+	GetUserByUsername(ctx context.Context, username string) (*models.Exec, error)
+	UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest, actor string) (*models.Exec, error)
+	DeactivateUserInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) (*pb.Confirmation, error)
+
+	ClassRepository
+	PrivacyRepository
+
+	// PurgeDeletedRecordsFromDB permanently removes the records that were soft deleted before the cutoff
+	// Records of people under legal hold and the records referencing them are kept until the hold is released
+	PurgeDeletedRecordsFromDB(ctx context.Context, cutoff time.Time) error
+}
+
+// ExtendedRepository adds the services only the mongodb backend provides
+// Their handlers are only registered when the backend selected at startup implements it
+type ExtendedRepository interface {
+	Repository
+	Extensions
+}
+
+// Extensions are the methods of an ExtendedRepository beyond those of every Repository
+type Extensions interface {
+	CourseRepository
+	AttendanceRepository
+	GradesRepository
+	TimetableRepository
+	AssignmentRepository
+	AnnouncementRepository
+	GuardianRepository
+	AcademicYearRepository
+}
+
+// ClassRepository stores the classes students belong to and the teaching assignments of their teachers
+// Classes still referenced by students or teaching assignments are not deleted, a ReferencedError is returned instead
+type ClassRepository interface {
+	AddClassesToDb(ctx context.Context, classes []*pb.Class, ordered, atomic bool, actor string) ([]*pb.Class, []*pb.BulkItemResult, error)
+	GetClassesFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Class, error)
+	ModifyClassesInDB(ctx context.Context, req *pb.UpdateClassesRequest, actor string) ([]*pb.Class, error)
+	DeleteClassesFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+	RestoreClassesInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error)
+
+	AddTeachingAssignmentsToDb(ctx context.Context, assignments []*pb.TeachingAssignment, ordered, atomic bool, actor string) ([]*pb.TeachingAssignment, []*pb.BulkItemResult, error)
+	GetTeachingAssignmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.TeachingAssignment, error)
+	DeleteTeachingAssignmentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+}
+
+// CourseRepository stores the course catalogue, the sections of courses and the enrollments of students in them
+// Courses that still have sections or are a prerequisite and sections with enrolled students are not deleted, a ReferencedError is returned instead
+type CourseRepository interface {
+	AddCoursesToDb(ctx context.Context, courses []*pb.Course, ordered, atomic bool, actor string) ([]*pb.Course, []*pb.BulkItemResult, error)
+	GetCoursesFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Course, error)
+	ModifyCoursesInDB(ctx context.Context, req *pb.UpdateCoursesRequest, actor string) ([]*pb.Course, error)
+	DeleteCoursesFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+
+	AddCourseSectionsToDb(ctx context.Context, sections []*pb.CourseSection, ordered, atomic bool, actor string) ([]*pb.CourseSection, []*pb.BulkItemResult, error)
+	GetCourseSectionsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.CourseSection, error)
+	DeleteCourseSectionsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+
+	// EnrollStudentsInDB returns an EnrollmentClosedError once enrollment in the section has closed, rejected students are reported in the results
+	EnrollStudentsInDB(ctx context.Context, sectionId string, studentIds []string, actor string) ([]*pb.Enrollment, []*pb.BulkItemResult, error)
+	DropStudentsInDB(ctx context.Context, sectionId string, studentIds []string, actor string) ([]*pb.Enrollment, error)
+	GetEnrollmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Enrollment, error)
+}
+
+// AttendanceRepository stores the attendance of students in the sessions of their classes
+type AttendanceRepository interface {
+	// RecordAttendanceInDB rejects students whose attendance of the session was already recorded in the results
+	RecordAttendanceInDB(ctx context.Context, classId string, sessionDate time.Time, session string, marks []*pb.AttendanceMark, actor string) ([]*pb.AttendanceRecord, []*pb.BulkItemResult, error)
+	CorrectAttendanceInDB(ctx context.Context, corrections []*pb.AttendanceRecordCorrection, atomic bool, actor string) ([]*pb.AttendanceRecord, error)
+	GetAttendanceFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.AttendanceRecord, error)
+	// GetAttendanceCountsFromDB counts the records of every student by status, the percentage is left to the caller
+	GetAttendanceCountsFromDB(ctx context.Context, filters bson.M) ([]*pb.AttendanceSummary, error)
+}
+
+// GradesRepository stores assessments, the scores of students for them and the settings their grades are worked out with
+// Writes touching a finalized term fail with a TermFinalizedError
+type GradesRepository interface {
+	AddAssessmentsToDb(ctx context.Context, assessments []*pb.Assessment, ordered, atomic bool, actor string) ([]*pb.Assessment, []*pb.BulkItemResult, error)
+	GetAssessmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Assessment, error)
+	ModifyAssessmentsInDB(ctx context.Context, req *pb.UpdateAssessmentsRequest, actor string) ([]*pb.Assessment, error)
+	DeleteAssessmentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+
+	// RecordScoresInDB reports entries that cannot be recorded in the results, changing a score needs a reason
+	RecordScoresInDB(ctx context.Context, assessmentId string, entries []*pb.ScoreEntry, actor string) ([]*pb.Score, []*pb.BulkItemResult, error)
+	GetScoresFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Score, error)
+
+	SetCategoryWeightsInDB(ctx context.Context, weights *pb.CategoryWeights, actor string) (*pb.CategoryWeights, error)
+	GetCategoryWeightsFromDB(ctx context.Context, filters bson.M) ([]*pb.CategoryWeights, error)
+
+	SetGradingScaleInDB(ctx context.Context, scale *pb.GradingScale, actor string) (*pb.GradingScale, error)
+	// GetGradingScaleFromDB returns nil while no grading scale has been set
+	GetGradingScaleFromDB(ctx context.Context) (*pb.GradingScale, error)
+
+	FinalizeTermInDB(ctx context.Context, term string, actor string) (*pb.TermLock, error)
+	ReopenTermInDB(ctx context.Context, term string) (*pb.TermLock, error)
+	GetTermLocksFromDB(ctx context.Context) ([]*pb.TermLock, error)
+}
+
+// TimetableRepository stores the weekly timetable of the classes
+// Writes booking a teacher, class or room already booked in the same period fail with a DoubleBookingError
+type TimetableRepository interface {
+	// AddTimetableSlotsToDb reports double-booking slots in the results instead
+	AddTimetableSlotsToDb(ctx context.Context, slots []*pb.TimetableSlot, atomic bool, actor string) ([]*pb.TimetableSlot, []*pb.BulkItemResult, error)
+	GetTimetableSlotsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.TimetableSlot, error)
+	ModifyTimetableSlotsInDB(ctx context.Context, req *pb.UpdateTimetableSlotsRequest, actor string) ([]*pb.TimetableSlot, error)
+	DeleteTimetableSlotsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+}
+
+// AssignmentRepository stores the assignments of classes and the submissions of students for them
+// The files of submissions are kept in a blob store by the handlers under the submission's ID and submitted_at
+type AssignmentRepository interface {
+	AddAssignmentsToDb(ctx context.Context, assignments []*pb.Assignment, ordered, atomic bool, actor string) ([]*pb.Assignment, []*pb.BulkItemResult, error)
+	GetAssignmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Assignment, error)
+	ModifyAssignmentsInDB(ctx context.Context, req *pb.UpdateAssignmentsRequest, actor string) ([]*pb.Assignment, error)
+	DeleteAssignmentsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+
+	// SaveSubmissionInDB returns the submission it replaced, a graded submission fails with a SubmissionGradedError
+	SaveSubmissionInDB(ctx context.Context, submission *pb.Submission, actor string) (*pb.Submission, *pb.Submission, error)
+	GetSubmissionsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Submission, error)
+	RecordFeedbackInDB(ctx context.Context, feedback *pb.SubmissionFeedback, actor string) (*pb.Submission, error)
+	// RecordErasedFilesInDB adds the number of submission files an erasure deleted to its audit record
+	RecordErasedFilesInDB(ctx context.Context, auditId string, filesDeleted int64) error
+}
+
+// AnnouncementRepository stores announcements and the receipts of the people who read them
+type AnnouncementRepository interface {
+	AddAnnouncementsToDb(ctx context.Context, announcements []*pb.Announcement, ordered, atomic bool, actor string) ([]*pb.Announcement, []*pb.BulkItemResult, error)
+	GetAnnouncementsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Announcement, error)
+	ModifyAnnouncementsInDB(ctx context.Context, req *pb.UpdateAnnouncementsRequest, actor string) ([]*pb.Announcement, error)
+	DeleteAnnouncementsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+	WatchAnnouncementsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Announcement, string, time.Time) error) error
+
+	// MarkAnnouncementsReadInDB keeps the first time an announcement was read by the reader
+	MarkAnnouncementsReadInDB(ctx context.Context, readerType pb.PersonType, readerId string, announcementIds []string) ([]*pb.ReadReceipt, error)
+	GetReadReceiptsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.ReadReceipt, error)
+}
+
+// GuardianRepository stores guardians together with the IDs of the students they are linked to
+type GuardianRepository interface {
+	AddGuardiansToDb(ctx context.Context, guardians []*pb.Guardian, ordered, atomic bool, actor string) ([]*pb.Guardian, []*pb.BulkItemResult, error)
+	GetGuardiansFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Guardian, error)
+	ModifyGuardiansInDB(ctx context.Context, req *pb.UpdateGuardiansRequest, actor string) ([]*pb.Guardian, error)
+	DeleteGuardiansFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+
+	// ChangeGuardianLinksInDB links guardians to students when link is set and unlinks them otherwise
+	ChangeGuardianLinksInDB(ctx context.Context, links []*pb.GuardianLink, link, atomic bool, actor string) ([]*pb.Guardian, error)
+}
+
+// AcademicYearRepository stores academic years and their terms, and moves students from one year to the next
+type AcademicYearRepository interface {
+	AddAcademicYearsToDb(ctx context.Context, academicYears []*pb.AcademicYear, ordered, atomic bool, actor string) ([]*pb.AcademicYear, []*pb.BulkItemResult, error)
+	GetAcademicYearsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.AcademicYear, error)
+	ModifyAcademicYearsInDB(ctx context.Context, req *pb.UpdateAcademicYearsRequest, actor string) ([]*pb.AcademicYear, error)
+	DeleteAcademicYearsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+
+	AddTermsToDb(ctx context.Context, terms []*pb.Term, ordered, atomic bool, actor string) ([]*pb.Term, []*pb.BulkItemResult, error)
+	GetTermsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Term, error)
+	ModifyTermsInDB(ctx context.Context, req *pb.UpdateTermsRequest, actor string) ([]*pb.Term, error)
+	DeleteTermsFromDB(ctx context.Context, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error)
+
+	GetClassMembershipsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.ClassMembership, error)
+	// PromoteStudentsInDB moves the students of the memberships to their next class and records the memberships, all or nothing
+	PromoteStudentsInDB(ctx context.Context, promotion *pb.Promotion, memberships []*pb.ClassMembership, actor string) (*pb.Promotion, []*pb.ClassMembership, error)
+	// UndoPromotionInDB fails with a VersionConflictError when a student was changed after the promotion,
+	// and with a ClassFullError when a class the students return to filled up in the meantime
+	UndoPromotionInDB(ctx context.Context, objId primitive.ObjectID, actor string) (*pb.Promotion, []*pb.ClassMembership, error)
+}
+
+// PrivacyRepository gathers and erases the records referencing a person
+type PrivacyRepository interface {
+	GetPersonDataFromDB(ctx context.Context, person *pb.PersonRef, actor string) (*pb.PersonDataBundle, error)
+	ErasePersonInDB(ctx context.Context, req *pb.ErasePersonRequest, actor string) (*pb.ErasePersonResponse, error)
+	AddLegalHoldToDb(ctx context.Context, req *pb.LegalHoldRequest, actor string) (*pb.LegalHold, error)
+	DeleteLegalHoldFromDB(ctx context.Context, person *pb.PersonRef, actor string) error
+	GetLegalHoldsFromDB(ctx context.Context) ([]*pb.LegalHold, error)
+}
+
+// Page selects a slice of a sorted result, pages are numbered from 1 and a zero size returns everything
+type Page struct {
+	Number int32
+	Size   int32
+}
+
+// Skip returns how many records come before the page
+func (p Page) Skip() int64 {
+	if p.Size <= 0 || p.Number <= 1 {
+		return 0
+	}
+	return int64(p.Number-1) * int64(p.Size)
+}
@@ -0,0 +1,257 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// How often the change bus looks for new entries in the change log
+const changePollInterval = 200 * time.Millisecond
+
+// Tables whose changes can be watched
+var watchedTables = []*table{studentsTable, teachersTable, execsTable}
+
+// The change log is filled by triggers, so every write is recorded in the transaction that makes it, whichever code path it takes
+// old holds the record before an update as a JSON object, so watchers learn when a record leaves their filter
+const changesSchema = `CREATE TABLE IF NOT EXISTS changes (
+		seq       INTEGER PRIMARY KEY AUTOINCREMENT,
+		tbl       TEXT NOT NULL,
+		record_id TEXT NOT NULL,
+		type      TEXT NOT NULL,
+		at        TEXT NOT NULL,
+		old       TEXT
+	)`
+
+// changeTriggers returns the statements creating the triggers that record the changes of a table in the change log
+// Like mongodb change events, setting deleted_at is a DELETED change and clearing it again an UPDATED one
+func changeTriggers(t *table) []string {
+	now := `strftime('%Y-%m-%dT%H:%M:%fZ', 'now')`
+	var old []string
+	for _, col := range t.columns {
+		old = append(old, fmt.Sprintf("'%[1]s', OLD.%[1]s", col.name))
+	}
+	return []string{
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %[1]s_created AFTER INSERT ON %[1]s BEGIN
+			INSERT INTO changes (tbl, record_id, type, at) VALUES ('%[1]s', NEW.id, 'CREATED', %[2]s);
+		END`, t.name, now),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %[1]s_updated AFTER UPDATE ON %[1]s BEGIN
+			INSERT INTO changes (tbl, record_id, type, at, old) VALUES ('%[1]s', NEW.id,
+				CASE WHEN NEW.deleted_at IS NOT NULL AND OLD.deleted_at IS NULL THEN 'DELETED' ELSE 'UPDATED' END, %[2]s, json_object(%[3]s));
+		END`, t.name, now, strings.Join(old, ", ")),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %[1]s_removed AFTER DELETE ON %[1]s BEGIN
+			INSERT INTO changes (tbl, record_id, type, at) VALUES ('%[1]s', OLD.id, 'REMOVED', %[2]s);
+		END`, t.name, now),
+	}
+}
+
+// changeBus wakes the watchers of a database whenever the change log grows
+type changeBus struct {
+	mu   sync.Mutex
+	wake chan struct{}
+	stop context.CancelFunc
+}
+
+// newChangeBus starts following the change log of the database until close is called
+func newChangeBus(db *sql.DB) *changeBus {
+	ctx, stop := context.WithCancel(context.Background())
+	bus := &changeBus{wake: make(chan struct{}), stop: stop}
+	go bus.follow(ctx, db)
+	return bus
+}
+
+func (b *changeBus) follow(ctx context.Context, db *sql.DB) {
+	ticker := time.NewTicker(changePollInterval)
+	defer ticker.Stop()
+
+	var last int64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var seq int64
+		err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(seq), 0) FROM changes").Scan(&seq)
+		if err != nil || seq == last {
+			continue
+		}
+		last = seq
+
+		b.mu.Lock()
+		close(b.wake)
+		b.wake = make(chan struct{})
+		b.mu.Unlock()
+	}
+}
+
+// wait returns a channel that is closed the next time the change log grows
+func (b *changeBus) wait() <-chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.wake
+}
+
+func (b *changeBus) close() {
+	b.stop()
+}
+
+// change is an entry of the change log
+type change struct {
+	seq      int64
+	recordId string
+	kind     string
+	at       time.Time
+	old      sql.NullString
+}
+
+// watchEntities streams the changes of a table to send until the context is cancelled or send fails
+// Only records matching the filter are reported, and a non-empty resume token continues right after the change it belongs to
+// The record is read when its change is sent, and a permanently removed record reaches every watcher with only the ID set
+// An update after which a record that matched the filter no longer does is sent as LEFT with only the ID set
+func watchEntities[M any, P any](ctx context.Context, r *Repository, t *table, filter bson.M, resumeToken string, toPb func(*M) P, send func(pb.ChangeType, P, string, time.Time) error) error {
+	var after int64
+	if resumeToken != "" {
+		var err error
+		after, err = strconv.ParseInt(resumeToken, 10, 64)
+		if err != nil {
+			return utils.ErrorHandler(err, "Invalid resume token")
+		}
+	} else {
+		err := r.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(seq), 0) FROM changes").Scan(&after)
+		if err != nil {
+			return utils.ErrorHandler(err, "Error reading the change log")
+		}
+	}
+
+	where, args, err := t.whereClause(filter)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	// The record before an update is read from its JSON object through a table of the same columns
+	var extracted []string
+	for _, col := range t.columns {
+		extracted = append(extracted, fmt.Sprintf("json_extract(?1, '$.%[1]s') AS %[1]s", col.name))
+	}
+	oldQuery := ""
+	if where != "" {
+		oldQuery = fmt.Sprintf("SELECT count(*) FROM (SELECT %s) AS %s%s", strings.Join(extracted, ", "), t.name, where)
+	}
+	if where == "" {
+		where = " WHERE id = ?"
+	} else {
+		where += " AND id = ?"
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s%s", t.columnList(), t.name, where)
+
+	for {
+		// Taken before reading, so a change logged while the batch is sent still wakes the watcher
+		wake := r.changes.wait()
+
+		changes, err := readChanges(ctx, r.db, t, after)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		for _, c := range changes {
+			after = c.seq
+			changeType, model, err := changedEntity[M](ctx, r.db, t, query, oldQuery, args, c)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			if model == nil {
+				continue
+			}
+			err = send(changeType, toPb(model), strconv.FormatInt(c.seq, 10), c.at)
+			if err != nil {
+				return err
+			}
+		}
+		if len(changes) > 0 {
+			continue
+		}
+
+		// A cancelled context means the client went away, which is how a watch normally ends
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+		}
+	}
+}
+
+// readChanges reads the next entries of the change log of a table
+func readChanges(ctx context.Context, db *sql.DB, t *table, after int64) ([]change, error) {
+	rows, err := db.QueryContext(ctx, "SELECT seq, record_id, type, at, old FROM changes WHERE tbl = ? AND seq > ? ORDER BY seq LIMIT 100", t.name, after)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the change log")
+	}
+	defer rows.Close()
+
+	var changes []change
+	for rows.Next() {
+		var c change
+		var at string
+		err := rows.Scan(&c.seq, &c.recordId, &c.kind, &at, &c.old)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error reading the change log")
+		}
+		c.at, err = time.Parse(time.RFC3339Nano, at)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error reading the change log")
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// changedEntity returns the record of a change when query, which selects a record by its ID as last argument, finds it
+// When it does not, oldQuery counts whether the record matched before an update, which is then a LEFT change of only the ID
+// It returns nil when the record does not match the filter of the query or no longer exists
+func changedEntity[M any](ctx context.Context, db *sql.DB, t *table, query, oldQuery string, args []any, c change) (pb.ChangeType, *M, error) {
+	idOnly := func() *M {
+		model := new(M)
+		reflect.ValueOf(model).Elem().Field(t.byName["id"].index).SetString(c.recordId)
+		return model
+	}
+	if c.kind == "REMOVED" {
+		return pb.ChangeType_DELETED, idOnly(), nil
+	}
+
+	model, err := scanEntity[M](t, db.QueryRowContext(ctx, query, append(slices.Clip(args), c.recordId)...))
+	if err == sql.ErrNoRows {
+		if oldQuery == "" || !c.old.Valid {
+			return 0, nil, nil
+		}
+		var matched int
+		// ?1 is the JSON object, the arguments of the filter follow it
+		err := db.QueryRowContext(ctx, oldQuery, append([]any{c.old.String}, args...)...).Scan(&matched)
+		if err != nil {
+			return 0, nil, utils.ErrorHandler(err, "Internal error")
+		}
+		if matched == 0 {
+			return 0, nil, nil
+		}
+		return pb.ChangeType_LEFT, idOnly(), nil
+	}
+	if err != nil {
+		return 0, nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return pb.ChangeType(pb.ChangeType_value[c.kind]), model, nil
+}
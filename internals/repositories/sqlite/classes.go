@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Tables whose records belong to a class through their class_id column
+var classMemberTables = []*table{studentsTable, teachingAssignmentsTable}
+
+// claimSeat fails with a ClassFullError when the class holds more active students than its capacity
+// It runs in the transaction that seated the students, after they were written, so they are part of the count and
+// the write lock keeps every other transaction from seating students until this one is done
+func claimSeat(ctx context.Context, q querier, classId string) error {
+	if classId == "" {
+		return nil
+	}
+
+	var capacity int32
+	err := q.QueryRowContext(ctx, "SELECT COALESCE(capacity, 0) FROM classes WHERE id = ? AND deleted_at IS NULL", classId).Scan(&capacity)
+	if err == sql.ErrNoRows {
+		return &repositories.NotFoundError{Entity: "class", Id: classId}
+	}
+	if err != nil {
+		return err
+	}
+	if capacity <= 0 {
+		return nil
+	}
+
+	var seated int64
+	err = q.QueryRowContext(ctx, "SELECT COUNT(*) FROM students WHERE class_id = ? AND deleted_at IS NULL", classId).Scan(&seated)
+	if err != nil {
+		return err
+	}
+	if seated > int64(capacity) {
+		return &repositories.ClassFullError{ClassId: classId, Capacity: capacity}
+	}
+	return nil
+}
+
+func (r *Repository) AddClassesToDb(ctx context.Context, classesFromReq []*pb.Class, ordered, atomic bool, actor string) ([]*pb.Class, []*pb.BulkItemResult, error) {
+	now := time.Now()
+	newClasses := make([]*models.Class, len(classesFromReq))
+	for i, pbClass := range classesFromReq {
+		modelClass := mongodb.MapPbClassToModelClass(pbClass)
+		modelClass.Version = 1
+		modelClass.CreatedAt = now
+		modelClass.UpdatedAt = now
+		modelClass.CreatedBy = actor
+		modelClass.UpdatedBy = actor
+		newClasses[i] = modelClass
+	}
+
+	results, err := addEntities(ctx, r.db, classesTable, newClasses, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedClasses []*pb.Class
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		addedClasses = append(addedClasses, mongodb.MapModelClassToPbClass(newClasses[i]))
+	}
+	return addedClasses, results, nil
+}
+
+func (r *Repository) GetClassesFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Class, error) {
+	modelClasses, err := getEntities[models.Class](ctx, r.db, classesTable, sortOptions, filters, repositories.Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	var classes []*pb.Class
+	for _, modelClass := range modelClasses {
+		classes = append(classes, mongodb.MapModelClassToPbClass(modelClass))
+	}
+	return classes, nil
+}
+
+func (r *Repository) ModifyClassesInDB(ctx context.Context, req *pb.UpdateClassesRequest, actor string) ([]*pb.Class, error) {
+	return modifyEntities(ctx, r.db, classesTable, req.GetClasses(), req.GetAtomic(), req.GetUpdateMask().GetPaths(), actor, mongodb.MapPbClassToModelClass, mongodb.MapModelClassToPbClass, nil, nil)
+}
+
+func (r *Repository) DeleteClassesFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return deleteEntities(ctx, r.db, classesTable, objectIdsToDelete, expectedVersions, actor, atomic, mongodb.MapModelClassToPbClass, func(q querier) error {
+		return checkClassesUnreferenced(ctx, q, objectIdsToDelete)
+	}, nil)
+}
+
+func (r *Repository) RestoreClassesInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return restoreEntities(ctx, r.db, classesTable, objIds, actor, nil)
+}
+
+// checkClassesUnreferenced fails with a ReferencedError when a student or teaching assignment that is not deleted still belongs to one of the classes
+func checkClassesUnreferenced(ctx context.Context, q querier, objIds []primitive.ObjectID) error {
+	ids, args := inClause(objIds)
+	for _, t := range classMemberTables {
+		var classId string
+		err := q.QueryRowContext(ctx, fmt.Sprintf("SELECT class_id FROM %s WHERE class_id IN %s AND deleted_at IS NULL LIMIT 1", t.name, ids), args...).Scan(&classId)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		return &repositories.ReferencedError{Entity: "class", Id: classId, ReferencedBy: t.name}
+	}
+	return nil
+}
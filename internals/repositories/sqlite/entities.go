@@ -0,0 +1,499 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Returned from inside a transaction to roll back a batch in which at least one item failed
+var errBatchRolledBack = errors.New("batch rolled back")
+
+// runInTransaction runs fn inside a transaction when atomic is set, otherwise fn is run directly against the database
+func runInTransaction(ctx context.Context, db *sql.DB, atomic bool, fn func(q querier) error) error {
+	if !atomic {
+		return fn(db)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return utils.ErrorHandler(err, "Unable to start a database transaction")
+	}
+	err = fn(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// rolledBack annotates the error that aborted an atomic batch with what was rolled back
+// Typed errors are returned unchanged so the handlers can still map them to their status codes
+func rolledBack(err error, message string) error {
+	var conflict *repositories.VersionConflictError
+	var duplicate *repositories.DuplicateKeyError
+	var full *repositories.ClassFullError
+	var notFound *repositories.NotFoundError
+	if errors.As(err, &conflict) || errors.As(err, &duplicate) || errors.As(err, &full) || errors.As(err, &notFound) {
+		return err
+	}
+	return utils.ErrorHandler(err, fmt.Sprintf("%s: %s", err.Error(), message))
+}
+
+// Matches the column in messages like "UNIQUE constraint failed: execs.username"
+var uniquePattern = regexp.MustCompile(`UNIQUE constraint failed: \w+\.(\w+)`)
+
+// asDuplicateKeyError converts a unique constraint violation into a DuplicateKeyError
+// Any other error is returned unchanged
+func asDuplicateKeyError(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) || sqliteErr.Code() != sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return err
+	}
+
+	match := uniquePattern.FindStringSubmatch(sqliteErr.Error())
+	if len(match) == 2 {
+		return &repositories.DuplicateKeyError{Field: match[1]}
+	}
+	return &repositories.DuplicateKeyError{Field: "key"}
+}
+
+// insertEntity inserts a single model under a new id
+func insertEntity(ctx context.Context, q querier, t *table, model interface{}) (string, error) {
+	id := primitive.NewObjectID().Hex()
+	reflect.ValueOf(model).Elem().Field(t.byName["id"].index).SetString(id)
+
+	values := t.values(model)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+	_, err := q.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", t.name, t.columnList(), placeholders), values...)
+	if err != nil {
+		reflect.ValueOf(model).Elem().Field(t.byName["id"].index).SetString("")
+		return "", asDuplicateKeyError(err)
+	}
+	return id, nil
+}
+
+// insertResult reports the outcome of inserting the item at index
+func insertResult(index int, id string, err error) *pb.BulkItemResult {
+	if err == nil {
+		return &pb.BulkItemResult{Index: int32(index), Id: id}
+	}
+
+	var duplicate *repositories.DuplicateKeyError
+	if errors.As(err, &duplicate) {
+		return &pb.BulkItemResult{Index: int32(index), ErrorCode: int32(codes.AlreadyExists), ErrorMessage: duplicate.Error()}
+	}
+	var full *repositories.ClassFullError
+	if errors.As(err, &full) {
+		return &pb.BulkItemResult{Index: int32(index), ErrorCode: int32(codes.FailedPrecondition), ErrorMessage: full.Error()}
+	}
+	var notFound *repositories.NotFoundError
+	if errors.As(err, &notFound) {
+		return &pb.BulkItemResult{Index: int32(index), ErrorCode: int32(codes.NotFound), ErrorMessage: notFound.Error()}
+	}
+	utils.ErrorHandler(err, "Error inserting data into sqlite")
+	return &pb.BulkItemResult{Index: int32(index), ErrorCode: int32(codes.Internal), ErrorMessage: "Error inserting data into sqlite"}
+}
+
+// addEntities inserts the models and reports the outcome of every item by its index, the ids are set on the models that were added
+// In ordered mode the insert stops at the first failure and every item after it is reported as not attempted,
+// in atomic mode the items are inserted in a single transaction, so either all of them are added or none
+// check, when set, runs after an item was inserted in the same transaction and rolls the item back when it fails,
+// without a batch transaction every item is then inserted in a transaction of its own
+func addEntities[M any](ctx context.Context, db *sql.DB, t *table, entities []*M, ordered, atomic bool, check func(q querier, entity *M) error) ([]*pb.BulkItemResult, error) {
+	results := make([]*pb.BulkItemResult, len(entities))
+	// A transaction is aborted by the first failure anyway, so atomic inserts are always ordered
+	ordered = ordered || atomic
+
+	err := runInTransaction(ctx, db, atomic, func(q querier) error {
+		failed := false
+		for i, entity := range entities {
+			if failed && ordered {
+				results[i] = &pb.BulkItemResult{
+					Index:        int32(i),
+					ErrorCode:    int32(codes.Aborted),
+					ErrorMessage: "Not attempted: an earlier item of the ordered insert failed",
+				}
+				continue
+			}
+
+			var id string
+			insert := func(q querier) error {
+				var err error
+				id, err = insertEntity(ctx, q, t, entity)
+				if err != nil || check == nil {
+					return err
+				}
+				err = check(q, entity)
+				if err != nil {
+					reflect.ValueOf(entity).Elem().Field(t.byName["id"].index).SetString("")
+				}
+				return err
+			}
+			var err error
+			if atomic {
+				err = insert(q)
+			} else {
+				err = runInTransaction(ctx, db, check != nil, insert)
+			}
+			results[i] = insertResult(i, id, err)
+			failed = failed || err != nil
+		}
+		if atomic && failed {
+			return errBatchRolledBack
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRolledBack) {
+		for i, result := range results {
+			if result.ErrorCode == 0 {
+				reflect.ValueOf(entities[i]).Elem().Field(t.byName["id"].index).SetString("")
+				result.Id = ""
+				result.ErrorCode = int32(codes.Aborted)
+				result.ErrorMessage = "Rolled back: another item of the atomic insert failed"
+			}
+		}
+		return results, nil
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error inserting data into sqlite")
+	}
+	return results, nil
+}
+
+// getEntities returns the models matching the filter in the given order, limited to the page
+func getEntities[M any](ctx context.Context, db *sql.DB, t *table, sortOptions bson.D, filter bson.M, page repositories.Page) ([]*M, error) {
+	query, args, err := t.selectQuery(sortOptions, filter, page)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	entities, err := queryEntities[M](ctx, db, t, query, args...)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return entities, nil
+}
+
+// streamEntities hands the matching records to fn as the rows are read, an error returned by fn stops the iteration and is returned as is
+func streamEntities[M any, P any](ctx context.Context, db *sql.DB, t *table, sortOptions bson.D, filter bson.M, page repositories.Page, toPb func(*M) P, fn func(P) error) error {
+	query, args, err := t.selectQuery(sortOptions, filter, page)
+	if err != nil {
+		return utils.ErrorHandler(err, err.Error())
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		model, err := scanEntity[M](t, rows)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if err := fn(toPb(model)); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return nil
+}
+
+// findEntity returns the stored model with the given id that has not been soft deleted, or sql.ErrNoRows
+func findEntity[M any](ctx context.Context, q querier, t *table, id string) (*M, error) {
+	row := q.QueryRowContext(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE id = ? AND deleted_at IS NULL", t.columnList(), t.name), id)
+	return scanEntity[M](t, row)
+}
+
+// updateEntity applies the update for a single model and returns the record as stored after the update
+// A non-zero expected version limits the update to the record with that version, every update bumps the version
+// and records when and by whom the record was last updated
+func updateEntity[M any, P proto.Message](ctx context.Context, q querier, t *table, id string, model *M, paths []string, expectedVersion int64, actor string, toPb func(*M) P) (*M, error) {
+	update, err := mongodb.BuildUpdate(model, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []string
+	var args []any
+	set, _ := update["$set"].(bson.M)
+	for field, value := range set {
+		col, ok := t.byName[columnFor(field)]
+		if !ok {
+			return nil, utils.ErrorHandler(fmt.Errorf("unknown field: %s", field), fmt.Sprintf("Unknown field: %s", field))
+		}
+		assignments = append(assignments, col.name+" = ?")
+		args = append(args, columnValue(value))
+	}
+	unset, _ := update["$unset"].(bson.M)
+	for field := range unset {
+		col, ok := t.byName[columnFor(field)]
+		if !ok {
+			return nil, utils.ErrorHandler(fmt.Errorf("unknown field: %s", field), fmt.Sprintf("Unknown field: %s", field))
+		}
+		assignments = append(assignments, col.name+" = NULL")
+	}
+	assignments = append(assignments, "updated_at = ?", "updated_by = ?", "version = version + 1")
+	args = append(args, columnValue(time.Now()), columnValue(actor), id)
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE id = ? AND deleted_at IS NULL", t.name, strings.Join(assignments, ", "))
+	if expectedVersion != 0 {
+		query += " AND version = ?"
+		args = append(args, expectedVersion)
+	}
+
+	result, err := q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, asDuplicateKeyError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	if affected == 0 {
+		return nil, missingOrConflict(ctx, q, t, id, toPb)
+	}
+
+	updated, err := findEntity[M](ctx, q, t, id)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return updated, nil
+}
+
+// missingOrConflict explains why a write filtered by id and version matched nothing
+// Either the record does not exist or its version differs, in which case the current record is returned with the error
+func missingOrConflict[M any, P proto.Message](ctx context.Context, q querier, t *table, id string, toPb func(*M) P) error {
+	current, err := findEntity[M](ctx, q, t, id)
+	if err == sql.ErrNoRows {
+		return utils.ErrorHandler(err, fmt.Sprintf("No %s found with ID: %s", t.entity, id))
+	}
+	if err != nil {
+		return utils.ErrorHandler(err, "Internal error")
+	}
+	return &repositories.VersionConflictError{Id: id, Current: toPb(current)}
+}
+
+// modifyEntities updates every message of a request, inside a single transaction when atomic is set
+// check, when set, runs after a record was updated in the same transaction and rolls the update back when it fails,
+// without a batch transaction every record is then updated in a transaction of its own
+func modifyEntities[M any, P proto.Message](ctx context.Context, db *sql.DB, t *table, messages []P, atomic bool, paths []string, actor string, toModel func(P) *M, toPb func(*M) P, prepare func(*M) error, check func(q querier, updated *M) error) ([]P, error) {
+	var updated []P
+	err := runInTransaction(ctx, db, atomic, func(q querier) error {
+		for _, message := range messages {
+			model := toModel(message)
+			modelVal := reflect.ValueOf(model).Elem()
+			id := modelVal.Field(t.byName["id"].index).String()
+			if id == "" {
+				return utils.ErrorHandler(errors.New("id cannot be blank"), "id cannot be blank")
+			}
+			expectedVersion := modelVal.Field(t.byName["version"].index).Int()
+
+			if prepare != nil {
+				err := prepare(model)
+				if err != nil {
+					return err
+				}
+			}
+
+			var updatedModel *M
+			update := func(q querier) error {
+				var err error
+				updatedModel, err = updateEntity(ctx, q, t, id, model, paths, expectedVersion, actor, toPb)
+				if err != nil || check == nil {
+					return err
+				}
+				return check(q, updatedModel)
+			}
+			var err error
+			if atomic {
+				err = update(q)
+			} else {
+				err = runInTransaction(ctx, db, check != nil, update)
+			}
+			if err != nil {
+				var conflict *repositories.VersionConflictError
+				var duplicate *repositories.DuplicateKeyError
+				var full *repositories.ClassFullError
+				var notFound *repositories.NotFoundError
+				if errors.As(err, &conflict) || errors.As(err, &duplicate) || errors.As(err, &full) || errors.As(err, &notFound) {
+					return err
+				}
+				return utils.ErrorHandler(err, fmt.Sprintf("Error updating %s with ID: %s", t.entity, id))
+			}
+
+			// Respond with the stored record rather than an echo of the request
+			updated = append(updated, toPb(updatedModel))
+		}
+		return nil
+	})
+	if err != nil {
+		if atomic {
+			return nil, rolledBack(err, fmt.Sprintf("no %ss were updated", t.entity))
+		}
+		return nil, err
+	}
+	return updated, nil
+}
+
+// inClause returns the placeholders and arguments of an id list
+func inClause(objIds []primitive.ObjectID) (string, []any) {
+	placeholders := make([]string, len(objIds))
+	args := make([]any, len(objIds))
+	for i, objId := range objIds {
+		placeholders[i] = "?"
+		args[i] = objId.Hex()
+	}
+	return "(" + strings.Join(placeholders, ", ") + ")", args
+}
+
+// softDeleteEntities marks the records with the given ids as deleted by the actor and returns how many were marked
+// Ids with a non-zero expected version are only deleted if the stored version matches, otherwise a VersionConflictError is returned
+func softDeleteEntities[M any, P proto.Message](ctx context.Context, q querier, t *table, objIds []primitive.ObjectID, expectedVersions []int64, actor string, toPb func(*M) P) (int64, error) {
+	now := columnValue(time.Now())
+	update := fmt.Sprintf("UPDATE %s SET deleted_at = ?, deleted_by = ?, updated_at = ?, updated_by = ?, version = version + 1", t.name)
+
+	var unversioned []primitive.ObjectID
+	var deleted int64
+	for i, objId := range objIds {
+		if i >= len(expectedVersions) || expectedVersions[i] == 0 {
+			unversioned = append(unversioned, objId)
+			continue
+		}
+
+		result, err := q.ExecContext(ctx, update+" WHERE id = ? AND version = ? AND deleted_at IS NULL", now, columnValue(actor), now, columnValue(actor), objId.Hex(), expectedVersions[i])
+		if err != nil {
+			return deleted, utils.ErrorHandler(err, "Internal error")
+		}
+		affected, _ := result.RowsAffected()
+		if affected == 0 {
+			return deleted, missingOrConflict(ctx, q, t, objId.Hex(), toPb)
+		}
+		deleted += affected
+	}
+
+	if len(unversioned) > 0 {
+		ids, idArgs := inClause(unversioned)
+		args := append([]any{now, columnValue(actor), now, columnValue(actor)}, idArgs...)
+		result, err := q.ExecContext(ctx, update+" WHERE id IN "+ids+" AND deleted_at IS NULL", args...)
+		if err != nil {
+			return deleted, utils.ErrorHandler(err, "Internal error")
+		}
+		affected, _ := result.RowsAffected()
+		deleted += affected
+	}
+	return deleted, nil
+}
+
+// deleteEntities soft deletes the records with the given ids, inside a single transaction when atomic is set
+// check, when set, runs first and can refuse the delete of records other records still depend on,
+// cascade, when set, runs after the delete and ends the records that only live as long as the deleted ones
+func deleteEntities[M any, P proto.Message](ctx context.Context, db *sql.DB, t *table, objIds []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool, toPb func(*M) P, check func(q querier) error, cascade func(q querier) error) ([]string, error) {
+	err := runInTransaction(ctx, db, atomic, func(q querier) error {
+		if check != nil {
+			err := check(q)
+			if err != nil {
+				return err
+			}
+		}
+
+		deletedCount, err := softDeleteEntities(ctx, q, t, objIds, expectedVersions, actor, toPb)
+		if err != nil {
+			return err
+		}
+
+		if deletedCount == 0 {
+			return utils.ErrorHandler(err, fmt.Sprintf("No %ss were deleted", t.entity))
+		}
+
+		// Rolling back the transaction leaves every record in place when some of them do not exist
+		if atomic && deletedCount != int64(len(objIds)) {
+			return utils.ErrorHandler(err, fmt.Sprintf("Not all %ss were found, no %ss were deleted", t.entity, t.entity))
+		}
+		if cascade != nil {
+			return cascade(q)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedIds []string
+	for _, v := range objIds {
+		deletedIds = append(deletedIds, v.Hex())
+	}
+	return deletedIds, nil
+}
+
+// restoreEntities clears the deletion marker of the soft deleted records with the given ids and returns the restored ids
+// A NotFoundError is returned when none of the records is deleted
+// check, when set, runs after the restore in the same transaction and rolls it back when it fails
+func restoreEntities(ctx context.Context, db *sql.DB, t *table, objIds []primitive.ObjectID, actor string, check func(q querier, restoredIds []string) error) ([]string, error) {
+	ids, args := inClause(objIds)
+
+	var restored []string
+	err := runInTransaction(ctx, db, true, func(q querier) error {
+		// Look up which of the records are actually deleted, so only those are reported back
+		rows, err := q.QueryContext(ctx, fmt.Sprintf("SELECT id FROM %s WHERE id IN %s AND deleted_at IS NOT NULL", t.name, ids), args...)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		for rows.Next() {
+			var id string
+			err := rows.Scan(&id)
+			if err != nil {
+				rows.Close()
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			restored = append(restored, id)
+		}
+		rows.Close()
+		if rows.Err() != nil {
+			return utils.ErrorHandler(rows.Err(), "Internal error")
+		}
+		if len(restored) == 0 {
+			var ids []string
+			for _, objId := range objIds {
+				ids = append(ids, objId.Hex())
+			}
+			return &repositories.NotFoundError{Entity: "deleted " + t.entity, Id: strings.Join(ids, ", ")}
+		}
+
+		now := columnValue(time.Now())
+		restoreArgs := append([]any{now, columnValue(actor)}, args...)
+		// The email of a deleted person may have been taken by someone else in the meantime
+		_, err = q.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET deleted_at = NULL, deleted_by = NULL, updated_at = ?, updated_by = ?, version = version + 1 WHERE id IN %s AND deleted_at IS NOT NULL", t.name, ids), restoreArgs...)
+		if err != nil {
+			return asDuplicateKeyError(err)
+		}
+		if check != nil {
+			return check(q, restored)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
@@ -0,0 +1,156 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// hashPassword replaces a plain text password of the model with its hash
+func hashPassword(modelExec *models.Exec) error {
+	if modelExec.Password == "" {
+		return nil
+	}
+	hashedPassword, err := utils.HashPassword(modelExec.Password)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error hashing password")
+	}
+	modelExec.Password = hashedPassword
+	return nil
+}
+
+func (r *Repository) AddExecsToDb(ctx context.Context, execsFromRequest []*pb.Exec, ordered, atomic bool, actor string) ([]*pb.Exec, []*pb.BulkItemResult, error) {
+	now := time.Now()
+	newExecs := make([]*models.Exec, len(execsFromRequest))
+	for i, pbExec := range execsFromRequest {
+		modelExec := mongodb.MapPbExecToModelExec(pbExec)
+		modelExec.Version = 1
+		modelExec.CreatedAt = now
+		modelExec.UpdatedAt = now
+		modelExec.CreatedBy = actor
+		modelExec.UpdatedBy = actor
+
+		err := hashPassword(modelExec)
+		if err != nil {
+			return nil, nil, err
+		}
+		newExecs[i] = modelExec
+	}
+
+	results, err := addEntities(ctx, r.db, execsTable, newExecs, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedExecs []*pb.Exec
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		addedExecs = append(addedExecs, mongodb.MapModelExecToPbExec(newExecs[i]))
+	}
+	return addedExecs, results, nil
+}
+
+func (r *Repository) GetExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Exec, error) {
+	modelExecs, err := getEntities[models.Exec](ctx, r.db, execsTable, sortOptions, filters, repositories.Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	var execs []*pb.Exec
+	for _, modelExec := range modelExecs {
+		execs = append(execs, mongodb.MapModelExecToPbExec(modelExec))
+	}
+	return execs, nil
+}
+
+func (r *Repository) StreamExecsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Exec) error) error {
+	return streamEntities(ctx, r.db, execsTable, sortOptions, filters, repositories.Page{}, mongodb.MapModelExecToPbExec, fn)
+}
+
+func (r *Repository) ModifyExecsInDB(ctx context.Context, req *pb.UpdateExecsRequest, actor string) ([]*pb.Exec, error) {
+	return modifyEntities(ctx, r.db, execsTable, req.GetExecs(), req.GetAtomic(), req.GetUpdateMask().GetPaths(), actor, mongodb.MapPbExecToModelExec, mongodb.MapModelExecToPbExec, hashPassword, nil)
+}
+
+func (r *Repository) DeleteExecsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return deleteEntities(ctx, r.db, execsTable, objectIdsToDelete, expectedVersions, actor, atomic, mongodb.MapModelExecToPbExec, nil, nil)
+}
+
+func (r *Repository) RestoreExecsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return restoreEntities(ctx, r.db, execsTable, objIds, actor, nil)
+}
+
+// Credentials are never part of exec events
+func (r *Repository) WatchExecsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Exec, string, time.Time) error) error {
+	return watchEntities(ctx, r, execsTable, filter, resumeToken, func(exec *models.Exec) *pb.Exec {
+		exec.Password = ""
+		exec.PasswordResetToken = ""
+		return mongodb.MapModelExecToPbExec(exec)
+	}, send)
+}
+
+func (r *Repository) GetUserByUsername(ctx context.Context, username string) (*models.Exec, error) {
+	row := r.db.QueryRowContext(ctx, fmt.Sprintf("SELECT %s FROM execs WHERE username = ? AND deleted_at IS NULL", execsTable.columnList()), username)
+	exec, err := scanEntity[models.Exec](execsTable, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.ErrorHandler(err, "User not found. Incorrect username or password ")
+		}
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return exec, nil
+}
+
+func (r *Repository) UpdateUserInDB(ctx context.Context, req *pb.UpdatePasswordRequest, actor string) (*models.Exec, error) {
+	objId, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	exec, err := findEntity[models.Exec](ctx, r.db, execsTable, objId.Hex())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "User not found")
+	}
+
+	err = utils.VerifyPassword(req.GetCurrentPassword(), exec.Password)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid username or password")
+	}
+
+	newHashedPassword, err := utils.HashPassword(req.GetNewPassword())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Unable to hash the password")
+	}
+
+	now := columnValue(time.Now())
+	_, err = r.db.ExecContext(ctx, "UPDATE execs SET password = ?, password_changed_at = ?, updated_at = ?, updated_by = ?, version = version + 1 WHERE id = ?",
+		newHashedPassword, now, now, columnValue(actor), objId.Hex())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Failed to update the password")
+	}
+	return exec, nil
+}
+
+func (r *Repository) DeactivateUserInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) (*pb.Confirmation, error) {
+	if len(objIds) == 0 {
+		return nil, nil
+	}
+
+	ids, idArgs := inClause(objIds)
+	args := append([]any{columnValue(time.Now()), columnValue(actor)}, idArgs...)
+	_, err := r.db.ExecContext(ctx, "UPDATE execs SET inactive_status = 1, updated_at = ?, updated_by = ?, version = version + 1 WHERE id IN "+ids+" AND deleted_at IS NULL", args...)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return nil, nil
+}
@@ -0,0 +1,433 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	legalHoldsTable   = newTable("legal_holds", "legal hold", models.LegalHold{})
+	privacyAuditTable = newTable("privacy_audit", "privacy audit", models.PrivacyAudit{})
+)
+
+// Tables whose records are soft deleted and purged once the retention period has passed
+var softDeleteTables = []*table{studentsTable, teachersTable, execsTable, classesTable, teachingAssignmentsTable}
+
+// Table holding the own record of every kind of person
+var personTables = map[pb.PersonType]*table{
+	pb.PersonType_STUDENT: studentsTable,
+	pb.PersonType_TEACHER: teachersTable,
+	pb.PersonType_EXEC:    execsTable,
+}
+
+// personReference is a column of a table that holds the ID of a person
+type personReference struct {
+	table  *table
+	column string
+}
+
+// Columns referencing each kind of person outside of their own record, the same fields the mongodb backend covers
+var personReferences = map[pb.PersonType][]personReference{
+	pb.PersonType_TEACHER: {
+		{classesTable, "homeroom_teacher_id"},
+		{teachingAssignmentsTable, "teacher_id"},
+	},
+}
+
+func init() {
+	// Every write records the ID of the exec who made it
+	for _, t := range softDeleteTables {
+		for _, column := range []string{"created_by", "updated_by", "deleted_by"} {
+			personReferences[pb.PersonType_EXEC] = append(personReferences[pb.PersonType_EXEC], personReference{t, column})
+		}
+	}
+}
+
+// A person is held at most once, placing the hold again replaces it
+const legalHoldsSchema = `CREATE TABLE IF NOT EXISTS legal_holds (
+		id          TEXT PRIMARY KEY,
+		person_type TEXT NOT NULL,
+		person_id   TEXT NOT NULL,
+		reason      TEXT,
+		placed_by   TEXT,
+		placed_at   TEXT,
+		UNIQUE (person_type, person_id)
+	)`
+
+// The audit only holds IDs and counts, never the personal data itself
+const privacyAuditSchema = `CREATE TABLE IF NOT EXISTS privacy_audit (
+		id                    TEXT PRIMARY KEY,
+		action                TEXT NOT NULL,
+		person_type           TEXT NOT NULL,
+		person_id             TEXT NOT NULL,
+		mode                  TEXT,
+		reason                TEXT,
+		actor                 TEXT,
+		at                    TEXT,
+		records_exported      INTEGER,
+		records_anonymized    INTEGER,
+		records_deleted       INTEGER,
+		references_anonymized INTEGER,
+		files_deleted         INTEGER
+	)`
+
+func personTypeName(personType pb.PersonType) string {
+	return strings.ToLower(personType.String())
+}
+
+// personTable returns the table of the person's own record, a NotFoundError for kinds of people this backend does not store
+func personTable(person *pb.PersonRef) (*table, error) {
+	t, ok := personTables[person.GetType()]
+	if !ok {
+		return nil, &repositories.NotFoundError{Entity: personTypeName(person.GetType()), Id: person.GetId()}
+	}
+	return t, nil
+}
+
+func (r *Repository) GetPersonDataFromDB(ctx context.Context, person *pb.PersonRef, actor string) (*pb.PersonDataBundle, error) {
+	_, err := primitive.ObjectIDFromHex(person.GetId())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+	own, err := personTable(person)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &pb.PersonDataBundle{Person: person, GeneratedAt: timestamppb.Now()}
+	byKey := make(map[string]*pb.PersonRecord)
+	collect := func(t *table, column string, field string) error {
+		rows, err := r.db.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s WHERE %s = ?", t.columnList(), t.name, column), person.GetId())
+		if err != nil {
+			return utils.ErrorHandler(err, fmt.Sprintf("Error reading %s", t.name))
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			model, err := scanModel(t, rows)
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			data, err := bson.Marshal(model)
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			raw := bson.Raw(data)
+			id := raw.Lookup("_id").StringValue()
+
+			record, ok := byKey[t.name+"/"+id]
+			if !ok {
+				document, err := mongodb.PersonDocument(raw)
+				if err != nil {
+					return utils.ErrorHandler(err, "Internal error")
+				}
+				record = &pb.PersonRecord{Collection: t.name, Id: id, Document: document}
+				byKey[t.name+"/"+id] = record
+				bundle.Records = append(bundle.Records, record)
+			}
+			if field != "" {
+				record.Fields = append(record.Fields, field)
+			}
+		}
+		return rows.Err()
+	}
+
+	err = collect(own, "id", "")
+	if err != nil {
+		return nil, err
+	}
+	for _, reference := range personReferences[person.GetType()] {
+		err := collect(reference.table, reference.column, reference.column)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(bundle.Records) == 0 {
+		return nil, &repositories.NotFoundError{Entity: personTypeName(person.GetType()), Id: person.GetId()}
+	}
+
+	_, err = writePrivacyAudit(ctx, r.db, &models.PrivacyAudit{
+		Action:          "export",
+		PersonType:      personTypeName(person.GetType()),
+		PersonId:        person.GetId(),
+		Actor:           actor,
+		RecordsExported: int64(len(bundle.Records)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// ErasePersonInDB anonymizes or deletes the own record of a person and anonymizes every reference to them
+// Everything including the audit record is written in a single transaction, and nothing is written for a person under legal hold
+func (r *Repository) ErasePersonInDB(ctx context.Context, req *pb.ErasePersonRequest, actor string) (*pb.ErasePersonResponse, error) {
+	person := req.GetPerson()
+	_, err := primitive.ObjectIDFromHex(person.GetId())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+	own, err := personTable(person)
+	if err != nil {
+		return nil, err
+	}
+
+	typeName := personTypeName(person.GetType())
+	response := &pb.ErasePersonResponse{}
+	err = runInTransaction(ctx, r.db, true, func(q querier) error {
+		var reason sql.NullString
+		err := q.QueryRowContext(ctx, "SELECT reason FROM legal_holds WHERE person_type = ? AND person_id = ?", typeName, person.GetId()).Scan(&reason)
+		if err == nil {
+			return &repositories.LegalHoldError{Reason: reason.String}
+		}
+		if err != sql.ErrNoRows {
+			return utils.ErrorHandler(err, "Error checking the legal holds")
+		}
+
+		if req.GetMode() == pb.ErasureMode_DELETE {
+			result, err := q.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = ?", own.name), person.GetId())
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error deleting the %s", typeName))
+			}
+			response.RecordsDeleted, _ = result.RowsAffected()
+		} else {
+			query, args, err := anonymizeQuery(own, person, actor)
+			if err != nil {
+				return utils.ErrorHandler(err, "Internal error")
+			}
+			result, err := q.ExecContext(ctx, query, args...)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error anonymizing the %s", typeName))
+			}
+			response.RecordsAnonymized, _ = result.RowsAffected()
+		}
+
+		for _, reference := range personReferences[person.GetType()] {
+			result, err := q.ExecContext(ctx,
+				fmt.Sprintf("UPDATE %s SET %s = ? WHERE %s = ?", reference.table.name, reference.column, reference.column),
+				mongodb.ErasedValue, person.GetId(),
+			)
+			if err != nil {
+				return utils.ErrorHandler(err, fmt.Sprintf("Error anonymizing the references in %s", reference.table.name))
+			}
+			affected, _ := result.RowsAffected()
+			response.ReferencesAnonymized += affected
+		}
+
+		if response.RecordsDeleted+response.RecordsAnonymized+response.ReferencesAnonymized == 0 {
+			return &repositories.NotFoundError{Entity: typeName, Id: person.GetId()}
+		}
+
+		// The change log keeps records as they were before an update, including the ones just erased
+		_, err = q.ExecContext(ctx, "UPDATE changes SET old = NULL WHERE instr(old, ?) > 0", person.GetId())
+		if err != nil {
+			return utils.ErrorHandler(err, "Error erasing the person from the change log")
+		}
+
+		response.AuditId, err = writePrivacyAudit(ctx, q, &models.PrivacyAudit{
+			Action:               "erase",
+			PersonType:           typeName,
+			PersonId:             person.GetId(),
+			Mode:                 strings.ToLower(req.GetMode().String()),
+			Reason:               req.GetReason(),
+			Actor:                actor,
+			RecordsAnonymized:    response.RecordsAnonymized,
+			RecordsDeleted:       response.RecordsDeleted,
+			ReferencesAnonymized: response.ReferencesAnonymized,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// anonymizeQuery translates the anonymizing update of the mongodb backend into an UPDATE of the person's own record
+func anonymizeQuery(t *table, person *pb.PersonRef, actor string) (string, []any, error) {
+	update := mongodb.AnonymizeUpdate(person, actor)
+
+	var assignments []string
+	var args []any
+	set, _ := update["$set"].(bson.M)
+	for field, value := range set {
+		col, ok := t.byName[columnFor(field)]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown field: %s", field)
+		}
+		assignments = append(assignments, col.name+" = ?")
+		args = append(args, columnValue(value))
+	}
+	unset, _ := update["$unset"].(bson.M)
+	for field := range unset {
+		col, ok := t.byName[columnFor(field)]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown field: %s", field)
+		}
+		assignments = append(assignments, col.name+" = NULL")
+	}
+	assignments = append(assignments, "version = version + 1")
+
+	return fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", t.name, strings.Join(assignments, ", ")), append(args, person.GetId()), nil
+}
+
+func (r *Repository) AddLegalHoldToDb(ctx context.Context, req *pb.LegalHoldRequest, actor string) (*pb.LegalHold, error) {
+	person := req.GetPerson()
+	_, err := primitive.ObjectIDFromHex(person.GetId())
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+	own, err := personTable(person)
+	if err != nil {
+		return nil, err
+	}
+
+	typeName := personTypeName(person.GetType())
+	now := time.Now()
+	err = runInTransaction(ctx, r.db, true, func(q querier) error {
+		var count int64
+		err := q.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE id = ?", own.name), person.GetId()).Scan(&count)
+		if err != nil {
+			return utils.ErrorHandler(err, "Internal error")
+		}
+		if count == 0 {
+			return &repositories.NotFoundError{Entity: typeName, Id: person.GetId()}
+		}
+
+		// Placing a hold again replaces its reason
+		_, err = q.ExecContext(ctx, `INSERT INTO legal_holds (id, person_type, person_id, reason, placed_by, placed_at) VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (person_type, person_id) DO UPDATE SET reason = excluded.reason, placed_by = excluded.placed_by, placed_at = excluded.placed_at`,
+			primitive.NewObjectID().Hex(), typeName, person.GetId(), columnValue(req.GetReason()), columnValue(actor), columnValue(now),
+		)
+		if err != nil {
+			return utils.ErrorHandler(err, "Error placing the legal hold")
+		}
+
+		_, err = writePrivacyAudit(ctx, q, &models.PrivacyAudit{
+			Action:     "legal_hold_placed",
+			PersonType: typeName,
+			PersonId:   person.GetId(),
+			Reason:     req.GetReason(),
+			Actor:      actor,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LegalHold{Person: person, Reason: req.GetReason(), PlacedBy: actor, PlacedAt: timestamppb.New(now)}, nil
+}
+
+func (r *Repository) DeleteLegalHoldFromDB(ctx context.Context, person *pb.PersonRef, actor string) error {
+	typeName := personTypeName(person.GetType())
+	return runInTransaction(ctx, r.db, true, func(q querier) error {
+		result, err := q.ExecContext(ctx, "DELETE FROM legal_holds WHERE person_type = ? AND person_id = ?", typeName, person.GetId())
+		if err != nil {
+			return utils.ErrorHandler(err, "Error releasing the legal hold")
+		}
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return &repositories.NotFoundError{Entity: "legal hold", Id: person.GetId()}
+		}
+
+		_, err = writePrivacyAudit(ctx, q, &models.PrivacyAudit{
+			Action:     "legal_hold_released",
+			PersonType: typeName,
+			PersonId:   person.GetId(),
+			Actor:      actor,
+		})
+		return err
+	})
+}
+
+func (r *Repository) GetLegalHoldsFromDB(ctx context.Context) ([]*pb.LegalHold, error) {
+	holds, err := getEntities[models.LegalHold](ctx, r.db, legalHoldsTable, bson.D{{Key: "placed_at", Value: 1}}, bson.M{}, repositories.Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	pbHolds := make([]*pb.LegalHold, len(holds))
+	for i, hold := range holds {
+		pbHolds[i] = &pb.LegalHold{
+			Person:   &pb.PersonRef{Type: pb.PersonType(pb.PersonType_value[strings.ToUpper(hold.PersonType)]), Id: hold.PersonId},
+			Reason:   hold.Reason,
+			PlacedBy: hold.PlacedBy,
+			PlacedAt: timestamppb.New(hold.PlacedAt),
+		}
+	}
+	return pbHolds, nil
+}
+
+func writePrivacyAudit(ctx context.Context, q querier, audit *models.PrivacyAudit) (string, error) {
+	audit.At = time.Now()
+	id, err := insertEntity(ctx, q, privacyAuditTable, audit)
+	if err != nil {
+		return "", utils.ErrorHandler(err, "Error writing the audit record")
+	}
+	return id, nil
+}
+
+// PurgeDeletedRecordsFromDB permanently removes the records that were soft deleted before the cutoff
+// Records of people under legal hold and the records referencing them are kept until the hold is released
+func (r *Repository) PurgeDeletedRecordsFromDB(ctx context.Context, cutoff time.Time) error {
+	// A failing table does not keep the others from being purged
+	var errs []error
+	for _, t := range softDeleteTables {
+		query, args := purgeQuery(t, cutoff)
+		result, err := r.db.ExecContext(ctx, query, args...)
+		if err != nil {
+			errs = append(errs, utils.ErrorHandler(err, "Purge failed for "+t.name))
+			continue
+		}
+		purged, _ := result.RowsAffected()
+		if purged > 0 {
+			log.Printf("Purged %d deleted records from %s\n", purged, t.name)
+		}
+	}
+
+	// Watchers cannot resume from changes older than the retention period
+	_, err := r.db.ExecContext(ctx, "DELETE FROM changes WHERE at < ?", columnValue(cutoff))
+	if err != nil {
+		errs = append(errs, utils.ErrorHandler(err, "Purge failed for changes"))
+	}
+	return errors.Join(errs...)
+}
+
+// purgeQuery builds the delete of the records of a table that were soft deleted before the cutoff and are not held
+// A record is held when it is the own record of a held person or one of its person references holds their ID
+func purgeQuery(t *table, cutoff time.Time) (string, []any) {
+	conditions := []string{"deleted_at < ?"}
+	args := []any{columnValue(cutoff)}
+	held := func(personType pb.PersonType, column string) {
+		conditions = append(conditions, fmt.Sprintf("NOT EXISTS (SELECT 1 FROM legal_holds WHERE person_type = ? AND person_id = %s.%s)", t.name, column))
+		args = append(args, personTypeName(personType))
+	}
+
+	for personType, own := range personTables {
+		if own == t {
+			held(personType, "id")
+		}
+	}
+	for personType, references := range personReferences {
+		for _, reference := range references {
+			if reference.table == t {
+				held(personType, reference.column)
+			}
+		}
+	}
+	return fmt.Sprintf("DELETE FROM %s WHERE %s", t.name, strings.Join(conditions, " AND ")), args
+}
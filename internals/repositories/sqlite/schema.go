@@ -0,0 +1,528 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories/mongodb/migrations"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Columns are named after the bson names of the model fields so the mongodb filters apply unchanged,
+// timestamps are stored as fixed width UTC text which sorts and compares in time order
+// Empty fields are stored as NULL, which also keeps the unique columns optional like the partial mongodb indexes
+var schema = []string{
+	classesSchema,
+	teachingAssignmentsSchema,
+	`CREATE INDEX IF NOT EXISTS teaching_assignments_teacher_id ON teaching_assignments (teacher_id, subject)`,
+	`CREATE INDEX IF NOT EXISTS teaching_assignments_class_id ON teaching_assignments (class_id)`,
+	// A teacher holds each class and subject only once, homeroom assignments may come without a subject.
+	// Erased teachers all share the erased ID, so their assignments are left out
+	`CREATE UNIQUE INDEX IF NOT EXISTS teaching_assignments_unique ON teaching_assignments (teacher_id, class_id, IFNULL(subject, '')) WHERE deleted_at IS NULL AND teacher_id != 'erased'`,
+
+	studentsSchema,
+	`CREATE INDEX IF NOT EXISTS students_class_id ON students (class_id)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS students_email ON students (email) WHERE deleted_at IS NULL`,
+
+	`CREATE TABLE IF NOT EXISTS teachers (
+		id         TEXT PRIMARY KEY,
+		first_name TEXT,
+		last_name  TEXT,
+		email      TEXT,
+		subject    TEXT,
+		version    INTEGER NOT NULL DEFAULT 1,
+		deleted_at TEXT,
+		deleted_by TEXT,
+		created_at TEXT,
+		updated_at TEXT,
+		created_by TEXT,
+		updated_by TEXT
+	)`,
+
+	execsSchema,
+	`CREATE UNIQUE INDEX IF NOT EXISTS execs_email ON execs (email) WHERE deleted_at IS NULL`,
+
+	legalHoldsSchema,
+	privacyAuditSchema,
+	`CREATE INDEX IF NOT EXISTS privacy_audit_person ON privacy_audit (person_type, person_id)`,
+}
+
+// The email of a soft deleted student can be used again, so its uniqueness is kept by the partial index students_email
+const studentsSchema = `CREATE TABLE IF NOT EXISTS students (
+		id         TEXT PRIMARY KEY,
+		first_name TEXT,
+		last_name  TEXT,
+		email      TEXT,
+		class_id   TEXT REFERENCES classes (id) ON DELETE SET NULL,
+		version    INTEGER NOT NULL DEFAULT 1,
+		deleted_at TEXT,
+		deleted_by TEXT,
+		created_at TEXT,
+		updated_at TEXT,
+		created_by TEXT,
+		updated_by TEXT
+	)`
+
+// Like for students the email is kept unique by the partial index execs_email
+const execsSchema = `CREATE TABLE IF NOT EXISTS execs (
+		id                     TEXT PRIMARY KEY,
+		first_name             TEXT,
+		last_name              TEXT,
+		email                  TEXT,
+		username               TEXT UNIQUE,
+		password               TEXT,
+		role                   TEXT,
+		password_changed_at    TEXT,
+		password_reset_token   TEXT,
+		password_token_expires TEXT,
+		inactive_status        INTEGER CHECK (inactive_status IN (0, 1)),
+		version                INTEGER NOT NULL DEFAULT 1,
+		deleted_at             TEXT,
+		deleted_by             TEXT,
+		created_at             TEXT,
+		updated_at             TEXT,
+		created_by             TEXT,
+		updated_by             TEXT
+	)`
+
+// A grade has every section only once per academic year
+const classesSchema = `CREATE TABLE IF NOT EXISTS classes (
+		id                  TEXT PRIMARY KEY,
+		grade               INTEGER,
+		section             TEXT,
+		academic_year_id    TEXT,
+		homeroom_teacher_id TEXT,
+		capacity            INTEGER,
+		version             INTEGER NOT NULL DEFAULT 1,
+		deleted_at          TEXT,
+		deleted_by          TEXT,
+		created_at          TEXT,
+		updated_at          TEXT,
+		created_by          TEXT,
+		updated_by          TEXT,
+		UNIQUE (academic_year_id, grade, section)
+	)`
+
+const teachingAssignmentsSchema = `CREATE TABLE IF NOT EXISTS teaching_assignments (
+		id         TEXT PRIMARY KEY,
+		teacher_id TEXT,
+		class_id   TEXT,
+		subject    TEXT,
+		role       TEXT,
+		version    INTEGER NOT NULL DEFAULT 1,
+		deleted_at TEXT,
+		deleted_by TEXT,
+		created_at TEXT,
+		updated_at TEXT,
+		created_by TEXT,
+		updated_by TEXT
+	)`
+
+// Tables that stored the class as a free-form string
+var classStringTables = []string{"students", "teachers"}
+
+// createSchema creates the tables, indexes and change log triggers that do not exist yet
+func createSchema(ctx context.Context, db *sql.DB) error {
+	statements := append(slices.Clip(schema), changesSchema)
+	for _, t := range watchedTables {
+		statements = append(statements, changeTriggers(t)...)
+	}
+	for _, statement := range statements {
+		_, err := db.ExecContext(ctx, statement)
+		if err != nil {
+			return utils.ErrorHandler(err, "Error creating the sqlite schema")
+		}
+	}
+	return nil
+}
+
+// upgradeSchema brings tables created by an older version up to date, it runs before createSchema
+// Students and teachers used to store their class as a free-form string, which is converted into the classes table
+// following the same rules as the mongodb migrations. Teachers then referenced a single class by class_id,
+// which becomes a teaching assignment. Tables whose constraints changed are rebuilt
+func upgradeSchema(ctx context.Context, db *sql.DB) error {
+	// Classes named their academic year in academic_year. SQLite stores no academic years, so the names are kept as IDs
+	columns, err := tableColumns(ctx, db, "classes")
+	if err != nil {
+		return err
+	}
+	if columns["academic_year"] {
+		_, err = db.ExecContext(ctx, "ALTER TABLE classes RENAME COLUMN academic_year TO academic_year_id")
+		if err != nil {
+			return utils.ErrorHandler(err, "Error renaming the academic year of the classes")
+		}
+		log.Println("Renamed the academic_year column of the classes of the sqlite database to academic_year_id")
+	}
+
+	columns, err = tableColumns(ctx, db, "students")
+	if err != nil {
+		return err
+	}
+	if columns["class"] {
+		err = runInTransaction(ctx, db, true, func(q querier) error {
+			return convertClasses(ctx, q)
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error converting the class strings into classes")
+		}
+		log.Println("Converted the class strings of the sqlite database into classes")
+	}
+
+	columns, err = tableColumns(ctx, db, "teachers")
+	if err != nil {
+		return err
+	}
+	if columns["class_id"] {
+		err = runInTransaction(ctx, db, true, func(q querier) error {
+			return convertTeacherClasses(ctx, q)
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error converting the classes of the teachers into teaching assignments")
+		}
+		log.Println("Converted the classes of the teachers of the sqlite database into teaching assignments")
+	}
+
+	// Students did not reference their class through a foreign key, so a student may point to a class that is gone
+	columns, err = tableColumns(ctx, db, "students")
+	if err != nil {
+		return err
+	}
+	references, err := referencedTables(ctx, db, "students")
+	if err != nil {
+		return err
+	}
+	if len(columns) > 0 && !references["classes"] {
+		var cleared int64
+		err = runInTransaction(ctx, db, true, func(q querier) error {
+			result, err := q.ExecContext(ctx, "UPDATE students SET class_id = NULL WHERE class_id IS NOT NULL AND class_id NOT IN (SELECT id FROM classes)")
+			if err != nil {
+				return err
+			}
+			cleared, _ = result.RowsAffected()
+			return rebuildTable(ctx, q, studentsTable, studentsSchema)
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error rebuilding the students table")
+		}
+		log.Printf("Rebuilt the students table of the sqlite database with a foreign key to classes, %d students of unknown classes lost their class\n", cleared)
+	}
+
+	// Teaching assignments were only kept unique by the server and outlived their deleted teachers, both are ended before
+	// the unique index is created
+	exists, err := indexExists(ctx, db, "teaching_assignments_unique")
+	if err != nil {
+		return err
+	}
+	columns, err = tableColumns(ctx, db, "teaching_assignments")
+	if err != nil {
+		return err
+	}
+	if len(columns) > 0 && !exists {
+		err = runInTransaction(ctx, db, true, func(q querier) error {
+			return endStaleTeachingAssignments(ctx, q)
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error ending stale teaching assignments")
+		}
+	}
+
+	// Emails used to be unique columns, which also kept the emails of soft deleted records from being used again
+	for _, rebuild := range []struct {
+		table  *table
+		schema string
+	}{{studentsTable, studentsSchema}, {execsTable, execsSchema}} {
+		unique, err := uniqueColumns(ctx, db, rebuild.table.name)
+		if err != nil {
+			return err
+		}
+		if !unique["email"] {
+			continue
+		}
+		err = runInTransaction(ctx, db, true, func(q querier) error {
+			return rebuildTable(ctx, q, rebuild.table, rebuild.schema)
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, fmt.Sprintf("Error rebuilding the %s table", rebuild.table.name))
+		}
+		log.Printf("Rebuilt the %s table of the sqlite database without the unique email column\n", rebuild.table.name)
+	}
+
+	// The change log did not keep the records before an update, its update triggers are created again by createSchema
+	columns, err = tableColumns(ctx, db, "changes")
+	if err != nil {
+		return err
+	}
+	if len(columns) > 0 && !columns["old"] {
+		err = runInTransaction(ctx, db, true, func(q querier) error {
+			_, err := q.ExecContext(ctx, "ALTER TABLE changes ADD COLUMN old TEXT")
+			if err != nil {
+				return err
+			}
+			for _, t := range watchedTables {
+				_, err := q.ExecContext(ctx, fmt.Sprintf("DROP TRIGGER IF EXISTS %s_updated", t.name))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error adding the records before an update to the change log")
+		}
+	}
+
+	// Erasures did not record the files of submissions they deleted
+	columns, err = tableColumns(ctx, db, "privacy_audit")
+	if err != nil {
+		return err
+	}
+	if len(columns) > 0 && !columns["files_deleted"] {
+		_, err = db.ExecContext(ctx, "ALTER TABLE privacy_audit ADD COLUMN files_deleted INTEGER")
+		if err != nil {
+			return utils.ErrorHandler(err, "Error adding the deleted files to the privacy audit")
+		}
+	}
+	return nil
+}
+
+// uniqueColumns returns the columns of a table that are unique on their own through a UNIQUE column constraint
+func uniqueColumns(ctx context.Context, db *sql.DB, name string) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, `SELECT info.name FROM pragma_index_list(?) AS list, pragma_index_info(list.name) AS info
+		WHERE list.origin = 'u' AND (SELECT count(*) FROM pragma_index_info(list.name)) = 1`, name)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the sqlite schema")
+	}
+	defer rows.Close()
+
+	columns := map[string]bool{}
+	for rows.Next() {
+		var column string
+		err := rows.Scan(&column)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error reading the sqlite schema")
+		}
+		columns[column] = true
+	}
+	return columns, rows.Err()
+}
+
+// indexExists reports whether the sqlite schema holds an index of the given name
+func indexExists(ctx context.Context, db *sql.DB, name string) (bool, error) {
+	var count int
+	err := db.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master WHERE type = 'index' AND name = ?", name).Scan(&count)
+	if err != nil {
+		return false, utils.ErrorHandler(err, "Error reading the sqlite schema")
+	}
+	return count > 0, nil
+}
+
+// endStaleTeachingAssignments soft deletes the assignments of deleted teachers and every copy of an assignment but the oldest
+func endStaleTeachingAssignments(ctx context.Context, q querier) error {
+	now := columnValue(time.Now())
+	_, err := q.ExecContext(ctx, `UPDATE teaching_assignments SET deleted_at = ?, updated_at = ?, version = version + 1
+		WHERE deleted_at IS NULL AND (
+			teacher_id IN (SELECT id FROM teachers WHERE deleted_at IS NOT NULL)
+			OR teacher_id != 'erased' AND EXISTS (SELECT 1 FROM teaching_assignments AS older WHERE older.deleted_at IS NULL AND older.teacher_id = teaching_assignments.teacher_id
+				AND older.class_id = teaching_assignments.class_id AND IFNULL(older.subject, '') = IFNULL(teaching_assignments.subject, '')
+				AND (older.created_at < teaching_assignments.created_at OR (older.created_at = teaching_assignments.created_at AND older.id < teaching_assignments.id)))
+		)`, now, now)
+	return err
+}
+
+// referencedTables returns the tables a table references through foreign keys
+func referencedTables(ctx context.Context, db *sql.DB, name string) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, `SELECT "table" FROM pragma_foreign_key_list(?)`, name)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the sqlite schema")
+	}
+	defer rows.Close()
+
+	tables := map[string]bool{}
+	for rows.Next() {
+		var table string
+		err := rows.Scan(&table)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error reading the sqlite schema")
+		}
+		tables[table] = true
+	}
+	return tables, rows.Err()
+}
+
+// rebuildTable recreates a table with its current schema and copies its rows over, its indexes are created again by createSchema
+func rebuildTable(ctx context.Context, q querier, t *table, schema string) error {
+	_, err := q.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s RENAME TO %s_old", t.name, t.name))
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, schema)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s_old", t.name, t.columnList(), t.columnList(), t.name))
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, fmt.Sprintf("DROP TABLE %s_old", t.name))
+	return err
+}
+
+// tableColumns returns the names of the columns of a table, which are none when the table does not exist yet
+func tableColumns(ctx context.Context, db *sql.DB, name string) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, "SELECT name FROM pragma_table_info(?)", name)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error reading the sqlite schema")
+	}
+	defer rows.Close()
+
+	columns := map[string]bool{}
+	for rows.Next() {
+		var column string
+		err := rows.Scan(&column)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error reading the sqlite schema")
+		}
+		columns[column] = true
+	}
+	return columns, rows.Err()
+}
+
+func convertClasses(ctx context.Context, q querier) error {
+	_, err := q.ExecContext(ctx, classesSchema)
+	if err != nil {
+		return err
+	}
+	for _, name := range classStringTables {
+		_, err := q.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN class_id TEXT", name))
+		if err != nil {
+			return err
+		}
+	}
+
+	// The old strings of every class, keyed by the normalised string
+	spellings := map[string][]string{}
+	var keys []string
+	rows, err := q.QueryContext(ctx, "SELECT class FROM students WHERE class IS NOT NULL UNION SELECT class FROM teachers WHERE class IS NOT NULL")
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var class string
+		err := rows.Scan(&class)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		key := migrations.NormaliseClass(class)
+		if key == "" {
+			continue
+		}
+		if _, seen := spellings[key]; !seen {
+			keys = append(keys, key)
+		}
+		spellings[key] = append(spellings[key], class)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	now := time.Now()
+	// SQLite stores no academic years, the name of the current one stands in for its ID
+	academicYear := migrations.CurrentAcademicYear(now)
+	for _, key := range keys {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(spellings[key])), ", ")
+		args := make([]any, len(spellings[key]))
+		for i, class := range spellings[key] {
+			args[i] = class
+		}
+
+		grade, section := migrations.ParseClass(key)
+		class := &models.Class{Grade: grade, Section: section, AcademicYearId: academicYear, Version: 1, CreatedAt: now, UpdatedAt: now}
+
+		// The first teacher of the class becomes its homeroom teacher
+		err := q.QueryRowContext(ctx, fmt.Sprintf("SELECT id FROM teachers WHERE class IN (%s) AND deleted_at IS NULL ORDER BY id LIMIT 1", placeholders), args...).Scan(&class.HomeroomTeacherId)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		classId, err := insertEntity(ctx, q, classesTable, class)
+		if err != nil {
+			return err
+		}
+		for _, name := range classStringTables {
+			_, err := q.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET class_id = ? WHERE class IN (%s)", name, placeholders), append([]any{classId}, args...)...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range classStringTables {
+		_, err := q.ExecContext(ctx, fmt.Sprintf("DROP INDEX IF EXISTS %s_class", name))
+		if err != nil {
+			return err
+		}
+		_, err = q.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s DROP COLUMN class", name))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertTeacherClasses turns the class of every teacher into a teaching assignment of their subject in that class,
+// a homeroom one when the teacher is the homeroom teacher of the class
+func convertTeacherClasses(ctx context.Context, q querier) error {
+	_, err := q.ExecContext(ctx, teachingAssignmentsSchema)
+	if err != nil {
+		return err
+	}
+
+	rows, err := q.QueryContext(ctx, `SELECT teachers.id, teachers.class_id, teachers.subject, classes.homeroom_teacher_id = teachers.id
+		FROM teachers LEFT JOIN classes ON classes.id = teachers.class_id WHERE teachers.class_id IS NOT NULL ORDER BY teachers.id`)
+	if err != nil {
+		return err
+	}
+	var assignments []*models.TeachingAssignment
+	now := time.Now()
+	for rows.Next() {
+		var subject sql.NullString
+		var homeroom sql.NullBool
+		assignment := &models.TeachingAssignment{Role: pb.TeachingRole_SUBJECT_TEACHER.String(), Version: 1, CreatedAt: now, UpdatedAt: now}
+		err := rows.Scan(&assignment.TeacherId, &assignment.ClassId, &subject, &homeroom)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		assignment.Subject = subject.String
+		if homeroom.Bool {
+			assignment.Role = pb.TeachingRole_HOMEROOM_TEACHER.String()
+		}
+		assignments = append(assignments, assignment)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	for _, assignment := range assignments {
+		_, err := insertEntity(ctx, q, teachingAssignmentsTable, assignment)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = q.ExecContext(ctx, "DROP INDEX IF EXISTS teachers_class_id")
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, "ALTER TABLE teachers DROP COLUMN class_id")
+	return err
+}
@@ -0,0 +1,55 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/pkg/utils"
+	"context"
+	"database/sql"
+	"log"
+
+	_ "modernc.org/sqlite"
+)
+
+// Repository stores the records in an embedded SQLite database file, for deployments without a mongodb server
+type Repository struct {
+	db      *sql.DB
+	changes *changeBus
+}
+
+var _ repositories.Repository = (*Repository)(nil)
+
+// Open opens the database file at path, creating it and its schema when they do not exist yet
+func Open(ctx context.Context, path string) (*Repository, error) {
+	// WAL lets readers run next to a writer and the busy timeout makes concurrent writers wait for each other
+	db, err := sql.Open("sqlite", path+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)&_pragma=foreign_keys(1)")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Unable to open the sqlite database")
+	}
+
+	err = db.PingContext(ctx)
+	if err != nil {
+		db.Close()
+		return nil, utils.ErrorHandler(err, "Unable to open the sqlite database")
+	}
+
+	err = upgradeSchema(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	err = createSchema(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	log.Printf("Opened sqlite database %s successfully\n", path)
+	return &Repository{db: db, changes: newChangeBus(db)}, nil
+}
+
+// Close stops following the change log and closes the underlying database
+func (r *Repository) Close() error {
+	r.changes.close()
+	return r.db.Close()
+}
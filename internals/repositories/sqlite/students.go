@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (r *Repository) AddStudentsToDb(ctx context.Context, studentsFromReq []*pb.Student, ordered, atomic bool, actor string) ([]*pb.Student, []*pb.BulkItemResult, error) {
+	now := time.Now()
+	newStudents := make([]*models.Student, len(studentsFromReq))
+	for i, pbStudent := range studentsFromReq {
+		modelStudent := mongodb.MapPbStudentToModelStudent(pbStudent)
+		modelStudent.Version = 1
+		modelStudent.CreatedAt = now
+		modelStudent.UpdatedAt = now
+		modelStudent.CreatedBy = actor
+		modelStudent.UpdatedBy = actor
+		newStudents[i] = modelStudent
+	}
+
+	results, err := addEntities(ctx, r.db, studentsTable, newStudents, ordered, atomic, func(q querier, student *models.Student) error {
+		return claimSeat(ctx, q, student.ClassId)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedStudents []*pb.Student
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		addedStudents = append(addedStudents, mongodb.MapModelStudentToPbStudent(newStudents[i]))
+	}
+	return addedStudents, results, nil
+}
+
+func (r *Repository) GetStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page repositories.Page) ([]*pb.Student, error) {
+	modelStudents, err := getEntities[models.Student](ctx, r.db, studentsTable, sortOptions, filters, page)
+	if err != nil {
+		return nil, err
+	}
+
+	var students []*pb.Student
+	for _, modelStudent := range modelStudents {
+		students = append(students, mongodb.MapModelStudentToPbStudent(modelStudent))
+	}
+	return students, nil
+}
+
+func (r *Repository) StreamStudentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, page repositories.Page, fn func(*pb.Student) error) error {
+	return streamEntities(ctx, r.db, studentsTable, sortOptions, filters, page, mongodb.MapModelStudentToPbStudent, fn)
+}
+
+func (r *Repository) ModifyStudentsInDB(ctx context.Context, req *pb.UpdateStudentsRequest, actor string) ([]*pb.Student, error) {
+	// Students moving to another class take a seat in it
+	var check func(q querier, student *models.Student) error
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 || slices.Contains(paths, "class_id") {
+		check = func(q querier, student *models.Student) error {
+			return claimSeat(ctx, q, student.ClassId)
+		}
+	}
+	return modifyEntities(ctx, r.db, studentsTable, req.GetStudents(), req.GetAtomic(), paths, actor, mongodb.MapPbStudentToModelStudent, mongodb.MapModelStudentToPbStudent, nil, check)
+}
+
+func (r *Repository) DeleteStudentsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return deleteEntities(ctx, r.db, studentsTable, objectIdsToDelete, expectedVersions, actor, atomic, mongodb.MapModelStudentToPbStudent, nil, nil)
+}
+
+func (r *Repository) RestoreStudentsInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	// Restored students take their seat back, it may have been given away in the meantime
+	return restoreEntities(ctx, r.db, studentsTable, objIds, actor, func(q querier, restoredIds []string) error {
+		for _, id := range restoredIds {
+			student, err := findEntity[models.Student](ctx, q, studentsTable, id)
+			if err != nil {
+				return err
+			}
+			err = claimSeat(ctx, q, student.ClassId)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *Repository) GetStudentsByTeacherIdFromDB(ctx context.Context, teacherId, subject string) ([]*pb.Student, error) {
+	classIds, err := r.teacherClassIds(ctx, teacherId, subject)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetStudentsFromDB(ctx, nil, notDeleted(bson.M{"class_id": bson.M{"$in": classIds}}), repositories.Page{})
+}
+
+func (r *Repository) GetStudentCountByTeacherIdFromDB(ctx context.Context, teacherId, subject string) (int64, error) {
+	classIds, err := r.teacherClassIds(ctx, teacherId, subject)
+	if err != nil {
+		return 0, err
+	}
+
+	where, args, err := studentsTable.whereClause(notDeleted(bson.M{"class_id": bson.M{"$in": classIds}}))
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	var count int64
+	err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM students"+where, args...).Scan(&count)
+	if err != nil {
+		return 0, utils.ErrorHandler(err, "Internal error")
+	}
+	return count, nil
+}
+
+func (r *Repository) WatchStudentsInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Student, string, time.Time) error) error {
+	return watchEntities(ctx, r, studentsTable, filter, resumeToken, mongodb.MapModelStudentToPbStudent, send)
+}
@@ -0,0 +1,308 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Timestamps are stored in UTC with a fixed width so that text comparison matches time order
+const timeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// querier is satisfied by both *sql.DB and *sql.Tx, so the same code runs inside and outside a transaction
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// column maps a model field to the column it is stored in
+type column struct {
+	name  string
+	index int
+}
+
+// table describes how the models of an entity are stored
+type table struct {
+	name      string
+	entity    string
+	modelType reflect.Type
+	columns   []column
+	byName    map[string]column
+}
+
+var (
+	studentsTable            = newTable("students", "student", models.Student{})
+	teachersTable            = newTable("teachers", "teacher", models.Teacher{})
+	execsTable               = newTable("execs", "exec", models.Exec{})
+	classesTable             = newTable("classes", "class", models.Class{})
+	teachingAssignmentsTable = newTable("teaching_assignments", "teaching assignment", models.TeachingAssignment{})
+)
+
+// newTable derives the columns from the bson tags of the model, the '_id' field is stored in the 'id' column
+func newTable(name, entity string, model interface{}) *table {
+	modelType := reflect.TypeOf(model)
+	t := &table{name: name, entity: entity, modelType: modelType, byName: map[string]column{}}
+	for i := 0; i < modelType.NumField(); i++ {
+		columnName := columnFor(strings.Split(modelType.Field(i).Tag.Get("bson"), ",")[0])
+		col := column{name: columnName, index: i}
+		t.columns = append(t.columns, col)
+		t.byName[columnName] = col
+	}
+	return t
+}
+
+// columnFor returns the column a bson field name is stored in
+func columnFor(field string) string {
+	if field == "_id" {
+		return "id"
+	}
+	return field
+}
+
+func (t *table) columnList() string {
+	names := make([]string, len(t.columns))
+	for i, col := range t.columns {
+		names[i] = col.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// values returns the column values of a model in column order
+func (t *table) values(model interface{}) []any {
+	modelVal := reflect.ValueOf(model).Elem()
+	values := make([]any, len(t.columns))
+	for i, col := range t.columns {
+		values[i] = columnValue(modelVal.Field(col.index).Interface())
+	}
+	return values
+}
+
+// columnValue converts a model or filter value into the value stored in a column
+// Empty strings and times become NULL, the same way mongodb leaves out empty fields
+func columnValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	case time.Time:
+		if v.IsZero() {
+			return nil
+		}
+		return v.UTC().Format(timeLayout)
+	case primitive.DateTime:
+		return v.Time().UTC().Format(timeLayout)
+	case primitive.ObjectID:
+		return v.Hex()
+	case int32:
+		return int64(v)
+	}
+	return value
+}
+
+// scanEntity reads a row selected with columnList into a new model
+func scanEntity[M any](t *table, row interface{ Scan(...any) error }) (*M, error) {
+	model := new(M)
+	err := scanInto(t, row, reflect.ValueOf(model).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// scanModel reads a row selected with columnList into a new model of the table, for code that handles several tables
+func scanModel(t *table, row interface{ Scan(...any) error }) (any, error) {
+	model := reflect.New(t.modelType)
+	err := scanInto(t, row, model.Elem())
+	if err != nil {
+		return nil, err
+	}
+	return model.Interface(), nil
+}
+
+func scanInto(t *table, row interface{ Scan(...any) error }, modelVal reflect.Value) error {
+	raw := make([]any, len(t.columns))
+	dest := make([]any, len(t.columns))
+	for i := range raw {
+		dest[i] = &raw[i]
+	}
+	err := row.Scan(dest...)
+	if err != nil {
+		return err
+	}
+
+	for i, col := range t.columns {
+		if raw[i] == nil {
+			continue
+		}
+		field := modelVal.Field(col.index)
+		switch field.Interface().(type) {
+		case string:
+			field.SetString(fmt.Sprint(raw[i]))
+		case int64, int32:
+			value, ok := raw[i].(int64)
+			if !ok {
+				return fmt.Errorf("column %s holds %T, expected an integer", col.name, raw[i])
+			}
+			field.SetInt(value)
+		case bool:
+			switch value := raw[i].(type) {
+			case int64:
+				field.SetBool(value != 0)
+			case bool:
+				field.SetBool(value)
+			}
+		case time.Time:
+			value, err := time.Parse(timeLayout, fmt.Sprint(raw[i]))
+			if err != nil {
+				return fmt.Errorf("column %s holds an invalid time: %w", col.name, err)
+			}
+			field.Set(reflect.ValueOf(value))
+		}
+	}
+	return nil
+}
+
+// queryEntities runs a select of every column and reads all the returned rows
+func queryEntities[M any](ctx context.Context, q querier, t *table, query string, args ...any) ([]*M, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entities []*M
+	for rows.Next() {
+		entity, err := scanEntity[M](t, rows)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+	return entities, rows.Err()
+}
+
+// selectQuery builds the query reading the records matching the filter in the given order, limited to the page
+func (t *table) selectQuery(sortOptions bson.D, filter bson.M, page repositories.Page) (string, []any, error) {
+	where, args, err := t.whereClause(filter)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s%s%s", t.columnList(), t.name, where, t.orderClause(sortOptions))
+	if page.Size > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, page.Size, page.Skip())
+	}
+	return query, args, nil
+}
+
+// whereClause translates a mongodb filter into a SQL condition on the table
+// Only what the handlers produce is supported: equality, $exists, $in and the range operators
+func (t *table) whereClause(filter bson.M) (string, []any, error) {
+	// Sorting the fields keeps the generated SQL stable
+	fields := make([]string, 0, len(filter))
+	for field := range filter {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var conditions []string
+	var args []any
+	for _, field := range fields {
+		col, ok := t.byName[columnFor(field)]
+		if !ok {
+			return "", nil, fmt.Errorf("unknown field: %s", field)
+		}
+
+		operators, ok := filter[field].(bson.M)
+		if !ok {
+			conditions = append(conditions, col.name+" = ?")
+			args = append(args, columnValue(filter[field]))
+			continue
+		}
+
+		ops := make([]string, 0, len(operators))
+		for op := range operators {
+			ops = append(ops, op)
+		}
+		sort.Strings(ops)
+
+		for _, op := range ops {
+			operand := operators[op]
+			switch op {
+			case "$exists":
+				if exists, _ := operand.(bool); exists {
+					conditions = append(conditions, col.name+" IS NOT NULL")
+				} else {
+					conditions = append(conditions, col.name+" IS NULL")
+				}
+			case "$gt", "$gte", "$lt", "$lte":
+				comparison := map[string]string{"$gt": ">", "$gte": ">=", "$lt": "<", "$lte": "<="}[op]
+				conditions = append(conditions, fmt.Sprintf("%s %s ?", col.name, comparison))
+				args = append(args, columnValue(operand))
+			case "$in":
+				list := reflect.ValueOf(operand)
+				if list.Kind() != reflect.Slice {
+					return "", nil, fmt.Errorf("$in on %s needs a list", field)
+				}
+				if list.Len() == 0 {
+					conditions = append(conditions, "0")
+					continue
+				}
+				placeholders := make([]string, list.Len())
+				for i := 0; i < list.Len(); i++ {
+					placeholders[i] = "?"
+					args = append(args, columnValue(list.Index(i).Interface()))
+				}
+				conditions = append(conditions, fmt.Sprintf("%s IN (%s)", col.name, strings.Join(placeholders, ", ")))
+			default:
+				return "", nil, fmt.Errorf("unsupported filter operator %s on %s", op, field)
+			}
+		}
+	}
+
+	if len(conditions) == 0 {
+		return "", nil, nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args, nil
+}
+
+// orderClause translates mongodb sort options into an ORDER BY clause
+// Fields that are not columns are skipped, just like mongodb ignores sorting on fields no document has
+func (t *table) orderClause(sortOptions bson.D) string {
+	var terms []string
+	for _, option := range sortOptions {
+		col, ok := t.byName[columnFor(option.Key)]
+		if !ok {
+			continue
+		}
+		direction := "ASC"
+		if order, ok := option.Value.(int); ok && order < 0 {
+			direction = "DESC"
+		}
+		terms = append(terms, col.name+" "+direction)
+	}
+
+	if len(terms) == 0 {
+		return ""
+	}
+	return " ORDER BY " + strings.Join(terms, ", ")
+}
+
+// notDeleted restricts a filter to records that have not been soft deleted
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$exists": false}
+	return filter
+}
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (r *Repository) AddTeachersToDb(ctx context.Context, teachersFromReq []*pb.Teacher, ordered, atomic bool, actor string) ([]*pb.Teacher, []*pb.BulkItemResult, error) {
+	now := time.Now()
+	newTeachers := make([]*models.Teacher, len(teachersFromReq))
+	for i, pbTeacher := range teachersFromReq {
+		modelTeacher := mongodb.MapPbTeacherToModelTeacher(pbTeacher)
+		modelTeacher.Version = 1
+		modelTeacher.CreatedAt = now
+		modelTeacher.UpdatedAt = now
+		modelTeacher.CreatedBy = actor
+		modelTeacher.UpdatedBy = actor
+		newTeachers[i] = modelTeacher
+	}
+
+	results, err := addEntities(ctx, r.db, teachersTable, newTeachers, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedTeachers []*pb.Teacher
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		addedTeachers = append(addedTeachers, mongodb.MapModelTeacherToPbTeacher(newTeachers[i]))
+	}
+	return addedTeachers, results, nil
+}
+
+func (r *Repository) GetTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.Teacher, error) {
+	modelTeachers, err := getEntities[models.Teacher](ctx, r.db, teachersTable, sortOptions, filters, repositories.Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	var teachers []*pb.Teacher
+	for _, modelTeacher := range modelTeachers {
+		teachers = append(teachers, mongodb.MapModelTeacherToPbTeacher(modelTeacher))
+	}
+	return teachers, nil
+}
+
+func (r *Repository) StreamTeachersFromDB(ctx context.Context, sortOptions bson.D, filters bson.M, fn func(*pb.Teacher) error) error {
+	return streamEntities(ctx, r.db, teachersTable, sortOptions, filters, repositories.Page{}, mongodb.MapModelTeacherToPbTeacher, fn)
+}
+
+func (r *Repository) ModifyTeachersInDB(ctx context.Context, req *pb.UpdateTeachersRequest, actor string) ([]*pb.Teacher, error) {
+	return modifyEntities(ctx, r.db, teachersTable, req.GetTeachers(), req.GetAtomic(), req.GetUpdateMask().GetPaths(), actor, mongodb.MapPbTeacherToModelTeacher, mongodb.MapModelTeacherToPbTeacher, nil, nil)
+}
+
+func (r *Repository) DeleteTeachersFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return deleteEntities(ctx, r.db, teachersTable, objectIdsToDelete, expectedVersions, actor, atomic, mongodb.MapModelTeacherToPbTeacher, nil, func(q querier) error {
+		return endTeachingAssignments(ctx, q, objectIdsToDelete, actor)
+	})
+}
+
+func (r *Repository) RestoreTeachersInDB(ctx context.Context, objIds []primitive.ObjectID, actor string) ([]string, error) {
+	return restoreEntities(ctx, r.db, teachersTable, objIds, actor, nil)
+}
+
+func (r *Repository) WatchTeachersInDB(ctx context.Context, filter bson.M, resumeToken string, send func(pb.ChangeType, *pb.Teacher, string, time.Time) error) error {
+	return watchEntities(ctx, r, teachersTable, filter, resumeToken, mongodb.MapModelTeacherToPbTeacher, send)
+}
@@ -0,0 +1,112 @@
+package sqlite
+
+import (
+	"ClassConnectRPC/internals/models"
+	"ClassConnectRPC/internals/repositories"
+	"ClassConnectRPC/internals/repositories/mongodb"
+	"ClassConnectRPC/pkg/utils"
+	pb "ClassConnectRPC/proto/gen"
+	"context"
+	"database/sql"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (r *Repository) AddTeachingAssignmentsToDb(ctx context.Context, assignmentsFromReq []*pb.TeachingAssignment, ordered, atomic bool, actor string) ([]*pb.TeachingAssignment, []*pb.BulkItemResult, error) {
+	now := time.Now()
+	newAssignments := make([]*models.TeachingAssignment, len(assignmentsFromReq))
+	for i, pbAssignment := range assignmentsFromReq {
+		modelAssignment := mongodb.MapPbTeachingAssignmentToModelTeachingAssignment(pbAssignment)
+		modelAssignment.Version = 1
+		modelAssignment.CreatedAt = now
+		modelAssignment.UpdatedAt = now
+		modelAssignment.CreatedBy = actor
+		modelAssignment.UpdatedBy = actor
+		newAssignments[i] = modelAssignment
+	}
+
+	results, err := addEntities(ctx, r.db, teachingAssignmentsTable, newAssignments, ordered, atomic, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var addedAssignments []*pb.TeachingAssignment
+	for i, result := range results {
+		if result.ErrorCode != 0 {
+			continue
+		}
+		addedAssignments = append(addedAssignments, mongodb.MapModelTeachingAssignmentToPbTeachingAssignment(newAssignments[i]))
+	}
+	return addedAssignments, results, nil
+}
+
+func (r *Repository) GetTeachingAssignmentsFromDB(ctx context.Context, sortOptions bson.D, filters bson.M) ([]*pb.TeachingAssignment, error) {
+	modelAssignments, err := getEntities[models.TeachingAssignment](ctx, r.db, teachingAssignmentsTable, sortOptions, filters, repositories.Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []*pb.TeachingAssignment
+	for _, modelAssignment := range modelAssignments {
+		assignments = append(assignments, mongodb.MapModelTeachingAssignmentToPbTeachingAssignment(modelAssignment))
+	}
+	return assignments, nil
+}
+
+func (r *Repository) DeleteTeachingAssignmentsFromDB(ctx context.Context, objectIdsToDelete []primitive.ObjectID, expectedVersions []int64, actor string, atomic bool) ([]string, error) {
+	return deleteEntities(ctx, r.db, teachingAssignmentsTable, objectIdsToDelete, expectedVersions, actor, atomic, mongodb.MapModelTeachingAssignmentToPbTeachingAssignment, nil, nil)
+}
+
+// endTeachingAssignments soft deletes the assignments of deleted teachers, so they no longer hold on to their classes
+// Restoring a teacher does not bring them back, the teacher has to be assigned again
+func endTeachingAssignments(ctx context.Context, q querier, teacherIds []primitive.ObjectID, actor string) error {
+	ids, args := inClause(teacherIds)
+	now := columnValue(time.Now())
+	_, err := q.ExecContext(ctx, "UPDATE teaching_assignments SET deleted_at = ?, deleted_by = ?, updated_at = ?, updated_by = ?, version = version + 1 WHERE teacher_id IN "+ids+" AND deleted_at IS NULL",
+		append([]any{now, columnValue(actor), now, columnValue(actor)}, args...)...)
+	return err
+}
+
+// teacherClassIds returns the IDs of the classes a teacher that is not deleted is assigned to, limited to the subject when it is not empty
+func (r *Repository) teacherClassIds(ctx context.Context, teacherId, subject string) ([]string, error) {
+	objId, err := primitive.ObjectIDFromHex(teacherId)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Invalid ID")
+	}
+
+	_, err = findEntity[models.Teacher](ctx, r.db, teachersTable, objId.Hex())
+	if err == sql.ErrNoRows {
+		return nil, utils.ErrorHandler(err, "Teacher with given ID not found")
+	}
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+
+	query := "SELECT DISTINCT class_id FROM teaching_assignments WHERE teacher_id = ? AND class_id IS NOT NULL AND deleted_at IS NULL"
+	args := []any{objId.Hex()}
+	if subject != "" {
+		query += " AND subject = ?"
+		args = append(args, subject)
+	}
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	defer rows.Close()
+
+	classIds := []string{}
+	for rows.Next() {
+		var classId string
+		err := rows.Scan(&classId)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Internal error")
+		}
+		classIds = append(classIds, classId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal error")
+	}
+	return classIds, nil
+}
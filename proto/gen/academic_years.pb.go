@@ -0,0 +1,2112 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: academic_years.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PromotionOutcome int32
+
+const (
+	// PROMOTION_OUTCOME_UNSPECIFIED is rejected, it keeps an exception without an outcome from reading as a promotion
+	PromotionOutcome_PROMOTION_OUTCOME_UNSPECIFIED PromotionOutcome = 0
+	// PROMOTED students move up to a class of the next grade
+	PromotionOutcome_PROMOTED PromotionOutcome = 1
+	// RETAINED students repeat their grade in a class of the next academic year
+	PromotionOutcome_RETAINED PromotionOutcome = 2
+	// GRADUATED and TRANSFERRED students leave the school and no longer belong to a class
+	PromotionOutcome_GRADUATED   PromotionOutcome = 3
+	PromotionOutcome_TRANSFERRED PromotionOutcome = 4
+)
+
+// Enum value maps for PromotionOutcome.
+var (
+	PromotionOutcome_name = map[int32]string{
+		0: "PROMOTION_OUTCOME_UNSPECIFIED",
+		1: "PROMOTED",
+		2: "RETAINED",
+		3: "GRADUATED",
+		4: "TRANSFERRED",
+	}
+	PromotionOutcome_value = map[string]int32{
+		"PROMOTION_OUTCOME_UNSPECIFIED": 0,
+		"PROMOTED":                      1,
+		"RETAINED":                      2,
+		"GRADUATED":                     3,
+		"TRANSFERRED":                   4,
+	}
+)
+
+func (x PromotionOutcome) Enum() *PromotionOutcome {
+	p := new(PromotionOutcome)
+	*p = x
+	return p
+}
+
+func (x PromotionOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromotionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_academic_years_proto_enumTypes[0].Descriptor()
+}
+
+func (PromotionOutcome) Type() protoreflect.EnumType {
+	return &file_academic_years_proto_enumTypes[0]
+}
+
+func (x PromotionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromotionOutcome.Descriptor instead.
+func (PromotionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{0}
+}
+
+// AcademicYear is a school year, classes reference it through their academic_year_id
+type AcademicYear struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name such as 2025-2026, unique among the academic years
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted academic years, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,7,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcademicYear) Reset() {
+	*x = AcademicYear{}
+	mi := &file_academic_years_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicYear) ProtoMessage() {}
+
+func (x *AcademicYear) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicYear.ProtoReflect.Descriptor instead.
+func (*AcademicYear) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcademicYear) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AcademicYear) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AcademicYear) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AcademicYear) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type AcademicYears struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYears []*AcademicYear        `protobuf:"bytes,1,rep,name=academic_years,json=academicYears,proto3" json:"academic_years,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcademicYears) Reset() {
+	*x = AcademicYears{}
+	mi := &file_academic_years_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicYears) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicYears) ProtoMessage() {}
+
+func (x *AcademicYears) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicYears.ProtoReflect.Descriptor instead.
+func (*AcademicYears) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcademicYears) GetAcademicYears() []*AcademicYear {
+	if x != nil {
+		return x.AcademicYears
+	}
+	return nil
+}
+
+type AddAcademicYearsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYears []*AcademicYear        `protobuf:"bytes,1,rep,name=academic_years,json=academicYears,proto3" json:"academic_years,omitempty"`
+	// ordered stops inserting at the first failing academic year, otherwise every valid academic year is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all academic years in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAcademicYearsRequest) Reset() {
+	*x = AddAcademicYearsRequest{}
+	mi := &file_academic_years_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAcademicYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAcademicYearsRequest) ProtoMessage() {}
+
+func (x *AddAcademicYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAcademicYearsRequest.ProtoReflect.Descriptor instead.
+func (*AddAcademicYearsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddAcademicYearsRequest) GetAcademicYears() []*AcademicYear {
+	if x != nil {
+		return x.AcademicYears
+	}
+	return nil
+}
+
+func (x *AddAcademicYearsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddAcademicYearsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddAcademicYearsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYears []*AcademicYear        `protobuf:"bytes,1,rep,name=academic_years,json=academicYears,proto3" json:"academic_years,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAcademicYearsResponse) Reset() {
+	*x = AddAcademicYearsResponse{}
+	mi := &file_academic_years_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAcademicYearsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAcademicYearsResponse) ProtoMessage() {}
+
+func (x *AddAcademicYearsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAcademicYearsResponse.ProtoReflect.Descriptor instead.
+func (*AddAcademicYearsResponse) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddAcademicYearsResponse) GetAcademicYears() []*AcademicYear {
+	if x != nil {
+		return x.AcademicYears
+	}
+	return nil
+}
+
+func (x *AddAcademicYearsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetAcademicYearsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only academic years matching the non-empty fields of the filter are returned
+	AcademicYear *AcademicYear `protobuf:"bytes,1,opt,name=academic_year,json=academicYear,proto3" json:"academic_year,omitempty"`
+	SortBy       []*SortField  `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted academic years
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAcademicYearsRequest) Reset() {
+	*x = GetAcademicYearsRequest{}
+	mi := &file_academic_years_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcademicYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcademicYearsRequest) ProtoMessage() {}
+
+func (x *GetAcademicYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcademicYearsRequest.ProtoReflect.Descriptor instead.
+func (*GetAcademicYearsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAcademicYearsRequest) GetAcademicYear() *AcademicYear {
+	if x != nil {
+		return x.AcademicYear
+	}
+	return nil
+}
+
+func (x *GetAcademicYearsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetAcademicYearsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateAcademicYearsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AcademicYears []*AcademicYear        `protobuf:"bytes,1,rep,name=academic_years,json=academicYears,proto3" json:"academic_years,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every academic year, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAcademicYearsRequest) Reset() {
+	*x = UpdateAcademicYearsRequest{}
+	mi := &file_academic_years_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAcademicYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAcademicYearsRequest) ProtoMessage() {}
+
+func (x *UpdateAcademicYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAcademicYearsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAcademicYearsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAcademicYearsRequest) GetAcademicYears() []*AcademicYear {
+	if x != nil {
+		return x.AcademicYears
+	}
+	return nil
+}
+
+func (x *UpdateAcademicYearsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateAcademicYearsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type AcademicYearId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the academic year, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcademicYearId) Reset() {
+	*x = AcademicYearId{}
+	mi := &file_academic_years_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcademicYearId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcademicYearId) ProtoMessage() {}
+
+func (x *AcademicYearId) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcademicYearId.ProtoReflect.Descriptor instead.
+func (*AcademicYearId) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcademicYearId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcademicYearId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteAcademicYearsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*AcademicYearId      `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all academic years in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAcademicYearsRequest) Reset() {
+	*x = DeleteAcademicYearsRequest{}
+	mi := &file_academic_years_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAcademicYearsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAcademicYearsRequest) ProtoMessage() {}
+
+func (x *DeleteAcademicYearsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAcademicYearsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAcademicYearsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAcademicYearsRequest) GetIds() []*AcademicYearId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteAcademicYearsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteAcademicYearsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAcademicYearsConfirmation) Reset() {
+	*x = DeleteAcademicYearsConfirmation{}
+	mi := &file_academic_years_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAcademicYearsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAcademicYearsConfirmation) ProtoMessage() {}
+
+func (x *DeleteAcademicYearsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAcademicYearsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteAcademicYearsConfirmation) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAcademicYearsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAcademicYearsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+// Term is a part of an academic year, the terms of a year do not overlap
+type Term struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AcademicYearId string                 `protobuf:"bytes,2,opt,name=academic_year_id,json=academicYearId,proto3" json:"academic_year_id,omitempty"`
+	// name such as Autumn, unique within the academic year
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted terms, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,8,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Term) Reset() {
+	*x = Term{}
+	mi := &file_academic_years_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Term) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Term) ProtoMessage() {}
+
+func (x *Term) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Term.ProtoReflect.Descriptor instead.
+func (*Term) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Term) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Term) GetAcademicYearId() string {
+	if x != nil {
+		return x.AcademicYearId
+	}
+	return ""
+}
+
+func (x *Term) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Term) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Term) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Term) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Term) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Term) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Term) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Term) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Term) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Term) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Terms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []*Term                `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Terms) Reset() {
+	*x = Terms{}
+	mi := &file_academic_years_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Terms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Terms) ProtoMessage() {}
+
+func (x *Terms) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Terms.ProtoReflect.Descriptor instead.
+func (*Terms) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Terms) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type AddTermsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Terms []*Term                `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	// ordered stops inserting at the first failing term, otherwise every valid term is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all terms in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTermsRequest) Reset() {
+	*x = AddTermsRequest{}
+	mi := &file_academic_years_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTermsRequest) ProtoMessage() {}
+
+func (x *AddTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTermsRequest.ProtoReflect.Descriptor instead.
+func (*AddTermsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddTermsRequest) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *AddTermsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddTermsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []*Term                `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTermsResponse) Reset() {
+	*x = AddTermsResponse{}
+	mi := &file_academic_years_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTermsResponse) ProtoMessage() {}
+
+func (x *AddTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTermsResponse.ProtoReflect.Descriptor instead.
+func (*AddTermsResponse) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddTermsResponse) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *AddTermsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetTermsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only terms matching the non-empty fields of the filter are returned
+	Term   *Term        `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	SortBy []*SortField `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted terms
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTermsRequest) Reset() {
+	*x = GetTermsRequest{}
+	mi := &file_academic_years_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTermsRequest) ProtoMessage() {}
+
+func (x *GetTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTermsRequest.ProtoReflect.Descriptor instead.
+func (*GetTermsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTermsRequest) GetTerm() *Term {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+func (x *GetTermsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetTermsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateTermsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Terms []*Term                `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every term, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTermsRequest) Reset() {
+	*x = UpdateTermsRequest{}
+	mi := &file_academic_years_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTermsRequest) ProtoMessage() {}
+
+func (x *UpdateTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTermsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTermsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateTermsRequest) GetTerms() []*Term {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *UpdateTermsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateTermsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type TermId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the term, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermId) Reset() {
+	*x = TermId{}
+	mi := &file_academic_years_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermId) ProtoMessage() {}
+
+func (x *TermId) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermId.ProtoReflect.Descriptor instead.
+func (*TermId) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TermId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TermId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteTermsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*TermId              `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all terms in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTermsRequest) Reset() {
+	*x = DeleteTermsRequest{}
+	mi := &file_academic_years_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTermsRequest) ProtoMessage() {}
+
+func (x *DeleteTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTermsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTermsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteTermsRequest) GetIds() []*TermId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteTermsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteTermsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTermsConfirmation) Reset() {
+	*x = DeleteTermsConfirmation{}
+	mi := &file_academic_years_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTermsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTermsConfirmation) ProtoMessage() {}
+
+func (x *DeleteTermsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTermsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteTermsConfirmation) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteTermsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteTermsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+// ClassMembership records the class a student was in during an academic year and where the student went afterwards
+// The students of the current academic year are found through their class_id, memberships are kept once a year is over
+type ClassMembership struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// academic_year_id is the ID of the academic year of the class
+	AcademicYearId string           `protobuf:"bytes,10,opt,name=academic_year_id,json=academicYearId,proto3" json:"academic_year_id,omitempty"`
+	Outcome        PromotionOutcome `protobuf:"varint,5,opt,name=outcome,proto3,enum=main.PromotionOutcome" json:"outcome,omitempty"`
+	// to_class_id is the class of the next academic year, it is empty for students who left the school
+	ToClassId     string                 `protobuf:"bytes,6,opt,name=to_class_id,json=toClassId,proto3" json:"to_class_id,omitempty"`
+	PromotionId   string                 `protobuf:"bytes,7,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassMembership) Reset() {
+	*x = ClassMembership{}
+	mi := &file_academic_years_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassMembership) ProtoMessage() {}
+
+func (x *ClassMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassMembership.ProtoReflect.Descriptor instead.
+func (*ClassMembership) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ClassMembership) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClassMembership) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ClassMembership) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassMembership) GetAcademicYearId() string {
+	if x != nil {
+		return x.AcademicYearId
+	}
+	return ""
+}
+
+func (x *ClassMembership) GetOutcome() PromotionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return PromotionOutcome_PROMOTION_OUTCOME_UNSPECIFIED
+}
+
+func (x *ClassMembership) GetToClassId() string {
+	if x != nil {
+		return x.ToClassId
+	}
+	return ""
+}
+
+func (x *ClassMembership) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+func (x *ClassMembership) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ClassMembership) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ClassMemberships struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memberships   []*ClassMembership     `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassMemberships) Reset() {
+	*x = ClassMemberships{}
+	mi := &file_academic_years_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassMemberships) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassMemberships) ProtoMessage() {}
+
+func (x *ClassMemberships) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassMemberships.ProtoReflect.Descriptor instead.
+func (*ClassMemberships) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ClassMemberships) GetMemberships() []*ClassMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+type GetClassMembershipsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only memberships matching the non-empty fields of the filter are returned
+	Membership    *ClassMembership `protobuf:"bytes,1,opt,name=membership,proto3" json:"membership,omitempty"`
+	SortBy        []*SortField     `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassMembershipsRequest) Reset() {
+	*x = GetClassMembershipsRequest{}
+	mi := &file_academic_years_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassMembershipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassMembershipsRequest) ProtoMessage() {}
+
+func (x *GetClassMembershipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassMembershipsRequest.ProtoReflect.Descriptor instead.
+func (*GetClassMembershipsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetClassMembershipsRequest) GetMembership() *ClassMembership {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+func (x *GetClassMembershipsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+// PromotionException changes what happens to a single student of a promotion
+type PromotionException struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Outcome   PromotionOutcome       `protobuf:"varint,2,opt,name=outcome,proto3,enum=main.PromotionOutcome" json:"outcome,omitempty"`
+	// class_id optionally names the class of the next academic year for a PROMOTED or RETAINED student
+	ClassId       string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionException) Reset() {
+	*x = PromotionException{}
+	mi := &file_academic_years_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionException) ProtoMessage() {}
+
+func (x *PromotionException) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionException.ProtoReflect.Descriptor instead.
+func (*PromotionException) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PromotionException) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *PromotionException) GetOutcome() PromotionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return PromotionOutcome_PROMOTION_OUTCOME_UNSPECIFIED
+}
+
+func (x *PromotionException) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+type PromoteStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// grade whose students are moved, they come from its classes of from_academic_year_id
+	Grade              int32  `protobuf:"varint,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	FromAcademicYearId string `protobuf:"bytes,2,opt,name=from_academic_year_id,json=fromAcademicYearId,proto3" json:"from_academic_year_id,omitempty"`
+	ToAcademicYearId   string `protobuf:"bytes,3,opt,name=to_academic_year_id,json=toAcademicYearId,proto3" json:"to_academic_year_id,omitempty"`
+	// graduating marks the last grade of the school, its students graduate unless an exception says otherwise
+	Graduating bool `protobuf:"varint,4,opt,name=graduating,proto3" json:"graduating,omitempty"`
+	// exceptions change the outcome or the class of single students
+	// Without one, students go to the class with the same section in the next grade, or graduate when graduating is set
+	Exceptions []*PromotionException `protobuf:"bytes,5,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	// dry_run returns the memberships the promotion would record without changing anything
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteStudentsRequest) Reset() {
+	*x = PromoteStudentsRequest{}
+	mi := &file_academic_years_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteStudentsRequest) ProtoMessage() {}
+
+func (x *PromoteStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteStudentsRequest.ProtoReflect.Descriptor instead.
+func (*PromoteStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PromoteStudentsRequest) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *PromoteStudentsRequest) GetFromAcademicYearId() string {
+	if x != nil {
+		return x.FromAcademicYearId
+	}
+	return ""
+}
+
+func (x *PromoteStudentsRequest) GetToAcademicYearId() string {
+	if x != nil {
+		return x.ToAcademicYearId
+	}
+	return ""
+}
+
+func (x *PromoteStudentsRequest) GetGraduating() bool {
+	if x != nil {
+		return x.Graduating
+	}
+	return false
+}
+
+func (x *PromoteStudentsRequest) GetExceptions() []*PromotionException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+func (x *PromoteStudentsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Promotion is a grade moved from one academic year to the next
+type Promotion struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Grade              int32                  `protobuf:"varint,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	FromAcademicYearId string                 `protobuf:"bytes,9,opt,name=from_academic_year_id,json=fromAcademicYearId,proto3" json:"from_academic_year_id,omitempty"`
+	ToAcademicYearId   string                 `protobuf:"bytes,10,opt,name=to_academic_year_id,json=toAcademicYearId,proto3" json:"to_academic_year_id,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy          string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// undone_at and undone_by are set once the promotion has been undone
+	UndoneAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=undone_at,json=undoneAt,proto3" json:"undone_at,omitempty"`
+	UndoneBy      string                 `protobuf:"bytes,8,opt,name=undone_by,json=undoneBy,proto3" json:"undone_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_academic_years_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Promotion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Promotion) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Promotion) GetFromAcademicYearId() string {
+	if x != nil {
+		return x.FromAcademicYearId
+	}
+	return ""
+}
+
+func (x *Promotion) GetToAcademicYearId() string {
+	if x != nil {
+		return x.ToAcademicYearId
+	}
+	return ""
+}
+
+func (x *Promotion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Promotion) GetUndoneAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UndoneAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetUndoneBy() string {
+	if x != nil {
+		return x.UndoneBy
+	}
+	return ""
+}
+
+type PromotionResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// promotion has no ID on a dry run
+	Promotion     *Promotion         `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	Memberships   []*ClassMembership `protobuf:"bytes,2,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionResult) Reset() {
+	*x = PromotionResult{}
+	mi := &file_academic_years_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionResult) ProtoMessage() {}
+
+func (x *PromotionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionResult.ProtoReflect.Descriptor instead.
+func (*PromotionResult) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PromotionResult) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+func (x *PromotionResult) GetMemberships() []*ClassMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+type PromotionId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionId) Reset() {
+	*x = PromotionId{}
+	mi := &file_academic_years_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionId) ProtoMessage() {}
+
+func (x *PromotionId) ProtoReflect() protoreflect.Message {
+	mi := &file_academic_years_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionId.ProtoReflect.Descriptor instead.
+func (*PromotionId) Descriptor() ([]byte, []int) {
+	return file_academic_years_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PromotionId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_academic_years_proto protoreflect.FileDescriptor
+
+const file_academic_years_proto_rawDesc = "" +
+	"\n" +
+	"\x14academic_years.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\"\xcc\x03\n" +
+	"\fAcademicYear\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\a \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\"J\n" +
+	"\rAcademicYears\x129\n" +
+	"\x0eacademic_years\x18\x01 \x03(\v2\x12.main.AcademicYearR\racademicYears\"\x86\x01\n" +
+	"\x17AddAcademicYearsRequest\x129\n" +
+	"\x0eacademic_years\x18\x01 \x03(\v2\x12.main.AcademicYearR\racademicYears\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"\x85\x01\n" +
+	"\x18AddAcademicYearsResponse\x129\n" +
+	"\x0eacademic_years\x18\x01 \x03(\v2\x12.main.AcademicYearR\racademicYears\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\xa5\x01\n" +
+	"\x17GetAcademicYearsRequest\x127\n" +
+	"\racademic_year\x18\x01 \x01(\v2\x12.main.AcademicYearR\facademicYear\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\xac\x01\n" +
+	"\x1aUpdateAcademicYearsRequest\x129\n" +
+	"\x0eacademic_years\x18\x01 \x03(\v2\x12.main.AcademicYearR\racademicYears\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\":\n" +
+	"\x0eAcademicYearId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\\\n" +
+	"\x1aDeleteAcademicYearsRequest\x12&\n" +
+	"\x03ids\x18\x01 \x03(\v2\x14.main.AcademicYearIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"Z\n" +
+	"\x1fDeleteAcademicYearsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xee\x03\n" +
+	"\x04Term\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x10academic_year_id\x18\x02 \x01(\tR\x0eacademicYearId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\b \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\")\n" +
+	"\x05Terms\x12 \n" +
+	"\x05terms\x18\x01 \x03(\v2\n" +
+	".main.TermR\x05terms\"e\n" +
+	"\x0fAddTermsRequest\x12 \n" +
+	"\x05terms\x18\x01 \x03(\v2\n" +
+	".main.TermR\x05terms\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"d\n" +
+	"\x10AddTermsResponse\x12 \n" +
+	"\x05terms\x18\x01 \x03(\v2\n" +
+	".main.TermR\x05terms\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x84\x01\n" +
+	"\x0fGetTermsRequest\x12\x1e\n" +
+	"\x04term\x18\x01 \x01(\v2\n" +
+	".main.TermR\x04term\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\x8b\x01\n" +
+	"\x12UpdateTermsRequest\x12 \n" +
+	"\x05terms\x18\x01 \x03(\v2\n" +
+	".main.TermR\x05terms\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"2\n" +
+	"\x06TermId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"L\n" +
+	"\x12DeleteTermsRequest\x12\x1e\n" +
+	"\x03ids\x18\x01 \x03(\v2\f.main.TermIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"R\n" +
+	"\x17DeleteTermsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xe9\x02\n" +
+	"\x0fClassMembership\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\tR\aclassId\x12(\n" +
+	"\x10academic_year_id\x18\n" +
+	" \x01(\tR\x0eacademicYearId\x120\n" +
+	"\aoutcome\x18\x05 \x01(\x0e2\x16.main.PromotionOutcomeR\aoutcome\x12\x1e\n" +
+	"\vto_class_id\x18\x06 \x01(\tR\ttoClassId\x12!\n" +
+	"\fpromotion_id\x18\a \x01(\tR\vpromotionId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedByJ\x04\b\x04\x10\x05R\racademic_year\"K\n" +
+	"\x10ClassMemberships\x127\n" +
+	"\vmemberships\x18\x01 \x03(\v2\x15.main.ClassMembershipR\vmemberships\"}\n" +
+	"\x1aGetClassMembershipsRequest\x125\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2\x15.main.ClassMembershipR\n" +
+	"membership\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\x80\x01\n" +
+	"\x12PromotionException\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x120\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2\x16.main.PromotionOutcomeR\aoutcome\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\tR\aclassId\"\x83\x02\n" +
+	"\x16PromoteStudentsRequest\x12\x14\n" +
+	"\x05grade\x18\x01 \x01(\x05R\x05grade\x121\n" +
+	"\x15from_academic_year_id\x18\x02 \x01(\tR\x12fromAcademicYearId\x12-\n" +
+	"\x13to_academic_year_id\x18\x03 \x01(\tR\x10toAcademicYearId\x12\x1e\n" +
+	"\n" +
+	"graduating\x18\x04 \x01(\bR\n" +
+	"graduating\x128\n" +
+	"\n" +
+	"exceptions\x18\x05 \x03(\v2\x18.main.PromotionExceptionR\n" +
+	"exceptions\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\xf5\x02\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\x05R\x05grade\x121\n" +
+	"\x15from_academic_year_id\x18\t \x01(\tR\x12fromAcademicYearId\x12-\n" +
+	"\x13to_academic_year_id\x18\n" +
+	" \x01(\tR\x10toAcademicYearId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x127\n" +
+	"\tundone_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bundoneAt\x12\x1b\n" +
+	"\tundone_by\x18\b \x01(\tR\bundoneByJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x12from_academic_yearR\x10to_academic_year\"y\n" +
+	"\x0fPromotionResult\x12-\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x0f.main.PromotionR\tpromotion\x127\n" +
+	"\vmemberships\x18\x02 \x03(\v2\x15.main.ClassMembershipR\vmemberships\"\x1d\n" +
+	"\vPromotionId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*q\n" +
+	"\x10PromotionOutcome\x12!\n" +
+	"\x1dPROMOTION_OUTCOME_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bPROMOTED\x10\x01\x12\f\n" +
+	"\bRETAINED\x10\x02\x12\r\n" +
+	"\tGRADUATED\x10\x03\x12\x0f\n" +
+	"\vTRANSFERRED\x10\x042\x9c\x06\n" +
+	"\x14AcademicYearsService\x12Q\n" +
+	"\x10AddAcademicYears\x12\x1d.main.AddAcademicYearsRequest\x1a\x1e.main.AddAcademicYearsResponse\x12F\n" +
+	"\x10GetAcademicYears\x12\x1d.main.GetAcademicYearsRequest\x1a\x13.main.AcademicYears\x12L\n" +
+	"\x13UpdateAcademicYears\x12 .main.UpdateAcademicYearsRequest\x1a\x13.main.AcademicYears\x12^\n" +
+	"\x13DeleteAcademicYears\x12 .main.DeleteAcademicYearsRequest\x1a%.main.DeleteAcademicYearsConfirmation\x129\n" +
+	"\bAddTerms\x12\x15.main.AddTermsRequest\x1a\x16.main.AddTermsResponse\x12.\n" +
+	"\bGetTerms\x12\x15.main.GetTermsRequest\x1a\v.main.Terms\x124\n" +
+	"\vUpdateTerms\x12\x18.main.UpdateTermsRequest\x1a\v.main.Terms\x12F\n" +
+	"\vDeleteTerms\x12\x18.main.DeleteTermsRequest\x1a\x1d.main.DeleteTermsConfirmation\x12O\n" +
+	"\x13GetClassMemberships\x12 .main.GetClassMembershipsRequest\x1a\x16.main.ClassMemberships\x12F\n" +
+	"\x0fPromoteStudents\x12\x1c.main.PromoteStudentsRequest\x1a\x15.main.PromotionResult\x129\n" +
+	"\rUndoPromotion\x12\x11.main.PromotionId\x1a\x15.main.PromotionResultB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_academic_years_proto_rawDescOnce sync.Once
+	file_academic_years_proto_rawDescData []byte
+)
+
+func file_academic_years_proto_rawDescGZIP() []byte {
+	file_academic_years_proto_rawDescOnce.Do(func() {
+		file_academic_years_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_academic_years_proto_rawDesc), len(file_academic_years_proto_rawDesc)))
+	})
+	return file_academic_years_proto_rawDescData
+}
+
+var file_academic_years_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_academic_years_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_academic_years_proto_goTypes = []any{
+	(PromotionOutcome)(0),                   // 0: main.PromotionOutcome
+	(*AcademicYear)(nil),                    // 1: main.AcademicYear
+	(*AcademicYears)(nil),                   // 2: main.AcademicYears
+	(*AddAcademicYearsRequest)(nil),         // 3: main.AddAcademicYearsRequest
+	(*AddAcademicYearsResponse)(nil),        // 4: main.AddAcademicYearsResponse
+	(*GetAcademicYearsRequest)(nil),         // 5: main.GetAcademicYearsRequest
+	(*UpdateAcademicYearsRequest)(nil),      // 6: main.UpdateAcademicYearsRequest
+	(*AcademicYearId)(nil),                  // 7: main.AcademicYearId
+	(*DeleteAcademicYearsRequest)(nil),      // 8: main.DeleteAcademicYearsRequest
+	(*DeleteAcademicYearsConfirmation)(nil), // 9: main.DeleteAcademicYearsConfirmation
+	(*Term)(nil),                            // 10: main.Term
+	(*Terms)(nil),                           // 11: main.Terms
+	(*AddTermsRequest)(nil),                 // 12: main.AddTermsRequest
+	(*AddTermsResponse)(nil),                // 13: main.AddTermsResponse
+	(*GetTermsRequest)(nil),                 // 14: main.GetTermsRequest
+	(*UpdateTermsRequest)(nil),              // 15: main.UpdateTermsRequest
+	(*TermId)(nil),                          // 16: main.TermId
+	(*DeleteTermsRequest)(nil),              // 17: main.DeleteTermsRequest
+	(*DeleteTermsConfirmation)(nil),         // 18: main.DeleteTermsConfirmation
+	(*ClassMembership)(nil),                 // 19: main.ClassMembership
+	(*ClassMemberships)(nil),                // 20: main.ClassMemberships
+	(*GetClassMembershipsRequest)(nil),      // 21: main.GetClassMembershipsRequest
+	(*PromotionException)(nil),              // 22: main.PromotionException
+	(*PromoteStudentsRequest)(nil),          // 23: main.PromoteStudentsRequest
+	(*Promotion)(nil),                       // 24: main.Promotion
+	(*PromotionResult)(nil),                 // 25: main.PromotionResult
+	(*PromotionId)(nil),                     // 26: main.PromotionId
+	(*timestamppb.Timestamp)(nil),           // 27: google.protobuf.Timestamp
+	(*BulkItemResult)(nil),                  // 28: main.BulkItemResult
+	(*SortField)(nil),                       // 29: main.SortField
+	(*fieldmaskpb.FieldMask)(nil),           // 30: google.protobuf.FieldMask
+}
+var file_academic_years_proto_depIdxs = []int32{
+	27, // 0: main.AcademicYear.start_date:type_name -> google.protobuf.Timestamp
+	27, // 1: main.AcademicYear.end_date:type_name -> google.protobuf.Timestamp
+	27, // 2: main.AcademicYear.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 3: main.AcademicYear.created_at:type_name -> google.protobuf.Timestamp
+	27, // 4: main.AcademicYear.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: main.AcademicYears.academic_years:type_name -> main.AcademicYear
+	1,  // 6: main.AddAcademicYearsRequest.academic_years:type_name -> main.AcademicYear
+	1,  // 7: main.AddAcademicYearsResponse.academic_years:type_name -> main.AcademicYear
+	28, // 8: main.AddAcademicYearsResponse.results:type_name -> main.BulkItemResult
+	1,  // 9: main.GetAcademicYearsRequest.academic_year:type_name -> main.AcademicYear
+	29, // 10: main.GetAcademicYearsRequest.sort_by:type_name -> main.SortField
+	1,  // 11: main.UpdateAcademicYearsRequest.academic_years:type_name -> main.AcademicYear
+	30, // 12: main.UpdateAcademicYearsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 13: main.DeleteAcademicYearsRequest.ids:type_name -> main.AcademicYearId
+	27, // 14: main.Term.start_date:type_name -> google.protobuf.Timestamp
+	27, // 15: main.Term.end_date:type_name -> google.protobuf.Timestamp
+	27, // 16: main.Term.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 17: main.Term.created_at:type_name -> google.protobuf.Timestamp
+	27, // 18: main.Term.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 19: main.Terms.terms:type_name -> main.Term
+	10, // 20: main.AddTermsRequest.terms:type_name -> main.Term
+	10, // 21: main.AddTermsResponse.terms:type_name -> main.Term
+	28, // 22: main.AddTermsResponse.results:type_name -> main.BulkItemResult
+	10, // 23: main.GetTermsRequest.term:type_name -> main.Term
+	29, // 24: main.GetTermsRequest.sort_by:type_name -> main.SortField
+	10, // 25: main.UpdateTermsRequest.terms:type_name -> main.Term
+	30, // 26: main.UpdateTermsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16, // 27: main.DeleteTermsRequest.ids:type_name -> main.TermId
+	0,  // 28: main.ClassMembership.outcome:type_name -> main.PromotionOutcome
+	27, // 29: main.ClassMembership.created_at:type_name -> google.protobuf.Timestamp
+	19, // 30: main.ClassMemberships.memberships:type_name -> main.ClassMembership
+	19, // 31: main.GetClassMembershipsRequest.membership:type_name -> main.ClassMembership
+	29, // 32: main.GetClassMembershipsRequest.sort_by:type_name -> main.SortField
+	0,  // 33: main.PromotionException.outcome:type_name -> main.PromotionOutcome
+	22, // 34: main.PromoteStudentsRequest.exceptions:type_name -> main.PromotionException
+	27, // 35: main.Promotion.created_at:type_name -> google.protobuf.Timestamp
+	27, // 36: main.Promotion.undone_at:type_name -> google.protobuf.Timestamp
+	24, // 37: main.PromotionResult.promotion:type_name -> main.Promotion
+	19, // 38: main.PromotionResult.memberships:type_name -> main.ClassMembership
+	3,  // 39: main.AcademicYearsService.AddAcademicYears:input_type -> main.AddAcademicYearsRequest
+	5,  // 40: main.AcademicYearsService.GetAcademicYears:input_type -> main.GetAcademicYearsRequest
+	6,  // 41: main.AcademicYearsService.UpdateAcademicYears:input_type -> main.UpdateAcademicYearsRequest
+	8,  // 42: main.AcademicYearsService.DeleteAcademicYears:input_type -> main.DeleteAcademicYearsRequest
+	12, // 43: main.AcademicYearsService.AddTerms:input_type -> main.AddTermsRequest
+	14, // 44: main.AcademicYearsService.GetTerms:input_type -> main.GetTermsRequest
+	15, // 45: main.AcademicYearsService.UpdateTerms:input_type -> main.UpdateTermsRequest
+	17, // 46: main.AcademicYearsService.DeleteTerms:input_type -> main.DeleteTermsRequest
+	21, // 47: main.AcademicYearsService.GetClassMemberships:input_type -> main.GetClassMembershipsRequest
+	23, // 48: main.AcademicYearsService.PromoteStudents:input_type -> main.PromoteStudentsRequest
+	26, // 49: main.AcademicYearsService.UndoPromotion:input_type -> main.PromotionId
+	4,  // 50: main.AcademicYearsService.AddAcademicYears:output_type -> main.AddAcademicYearsResponse
+	2,  // 51: main.AcademicYearsService.GetAcademicYears:output_type -> main.AcademicYears
+	2,  // 52: main.AcademicYearsService.UpdateAcademicYears:output_type -> main.AcademicYears
+	9,  // 53: main.AcademicYearsService.DeleteAcademicYears:output_type -> main.DeleteAcademicYearsConfirmation
+	13, // 54: main.AcademicYearsService.AddTerms:output_type -> main.AddTermsResponse
+	11, // 55: main.AcademicYearsService.GetTerms:output_type -> main.Terms
+	11, // 56: main.AcademicYearsService.UpdateTerms:output_type -> main.Terms
+	18, // 57: main.AcademicYearsService.DeleteTerms:output_type -> main.DeleteTermsConfirmation
+	20, // 58: main.AcademicYearsService.GetClassMemberships:output_type -> main.ClassMemberships
+	25, // 59: main.AcademicYearsService.PromoteStudents:output_type -> main.PromotionResult
+	25, // 60: main.AcademicYearsService.UndoPromotion:output_type -> main.PromotionResult
+	50, // [50:61] is the sub-list for method output_type
+	39, // [39:50] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_academic_years_proto_init() }
+func file_academic_years_proto_init() {
+	if File_academic_years_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_academic_years_proto_rawDesc), len(file_academic_years_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_academic_years_proto_goTypes,
+		DependencyIndexes: file_academic_years_proto_depIdxs,
+		EnumInfos:         file_academic_years_proto_enumTypes,
+		MessageInfos:      file_academic_years_proto_msgTypes,
+	}.Build()
+	File_academic_years_proto = out.File
+	file_academic_years_proto_goTypes = nil
+	file_academic_years_proto_depIdxs = nil
+}
@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: academic_years.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AcademicYearsService_AddAcademicYears_FullMethodName    = "/main.AcademicYearsService/AddAcademicYears"
+	AcademicYearsService_GetAcademicYears_FullMethodName    = "/main.AcademicYearsService/GetAcademicYears"
+	AcademicYearsService_UpdateAcademicYears_FullMethodName = "/main.AcademicYearsService/UpdateAcademicYears"
+	AcademicYearsService_DeleteAcademicYears_FullMethodName = "/main.AcademicYearsService/DeleteAcademicYears"
+	AcademicYearsService_AddTerms_FullMethodName            = "/main.AcademicYearsService/AddTerms"
+	AcademicYearsService_GetTerms_FullMethodName            = "/main.AcademicYearsService/GetTerms"
+	AcademicYearsService_UpdateTerms_FullMethodName         = "/main.AcademicYearsService/UpdateTerms"
+	AcademicYearsService_DeleteTerms_FullMethodName         = "/main.AcademicYearsService/DeleteTerms"
+	AcademicYearsService_GetClassMemberships_FullMethodName = "/main.AcademicYearsService/GetClassMemberships"
+	AcademicYearsService_PromoteStudents_FullMethodName     = "/main.AcademicYearsService/PromoteStudents"
+	AcademicYearsService_UndoPromotion_FullMethodName       = "/main.AcademicYearsService/UndoPromotion"
+)
+
+// AcademicYearsServiceClient is the client API for AcademicYearsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to academic years, their terms and moving students from one year to the next
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AcademicYearsServiceClient interface {
+	// AddAcademicYears adds new academic years
+	AddAcademicYears(ctx context.Context, in *AddAcademicYearsRequest, opts ...grpc.CallOption) (*AddAcademicYearsResponse, error)
+	// GetAcademicYears retrieves a list of academic years based on the provided criteria
+	GetAcademicYears(ctx context.Context, in *GetAcademicYearsRequest, opts ...grpc.CallOption) (*AcademicYears, error)
+	// UpdateAcademicYears updates existing academic years, their names cannot be changed
+	UpdateAcademicYears(ctx context.Context, in *UpdateAcademicYearsRequest, opts ...grpc.CallOption) (*AcademicYears, error)
+	// DeleteAcademicYears removes academic years by their IDs, years that terms or classes still reference are kept
+	DeleteAcademicYears(ctx context.Context, in *DeleteAcademicYearsRequest, opts ...grpc.CallOption) (*DeleteAcademicYearsConfirmation, error)
+	// AddTerms adds new terms to academic years
+	AddTerms(ctx context.Context, in *AddTermsRequest, opts ...grpc.CallOption) (*AddTermsResponse, error)
+	// GetTerms retrieves a list of terms based on the provided criteria
+	GetTerms(ctx context.Context, in *GetTermsRequest, opts ...grpc.CallOption) (*Terms, error)
+	// UpdateTerms updates existing terms, they stay in their academic year
+	UpdateTerms(ctx context.Context, in *UpdateTermsRequest, opts ...grpc.CallOption) (*Terms, error)
+	// DeleteTerms removes terms by their IDs
+	DeleteTerms(ctx context.Context, in *DeleteTermsRequest, opts ...grpc.CallOption) (*DeleteTermsConfirmation, error)
+	// GetClassMemberships retrieves the classes students were in during past academic years
+	GetClassMemberships(ctx context.Context, in *GetClassMembershipsRequest, opts ...grpc.CallOption) (*ClassMemberships, error)
+	// PromoteStudents moves the students of a grade to the classes of the next academic year
+	PromoteStudents(ctx context.Context, in *PromoteStudentsRequest, opts ...grpc.CallOption) (*PromotionResult, error)
+	// UndoPromotion moves the students of a promotion back to the classes they came from
+	UndoPromotion(ctx context.Context, in *PromotionId, opts ...grpc.CallOption) (*PromotionResult, error)
+}
+
+type academicYearsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAcademicYearsServiceClient(cc grpc.ClientConnInterface) AcademicYearsServiceClient {
+	return &academicYearsServiceClient{cc}
+}
+
+func (c *academicYearsServiceClient) AddAcademicYears(ctx context.Context, in *AddAcademicYearsRequest, opts ...grpc.CallOption) (*AddAcademicYearsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAcademicYearsResponse)
+	err := c.cc.Invoke(ctx, AcademicYearsService_AddAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) GetAcademicYears(ctx context.Context, in *GetAcademicYearsRequest, opts ...grpc.CallOption) (*AcademicYears, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcademicYears)
+	err := c.cc.Invoke(ctx, AcademicYearsService_GetAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) UpdateAcademicYears(ctx context.Context, in *UpdateAcademicYearsRequest, opts ...grpc.CallOption) (*AcademicYears, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcademicYears)
+	err := c.cc.Invoke(ctx, AcademicYearsService_UpdateAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) DeleteAcademicYears(ctx context.Context, in *DeleteAcademicYearsRequest, opts ...grpc.CallOption) (*DeleteAcademicYearsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAcademicYearsConfirmation)
+	err := c.cc.Invoke(ctx, AcademicYearsService_DeleteAcademicYears_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) AddTerms(ctx context.Context, in *AddTermsRequest, opts ...grpc.CallOption) (*AddTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTermsResponse)
+	err := c.cc.Invoke(ctx, AcademicYearsService_AddTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) GetTerms(ctx context.Context, in *GetTermsRequest, opts ...grpc.CallOption) (*Terms, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Terms)
+	err := c.cc.Invoke(ctx, AcademicYearsService_GetTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) UpdateTerms(ctx context.Context, in *UpdateTermsRequest, opts ...grpc.CallOption) (*Terms, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Terms)
+	err := c.cc.Invoke(ctx, AcademicYearsService_UpdateTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) DeleteTerms(ctx context.Context, in *DeleteTermsRequest, opts ...grpc.CallOption) (*DeleteTermsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTermsConfirmation)
+	err := c.cc.Invoke(ctx, AcademicYearsService_DeleteTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) GetClassMemberships(ctx context.Context, in *GetClassMembershipsRequest, opts ...grpc.CallOption) (*ClassMemberships, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassMemberships)
+	err := c.cc.Invoke(ctx, AcademicYearsService_GetClassMemberships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) PromoteStudents(ctx context.Context, in *PromoteStudentsRequest, opts ...grpc.CallOption) (*PromotionResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionResult)
+	err := c.cc.Invoke(ctx, AcademicYearsService_PromoteStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *academicYearsServiceClient) UndoPromotion(ctx context.Context, in *PromotionId, opts ...grpc.CallOption) (*PromotionResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionResult)
+	err := c.cc.Invoke(ctx, AcademicYearsService_UndoPromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AcademicYearsServiceServer is the server API for AcademicYearsService service.
+// All implementations must embed UnimplementedAcademicYearsServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to academic years, their terms and moving students from one year to the next
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AcademicYearsServiceServer interface {
+	// AddAcademicYears adds new academic years
+	AddAcademicYears(context.Context, *AddAcademicYearsRequest) (*AddAcademicYearsResponse, error)
+	// GetAcademicYears retrieves a list of academic years based on the provided criteria
+	GetAcademicYears(context.Context, *GetAcademicYearsRequest) (*AcademicYears, error)
+	// UpdateAcademicYears updates existing academic years, their names cannot be changed
+	UpdateAcademicYears(context.Context, *UpdateAcademicYearsRequest) (*AcademicYears, error)
+	// DeleteAcademicYears removes academic years by their IDs, years that terms or classes still reference are kept
+	DeleteAcademicYears(context.Context, *DeleteAcademicYearsRequest) (*DeleteAcademicYearsConfirmation, error)
+	// AddTerms adds new terms to academic years
+	AddTerms(context.Context, *AddTermsRequest) (*AddTermsResponse, error)
+	// GetTerms retrieves a list of terms based on the provided criteria
+	GetTerms(context.Context, *GetTermsRequest) (*Terms, error)
+	// UpdateTerms updates existing terms, they stay in their academic year
+	UpdateTerms(context.Context, *UpdateTermsRequest) (*Terms, error)
+	// DeleteTerms removes terms by their IDs
+	DeleteTerms(context.Context, *DeleteTermsRequest) (*DeleteTermsConfirmation, error)
+	// GetClassMemberships retrieves the classes students were in during past academic years
+	GetClassMemberships(context.Context, *GetClassMembershipsRequest) (*ClassMemberships, error)
+	// PromoteStudents moves the students of a grade to the classes of the next academic year
+	PromoteStudents(context.Context, *PromoteStudentsRequest) (*PromotionResult, error)
+	// UndoPromotion moves the students of a promotion back to the classes they came from
+	UndoPromotion(context.Context, *PromotionId) (*PromotionResult, error)
+	mustEmbedUnimplementedAcademicYearsServiceServer()
+}
+
+// UnimplementedAcademicYearsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAcademicYearsServiceServer struct{}
+
+func (UnimplementedAcademicYearsServiceServer) AddAcademicYears(context.Context, *AddAcademicYearsRequest) (*AddAcademicYearsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAcademicYears not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) GetAcademicYears(context.Context, *GetAcademicYearsRequest) (*AcademicYears, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAcademicYears not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) UpdateAcademicYears(context.Context, *UpdateAcademicYearsRequest) (*AcademicYears, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAcademicYears not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) DeleteAcademicYears(context.Context, *DeleteAcademicYearsRequest) (*DeleteAcademicYearsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAcademicYears not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) AddTerms(context.Context, *AddTermsRequest) (*AddTermsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTerms not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) GetTerms(context.Context, *GetTermsRequest) (*Terms, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTerms not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) UpdateTerms(context.Context, *UpdateTermsRequest) (*Terms, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTerms not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) DeleteTerms(context.Context, *DeleteTermsRequest) (*DeleteTermsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTerms not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) GetClassMemberships(context.Context, *GetClassMembershipsRequest) (*ClassMemberships, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClassMemberships not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) PromoteStudents(context.Context, *PromoteStudentsRequest) (*PromotionResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method PromoteStudents not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) UndoPromotion(context.Context, *PromotionId) (*PromotionResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndoPromotion not implemented")
+}
+func (UnimplementedAcademicYearsServiceServer) mustEmbedUnimplementedAcademicYearsServiceServer() {}
+func (UnimplementedAcademicYearsServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeAcademicYearsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AcademicYearsServiceServer will
+// result in compilation errors.
+type UnsafeAcademicYearsServiceServer interface {
+	mustEmbedUnimplementedAcademicYearsServiceServer()
+}
+
+func RegisterAcademicYearsServiceServer(s grpc.ServiceRegistrar, srv AcademicYearsServiceServer) {
+	// If the following call panics, it indicates UnimplementedAcademicYearsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AcademicYearsService_ServiceDesc, srv)
+}
+
+func _AcademicYearsService_AddAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAcademicYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).AddAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_AddAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).AddAcademicYears(ctx, req.(*AddAcademicYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_GetAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAcademicYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).GetAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_GetAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).GetAcademicYears(ctx, req.(*GetAcademicYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_UpdateAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAcademicYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).UpdateAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_UpdateAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).UpdateAcademicYears(ctx, req.(*UpdateAcademicYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_DeleteAcademicYears_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAcademicYearsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).DeleteAcademicYears(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_DeleteAcademicYears_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).DeleteAcademicYears(ctx, req.(*DeleteAcademicYearsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_AddTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).AddTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_AddTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).AddTerms(ctx, req.(*AddTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_GetTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).GetTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_GetTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).GetTerms(ctx, req.(*GetTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_UpdateTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).UpdateTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_UpdateTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).UpdateTerms(ctx, req.(*UpdateTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_DeleteTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).DeleteTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_DeleteTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).DeleteTerms(ctx, req.(*DeleteTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_GetClassMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).GetClassMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_GetClassMemberships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).GetClassMemberships(ctx, req.(*GetClassMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_PromoteStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).PromoteStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_PromoteStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).PromoteStudents(ctx, req.(*PromoteStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AcademicYearsService_UndoPromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AcademicYearsServiceServer).UndoPromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AcademicYearsService_UndoPromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AcademicYearsServiceServer).UndoPromotion(ctx, req.(*PromotionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AcademicYearsService_ServiceDesc is the grpc.ServiceDesc for AcademicYearsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AcademicYearsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AcademicYearsService",
+	HandlerType: (*AcademicYearsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAcademicYears",
+			Handler:    _AcademicYearsService_AddAcademicYears_Handler,
+		},
+		{
+			MethodName: "GetAcademicYears",
+			Handler:    _AcademicYearsService_GetAcademicYears_Handler,
+		},
+		{
+			MethodName: "UpdateAcademicYears",
+			Handler:    _AcademicYearsService_UpdateAcademicYears_Handler,
+		},
+		{
+			MethodName: "DeleteAcademicYears",
+			Handler:    _AcademicYearsService_DeleteAcademicYears_Handler,
+		},
+		{
+			MethodName: "AddTerms",
+			Handler:    _AcademicYearsService_AddTerms_Handler,
+		},
+		{
+			MethodName: "GetTerms",
+			Handler:    _AcademicYearsService_GetTerms_Handler,
+		},
+		{
+			MethodName: "UpdateTerms",
+			Handler:    _AcademicYearsService_UpdateTerms_Handler,
+		},
+		{
+			MethodName: "DeleteTerms",
+			Handler:    _AcademicYearsService_DeleteTerms_Handler,
+		},
+		{
+			MethodName: "GetClassMemberships",
+			Handler:    _AcademicYearsService_GetClassMemberships_Handler,
+		},
+		{
+			MethodName: "PromoteStudents",
+			Handler:    _AcademicYearsService_PromoteStudents_Handler,
+		},
+		{
+			MethodName: "UndoPromotion",
+			Handler:    _AcademicYearsService_UndoPromotion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "academic_years.proto",
+}
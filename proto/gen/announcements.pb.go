@@ -0,0 +1,1266 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: announcements.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AudienceType int32
+
+const (
+	AudienceType_SCHOOL   AudienceType = 0
+	AudienceType_GRADE    AudienceType = 1
+	AudienceType_CLASS    AudienceType = 2
+	AudienceType_STUDENTS AudienceType = 3
+)
+
+// Enum value maps for AudienceType.
+var (
+	AudienceType_name = map[int32]string{
+		0: "SCHOOL",
+		1: "GRADE",
+		2: "CLASS",
+		3: "STUDENTS",
+	}
+	AudienceType_value = map[string]int32{
+		"SCHOOL":   0,
+		"GRADE":    1,
+		"CLASS":    2,
+		"STUDENTS": 3,
+	}
+)
+
+func (x AudienceType) Enum() *AudienceType {
+	p := new(AudienceType)
+	*p = x
+	return p
+}
+
+func (x AudienceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AudienceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_announcements_proto_enumTypes[0].Descriptor()
+}
+
+func (AudienceType) Type() protoreflect.EnumType {
+	return &file_announcements_proto_enumTypes[0]
+}
+
+func (x AudienceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AudienceType.Descriptor instead.
+func (AudienceType) EnumDescriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{0}
+}
+
+// Announcement is a message to the whole school, a grade, classes or individual students
+// Students receive the announcements addressed to them, teachers those of the school and of the grades and classes they teach
+type Announcement struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Audience AudienceType           `protobuf:"varint,4,opt,name=audience,proto3,enum=main.AudienceType" json:"audience,omitempty"`
+	// grade is set for a GRADE audience
+	Grade int32 `protobuf:"varint,5,opt,name=grade,proto3" json:"grade,omitempty"`
+	// class_ids are set for a CLASS audience
+	ClassIds []string `protobuf:"bytes,6,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	// student_ids are set for a STUDENTS audience
+	StudentIds []string `protobuf:"bytes,7,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	// teacher_id names the teacher posting the announcement, it is empty for announcements of the school management
+	TeacherId string `protobuf:"bytes,8,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// expires_at is optional, expired announcements are only returned when asked for
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted announcements, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,12,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,15,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,16,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_announcements_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Announcement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Announcement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Announcement) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Announcement) GetAudience() AudienceType {
+	if x != nil {
+		return x.Audience
+	}
+	return AudienceType_SCHOOL
+}
+
+func (x *Announcement) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Announcement) GetClassIds() []string {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+func (x *Announcement) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *Announcement) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Announcement) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Announcement) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Announcement) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Announcements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcements) Reset() {
+	*x = Announcements{}
+	mi := &file_announcements_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcements) ProtoMessage() {}
+
+func (x *Announcements) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcements.ProtoReflect.Descriptor instead.
+func (*Announcements) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Announcements) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+type AddAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	// ordered stops inserting at the first failing announcement, otherwise every valid announcement is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all announcements in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAnnouncementsRequest) Reset() {
+	*x = AddAnnouncementsRequest{}
+	mi := &file_announcements_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnnouncementsRequest) ProtoMessage() {}
+
+func (x *AddAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*AddAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddAnnouncementsRequest) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *AddAnnouncementsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddAnnouncementsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAnnouncementsResponse) Reset() {
+	*x = AddAnnouncementsResponse{}
+	mi := &file_announcements_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnnouncementsResponse) ProtoMessage() {}
+
+func (x *AddAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*AddAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *AddAnnouncementsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetAnnouncementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only announcements matching the non-empty fields of the filter are returned
+	// SCHOOL is the zero audience, so it cannot be told apart from an unset filter and only the other audiences filter
+	Announcement *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// recipient limits the announcements to those addressed to the person, who has to be the caller unless the caller is an exec
+	Recipient *PersonRef `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// unread_only leaves out the announcements the recipient has read
+	UnreadOnly bool         `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	SortBy     []*SortField `protobuf:"bytes,4,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_expired also returns expired announcements
+	IncludeExpired bool `protobuf:"varint,5,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	// include_deleted also returns soft deleted announcements
+	IncludeDeleted bool `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementsRequest) Reset() {
+	*x = GetAnnouncementsRequest{}
+	mi := &file_announcements_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsRequest) ProtoMessage() {}
+
+func (x *GetAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAnnouncementsRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsRequest) GetRecipient() *PersonRef {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *GetAnnouncementsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetAnnouncementsRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+func (x *GetAnnouncementsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateAnnouncementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every announcement, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAnnouncementsRequest) Reset() {
+	*x = UpdateAnnouncementsRequest{}
+	mi := &file_announcements_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnnouncementsRequest) ProtoMessage() {}
+
+func (x *UpdateAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAnnouncementsRequest) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *UpdateAnnouncementsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateAnnouncementsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type AnnouncementId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the announcement, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnouncementId) Reset() {
+	*x = AnnouncementId{}
+	mi := &file_announcements_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementId) ProtoMessage() {}
+
+func (x *AnnouncementId) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementId.ProtoReflect.Descriptor instead.
+func (*AnnouncementId) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AnnouncementId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnnouncementId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteAnnouncementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*AnnouncementId      `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all announcements in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementsRequest) Reset() {
+	*x = DeleteAnnouncementsRequest{}
+	mi := &file_announcements_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementsRequest) ProtoMessage() {}
+
+func (x *DeleteAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAnnouncementsRequest) GetIds() []*AnnouncementId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteAnnouncementsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteAnnouncementsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementsConfirmation) Reset() {
+	*x = DeleteAnnouncementsConfirmation{}
+	mi := &file_announcements_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementsConfirmation) ProtoMessage() {}
+
+func (x *DeleteAnnouncementsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementsConfirmation) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAnnouncementsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAnnouncementsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// recipient whose announcements are streamed, an exec receives every announcement
+	// The grades and classes of the recipient are looked up when subscribing, the recipient has to be the caller unless the caller is an exec
+	Recipient *PersonRef `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// resume_token of the last received event, the stream continues right after it
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_announcements_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubscribeRequest) GetRecipient() *PersonRef {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type AnnouncementEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ChangeType             `protobuf:"varint,1,opt,name=type,proto3,enum=main.ChangeType" json:"type,omitempty"`
+	// announcement as stored after the change
+	Announcement  *Announcement          `protobuf:"bytes,2,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnouncementEvent) Reset() {
+	*x = AnnouncementEvent{}
+	mi := &file_announcements_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementEvent) ProtoMessage() {}
+
+func (x *AnnouncementEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementEvent.ProtoReflect.Descriptor instead.
+func (*AnnouncementEvent) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AnnouncementEvent) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *AnnouncementEvent) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *AnnouncementEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *AnnouncementEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// ReadReceipt records when a recipient first read an announcement
+type ReadReceipt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId string                 `protobuf:"bytes,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	ReaderType     PersonType             `protobuf:"varint,2,opt,name=reader_type,json=readerType,proto3,enum=main.PersonType" json:"reader_type,omitempty"`
+	ReaderId       string                 `protobuf:"bytes,3,opt,name=reader_id,json=readerId,proto3" json:"reader_id,omitempty"`
+	ReadAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadReceipt) Reset() {
+	*x = ReadReceipt{}
+	mi := &file_announcements_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipt) ProtoMessage() {}
+
+func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadReceipt) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetReaderType() PersonType {
+	if x != nil {
+		return x.ReaderType
+	}
+	return PersonType_STUDENT
+}
+
+func (x *ReadReceipt) GetReaderId() string {
+	if x != nil {
+		return x.ReaderId
+	}
+	return ""
+}
+
+func (x *ReadReceipt) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+type ReadReceipts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipts      []*ReadReceipt         `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadReceipts) Reset() {
+	*x = ReadReceipts{}
+	mi := &file_announcements_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadReceipts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipts) ProtoMessage() {}
+
+func (x *ReadReceipts) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipts.ProtoReflect.Descriptor instead.
+func (*ReadReceipts) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReadReceipts) GetReceipts() []*ReadReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type MarkAnnouncementsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// reader has to be the caller unless the caller is an exec
+	Reader *PersonRef `protobuf:"bytes,1,opt,name=reader,proto3" json:"reader,omitempty"`
+	// announcement_ids have to be addressed to the reader
+	AnnouncementIds []string `protobuf:"bytes,2,rep,name=announcement_ids,json=announcementIds,proto3" json:"announcement_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkAnnouncementsReadRequest) Reset() {
+	*x = MarkAnnouncementsReadRequest{}
+	mi := &file_announcements_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnnouncementsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnnouncementsReadRequest) ProtoMessage() {}
+
+func (x *MarkAnnouncementsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnnouncementsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAnnouncementsReadRequest) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkAnnouncementsReadRequest) GetReader() *PersonRef {
+	if x != nil {
+		return x.Reader
+	}
+	return nil
+}
+
+func (x *MarkAnnouncementsReadRequest) GetAnnouncementIds() []string {
+	if x != nil {
+		return x.AnnouncementIds
+	}
+	return nil
+}
+
+type GetReadReceiptsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AnnouncementId string                 `protobuf:"bytes,1,opt,name=announcement_id,json=announcementId,proto3" json:"announcement_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReadReceiptsRequest) Reset() {
+	*x = GetReadReceiptsRequest{}
+	mi := &file_announcements_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadReceiptsRequest) ProtoMessage() {}
+
+func (x *GetReadReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_announcements_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*GetReadReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_announcements_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReadReceiptsRequest) GetAnnouncementId() string {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return ""
+}
+
+var File_announcements_proto protoreflect.FileDescriptor
+
+const file_announcements_proto_rawDesc = "" +
+	"\n" +
+	"\x13announcements.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\x1a\rprivacy.proto\"\xce\x04\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12.\n" +
+	"\baudience\x18\x04 \x01(\x0e2\x12.main.AudienceTypeR\baudience\x12\x14\n" +
+	"\x05grade\x18\x05 \x01(\x05R\x05grade\x12\x1b\n" +
+	"\tclass_ids\x18\x06 \x03(\tR\bclassIds\x12\x1f\n" +
+	"\vstudent_ids\x18\a \x03(\tR\n" +
+	"studentIds\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\b \x01(\tR\tteacherId\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\f \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0f \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x10 \x01(\tR\tupdatedBy\"I\n" +
+	"\rAnnouncements\x128\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x12.main.AnnouncementR\rannouncements\"\x85\x01\n" +
+	"\x17AddAnnouncementsRequest\x128\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x12.main.AnnouncementR\rannouncements\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"\x84\x01\n" +
+	"\x18AddAnnouncementsResponse\x128\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x12.main.AnnouncementR\rannouncements\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x9d\x02\n" +
+	"\x17GetAnnouncementsRequest\x126\n" +
+	"\fannouncement\x18\x01 \x01(\v2\x12.main.AnnouncementR\fannouncement\x12-\n" +
+	"\trecipient\x18\x02 \x01(\v2\x0f.main.PersonRefR\trecipient\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\x12(\n" +
+	"\asort_by\x18\x04 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_expired\x18\x05 \x01(\bR\x0eincludeExpired\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"\xab\x01\n" +
+	"\x1aUpdateAnnouncementsRequest\x128\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x12.main.AnnouncementR\rannouncements\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\":\n" +
+	"\x0eAnnouncementId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\\\n" +
+	"\x1aDeleteAnnouncementsRequest\x12&\n" +
+	"\x03ids\x18\x01 \x03(\v2\x14.main.AnnouncementIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"Z\n" +
+	"\x1fDeleteAnnouncementsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"d\n" +
+	"\x10SubscribeRequest\x12-\n" +
+	"\trecipient\x18\x01 \x01(\v2\x0f.main.PersonRefR\trecipient\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\xd1\x01\n" +
+	"\x11AnnouncementEvent\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.main.ChangeTypeR\x04type\x126\n" +
+	"\fannouncement\x18\x02 \x01(\v2\x12.main.AnnouncementR\fannouncement\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xbb\x01\n" +
+	"\vReadReceipt\x12'\n" +
+	"\x0fannouncement_id\x18\x01 \x01(\tR\x0eannouncementId\x121\n" +
+	"\vreader_type\x18\x02 \x01(\x0e2\x10.main.PersonTypeR\n" +
+	"readerType\x12\x1b\n" +
+	"\treader_id\x18\x03 \x01(\tR\breaderId\x123\n" +
+	"\aread_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"=\n" +
+	"\fReadReceipts\x12-\n" +
+	"\breceipts\x18\x01 \x03(\v2\x11.main.ReadReceiptR\breceipts\"r\n" +
+	"\x1cMarkAnnouncementsReadRequest\x12'\n" +
+	"\x06reader\x18\x01 \x01(\v2\x0f.main.PersonRefR\x06reader\x12)\n" +
+	"\x10announcement_ids\x18\x02 \x03(\tR\x0fannouncementIds\"A\n" +
+	"\x16GetReadReceiptsRequest\x12'\n" +
+	"\x0fannouncement_id\x18\x01 \x01(\tR\x0eannouncementId*>\n" +
+	"\fAudienceType\x12\n" +
+	"\n" +
+	"\x06SCHOOL\x10\x00\x12\t\n" +
+	"\x05GRADE\x10\x01\x12\t\n" +
+	"\x05CLASS\x10\x02\x12\f\n" +
+	"\bSTUDENTS\x10\x032\xb5\x04\n" +
+	"\x14AnnouncementsService\x12Q\n" +
+	"\x10AddAnnouncements\x12\x1d.main.AddAnnouncementsRequest\x1a\x1e.main.AddAnnouncementsResponse\x12F\n" +
+	"\x10GetAnnouncements\x12\x1d.main.GetAnnouncementsRequest\x1a\x13.main.Announcements\x12L\n" +
+	"\x13UpdateAnnouncements\x12 .main.UpdateAnnouncementsRequest\x1a\x13.main.Announcements\x12^\n" +
+	"\x13DeleteAnnouncements\x12 .main.DeleteAnnouncementsRequest\x1a%.main.DeleteAnnouncementsConfirmation\x12>\n" +
+	"\tSubscribe\x12\x16.main.SubscribeRequest\x1a\x17.main.AnnouncementEvent0\x01\x12O\n" +
+	"\x15MarkAnnouncementsRead\x12\".main.MarkAnnouncementsReadRequest\x1a\x12.main.ReadReceipts\x12C\n" +
+	"\x0fGetReadReceipts\x12\x1c.main.GetReadReceiptsRequest\x1a\x12.main.ReadReceiptsB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_announcements_proto_rawDescOnce sync.Once
+	file_announcements_proto_rawDescData []byte
+)
+
+func file_announcements_proto_rawDescGZIP() []byte {
+	file_announcements_proto_rawDescOnce.Do(func() {
+		file_announcements_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_announcements_proto_rawDesc), len(file_announcements_proto_rawDesc)))
+	})
+	return file_announcements_proto_rawDescData
+}
+
+var file_announcements_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_announcements_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_announcements_proto_goTypes = []any{
+	(AudienceType)(0),                       // 0: main.AudienceType
+	(*Announcement)(nil),                    // 1: main.Announcement
+	(*Announcements)(nil),                   // 2: main.Announcements
+	(*AddAnnouncementsRequest)(nil),         // 3: main.AddAnnouncementsRequest
+	(*AddAnnouncementsResponse)(nil),        // 4: main.AddAnnouncementsResponse
+	(*GetAnnouncementsRequest)(nil),         // 5: main.GetAnnouncementsRequest
+	(*UpdateAnnouncementsRequest)(nil),      // 6: main.UpdateAnnouncementsRequest
+	(*AnnouncementId)(nil),                  // 7: main.AnnouncementId
+	(*DeleteAnnouncementsRequest)(nil),      // 8: main.DeleteAnnouncementsRequest
+	(*DeleteAnnouncementsConfirmation)(nil), // 9: main.DeleteAnnouncementsConfirmation
+	(*SubscribeRequest)(nil),                // 10: main.SubscribeRequest
+	(*AnnouncementEvent)(nil),               // 11: main.AnnouncementEvent
+	(*ReadReceipt)(nil),                     // 12: main.ReadReceipt
+	(*ReadReceipts)(nil),                    // 13: main.ReadReceipts
+	(*MarkAnnouncementsReadRequest)(nil),    // 14: main.MarkAnnouncementsReadRequest
+	(*GetReadReceiptsRequest)(nil),          // 15: main.GetReadReceiptsRequest
+	(*timestamppb.Timestamp)(nil),           // 16: google.protobuf.Timestamp
+	(*BulkItemResult)(nil),                  // 17: main.BulkItemResult
+	(*PersonRef)(nil),                       // 18: main.PersonRef
+	(*SortField)(nil),                       // 19: main.SortField
+	(*fieldmaskpb.FieldMask)(nil),           // 20: google.protobuf.FieldMask
+	(ChangeType)(0),                         // 21: main.ChangeType
+	(PersonType)(0),                         // 22: main.PersonType
+}
+var file_announcements_proto_depIdxs = []int32{
+	0,  // 0: main.Announcement.audience:type_name -> main.AudienceType
+	16, // 1: main.Announcement.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 2: main.Announcement.deleted_at:type_name -> google.protobuf.Timestamp
+	16, // 3: main.Announcement.created_at:type_name -> google.protobuf.Timestamp
+	16, // 4: main.Announcement.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: main.Announcements.announcements:type_name -> main.Announcement
+	1,  // 6: main.AddAnnouncementsRequest.announcements:type_name -> main.Announcement
+	1,  // 7: main.AddAnnouncementsResponse.announcements:type_name -> main.Announcement
+	17, // 8: main.AddAnnouncementsResponse.results:type_name -> main.BulkItemResult
+	1,  // 9: main.GetAnnouncementsRequest.announcement:type_name -> main.Announcement
+	18, // 10: main.GetAnnouncementsRequest.recipient:type_name -> main.PersonRef
+	19, // 11: main.GetAnnouncementsRequest.sort_by:type_name -> main.SortField
+	1,  // 12: main.UpdateAnnouncementsRequest.announcements:type_name -> main.Announcement
+	20, // 13: main.UpdateAnnouncementsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 14: main.DeleteAnnouncementsRequest.ids:type_name -> main.AnnouncementId
+	18, // 15: main.SubscribeRequest.recipient:type_name -> main.PersonRef
+	21, // 16: main.AnnouncementEvent.type:type_name -> main.ChangeType
+	1,  // 17: main.AnnouncementEvent.announcement:type_name -> main.Announcement
+	16, // 18: main.AnnouncementEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	22, // 19: main.ReadReceipt.reader_type:type_name -> main.PersonType
+	16, // 20: main.ReadReceipt.read_at:type_name -> google.protobuf.Timestamp
+	12, // 21: main.ReadReceipts.receipts:type_name -> main.ReadReceipt
+	18, // 22: main.MarkAnnouncementsReadRequest.reader:type_name -> main.PersonRef
+	3,  // 23: main.AnnouncementsService.AddAnnouncements:input_type -> main.AddAnnouncementsRequest
+	5,  // 24: main.AnnouncementsService.GetAnnouncements:input_type -> main.GetAnnouncementsRequest
+	6,  // 25: main.AnnouncementsService.UpdateAnnouncements:input_type -> main.UpdateAnnouncementsRequest
+	8,  // 26: main.AnnouncementsService.DeleteAnnouncements:input_type -> main.DeleteAnnouncementsRequest
+	10, // 27: main.AnnouncementsService.Subscribe:input_type -> main.SubscribeRequest
+	14, // 28: main.AnnouncementsService.MarkAnnouncementsRead:input_type -> main.MarkAnnouncementsReadRequest
+	15, // 29: main.AnnouncementsService.GetReadReceipts:input_type -> main.GetReadReceiptsRequest
+	4,  // 30: main.AnnouncementsService.AddAnnouncements:output_type -> main.AddAnnouncementsResponse
+	2,  // 31: main.AnnouncementsService.GetAnnouncements:output_type -> main.Announcements
+	2,  // 32: main.AnnouncementsService.UpdateAnnouncements:output_type -> main.Announcements
+	9,  // 33: main.AnnouncementsService.DeleteAnnouncements:output_type -> main.DeleteAnnouncementsConfirmation
+	11, // 34: main.AnnouncementsService.Subscribe:output_type -> main.AnnouncementEvent
+	13, // 35: main.AnnouncementsService.MarkAnnouncementsRead:output_type -> main.ReadReceipts
+	13, // 36: main.AnnouncementsService.GetReadReceipts:output_type -> main.ReadReceipts
+	30, // [30:37] is the sub-list for method output_type
+	23, // [23:30] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_announcements_proto_init() }
+func file_announcements_proto_init() {
+	if File_announcements_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	file_privacy_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_announcements_proto_rawDesc), len(file_announcements_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_announcements_proto_goTypes,
+		DependencyIndexes: file_announcements_proto_depIdxs,
+		EnumInfos:         file_announcements_proto_enumTypes,
+		MessageInfos:      file_announcements_proto_msgTypes,
+	}.Build()
+	File_announcements_proto = out.File
+	file_announcements_proto_goTypes = nil
+	file_announcements_proto_depIdxs = nil
+}
@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: announcements.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnnouncementsService_AddAnnouncements_FullMethodName      = "/main.AnnouncementsService/AddAnnouncements"
+	AnnouncementsService_GetAnnouncements_FullMethodName      = "/main.AnnouncementsService/GetAnnouncements"
+	AnnouncementsService_UpdateAnnouncements_FullMethodName   = "/main.AnnouncementsService/UpdateAnnouncements"
+	AnnouncementsService_DeleteAnnouncements_FullMethodName   = "/main.AnnouncementsService/DeleteAnnouncements"
+	AnnouncementsService_Subscribe_FullMethodName             = "/main.AnnouncementsService/Subscribe"
+	AnnouncementsService_MarkAnnouncementsRead_FullMethodName = "/main.AnnouncementsService/MarkAnnouncementsRead"
+	AnnouncementsService_GetReadReceipts_FullMethodName       = "/main.AnnouncementsService/GetReadReceipts"
+)
+
+// AnnouncementsServiceClient is the client API for AnnouncementsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to announcements and who read them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AnnouncementsServiceClient interface {
+	// AddAnnouncements posts announcements to their audience
+	AddAnnouncements(ctx context.Context, in *AddAnnouncementsRequest, opts ...grpc.CallOption) (*AddAnnouncementsResponse, error)
+	// GetAnnouncements retrieves a list of announcements based on the provided criteria
+	GetAnnouncements(ctx context.Context, in *GetAnnouncementsRequest, opts ...grpc.CallOption) (*Announcements, error)
+	// UpdateAnnouncements updates existing announcements, their audience cannot be changed
+	UpdateAnnouncements(ctx context.Context, in *UpdateAnnouncementsRequest, opts ...grpc.CallOption) (*Announcements, error)
+	// DeleteAnnouncements removes announcements by their IDs
+	DeleteAnnouncements(ctx context.Context, in *DeleteAnnouncementsRequest, opts ...grpc.CallOption) (*DeleteAnnouncementsConfirmation, error)
+	// Subscribe streams every announcement posted, changed or deleted for a recipient as it happens
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AnnouncementEvent], error)
+	// MarkAnnouncementsRead records that a recipient read announcements, marking one again keeps the first time it was read
+	MarkAnnouncementsRead(ctx context.Context, in *MarkAnnouncementsReadRequest, opts ...grpc.CallOption) (*ReadReceipts, error)
+	// GetReadReceipts lists who read an announcement
+	GetReadReceipts(ctx context.Context, in *GetReadReceiptsRequest, opts ...grpc.CallOption) (*ReadReceipts, error)
+}
+
+type announcementsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnnouncementsServiceClient(cc grpc.ClientConnInterface) AnnouncementsServiceClient {
+	return &announcementsServiceClient{cc}
+}
+
+func (c *announcementsServiceClient) AddAnnouncements(ctx context.Context, in *AddAnnouncementsRequest, opts ...grpc.CallOption) (*AddAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, AnnouncementsService_AddAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) GetAnnouncements(ctx context.Context, in *GetAnnouncementsRequest, opts ...grpc.CallOption) (*Announcements, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, AnnouncementsService_GetAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) UpdateAnnouncements(ctx context.Context, in *UpdateAnnouncementsRequest, opts ...grpc.CallOption) (*Announcements, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, AnnouncementsService_UpdateAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) DeleteAnnouncements(ctx context.Context, in *DeleteAnnouncementsRequest, opts ...grpc.CallOption) (*DeleteAnnouncementsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAnnouncementsConfirmation)
+	err := c.cc.Invoke(ctx, AnnouncementsService_DeleteAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AnnouncementEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AnnouncementsService_ServiceDesc.Streams[0], AnnouncementsService_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, AnnouncementEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnnouncementsService_SubscribeClient = grpc.ServerStreamingClient[AnnouncementEvent]
+
+func (c *announcementsServiceClient) MarkAnnouncementsRead(ctx context.Context, in *MarkAnnouncementsReadRequest, opts ...grpc.CallOption) (*ReadReceipts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadReceipts)
+	err := c.cc.Invoke(ctx, AnnouncementsService_MarkAnnouncementsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementsServiceClient) GetReadReceipts(ctx context.Context, in *GetReadReceiptsRequest, opts ...grpc.CallOption) (*ReadReceipts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadReceipts)
+	err := c.cc.Invoke(ctx, AnnouncementsService_GetReadReceipts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnnouncementsServiceServer is the server API for AnnouncementsService service.
+// All implementations must embed UnimplementedAnnouncementsServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to announcements and who read them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AnnouncementsServiceServer interface {
+	// AddAnnouncements posts announcements to their audience
+	AddAnnouncements(context.Context, *AddAnnouncementsRequest) (*AddAnnouncementsResponse, error)
+	// GetAnnouncements retrieves a list of announcements based on the provided criteria
+	GetAnnouncements(context.Context, *GetAnnouncementsRequest) (*Announcements, error)
+	// UpdateAnnouncements updates existing announcements, their audience cannot be changed
+	UpdateAnnouncements(context.Context, *UpdateAnnouncementsRequest) (*Announcements, error)
+	// DeleteAnnouncements removes announcements by their IDs
+	DeleteAnnouncements(context.Context, *DeleteAnnouncementsRequest) (*DeleteAnnouncementsConfirmation, error)
+	// Subscribe streams every announcement posted, changed or deleted for a recipient as it happens
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[AnnouncementEvent]) error
+	// MarkAnnouncementsRead records that a recipient read announcements, marking one again keeps the first time it was read
+	MarkAnnouncementsRead(context.Context, *MarkAnnouncementsReadRequest) (*ReadReceipts, error)
+	// GetReadReceipts lists who read an announcement
+	GetReadReceipts(context.Context, *GetReadReceiptsRequest) (*ReadReceipts, error)
+	mustEmbedUnimplementedAnnouncementsServiceServer()
+}
+
+// UnimplementedAnnouncementsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnnouncementsServiceServer struct{}
+
+func (UnimplementedAnnouncementsServiceServer) AddAnnouncements(context.Context, *AddAnnouncementsRequest) (*AddAnnouncementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) GetAnnouncements(context.Context, *GetAnnouncementsRequest) (*Announcements, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) UpdateAnnouncements(context.Context, *UpdateAnnouncementsRequest) (*Announcements, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) DeleteAnnouncements(context.Context, *DeleteAnnouncementsRequest) (*DeleteAnnouncementsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAnnouncements not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[AnnouncementEvent]) error {
+	return status.Error(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) MarkAnnouncementsRead(context.Context, *MarkAnnouncementsReadRequest) (*ReadReceipts, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkAnnouncementsRead not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) GetReadReceipts(context.Context, *GetReadReceiptsRequest) (*ReadReceipts, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReadReceipts not implemented")
+}
+func (UnimplementedAnnouncementsServiceServer) mustEmbedUnimplementedAnnouncementsServiceServer() {}
+func (UnimplementedAnnouncementsServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeAnnouncementsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnnouncementsServiceServer will
+// result in compilation errors.
+type UnsafeAnnouncementsServiceServer interface {
+	mustEmbedUnimplementedAnnouncementsServiceServer()
+}
+
+func RegisterAnnouncementsServiceServer(s grpc.ServiceRegistrar, srv AnnouncementsServiceServer) {
+	// If the following call panics, it indicates UnimplementedAnnouncementsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnnouncementsService_ServiceDesc, srv)
+}
+
+func _AnnouncementsService_AddAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).AddAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_AddAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).AddAnnouncements(ctx, req.(*AddAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_GetAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).GetAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_GetAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).GetAnnouncements(ctx, req.(*GetAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_UpdateAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).UpdateAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_UpdateAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).UpdateAnnouncements(ctx, req.(*UpdateAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_DeleteAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).DeleteAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_DeleteAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).DeleteAnnouncements(ctx, req.(*DeleteAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AnnouncementsServiceServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, AnnouncementEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AnnouncementsService_SubscribeServer = grpc.ServerStreamingServer[AnnouncementEvent]
+
+func _AnnouncementsService_MarkAnnouncementsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAnnouncementsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).MarkAnnouncementsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_MarkAnnouncementsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).MarkAnnouncementsRead(ctx, req.(*MarkAnnouncementsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementsService_GetReadReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementsServiceServer).GetReadReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementsService_GetReadReceipts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementsServiceServer).GetReadReceipts(ctx, req.(*GetReadReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnnouncementsService_ServiceDesc is the grpc.ServiceDesc for AnnouncementsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnnouncementsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AnnouncementsService",
+	HandlerType: (*AnnouncementsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAnnouncements",
+			Handler:    _AnnouncementsService_AddAnnouncements_Handler,
+		},
+		{
+			MethodName: "GetAnnouncements",
+			Handler:    _AnnouncementsService_GetAnnouncements_Handler,
+		},
+		{
+			MethodName: "UpdateAnnouncements",
+			Handler:    _AnnouncementsService_UpdateAnnouncements_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncements",
+			Handler:    _AnnouncementsService_DeleteAnnouncements_Handler,
+		},
+		{
+			MethodName: "MarkAnnouncementsRead",
+			Handler:    _AnnouncementsService_MarkAnnouncementsRead_Handler,
+		},
+		{
+			MethodName: "GetReadReceipts",
+			Handler:    _AnnouncementsService_GetReadReceipts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _AnnouncementsService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "announcements.proto",
+}
@@ -0,0 +1,1388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: assignments.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Assignment is work a teacher sets a class in a subject, submissions after the due date are accepted but marked late
+type Assignment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId     string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Subject     string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	TeacherId   string                 `protobuf:"bytes,4,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	Title       string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	DueAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	MaxScore    float64                `protobuf:"fixed64,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted assignments, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,11,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,15,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	mi := &file_assignments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Assignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assignment) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Assignment) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Assignment) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Assignment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Assignment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Assignment) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Assignment) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Assignment) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Assignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Assignment) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Assignments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assignments) Reset() {
+	*x = Assignments{}
+	mi := &file_assignments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignments) ProtoMessage() {}
+
+func (x *Assignments) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignments.ProtoReflect.Descriptor instead.
+func (*Assignments) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Assignments) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type AddAssignmentsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Assignments []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	// ordered stops inserting at the first failing assignment, otherwise every valid assignment is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all assignments in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAssignmentsRequest) Reset() {
+	*x = AddAssignmentsRequest{}
+	mi := &file_assignments_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAssignmentsRequest) ProtoMessage() {}
+
+func (x *AddAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*AddAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddAssignmentsRequest) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *AddAssignmentsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddAssignmentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAssignmentsResponse) Reset() {
+	*x = AddAssignmentsResponse{}
+	mi := &file_assignments_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAssignmentsResponse) ProtoMessage() {}
+
+func (x *AddAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*AddAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddAssignmentsResponse) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *AddAssignmentsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetAssignmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only assignments matching the non-empty fields of the filter are returned
+	Assignment *Assignment  `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	SortBy     []*SortField `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted assignments
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAssignmentsRequest) Reset() {
+	*x = GetAssignmentsRequest{}
+	mi := &file_assignments_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentsRequest) ProtoMessage() {}
+
+func (x *GetAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAssignmentsRequest) GetAssignment() *Assignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *GetAssignmentsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetAssignmentsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateAssignmentsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Assignments []*Assignment          `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every assignment, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentsRequest) Reset() {
+	*x = UpdateAssignmentsRequest{}
+	mi := &file_assignments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentsRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAssignmentsRequest) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *UpdateAssignmentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateAssignmentsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type AssignmentId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the assignment, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentId) Reset() {
+	*x = AssignmentId{}
+	mi := &file_assignments_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentId) ProtoMessage() {}
+
+func (x *AssignmentId) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentId.ProtoReflect.Descriptor instead.
+func (*AssignmentId) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssignmentId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignmentId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteAssignmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*AssignmentId        `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all assignments in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentsRequest) Reset() {
+	*x = DeleteAssignmentsRequest{}
+	mi := &file_assignments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentsRequest) ProtoMessage() {}
+
+func (x *DeleteAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAssignmentsRequest) GetIds() []*AssignmentId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteAssignmentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteAssignmentsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssignmentsConfirmation) Reset() {
+	*x = DeleteAssignmentsConfirmation{}
+	mi := &file_assignments_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssignmentsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssignmentsConfirmation) ProtoMessage() {}
+
+func (x *DeleteAssignmentsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssignmentsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteAssignmentsConfirmation) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAssignmentsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAssignmentsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+// Submission is the file a student handed in for an assignment, a student has a single submission per assignment
+type Submission struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId string                 `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId    string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	FileName     string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType  string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// size of the file in bytes
+	Size int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// sha256 is the hex encoded SHA-256 checksum of the file
+	Sha256      string                 `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	SubmittedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// late tells whether the file was submitted after the due date
+	Late     bool    `protobuf:"varint,9,opt,name=late,proto3" json:"late,omitempty"`
+	Feedback string  `protobuf:"bytes,10,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Score    float64 `protobuf:"fixed64,11,opt,name=score,proto3" json:"score,omitempty"`
+	// graded_at and graded_by are set once feedback is recorded, the score only counts from then on
+	GradedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	GradedBy string                 `protobuf:"bytes,13,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	// version is increased on every change, send it back when recording feedback to detect concurrent edits
+	Version int64 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,17,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,18,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	mi := &file_assignments_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Submission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Submission) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *Submission) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Submission) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Submission) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Submission) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Submission) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Submission) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *Submission) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *Submission) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Submission) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetGradedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *Submission) GetGradedBy() string {
+	if x != nil {
+		return x.GradedBy
+	}
+	return ""
+}
+
+func (x *Submission) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Submission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Submission) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Submission) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Submission) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Submissions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*Submission          `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Submissions) Reset() {
+	*x = Submissions{}
+	mi := &file_assignments_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Submissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submissions) ProtoMessage() {}
+
+func (x *Submissions) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submissions.ProtoReflect.Descriptor instead.
+func (*Submissions) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Submissions) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// SubmissionUpload is a chunk of an uploaded file, the fields describing the file are read from the first chunk
+// Chunks should stay well below the 4 MB message limit of gRPC, 64 KB is a good size
+type SubmissionUpload struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId    string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	FileName     string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType  string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// sha256 optionally gives the hex encoded SHA-256 checksum of the file, the upload fails with DATA_LOSS if it differs
+	Sha256        string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Data          []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionUpload) Reset() {
+	*x = SubmissionUpload{}
+	mi := &file_assignments_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionUpload) ProtoMessage() {}
+
+func (x *SubmissionUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionUpload.ProtoReflect.Descriptor instead.
+func (*SubmissionUpload) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubmissionUpload) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *SubmissionUpload) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SubmissionUpload) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *SubmissionUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *SubmissionUpload) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *SubmissionUpload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetSubmissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// at least one of assignment_id and student_id is required
+	AssignmentId  string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	StudentId     string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubmissionsRequest) Reset() {
+	*x = GetSubmissionsRequest{}
+	mi := &file_assignments_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubmissionsRequest) ProtoMessage() {}
+
+func (x *GetSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSubmissionsRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *GetSubmissionsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type SubmissionId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionId) Reset() {
+	*x = SubmissionId{}
+	mi := &file_assignments_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionId) ProtoMessage() {}
+
+func (x *SubmissionId) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionId.ProtoReflect.Descriptor instead.
+func (*SubmissionId) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SubmissionId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SubmissionDownload carries the submission in the first message and the content of its file in the following ones
+type SubmissionDownload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *Submission            `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionDownload) Reset() {
+	*x = SubmissionDownload{}
+	mi := &file_assignments_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionDownload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionDownload) ProtoMessage() {}
+
+func (x *SubmissionDownload) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionDownload.ProtoReflect.Descriptor instead.
+func (*SubmissionDownload) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SubmissionDownload) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+func (x *SubmissionDownload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SubmissionFeedback struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Feedback string                 `protobuf:"bytes,2,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	// score between 0 and the max_score of the assignment
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// expected version of the submission, recording feedback fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmissionFeedback) Reset() {
+	*x = SubmissionFeedback{}
+	mi := &file_assignments_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmissionFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmissionFeedback) ProtoMessage() {}
+
+func (x *SubmissionFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_assignments_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmissionFeedback.ProtoReflect.Descriptor instead.
+func (*SubmissionFeedback) Descriptor() ([]byte, []int) {
+	return file_assignments_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubmissionFeedback) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubmissionFeedback) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *SubmissionFeedback) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmissionFeedback) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_assignments_proto protoreflect.FileDescriptor
+
+const file_assignments_proto_rawDesc = "" +
+	"\n" +
+	"\x11assignments.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\"\xa0\x04\n" +
+	"\n" +
+	"Assignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x04 \x01(\tR\tteacherId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x121\n" +
+	"\x06due_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x1b\n" +
+	"\tmax_score\x18\b \x01(\x01R\bmaxScore\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\v \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0f \x01(\tR\tupdatedBy\"A\n" +
+	"\vAssignments\x122\n" +
+	"\vassignments\x18\x01 \x03(\v2\x10.main.AssignmentR\vassignments\"}\n" +
+	"\x15AddAssignmentsRequest\x122\n" +
+	"\vassignments\x18\x01 \x03(\v2\x10.main.AssignmentR\vassignments\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"|\n" +
+	"\x16AddAssignmentsResponse\x122\n" +
+	"\vassignments\x18\x01 \x03(\v2\x10.main.AssignmentR\vassignments\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x9c\x01\n" +
+	"\x15GetAssignmentsRequest\x120\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x10.main.AssignmentR\n" +
+	"assignment\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\xa3\x01\n" +
+	"\x18UpdateAssignmentsRequest\x122\n" +
+	"\vassignments\x18\x01 \x03(\v2\x10.main.AssignmentR\vassignments\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"8\n" +
+	"\fAssignmentId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"X\n" +
+	"\x18DeleteAssignmentsRequest\x12$\n" +
+	"\x03ids\x18\x01 \x03(\v2\x12.main.AssignmentIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"X\n" +
+	"\x1dDeleteAssignmentsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xf5\x04\n" +
+	"\n" +
+	"Submission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rassignment_id\x18\x02 \x01(\tR\fassignmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x12=\n" +
+	"\fsubmitted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12\x12\n" +
+	"\x04late\x18\t \x01(\bR\x04late\x12\x1a\n" +
+	"\bfeedback\x18\n" +
+	" \x01(\tR\bfeedback\x12\x14\n" +
+	"\x05score\x18\v \x01(\x01R\x05score\x127\n" +
+	"\tgraded_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bgradedAt\x12\x1b\n" +
+	"\tgraded_by\x18\r \x01(\tR\bgradedBy\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x11 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x12 \x01(\tR\tupdatedBy\"A\n" +
+	"\vSubmissions\x122\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x10.main.SubmissionR\vsubmissions\"\xc2\x01\n" +
+	"\x10SubmissionUpload\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\"[\n" +
+	"\x15GetSubmissionsRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\x1e\n" +
+	"\fSubmissionId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x12SubmissionDownload\x120\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x10.main.SubmissionR\n" +
+	"submission\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"p\n" +
+	"\x12SubmissionFeedback\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfeedback\x18\x02 \x01(\tR\bfeedback\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion2\xcb\x04\n" +
+	"\x12AssignmentsService\x12K\n" +
+	"\x0eAddAssignments\x12\x1b.main.AddAssignmentsRequest\x1a\x1c.main.AddAssignmentsResponse\x12@\n" +
+	"\x0eGetAssignments\x12\x1b.main.GetAssignmentsRequest\x1a\x11.main.Assignments\x12F\n" +
+	"\x11UpdateAssignments\x12\x1e.main.UpdateAssignmentsRequest\x1a\x11.main.Assignments\x12X\n" +
+	"\x11DeleteAssignments\x12\x1e.main.DeleteAssignmentsRequest\x1a#.main.DeleteAssignmentsConfirmation\x12>\n" +
+	"\x10UploadSubmission\x12\x16.main.SubmissionUpload\x1a\x10.main.Submission(\x01\x12@\n" +
+	"\x0eGetSubmissions\x12\x1b.main.GetSubmissionsRequest\x1a\x11.main.Submissions\x12D\n" +
+	"\x12DownloadSubmission\x12\x12.main.SubmissionId\x1a\x18.main.SubmissionDownload0\x01\x12<\n" +
+	"\x0eRecordFeedback\x12\x18.main.SubmissionFeedback\x1a\x10.main.SubmissionB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_assignments_proto_rawDescOnce sync.Once
+	file_assignments_proto_rawDescData []byte
+)
+
+func file_assignments_proto_rawDescGZIP() []byte {
+	file_assignments_proto_rawDescOnce.Do(func() {
+		file_assignments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_assignments_proto_rawDesc), len(file_assignments_proto_rawDesc)))
+	})
+	return file_assignments_proto_rawDescData
+}
+
+var file_assignments_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_assignments_proto_goTypes = []any{
+	(*Assignment)(nil),                    // 0: main.Assignment
+	(*Assignments)(nil),                   // 1: main.Assignments
+	(*AddAssignmentsRequest)(nil),         // 2: main.AddAssignmentsRequest
+	(*AddAssignmentsResponse)(nil),        // 3: main.AddAssignmentsResponse
+	(*GetAssignmentsRequest)(nil),         // 4: main.GetAssignmentsRequest
+	(*UpdateAssignmentsRequest)(nil),      // 5: main.UpdateAssignmentsRequest
+	(*AssignmentId)(nil),                  // 6: main.AssignmentId
+	(*DeleteAssignmentsRequest)(nil),      // 7: main.DeleteAssignmentsRequest
+	(*DeleteAssignmentsConfirmation)(nil), // 8: main.DeleteAssignmentsConfirmation
+	(*Submission)(nil),                    // 9: main.Submission
+	(*Submissions)(nil),                   // 10: main.Submissions
+	(*SubmissionUpload)(nil),              // 11: main.SubmissionUpload
+	(*GetSubmissionsRequest)(nil),         // 12: main.GetSubmissionsRequest
+	(*SubmissionId)(nil),                  // 13: main.SubmissionId
+	(*SubmissionDownload)(nil),            // 14: main.SubmissionDownload
+	(*SubmissionFeedback)(nil),            // 15: main.SubmissionFeedback
+	(*timestamppb.Timestamp)(nil),         // 16: google.protobuf.Timestamp
+	(*BulkItemResult)(nil),                // 17: main.BulkItemResult
+	(*SortField)(nil),                     // 18: main.SortField
+	(*fieldmaskpb.FieldMask)(nil),         // 19: google.protobuf.FieldMask
+}
+var file_assignments_proto_depIdxs = []int32{
+	16, // 0: main.Assignment.due_at:type_name -> google.protobuf.Timestamp
+	16, // 1: main.Assignment.deleted_at:type_name -> google.protobuf.Timestamp
+	16, // 2: main.Assignment.created_at:type_name -> google.protobuf.Timestamp
+	16, // 3: main.Assignment.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: main.Assignments.assignments:type_name -> main.Assignment
+	0,  // 5: main.AddAssignmentsRequest.assignments:type_name -> main.Assignment
+	0,  // 6: main.AddAssignmentsResponse.assignments:type_name -> main.Assignment
+	17, // 7: main.AddAssignmentsResponse.results:type_name -> main.BulkItemResult
+	0,  // 8: main.GetAssignmentsRequest.assignment:type_name -> main.Assignment
+	18, // 9: main.GetAssignmentsRequest.sort_by:type_name -> main.SortField
+	0,  // 10: main.UpdateAssignmentsRequest.assignments:type_name -> main.Assignment
+	19, // 11: main.UpdateAssignmentsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 12: main.DeleteAssignmentsRequest.ids:type_name -> main.AssignmentId
+	16, // 13: main.Submission.submitted_at:type_name -> google.protobuf.Timestamp
+	16, // 14: main.Submission.graded_at:type_name -> google.protobuf.Timestamp
+	16, // 15: main.Submission.created_at:type_name -> google.protobuf.Timestamp
+	16, // 16: main.Submission.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 17: main.Submissions.submissions:type_name -> main.Submission
+	9,  // 18: main.SubmissionDownload.submission:type_name -> main.Submission
+	2,  // 19: main.AssignmentsService.AddAssignments:input_type -> main.AddAssignmentsRequest
+	4,  // 20: main.AssignmentsService.GetAssignments:input_type -> main.GetAssignmentsRequest
+	5,  // 21: main.AssignmentsService.UpdateAssignments:input_type -> main.UpdateAssignmentsRequest
+	7,  // 22: main.AssignmentsService.DeleteAssignments:input_type -> main.DeleteAssignmentsRequest
+	11, // 23: main.AssignmentsService.UploadSubmission:input_type -> main.SubmissionUpload
+	12, // 24: main.AssignmentsService.GetSubmissions:input_type -> main.GetSubmissionsRequest
+	13, // 25: main.AssignmentsService.DownloadSubmission:input_type -> main.SubmissionId
+	15, // 26: main.AssignmentsService.RecordFeedback:input_type -> main.SubmissionFeedback
+	3,  // 27: main.AssignmentsService.AddAssignments:output_type -> main.AddAssignmentsResponse
+	1,  // 28: main.AssignmentsService.GetAssignments:output_type -> main.Assignments
+	1,  // 29: main.AssignmentsService.UpdateAssignments:output_type -> main.Assignments
+	8,  // 30: main.AssignmentsService.DeleteAssignments:output_type -> main.DeleteAssignmentsConfirmation
+	9,  // 31: main.AssignmentsService.UploadSubmission:output_type -> main.Submission
+	10, // 32: main.AssignmentsService.GetSubmissions:output_type -> main.Submissions
+	14, // 33: main.AssignmentsService.DownloadSubmission:output_type -> main.SubmissionDownload
+	9,  // 34: main.AssignmentsService.RecordFeedback:output_type -> main.Submission
+	27, // [27:35] is the sub-list for method output_type
+	19, // [19:27] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_assignments_proto_init() }
+func file_assignments_proto_init() {
+	if File_assignments_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_assignments_proto_rawDesc), len(file_assignments_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_assignments_proto_goTypes,
+		DependencyIndexes: file_assignments_proto_depIdxs,
+		MessageInfos:      file_assignments_proto_msgTypes,
+	}.Build()
+	File_assignments_proto = out.File
+	file_assignments_proto_goTypes = nil
+	file_assignments_proto_depIdxs = nil
+}
@@ -0,0 +1,406 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: assignments.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AssignmentsService_AddAssignments_FullMethodName     = "/main.AssignmentsService/AddAssignments"
+	AssignmentsService_GetAssignments_FullMethodName     = "/main.AssignmentsService/GetAssignments"
+	AssignmentsService_UpdateAssignments_FullMethodName  = "/main.AssignmentsService/UpdateAssignments"
+	AssignmentsService_DeleteAssignments_FullMethodName  = "/main.AssignmentsService/DeleteAssignments"
+	AssignmentsService_UploadSubmission_FullMethodName   = "/main.AssignmentsService/UploadSubmission"
+	AssignmentsService_GetSubmissions_FullMethodName     = "/main.AssignmentsService/GetSubmissions"
+	AssignmentsService_DownloadSubmission_FullMethodName = "/main.AssignmentsService/DownloadSubmission"
+	AssignmentsService_RecordFeedback_FullMethodName     = "/main.AssignmentsService/RecordFeedback"
+)
+
+// AssignmentsServiceClient is the client API for AssignmentsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to assignments and the files students submit for them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AssignmentsServiceClient interface {
+	// AddAssignments publishes assignments to classes
+	AddAssignments(ctx context.Context, in *AddAssignmentsRequest, opts ...grpc.CallOption) (*AddAssignmentsResponse, error)
+	// GetAssignments retrieves a list of assignments based on the provided criteria
+	GetAssignments(ctx context.Context, in *GetAssignmentsRequest, opts ...grpc.CallOption) (*Assignments, error)
+	// UpdateAssignments updates existing assignments
+	UpdateAssignments(ctx context.Context, in *UpdateAssignmentsRequest, opts ...grpc.CallOption) (*Assignments, error)
+	// DeleteAssignments removes assignments by their IDs
+	DeleteAssignments(ctx context.Context, in *DeleteAssignmentsRequest, opts ...grpc.CallOption) (*DeleteAssignmentsConfirmation, error)
+	// UploadSubmission streams the file a student submits for an assignment, uploading again replaces the file until feedback is recorded
+	UploadSubmission(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SubmissionUpload, Submission], error)
+	// GetSubmissions retrieves the submissions for an assignment or of a student
+	GetSubmissions(ctx context.Context, in *GetSubmissionsRequest, opts ...grpc.CallOption) (*Submissions, error)
+	// DownloadSubmission streams a submission followed by its file
+	DownloadSubmission(ctx context.Context, in *SubmissionId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubmissionDownload], error)
+	// RecordFeedback records the feedback and score of a submission
+	RecordFeedback(ctx context.Context, in *SubmissionFeedback, opts ...grpc.CallOption) (*Submission, error)
+}
+
+type assignmentsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignmentsServiceClient(cc grpc.ClientConnInterface) AssignmentsServiceClient {
+	return &assignmentsServiceClient{cc}
+}
+
+func (c *assignmentsServiceClient) AddAssignments(ctx context.Context, in *AddAssignmentsRequest, opts ...grpc.CallOption) (*AddAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAssignmentsResponse)
+	err := c.cc.Invoke(ctx, AssignmentsService_AddAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentsServiceClient) GetAssignments(ctx context.Context, in *GetAssignmentsRequest, opts ...grpc.CallOption) (*Assignments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignments)
+	err := c.cc.Invoke(ctx, AssignmentsService_GetAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentsServiceClient) UpdateAssignments(ctx context.Context, in *UpdateAssignmentsRequest, opts ...grpc.CallOption) (*Assignments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignments)
+	err := c.cc.Invoke(ctx, AssignmentsService_UpdateAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentsServiceClient) DeleteAssignments(ctx context.Context, in *DeleteAssignmentsRequest, opts ...grpc.CallOption) (*DeleteAssignmentsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssignmentsConfirmation)
+	err := c.cc.Invoke(ctx, AssignmentsService_DeleteAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentsServiceClient) UploadSubmission(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SubmissionUpload, Submission], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AssignmentsService_ServiceDesc.Streams[0], AssignmentsService_UploadSubmission_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubmissionUpload, Submission]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssignmentsService_UploadSubmissionClient = grpc.ClientStreamingClient[SubmissionUpload, Submission]
+
+func (c *assignmentsServiceClient) GetSubmissions(ctx context.Context, in *GetSubmissionsRequest, opts ...grpc.CallOption) (*Submissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Submissions)
+	err := c.cc.Invoke(ctx, AssignmentsService_GetSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentsServiceClient) DownloadSubmission(ctx context.Context, in *SubmissionId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubmissionDownload], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AssignmentsService_ServiceDesc.Streams[1], AssignmentsService_DownloadSubmission_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubmissionId, SubmissionDownload]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssignmentsService_DownloadSubmissionClient = grpc.ServerStreamingClient[SubmissionDownload]
+
+func (c *assignmentsServiceClient) RecordFeedback(ctx context.Context, in *SubmissionFeedback, opts ...grpc.CallOption) (*Submission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, AssignmentsService_RecordFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignmentsServiceServer is the server API for AssignmentsService service.
+// All implementations must embed UnimplementedAssignmentsServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to assignments and the files students submit for them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AssignmentsServiceServer interface {
+	// AddAssignments publishes assignments to classes
+	AddAssignments(context.Context, *AddAssignmentsRequest) (*AddAssignmentsResponse, error)
+	// GetAssignments retrieves a list of assignments based on the provided criteria
+	GetAssignments(context.Context, *GetAssignmentsRequest) (*Assignments, error)
+	// UpdateAssignments updates existing assignments
+	UpdateAssignments(context.Context, *UpdateAssignmentsRequest) (*Assignments, error)
+	// DeleteAssignments removes assignments by their IDs
+	DeleteAssignments(context.Context, *DeleteAssignmentsRequest) (*DeleteAssignmentsConfirmation, error)
+	// UploadSubmission streams the file a student submits for an assignment, uploading again replaces the file until feedback is recorded
+	UploadSubmission(grpc.ClientStreamingServer[SubmissionUpload, Submission]) error
+	// GetSubmissions retrieves the submissions for an assignment or of a student
+	GetSubmissions(context.Context, *GetSubmissionsRequest) (*Submissions, error)
+	// DownloadSubmission streams a submission followed by its file
+	DownloadSubmission(*SubmissionId, grpc.ServerStreamingServer[SubmissionDownload]) error
+	// RecordFeedback records the feedback and score of a submission
+	RecordFeedback(context.Context, *SubmissionFeedback) (*Submission, error)
+	mustEmbedUnimplementedAssignmentsServiceServer()
+}
+
+// UnimplementedAssignmentsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssignmentsServiceServer struct{}
+
+func (UnimplementedAssignmentsServiceServer) AddAssignments(context.Context, *AddAssignmentsRequest) (*AddAssignmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAssignments not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) GetAssignments(context.Context, *GetAssignmentsRequest) (*Assignments, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAssignments not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) UpdateAssignments(context.Context, *UpdateAssignmentsRequest) (*Assignments, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAssignments not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) DeleteAssignments(context.Context, *DeleteAssignmentsRequest) (*DeleteAssignmentsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAssignments not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) UploadSubmission(grpc.ClientStreamingServer[SubmissionUpload, Submission]) error {
+	return status.Error(codes.Unimplemented, "method UploadSubmission not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) GetSubmissions(context.Context, *GetSubmissionsRequest) (*Submissions, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubmissions not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) DownloadSubmission(*SubmissionId, grpc.ServerStreamingServer[SubmissionDownload]) error {
+	return status.Error(codes.Unimplemented, "method DownloadSubmission not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) RecordFeedback(context.Context, *SubmissionFeedback) (*Submission, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordFeedback not implemented")
+}
+func (UnimplementedAssignmentsServiceServer) mustEmbedUnimplementedAssignmentsServiceServer() {}
+func (UnimplementedAssignmentsServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeAssignmentsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignmentsServiceServer will
+// result in compilation errors.
+type UnsafeAssignmentsServiceServer interface {
+	mustEmbedUnimplementedAssignmentsServiceServer()
+}
+
+func RegisterAssignmentsServiceServer(s grpc.ServiceRegistrar, srv AssignmentsServiceServer) {
+	// If the following call panics, it indicates UnimplementedAssignmentsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssignmentsService_ServiceDesc, srv)
+}
+
+func _AssignmentsService_AddAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentsServiceServer).AddAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentsService_AddAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentsServiceServer).AddAssignments(ctx, req.(*AddAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentsService_GetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentsServiceServer).GetAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentsService_GetAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentsServiceServer).GetAssignments(ctx, req.(*GetAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentsService_UpdateAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentsServiceServer).UpdateAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentsService_UpdateAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentsServiceServer).UpdateAssignments(ctx, req.(*UpdateAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentsService_DeleteAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentsServiceServer).DeleteAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentsService_DeleteAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentsServiceServer).DeleteAssignments(ctx, req.(*DeleteAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentsService_UploadSubmission_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AssignmentsServiceServer).UploadSubmission(&grpc.GenericServerStream[SubmissionUpload, Submission]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssignmentsService_UploadSubmissionServer = grpc.ClientStreamingServer[SubmissionUpload, Submission]
+
+func _AssignmentsService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentsServiceServer).GetSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentsService_GetSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentsServiceServer).GetSubmissions(ctx, req.(*GetSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentsService_DownloadSubmission_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubmissionId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AssignmentsServiceServer).DownloadSubmission(m, &grpc.GenericServerStream[SubmissionId, SubmissionDownload]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AssignmentsService_DownloadSubmissionServer = grpc.ServerStreamingServer[SubmissionDownload]
+
+func _AssignmentsService_RecordFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentsServiceServer).RecordFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentsService_RecordFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentsServiceServer).RecordFeedback(ctx, req.(*SubmissionFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignmentsService_ServiceDesc is the grpc.ServiceDesc for AssignmentsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignmentsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AssignmentsService",
+	HandlerType: (*AssignmentsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAssignments",
+			Handler:    _AssignmentsService_AddAssignments_Handler,
+		},
+		{
+			MethodName: "GetAssignments",
+			Handler:    _AssignmentsService_GetAssignments_Handler,
+		},
+		{
+			MethodName: "UpdateAssignments",
+			Handler:    _AssignmentsService_UpdateAssignments_Handler,
+		},
+		{
+			MethodName: "DeleteAssignments",
+			Handler:    _AssignmentsService_DeleteAssignments_Handler,
+		},
+		{
+			MethodName: "GetSubmissions",
+			Handler:    _AssignmentsService_GetSubmissions_Handler,
+		},
+		{
+			MethodName: "RecordFeedback",
+			Handler:    _AssignmentsService_RecordFeedback_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadSubmission",
+			Handler:       _AssignmentsService_UploadSubmission_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadSubmission",
+			Handler:       _AssignmentsService_DownloadSubmission_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "assignments.proto",
+}
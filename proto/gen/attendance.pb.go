@@ -0,0 +1,1232 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttendanceStatus int32
+
+const (
+	// ATTENDANCE_STATUS_UNSPECIFIED is rejected, it keeps a mark without a status from reading as present
+	AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED AttendanceStatus = 0
+	AttendanceStatus_PRESENT                       AttendanceStatus = 1
+	AttendanceStatus_ABSENT                        AttendanceStatus = 2
+	AttendanceStatus_LATE                          AttendanceStatus = 3
+	AttendanceStatus_EXCUSED                       AttendanceStatus = 4
+)
+
+// Enum value maps for AttendanceStatus.
+var (
+	AttendanceStatus_name = map[int32]string{
+		0: "ATTENDANCE_STATUS_UNSPECIFIED",
+		1: "PRESENT",
+		2: "ABSENT",
+		3: "LATE",
+		4: "EXCUSED",
+	}
+	AttendanceStatus_value = map[string]int32{
+		"ATTENDANCE_STATUS_UNSPECIFIED": 0,
+		"PRESENT":                       1,
+		"ABSENT":                        2,
+		"LATE":                          3,
+		"EXCUSED":                       4,
+	}
+)
+
+func (x AttendanceStatus) Enum() *AttendanceStatus {
+	p := new(AttendanceStatus)
+	*p = x
+	return p
+}
+
+func (x AttendanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttendanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_attendance_proto_enumTypes[0].Descriptor()
+}
+
+func (AttendanceStatus) Type() protoreflect.EnumType {
+	return &file_attendance_proto_enumTypes[0]
+}
+
+func (x AttendanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttendanceStatus.Descriptor instead.
+func (AttendanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+// AttendanceRecord is the status of a student in one session of a class
+// A student has a single record per class, day and session
+type AttendanceRecord struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// session_date is the day of the session, stored as midnight UTC
+	SessionDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=session_date,json=sessionDate,proto3" json:"session_date,omitempty"`
+	// session tells the sessions of a day apart, such as morning or period 3
+	Session string           `protobuf:"bytes,5,opt,name=session,proto3" json:"session,omitempty"`
+	Status  AttendanceStatus `protobuf:"varint,6,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	Note    string           `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	// corrections lists every change of the status, oldest first
+	Corrections []*AttendanceCorrection `protobuf:"bytes,8,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	// version is increased on every change, send it back with a correction to detect concurrent edits
+	Version int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,13,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecord) Reset() {
+	*x = AttendanceRecord{}
+	mi := &file_attendance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecord) ProtoMessage() {}
+
+func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecord.ProtoReflect.Descriptor instead.
+func (*AttendanceRecord) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttendanceRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetSessionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionDate
+	}
+	return nil
+}
+
+func (x *AttendanceRecord) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceRecord) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetCorrections() []*AttendanceCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *AttendanceRecord) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AttendanceRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AttendanceRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AttendanceRecord) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type AttendanceCorrection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PreviousStatus AttendanceStatus       `protobuf:"varint,1,opt,name=previous_status,json=previousStatus,proto3,enum=main.AttendanceStatus" json:"previous_status,omitempty"`
+	Status         AttendanceStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CorrectedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=corrected_at,json=correctedAt,proto3" json:"corrected_at,omitempty"`
+	CorrectedBy    string                 `protobuf:"bytes,5,opt,name=corrected_by,json=correctedBy,proto3" json:"corrected_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttendanceCorrection) Reset() {
+	*x = AttendanceCorrection{}
+	mi := &file_attendance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceCorrection) ProtoMessage() {}
+
+func (x *AttendanceCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceCorrection.ProtoReflect.Descriptor instead.
+func (*AttendanceCorrection) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttendanceCorrection) GetPreviousStatus() AttendanceStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceCorrection) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceCorrection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AttendanceCorrection) GetCorrectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CorrectedAt
+	}
+	return nil
+}
+
+func (x *AttendanceCorrection) GetCorrectedBy() string {
+	if x != nil {
+		return x.CorrectedBy
+	}
+	return ""
+}
+
+type AttendanceRecords struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AttendanceRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecords) Reset() {
+	*x = AttendanceRecords{}
+	mi := &file_attendance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecords) ProtoMessage() {}
+
+func (x *AttendanceRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecords.ProtoReflect.Descriptor instead.
+func (*AttendanceRecords) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttendanceRecords) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// AttendanceMark is the status of one student in a RecordAttendanceRequest
+type AttendanceMark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status        AttendanceStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceMark) Reset() {
+	*x = AttendanceMark{}
+	mi := &file_attendance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceMark) ProtoMessage() {}
+
+func (x *AttendanceMark) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceMark.ProtoReflect.Descriptor instead.
+func (*AttendanceMark) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttendanceMark) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AttendanceMark) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceMark) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RecordAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	SessionDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=session_date,json=sessionDate,proto3" json:"session_date,omitempty"`
+	Session       string                 `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	Marks         []*AttendanceMark      `protobuf:"bytes,4,rep,name=marks,proto3" json:"marks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAttendanceRequest) Reset() {
+	*x = RecordAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAttendanceRequest) ProtoMessage() {}
+
+func (x *RecordAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*RecordAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordAttendanceRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *RecordAttendanceRequest) GetSessionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionDate
+	}
+	return nil
+}
+
+func (x *RecordAttendanceRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *RecordAttendanceRequest) GetMarks() []*AttendanceMark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+type RecordAttendanceResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Records []*AttendanceRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// results reports the outcome for every mark by its index, attendance that was already recorded is rejected with ALREADY_EXISTS
+	Results       []*BulkItemResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAttendanceResponse) Reset() {
+	*x = RecordAttendanceResponse{}
+	mi := &file_attendance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAttendanceResponse) ProtoMessage() {}
+
+func (x *RecordAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*RecordAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecordAttendanceResponse) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *RecordAttendanceResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AttendanceRecordCorrection struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status AttendanceStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	// reason is required
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expected version of the record, the correction fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecordCorrection) Reset() {
+	*x = AttendanceRecordCorrection{}
+	mi := &file_attendance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecordCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecordCorrection) ProtoMessage() {}
+
+func (x *AttendanceRecordCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecordCorrection.ProtoReflect.Descriptor instead.
+func (*AttendanceRecordCorrection) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AttendanceRecordCorrection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceRecordCorrection) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceRecordCorrection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AttendanceRecordCorrection) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CorrectAttendanceRequest struct {
+	state       protoimpl.MessageState        `protogen:"open.v1"`
+	Corrections []*AttendanceRecordCorrection `protobuf:"bytes,1,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	// atomic applies all corrections in a single transaction, so either all of them are applied or none
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CorrectAttendanceRequest) Reset() {
+	*x = CorrectAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorrectAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectAttendanceRequest) ProtoMessage() {}
+
+func (x *CorrectAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*CorrectAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CorrectAttendanceRequest) GetCorrections() []*AttendanceRecordCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *CorrectAttendanceRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+// DateRange limits a query to the sessions from the day of from up to and including the day of to, either end may be left open
+type DateRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_attendance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DateRange) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DateRange) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetAttendanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// at least one of student_id and class_id is required
+	StudentId     string     `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId       string     `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Dates         *DateRange `protobuf:"bytes,3,opt,name=dates,proto3" json:"dates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRequest) Reset() {
+	*x = GetAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAttendanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetDates() *DateRange {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+// AttendanceSummary counts sessions by status
+// percentage is the share of sessions the student was present or late for, excused sessions are left out and it is 0 without any sessions left
+type AttendanceSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId       string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Sessions      int64                  `protobuf:"varint,3,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	Present       int64                  `protobuf:"varint,4,opt,name=present,proto3" json:"present,omitempty"`
+	Absent        int64                  `protobuf:"varint,5,opt,name=absent,proto3" json:"absent,omitempty"`
+	Late          int64                  `protobuf:"varint,6,opt,name=late,proto3" json:"late,omitempty"`
+	Excused       int64                  `protobuf:"varint,7,opt,name=excused,proto3" json:"excused,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,8,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceSummary) Reset() {
+	*x = AttendanceSummary{}
+	mi := &file_attendance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceSummary) ProtoMessage() {}
+
+func (x *AttendanceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceSummary.ProtoReflect.Descriptor instead.
+func (*AttendanceSummary) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AttendanceSummary) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AttendanceSummary) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *AttendanceSummary) GetSessions() int64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetPresent() int64 {
+	if x != nil {
+		return x.Present
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetAbsent() int64 {
+	if x != nil {
+		return x.Absent
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetLate() int64 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetExcused() int64 {
+	if x != nil {
+		return x.Excused
+	}
+	return 0
+}
+
+func (x *AttendanceSummary) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type StudentAttendanceSummaryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// class_id limits the summary to one class, otherwise every class of the student counts
+	ClassId       string     `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Dates         *DateRange `protobuf:"bytes,3,opt,name=dates,proto3" json:"dates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentAttendanceSummaryRequest) Reset() {
+	*x = StudentAttendanceSummaryRequest{}
+	mi := &file_attendance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentAttendanceSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentAttendanceSummaryRequest) ProtoMessage() {}
+
+func (x *StudentAttendanceSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentAttendanceSummaryRequest.ProtoReflect.Descriptor instead.
+func (*StudentAttendanceSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StudentAttendanceSummaryRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentAttendanceSummaryRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *StudentAttendanceSummaryRequest) GetDates() *DateRange {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+type ClassAttendanceSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Dates         *DateRange             `protobuf:"bytes,2,opt,name=dates,proto3" json:"dates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassAttendanceSummaryRequest) Reset() {
+	*x = ClassAttendanceSummaryRequest{}
+	mi := &file_attendance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassAttendanceSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassAttendanceSummaryRequest) ProtoMessage() {}
+
+func (x *ClassAttendanceSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassAttendanceSummaryRequest.ProtoReflect.Descriptor instead.
+func (*ClassAttendanceSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClassAttendanceSummaryRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassAttendanceSummaryRequest) GetDates() *DateRange {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+type ClassAttendanceSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// total sums up the sessions of every student of the class
+	Total         *AttendanceSummary   `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Students      []*AttendanceSummary `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassAttendanceSummary) Reset() {
+	*x = ClassAttendanceSummary{}
+	mi := &file_attendance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassAttendanceSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassAttendanceSummary) ProtoMessage() {}
+
+func (x *ClassAttendanceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassAttendanceSummary.ProtoReflect.Descriptor instead.
+func (*ClassAttendanceSummary) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClassAttendanceSummary) GetTotal() *AttendanceSummary {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ClassAttendanceSummary) GetStudents() []*AttendanceSummary {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+var File_attendance_proto protoreflect.FileDescriptor
+
+const file_attendance_proto_rawDesc = "" +
+	"\n" +
+	"\x10attendance.proto\x12\x04main\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\"\x85\x04\n" +
+	"\x10AttendanceRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\tR\aclassId\x12=\n" +
+	"\fsession_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vsessionDate\x12\x18\n" +
+	"\asession\x18\x05 \x01(\tR\asession\x12.\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.main.AttendanceStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12<\n" +
+	"\vcorrections\x18\b \x03(\v2\x1a.main.AttendanceCorrectionR\vcorrections\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\r \x01(\tR\tupdatedBy\"\x81\x02\n" +
+	"\x14AttendanceCorrection\x12?\n" +
+	"\x0fprevious_status\x18\x01 \x01(\x0e2\x16.main.AttendanceStatusR\x0epreviousStatus\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.main.AttendanceStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12=\n" +
+	"\fcorrected_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcorrectedAt\x12!\n" +
+	"\fcorrected_by\x18\x05 \x01(\tR\vcorrectedBy\"E\n" +
+	"\x11AttendanceRecords\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.main.AttendanceRecordR\arecords\"s\n" +
+	"\x0eAttendanceMark\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.main.AttendanceStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xb9\x01\n" +
+	"\x17RecordAttendanceRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12=\n" +
+	"\fsession_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vsessionDate\x12\x18\n" +
+	"\asession\x18\x03 \x01(\tR\asession\x12*\n" +
+	"\x05marks\x18\x04 \x03(\v2\x14.main.AttendanceMarkR\x05marks\"|\n" +
+	"\x18RecordAttendanceResponse\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.main.AttendanceRecordR\arecords\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x8e\x01\n" +
+	"\x1aAttendanceRecordCorrection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.main.AttendanceStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\"v\n" +
+	"\x18CorrectAttendanceRequest\x12B\n" +
+	"\vcorrections\x18\x01 \x03(\v2 .main.AttendanceRecordCorrectionR\vcorrections\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"g\n" +
+	"\tDateRange\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"w\n" +
+	"\x14GetAttendanceRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12%\n" +
+	"\x05dates\x18\x03 \x01(\v2\x0f.main.DateRangeR\x05dates\"\xe9\x01\n" +
+	"\x11AttendanceSummary\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12\x1a\n" +
+	"\bsessions\x18\x03 \x01(\x03R\bsessions\x12\x18\n" +
+	"\apresent\x18\x04 \x01(\x03R\apresent\x12\x16\n" +
+	"\x06absent\x18\x05 \x01(\x03R\x06absent\x12\x12\n" +
+	"\x04late\x18\x06 \x01(\x03R\x04late\x12\x18\n" +
+	"\aexcused\x18\a \x01(\x03R\aexcused\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\b \x01(\x01R\n" +
+	"percentage\"\x82\x01\n" +
+	"\x1fStudentAttendanceSummaryRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12%\n" +
+	"\x05dates\x18\x03 \x01(\v2\x0f.main.DateRangeR\x05dates\"a\n" +
+	"\x1dClassAttendanceSummaryRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12%\n" +
+	"\x05dates\x18\x02 \x01(\v2\x0f.main.DateRangeR\x05dates\"|\n" +
+	"\x16ClassAttendanceSummary\x12-\n" +
+	"\x05total\x18\x01 \x01(\v2\x17.main.AttendanceSummaryR\x05total\x123\n" +
+	"\bstudents\x18\x02 \x03(\v2\x17.main.AttendanceSummaryR\bstudents*e\n" +
+	"\x10AttendanceStatus\x12!\n" +
+	"\x1dATTENDANCE_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPRESENT\x10\x01\x12\n" +
+	"\n" +
+	"\x06ABSENT\x10\x02\x12\b\n" +
+	"\x04LATE\x10\x03\x12\v\n" +
+	"\aEXCUSED\x10\x042\xb9\x03\n" +
+	"\x11AttendanceService\x12Q\n" +
+	"\x10RecordAttendance\x12\x1d.main.RecordAttendanceRequest\x1a\x1e.main.RecordAttendanceResponse\x12L\n" +
+	"\x11CorrectAttendance\x12\x1e.main.CorrectAttendanceRequest\x1a\x17.main.AttendanceRecords\x12D\n" +
+	"\rGetAttendance\x12\x1a.main.GetAttendanceRequest\x1a\x17.main.AttendanceRecords\x12]\n" +
+	"\x1bGetStudentAttendanceSummary\x12%.main.StudentAttendanceSummaryRequest\x1a\x17.main.AttendanceSummary\x12^\n" +
+	"\x19GetClassAttendanceSummary\x12#.main.ClassAttendanceSummaryRequest\x1a\x1c.main.ClassAttendanceSummaryB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_attendance_proto_rawDescOnce sync.Once
+	file_attendance_proto_rawDescData []byte
+)
+
+func file_attendance_proto_rawDescGZIP() []byte {
+	file_attendance_proto_rawDescOnce.Do(func() {
+		file_attendance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)))
+	})
+	return file_attendance_proto_rawDescData
+}
+
+var file_attendance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_attendance_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_attendance_proto_goTypes = []any{
+	(AttendanceStatus)(0),                   // 0: main.AttendanceStatus
+	(*AttendanceRecord)(nil),                // 1: main.AttendanceRecord
+	(*AttendanceCorrection)(nil),            // 2: main.AttendanceCorrection
+	(*AttendanceRecords)(nil),               // 3: main.AttendanceRecords
+	(*AttendanceMark)(nil),                  // 4: main.AttendanceMark
+	(*RecordAttendanceRequest)(nil),         // 5: main.RecordAttendanceRequest
+	(*RecordAttendanceResponse)(nil),        // 6: main.RecordAttendanceResponse
+	(*AttendanceRecordCorrection)(nil),      // 7: main.AttendanceRecordCorrection
+	(*CorrectAttendanceRequest)(nil),        // 8: main.CorrectAttendanceRequest
+	(*DateRange)(nil),                       // 9: main.DateRange
+	(*GetAttendanceRequest)(nil),            // 10: main.GetAttendanceRequest
+	(*AttendanceSummary)(nil),               // 11: main.AttendanceSummary
+	(*StudentAttendanceSummaryRequest)(nil), // 12: main.StudentAttendanceSummaryRequest
+	(*ClassAttendanceSummaryRequest)(nil),   // 13: main.ClassAttendanceSummaryRequest
+	(*ClassAttendanceSummary)(nil),          // 14: main.ClassAttendanceSummary
+	(*timestamppb.Timestamp)(nil),           // 15: google.protobuf.Timestamp
+	(*BulkItemResult)(nil),                  // 16: main.BulkItemResult
+}
+var file_attendance_proto_depIdxs = []int32{
+	15, // 0: main.AttendanceRecord.session_date:type_name -> google.protobuf.Timestamp
+	0,  // 1: main.AttendanceRecord.status:type_name -> main.AttendanceStatus
+	2,  // 2: main.AttendanceRecord.corrections:type_name -> main.AttendanceCorrection
+	15, // 3: main.AttendanceRecord.created_at:type_name -> google.protobuf.Timestamp
+	15, // 4: main.AttendanceRecord.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: main.AttendanceCorrection.previous_status:type_name -> main.AttendanceStatus
+	0,  // 6: main.AttendanceCorrection.status:type_name -> main.AttendanceStatus
+	15, // 7: main.AttendanceCorrection.corrected_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: main.AttendanceRecords.records:type_name -> main.AttendanceRecord
+	0,  // 9: main.AttendanceMark.status:type_name -> main.AttendanceStatus
+	15, // 10: main.RecordAttendanceRequest.session_date:type_name -> google.protobuf.Timestamp
+	4,  // 11: main.RecordAttendanceRequest.marks:type_name -> main.AttendanceMark
+	1,  // 12: main.RecordAttendanceResponse.records:type_name -> main.AttendanceRecord
+	16, // 13: main.RecordAttendanceResponse.results:type_name -> main.BulkItemResult
+	0,  // 14: main.AttendanceRecordCorrection.status:type_name -> main.AttendanceStatus
+	7,  // 15: main.CorrectAttendanceRequest.corrections:type_name -> main.AttendanceRecordCorrection
+	15, // 16: main.DateRange.from:type_name -> google.protobuf.Timestamp
+	15, // 17: main.DateRange.to:type_name -> google.protobuf.Timestamp
+	9,  // 18: main.GetAttendanceRequest.dates:type_name -> main.DateRange
+	9,  // 19: main.StudentAttendanceSummaryRequest.dates:type_name -> main.DateRange
+	9,  // 20: main.ClassAttendanceSummaryRequest.dates:type_name -> main.DateRange
+	11, // 21: main.ClassAttendanceSummary.total:type_name -> main.AttendanceSummary
+	11, // 22: main.ClassAttendanceSummary.students:type_name -> main.AttendanceSummary
+	5,  // 23: main.AttendanceService.RecordAttendance:input_type -> main.RecordAttendanceRequest
+	8,  // 24: main.AttendanceService.CorrectAttendance:input_type -> main.CorrectAttendanceRequest
+	10, // 25: main.AttendanceService.GetAttendance:input_type -> main.GetAttendanceRequest
+	12, // 26: main.AttendanceService.GetStudentAttendanceSummary:input_type -> main.StudentAttendanceSummaryRequest
+	13, // 27: main.AttendanceService.GetClassAttendanceSummary:input_type -> main.ClassAttendanceSummaryRequest
+	6,  // 28: main.AttendanceService.RecordAttendance:output_type -> main.RecordAttendanceResponse
+	3,  // 29: main.AttendanceService.CorrectAttendance:output_type -> main.AttendanceRecords
+	3,  // 30: main.AttendanceService.GetAttendance:output_type -> main.AttendanceRecords
+	11, // 31: main.AttendanceService.GetStudentAttendanceSummary:output_type -> main.AttendanceSummary
+	14, // 32: main.AttendanceService.GetClassAttendanceSummary:output_type -> main.ClassAttendanceSummary
+	28, // [28:33] is the sub-list for method output_type
+	23, // [23:28] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_attendance_proto_init() }
+func file_attendance_proto_init() {
+	if File_attendance_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attendance_proto_goTypes,
+		DependencyIndexes: file_attendance_proto_depIdxs,
+		EnumInfos:         file_attendance_proto_enumTypes,
+		MessageInfos:      file_attendance_proto_msgTypes,
+	}.Build()
+	File_attendance_proto = out.File
+	file_attendance_proto_goTypes = nil
+	file_attendance_proto_depIdxs = nil
+}
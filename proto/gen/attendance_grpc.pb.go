@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttendanceService_RecordAttendance_FullMethodName            = "/main.AttendanceService/RecordAttendance"
+	AttendanceService_CorrectAttendance_FullMethodName           = "/main.AttendanceService/CorrectAttendance"
+	AttendanceService_GetAttendance_FullMethodName               = "/main.AttendanceService/GetAttendance"
+	AttendanceService_GetStudentAttendanceSummary_FullMethodName = "/main.AttendanceService/GetStudentAttendanceSummary"
+	AttendanceService_GetClassAttendanceSummary_FullMethodName   = "/main.AttendanceService/GetClassAttendanceSummary"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to taking attendance in classes
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AttendanceServiceClient interface {
+	// RecordAttendance records the attendance of students of a class in one session, every student is accepted or rejected on their own
+	RecordAttendance(ctx context.Context, in *RecordAttendanceRequest, opts ...grpc.CallOption) (*RecordAttendanceResponse, error)
+	// CorrectAttendance changes the status of recorded attendance, the previous status and the reason are kept with the record
+	CorrectAttendance(ctx context.Context, in *CorrectAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error)
+	// GetAttendance retrieves the attendance of a student or class, optionally limited to a date range
+	GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error)
+	// GetStudentAttendanceSummary counts the sessions of a student by status and works out their attendance percentage
+	GetStudentAttendanceSummary(ctx context.Context, in *StudentAttendanceSummaryRequest, opts ...grpc.CallOption) (*AttendanceSummary, error)
+	// GetClassAttendanceSummary summarises the attendance of a class as a whole and of each of its students
+	GetClassAttendanceSummary(ctx context.Context, in *ClassAttendanceSummaryRequest, opts ...grpc.CallOption) (*ClassAttendanceSummary, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) RecordAttendance(ctx context.Context, in *RecordAttendanceRequest, opts ...grpc.CallOption) (*RecordAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_RecordAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) CorrectAttendance(ctx context.Context, in *CorrectAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecords)
+	err := c.cc.Invoke(ctx, AttendanceService_CorrectAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecords)
+	err := c.cc.Invoke(ctx, AttendanceService_GetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetStudentAttendanceSummary(ctx context.Context, in *StudentAttendanceSummaryRequest, opts ...grpc.CallOption) (*AttendanceSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceSummary)
+	err := c.cc.Invoke(ctx, AttendanceService_GetStudentAttendanceSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetClassAttendanceSummary(ctx context.Context, in *ClassAttendanceSummaryRequest, opts ...grpc.CallOption) (*ClassAttendanceSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassAttendanceSummary)
+	err := c.cc.Invoke(ctx, AttendanceService_GetClassAttendanceSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to taking attendance in classes
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type AttendanceServiceServer interface {
+	// RecordAttendance records the attendance of students of a class in one session, every student is accepted or rejected on their own
+	RecordAttendance(context.Context, *RecordAttendanceRequest) (*RecordAttendanceResponse, error)
+	// CorrectAttendance changes the status of recorded attendance, the previous status and the reason are kept with the record
+	CorrectAttendance(context.Context, *CorrectAttendanceRequest) (*AttendanceRecords, error)
+	// GetAttendance retrieves the attendance of a student or class, optionally limited to a date range
+	GetAttendance(context.Context, *GetAttendanceRequest) (*AttendanceRecords, error)
+	// GetStudentAttendanceSummary counts the sessions of a student by status and works out their attendance percentage
+	GetStudentAttendanceSummary(context.Context, *StudentAttendanceSummaryRequest) (*AttendanceSummary, error)
+	// GetClassAttendanceSummary summarises the attendance of a class as a whole and of each of its students
+	GetClassAttendanceSummary(context.Context, *ClassAttendanceSummaryRequest) (*ClassAttendanceSummary, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) RecordAttendance(context.Context, *RecordAttendanceRequest) (*RecordAttendanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) CorrectAttendance(context.Context, *CorrectAttendanceRequest) (*AttendanceRecords, error) {
+	return nil, status.Error(codes.Unimplemented, "method CorrectAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetAttendance(context.Context, *GetAttendanceRequest) (*AttendanceRecords, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetStudentAttendanceSummary(context.Context, *StudentAttendanceSummaryRequest) (*AttendanceSummary, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStudentAttendanceSummary not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetClassAttendanceSummary(context.Context, *ClassAttendanceSummaryRequest) (*ClassAttendanceSummary, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClassAttendanceSummary not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call panics, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_RecordAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).RecordAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_RecordAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).RecordAttendance(ctx, req.(*RecordAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_CorrectAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CorrectAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CorrectAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CorrectAttendance(ctx, req.(*CorrectAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, req.(*GetAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetStudentAttendanceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentAttendanceSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetStudentAttendanceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetStudentAttendanceSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetStudentAttendanceSummary(ctx, req.(*StudentAttendanceSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetClassAttendanceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassAttendanceSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetClassAttendanceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetClassAttendanceSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetClassAttendanceSummary(ctx, req.(*ClassAttendanceSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordAttendance",
+			Handler:    _AttendanceService_RecordAttendance_Handler,
+		},
+		{
+			MethodName: "CorrectAttendance",
+			Handler:    _AttendanceService_CorrectAttendance_Handler,
+		},
+		{
+			MethodName: "GetAttendance",
+			Handler:    _AttendanceService_GetAttendance_Handler,
+		},
+		{
+			MethodName: "GetStudentAttendanceSummary",
+			Handler:    _AttendanceService_GetStudentAttendanceSummary_Handler,
+		},
+		{
+			MethodName: "GetClassAttendanceSummary",
+			Handler:    _AttendanceService_GetClassAttendanceSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "attendance.proto",
+}
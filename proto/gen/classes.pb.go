@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: classes.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddClassesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Classes []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	// ordered stops inserting at the first failing class, otherwise every valid class is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all classes in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddClassesRequest) Reset() {
+	*x = AddClassesRequest{}
+	mi := &file_classes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddClassesRequest) ProtoMessage() {}
+
+func (x *AddClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddClassesRequest.ProtoReflect.Descriptor instead.
+func (*AddClassesRequest) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddClassesRequest) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *AddClassesRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddClassesRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type UpdateClassesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Classes []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every class, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClassesRequest) Reset() {
+	*x = UpdateClassesRequest{}
+	mi := &file_classes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClassesRequest) ProtoMessage() {}
+
+func (x *UpdateClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClassesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClassesRequest) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateClassesRequest) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *UpdateClassesRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateClassesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteClassesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*ClassId             `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all classes in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassesRequest) Reset() {
+	*x = DeleteClassesRequest{}
+	mi := &file_classes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassesRequest) ProtoMessage() {}
+
+func (x *DeleteClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClassesRequest) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteClassesRequest) GetIds() []*ClassId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteClassesRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddClassesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddClassesResponse) Reset() {
+	*x = AddClassesResponse{}
+	mi := &file_classes_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddClassesResponse) ProtoMessage() {}
+
+func (x *AddClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddClassesResponse.ProtoReflect.Descriptor instead.
+func (*AddClassesResponse) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddClassesResponse) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *AddClassesResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RestoreClassesConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredIds   []string               `protobuf:"bytes,2,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreClassesConfirmation) Reset() {
+	*x = RestoreClassesConfirmation{}
+	mi := &file_classes_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreClassesConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreClassesConfirmation) ProtoMessage() {}
+
+func (x *RestoreClassesConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreClassesConfirmation.ProtoReflect.Descriptor instead.
+func (*RestoreClassesConfirmation) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestoreClassesConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestoreClassesConfirmation) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
+type DeleteClassesConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassesConfirmation) Reset() {
+	*x = DeleteClassesConfirmation{}
+	mi := &file_classes_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassesConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassesConfirmation) ProtoMessage() {}
+
+func (x *DeleteClassesConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassesConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteClassesConfirmation) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteClassesConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteClassesConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type ClassId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the class, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassId) Reset() {
+	*x = ClassId{}
+	mi := &file_classes_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassId) ProtoMessage() {}
+
+func (x *ClassId) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassId.ProtoReflect.Descriptor instead.
+func (*ClassId) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClassId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClassId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ClassIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []*ClassId             `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassIds) Reset() {
+	*x = ClassIds{}
+	mi := &file_classes_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassIds) ProtoMessage() {}
+
+func (x *ClassIds) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassIds.ProtoReflect.Descriptor instead.
+func (*ClassIds) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClassIds) GetIds() []*ClassId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetClassesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Class  *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	SortBy []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted classes
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// time_filters limit the classes to the given time ranges of their timestamp fields
+	TimeFilters   []*TimestampFilter `protobuf:"bytes,4,rep,name=time_filters,json=timeFilters,proto3" json:"time_filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassesRequest) Reset() {
+	*x = GetClassesRequest{}
+	mi := &file_classes_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassesRequest) ProtoMessage() {}
+
+func (x *GetClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassesRequest.ProtoReflect.Descriptor instead.
+func (*GetClassesRequest) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetClassesRequest) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *GetClassesRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetClassesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetClassesRequest) GetTimeFilters() []*TimestampFilter {
+	if x != nil {
+		return x.TimeFilters
+	}
+	return nil
+}
+
+// Class is a group of students of one grade in an academic year, the grade, section and academic year are unique together
+type Class struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Grade   int32                  `protobuf:"varint,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	Section string                 `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	// academic_year_id is the ID of the academic year the class belongs to
+	AcademicYearId string `protobuf:"bytes,14,opt,name=academic_year_id,json=academicYearId,proto3" json:"academic_year_id,omitempty"`
+	// homeroom_teacher_id is the ID of the teacher in charge of the class
+	HomeroomTeacherId string `protobuf:"bytes,5,opt,name=homeroom_teacher_id,json=homeroomTeacherId,proto3" json:"homeroom_teacher_id,omitempty"`
+	// capacity is the most students the class takes, 0 means unlimited
+	Capacity int32 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted classes, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,9,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,13,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	mi := &file_classes_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Class) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Class) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Class) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Class) GetAcademicYearId() string {
+	if x != nil {
+		return x.AcademicYearId
+	}
+	return ""
+}
+
+func (x *Class) GetHomeroomTeacherId() string {
+	if x != nil {
+		return x.HomeroomTeacherId
+	}
+	return ""
+}
+
+func (x *Class) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Class) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Class) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Class) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Class) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Class) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Class) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Class) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Classes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Classes) Reset() {
+	*x = Classes{}
+	mi := &file_classes_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Classes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Classes) ProtoMessage() {}
+
+func (x *Classes) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Classes.ProtoReflect.Descriptor instead.
+func (*Classes) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Classes) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+var File_classes_proto protoreflect.FileDescriptor
+
+const file_classes_proto_rawDesc = "" +
+	"\n" +
+	"\rclasses.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\"l\n" +
+	"\x11AddClassesRequest\x12%\n" +
+	"\aclasses\x18\x01 \x03(\v2\v.main.ClassR\aclasses\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"\x92\x01\n" +
+	"\x14UpdateClassesRequest\x12%\n" +
+	"\aclasses\x18\x01 \x03(\v2\v.main.ClassR\aclasses\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"O\n" +
+	"\x14DeleteClassesRequest\x12\x1f\n" +
+	"\x03ids\x18\x01 \x03(\v2\r.main.ClassIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"k\n" +
+	"\x12AddClassesResponse\x12%\n" +
+	"\aclasses\x18\x01 \x03(\v2\v.main.ClassR\aclasses\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"W\n" +
+	"\x1aRestoreClassesConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\frestored_ids\x18\x02 \x03(\tR\vrestoredIds\"T\n" +
+	"\x19DeleteClassesConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"3\n" +
+	"\aClassId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"+\n" +
+	"\bClassIds\x12\x1f\n" +
+	"\x03ids\x18\x01 \x03(\v2\r.main.ClassIdR\x03ids\"\xc3\x01\n" +
+	"\x11GetClassesRequest\x12!\n" +
+	"\x05class\x18\x01 \x01(\v2\v.main.ClassR\x05class\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\x128\n" +
+	"\ftime_filters\x18\x04 \x03(\v2\x15.main.TimestampFilterR\vtimeFilters\"\xfa\x03\n" +
+	"\x05Class\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\x05R\x05grade\x12\x18\n" +
+	"\asection\x18\x03 \x01(\tR\asection\x12(\n" +
+	"\x10academic_year_id\x18\x0e \x01(\tR\x0eacademicYearId\x12.\n" +
+	"\x13homeroom_teacher_id\x18\x05 \x01(\tR\x11homeroomTeacherId\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\t \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\r \x01(\tR\tupdatedByJ\x04\b\x04\x10\x05R\racademic_year\"0\n" +
+	"\aClasses\x12%\n" +
+	"\aclasses\x18\x01 \x03(\v2\v.main.ClassR\aclasses2\x8a\x03\n" +
+	"\x0eClassesService\x124\n" +
+	"\n" +
+	"GetClasses\x12\x17.main.GetClassesRequest\x1a\r.main.Classes\x12?\n" +
+	"\n" +
+	"AddClasses\x12\x17.main.AddClassesRequest\x1a\x18.main.AddClassesResponse\x12:\n" +
+	"\rUpdateClasses\x12\x1a.main.UpdateClassesRequest\x1a\r.main.Classes\x12L\n" +
+	"\rDeleteClasses\x12\x1a.main.DeleteClassesRequest\x1a\x1f.main.DeleteClassesConfirmation\x12B\n" +
+	"\x0eRestoreClasses\x12\x0e.main.ClassIds\x1a .main.RestoreClassesConfirmation\x123\n" +
+	"\x12GetStudentsByClass\x12\r.main.ClassId\x1a\x0e.main.StudentsB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_classes_proto_rawDescOnce sync.Once
+	file_classes_proto_rawDescData []byte
+)
+
+func file_classes_proto_rawDescGZIP() []byte {
+	file_classes_proto_rawDescOnce.Do(func() {
+		file_classes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_classes_proto_rawDesc), len(file_classes_proto_rawDesc)))
+	})
+	return file_classes_proto_rawDescData
+}
+
+var file_classes_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_classes_proto_goTypes = []any{
+	(*AddClassesRequest)(nil),          // 0: main.AddClassesRequest
+	(*UpdateClassesRequest)(nil),       // 1: main.UpdateClassesRequest
+	(*DeleteClassesRequest)(nil),       // 2: main.DeleteClassesRequest
+	(*AddClassesResponse)(nil),         // 3: main.AddClassesResponse
+	(*RestoreClassesConfirmation)(nil), // 4: main.RestoreClassesConfirmation
+	(*DeleteClassesConfirmation)(nil),  // 5: main.DeleteClassesConfirmation
+	(*ClassId)(nil),                    // 6: main.ClassId
+	(*ClassIds)(nil),                   // 7: main.ClassIds
+	(*GetClassesRequest)(nil),          // 8: main.GetClassesRequest
+	(*Class)(nil),                      // 9: main.Class
+	(*Classes)(nil),                    // 10: main.Classes
+	(*fieldmaskpb.FieldMask)(nil),      // 11: google.protobuf.FieldMask
+	(*BulkItemResult)(nil),             // 12: main.BulkItemResult
+	(*SortField)(nil),                  // 13: main.SortField
+	(*TimestampFilter)(nil),            // 14: main.TimestampFilter
+	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+	(*Students)(nil),                   // 16: main.Students
+}
+var file_classes_proto_depIdxs = []int32{
+	9,  // 0: main.AddClassesRequest.classes:type_name -> main.Class
+	9,  // 1: main.UpdateClassesRequest.classes:type_name -> main.Class
+	11, // 2: main.UpdateClassesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 3: main.DeleteClassesRequest.ids:type_name -> main.ClassId
+	9,  // 4: main.AddClassesResponse.classes:type_name -> main.Class
+	12, // 5: main.AddClassesResponse.results:type_name -> main.BulkItemResult
+	6,  // 6: main.ClassIds.ids:type_name -> main.ClassId
+	9,  // 7: main.GetClassesRequest.class:type_name -> main.Class
+	13, // 8: main.GetClassesRequest.sort_by:type_name -> main.SortField
+	14, // 9: main.GetClassesRequest.time_filters:type_name -> main.TimestampFilter
+	15, // 10: main.Class.deleted_at:type_name -> google.protobuf.Timestamp
+	15, // 11: main.Class.created_at:type_name -> google.protobuf.Timestamp
+	15, // 12: main.Class.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 13: main.Classes.classes:type_name -> main.Class
+	8,  // 14: main.ClassesService.GetClasses:input_type -> main.GetClassesRequest
+	0,  // 15: main.ClassesService.AddClasses:input_type -> main.AddClassesRequest
+	1,  // 16: main.ClassesService.UpdateClasses:input_type -> main.UpdateClassesRequest
+	2,  // 17: main.ClassesService.DeleteClasses:input_type -> main.DeleteClassesRequest
+	7,  // 18: main.ClassesService.RestoreClasses:input_type -> main.ClassIds
+	6,  // 19: main.ClassesService.GetStudentsByClass:input_type -> main.ClassId
+	10, // 20: main.ClassesService.GetClasses:output_type -> main.Classes
+	3,  // 21: main.ClassesService.AddClasses:output_type -> main.AddClassesResponse
+	10, // 22: main.ClassesService.UpdateClasses:output_type -> main.Classes
+	5,  // 23: main.ClassesService.DeleteClasses:output_type -> main.DeleteClassesConfirmation
+	4,  // 24: main.ClassesService.RestoreClasses:output_type -> main.RestoreClassesConfirmation
+	16, // 25: main.ClassesService.GetStudentsByClass:output_type -> main.Students
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_classes_proto_init() }
+func file_classes_proto_init() {
+	if File_classes_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_classes_proto_rawDesc), len(file_classes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_classes_proto_goTypes,
+		DependencyIndexes: file_classes_proto_depIdxs,
+		MessageInfos:      file_classes_proto_msgTypes,
+	}.Build()
+	File_classes_proto = out.File
+	file_classes_proto_goTypes = nil
+	file_classes_proto_depIdxs = nil
+}
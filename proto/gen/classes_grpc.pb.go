@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: classes.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClassesService_GetClasses_FullMethodName         = "/main.ClassesService/GetClasses"
+	ClassesService_AddClasses_FullMethodName         = "/main.ClassesService/AddClasses"
+	ClassesService_UpdateClasses_FullMethodName      = "/main.ClassesService/UpdateClasses"
+	ClassesService_DeleteClasses_FullMethodName      = "/main.ClassesService/DeleteClasses"
+	ClassesService_RestoreClasses_FullMethodName     = "/main.ClassesService/RestoreClasses"
+	ClassesService_GetStudentsByClass_FullMethodName = "/main.ClassesService/GetStudentsByClass"
+)
+
+// ClassesServiceClient is the client API for ClassesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to classes
+type ClassesServiceClient interface {
+	// GetClasses retrieves a list of classes based on the provided criteria
+	GetClasses(ctx context.Context, in *GetClassesRequest, opts ...grpc.CallOption) (*Classes, error)
+	// AddClasses adds new classes to the system
+	AddClasses(ctx context.Context, in *AddClassesRequest, opts ...grpc.CallOption) (*AddClassesResponse, error)
+	// UpdateClasses updates existing class information
+	UpdateClasses(ctx context.Context, in *UpdateClassesRequest, opts ...grpc.CallOption) (*Classes, error)
+	// DeleteClasses removes classes from the system by their IDs, classes that students or teaching assignments still reference are kept
+	DeleteClasses(ctx context.Context, in *DeleteClassesRequest, opts ...grpc.CallOption) (*DeleteClassesConfirmation, error)
+	// RestoreClasses brings back soft deleted classes by their IDs
+	RestoreClasses(ctx context.Context, in *ClassIds, opts ...grpc.CallOption) (*RestoreClassesConfirmation, error)
+	// GetStudentsByClass retrieves all students of a class
+	GetStudentsByClass(ctx context.Context, in *ClassId, opts ...grpc.CallOption) (*Students, error)
+}
+
+type classesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClassesServiceClient(cc grpc.ClientConnInterface) ClassesServiceClient {
+	return &classesServiceClient{cc}
+}
+
+func (c *classesServiceClient) GetClasses(ctx context.Context, in *GetClassesRequest, opts ...grpc.CallOption) (*Classes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Classes)
+	err := c.cc.Invoke(ctx, ClassesService_GetClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) AddClasses(ctx context.Context, in *AddClassesRequest, opts ...grpc.CallOption) (*AddClassesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddClassesResponse)
+	err := c.cc.Invoke(ctx, ClassesService_AddClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) UpdateClasses(ctx context.Context, in *UpdateClassesRequest, opts ...grpc.CallOption) (*Classes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Classes)
+	err := c.cc.Invoke(ctx, ClassesService_UpdateClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) DeleteClasses(ctx context.Context, in *DeleteClassesRequest, opts ...grpc.CallOption) (*DeleteClassesConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteClassesConfirmation)
+	err := c.cc.Invoke(ctx, ClassesService_DeleteClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) RestoreClasses(ctx context.Context, in *ClassIds, opts ...grpc.CallOption) (*RestoreClassesConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreClassesConfirmation)
+	err := c.cc.Invoke(ctx, ClassesService_RestoreClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) GetStudentsByClass(ctx context.Context, in *ClassId, opts ...grpc.CallOption) (*Students, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Students)
+	err := c.cc.Invoke(ctx, ClassesService_GetStudentsByClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClassesServiceServer is the server API for ClassesService service.
+// All implementations must embed UnimplementedClassesServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to classes
+type ClassesServiceServer interface {
+	// GetClasses retrieves a list of classes based on the provided criteria
+	GetClasses(context.Context, *GetClassesRequest) (*Classes, error)
+	// AddClasses adds new classes to the system
+	AddClasses(context.Context, *AddClassesRequest) (*AddClassesResponse, error)
+	// UpdateClasses updates existing class information
+	UpdateClasses(context.Context, *UpdateClassesRequest) (*Classes, error)
+	// DeleteClasses removes classes from the system by their IDs, classes that students or teaching assignments still reference are kept
+	DeleteClasses(context.Context, *DeleteClassesRequest) (*DeleteClassesConfirmation, error)
+	// RestoreClasses brings back soft deleted classes by their IDs
+	RestoreClasses(context.Context, *ClassIds) (*RestoreClassesConfirmation, error)
+	// GetStudentsByClass retrieves all students of a class
+	GetStudentsByClass(context.Context, *ClassId) (*Students, error)
+	mustEmbedUnimplementedClassesServiceServer()
+}
+
+// UnimplementedClassesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClassesServiceServer struct{}
+
+func (UnimplementedClassesServiceServer) GetClasses(context.Context, *GetClassesRequest) (*Classes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) AddClasses(context.Context, *AddClassesRequest) (*AddClassesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) UpdateClasses(context.Context, *UpdateClassesRequest) (*Classes, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) DeleteClasses(context.Context, *DeleteClassesRequest) (*DeleteClassesConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) RestoreClasses(context.Context, *ClassIds) (*RestoreClassesConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) GetStudentsByClass(context.Context, *ClassId) (*Students, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStudentsByClass not implemented")
+}
+func (UnimplementedClassesServiceServer) mustEmbedUnimplementedClassesServiceServer() {}
+func (UnimplementedClassesServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeClassesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClassesServiceServer will
+// result in compilation errors.
+type UnsafeClassesServiceServer interface {
+	mustEmbedUnimplementedClassesServiceServer()
+}
+
+func RegisterClassesServiceServer(s grpc.ServiceRegistrar, srv ClassesServiceServer) {
+	// If the following call panics, it indicates UnimplementedClassesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClassesService_ServiceDesc, srv)
+}
+
+func _ClassesService_GetClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).GetClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_GetClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).GetClasses(ctx, req.(*GetClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_AddClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).AddClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_AddClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).AddClasses(ctx, req.(*AddClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_UpdateClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).UpdateClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_UpdateClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).UpdateClasses(ctx, req.(*UpdateClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_DeleteClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).DeleteClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_DeleteClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).DeleteClasses(ctx, req.(*DeleteClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_RestoreClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).RestoreClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_RestoreClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).RestoreClasses(ctx, req.(*ClassIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_GetStudentsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).GetStudentsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_GetStudentsByClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).GetStudentsByClass(ctx, req.(*ClassId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClassesService_ServiceDesc is the grpc.ServiceDesc for ClassesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClassesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.ClassesService",
+	HandlerType: (*ClassesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClasses",
+			Handler:    _ClassesService_GetClasses_Handler,
+		},
+		{
+			MethodName: "AddClasses",
+			Handler:    _ClassesService_AddClasses_Handler,
+		},
+		{
+			MethodName: "UpdateClasses",
+			Handler:    _ClassesService_UpdateClasses_Handler,
+		},
+		{
+			MethodName: "DeleteClasses",
+			Handler:    _ClassesService_DeleteClasses_Handler,
+		},
+		{
+			MethodName: "RestoreClasses",
+			Handler:    _ClassesService_RestoreClasses_Handler,
+		},
+		{
+			MethodName: "GetStudentsByClass",
+			Handler:    _ClassesService_GetStudentsByClass_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "classes.proto",
+}
@@ -0,0 +1,2120 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: courses.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EnrollmentStatus int32
+
+const (
+	EnrollmentStatus_ENROLLED EnrollmentStatus = 0
+	EnrollmentStatus_DROPPED  EnrollmentStatus = 1
+)
+
+// Enum value maps for EnrollmentStatus.
+var (
+	EnrollmentStatus_name = map[int32]string{
+		0: "ENROLLED",
+		1: "DROPPED",
+	}
+	EnrollmentStatus_value = map[string]int32{
+		"ENROLLED": 0,
+		"DROPPED":  1,
+	}
+)
+
+func (x EnrollmentStatus) Enum() *EnrollmentStatus {
+	p := new(EnrollmentStatus)
+	*p = x
+	return p
+}
+
+func (x EnrollmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnrollmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_courses_proto_enumTypes[0].Descriptor()
+}
+
+func (EnrollmentStatus) Type() protoreflect.EnumType {
+	return &file_courses_proto_enumTypes[0]
+}
+
+func (x EnrollmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnrollmentStatus.Descriptor instead.
+func (EnrollmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{0}
+}
+
+// Course is an entry of the catalogue, its code is unique
+type Course struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code    string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Credits int32                  `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	Subject string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	// teacher_ids are the teachers assigned to the course
+	TeacherIds []string `protobuf:"bytes,6,rep,name=teacher_ids,json=teacherIds,proto3" json:"teacher_ids,omitempty"`
+	// prerequisite_ids are the courses a student has to complete before enrolling
+	PrerequisiteIds []string `protobuf:"bytes,7,rep,name=prerequisite_ids,json=prerequisiteIds,proto3" json:"prerequisite_ids,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted courses, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,10,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,14,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Course) Reset() {
+	*x = Course{}
+	mi := &file_courses_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Course) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Course) ProtoMessage() {}
+
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Course) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Course) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Course) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Course) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *Course) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Course) GetTeacherIds() []string {
+	if x != nil {
+		return x.TeacherIds
+	}
+	return nil
+}
+
+func (x *Course) GetPrerequisiteIds() []string {
+	if x != nil {
+		return x.PrerequisiteIds
+	}
+	return nil
+}
+
+func (x *Course) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Course) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Course) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Course) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Course) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Course) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Course) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Courses struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courses) Reset() {
+	*x = Courses{}
+	mi := &file_courses_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courses) ProtoMessage() {}
+
+func (x *Courses) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courses.ProtoReflect.Descriptor instead.
+func (*Courses) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Courses) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+type AddCoursesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Courses []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	// ordered stops inserting at the first failing course, otherwise every valid course is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all courses in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCoursesRequest) Reset() {
+	*x = AddCoursesRequest{}
+	mi := &file_courses_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCoursesRequest) ProtoMessage() {}
+
+func (x *AddCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCoursesRequest.ProtoReflect.Descriptor instead.
+func (*AddCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddCoursesRequest) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *AddCoursesRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddCoursesRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddCoursesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Courses       []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCoursesResponse) Reset() {
+	*x = AddCoursesResponse{}
+	mi := &file_courses_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCoursesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCoursesResponse) ProtoMessage() {}
+
+func (x *AddCoursesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCoursesResponse.ProtoReflect.Descriptor instead.
+func (*AddCoursesResponse) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddCoursesResponse) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *AddCoursesResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetCoursesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only courses matching the non-empty fields of the filter are returned, listed IDs all have to be present
+	Course *Course      `protobuf:"bytes,1,opt,name=course,proto3" json:"course,omitempty"`
+	SortBy []*SortField `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted courses
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCoursesRequest) Reset() {
+	*x = GetCoursesRequest{}
+	mi := &file_courses_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoursesRequest) ProtoMessage() {}
+
+func (x *GetCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoursesRequest.ProtoReflect.Descriptor instead.
+func (*GetCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCoursesRequest) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetCoursesRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetCoursesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateCoursesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Courses []*Course              `protobuf:"bytes,1,rep,name=courses,proto3" json:"courses,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every course, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCoursesRequest) Reset() {
+	*x = UpdateCoursesRequest{}
+	mi := &file_courses_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCoursesRequest) ProtoMessage() {}
+
+func (x *UpdateCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCoursesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCoursesRequest) GetCourses() []*Course {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *UpdateCoursesRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateCoursesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type CourseId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the course, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseId) Reset() {
+	*x = CourseId{}
+	mi := &file_courses_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseId) ProtoMessage() {}
+
+func (x *CourseId) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseId.ProtoReflect.Descriptor instead.
+func (*CourseId) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CourseId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteCoursesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*CourseId            `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all courses in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoursesRequest) Reset() {
+	*x = DeleteCoursesRequest{}
+	mi := &file_courses_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoursesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoursesRequest) ProtoMessage() {}
+
+func (x *DeleteCoursesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoursesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCoursesRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteCoursesRequest) GetIds() []*CourseId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteCoursesRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteCoursesConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCoursesConfirmation) Reset() {
+	*x = DeleteCoursesConfirmation{}
+	mi := &file_courses_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCoursesConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCoursesConfirmation) ProtoMessage() {}
+
+func (x *DeleteCoursesConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCoursesConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteCoursesConfirmation) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteCoursesConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteCoursesConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+// CourseSection is a course taught in a term, students enroll in sections
+// The course, term and name are unique together
+type CourseSection struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// name tells the sections of a course in the same term apart, such as A or B
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// term such as 2026-2027 Fall
+	Term     string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// enrollment_closes_at is the last moment students can enroll, without it they can enroll until the section ends
+	EnrollmentClosesAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=enrollment_closes_at,json=enrollmentClosesAt,proto3" json:"enrollment_closes_at,omitempty"`
+	// capacity is the most students the section takes, 0 means unlimited
+	Capacity int32 `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// enrolled is the number of students currently enrolled, maintained by the server
+	Enrolled int32 `protobuf:"varint,9,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	// teacher_ids are the teachers of the section
+	TeacherIds []string `protobuf:"bytes,10,rep,name=teacher_ids,json=teacherIds,proto3" json:"teacher_ids,omitempty"`
+	// version is increased on every change, send it back with a delete to detect concurrent edits
+	Version int64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted sections, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,13,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,17,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseSection) Reset() {
+	*x = CourseSection{}
+	mi := &file_courses_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSection) ProtoMessage() {}
+
+func (x *CourseSection) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSection.ProtoReflect.Descriptor instead.
+func (*CourseSection) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CourseSection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseSection) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourseSection) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *CourseSection) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CourseSection) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CourseSection) GetEnrollmentClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrollmentClosesAt
+	}
+	return nil
+}
+
+func (x *CourseSection) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CourseSection) GetEnrolled() int32 {
+	if x != nil {
+		return x.Enrolled
+	}
+	return 0
+}
+
+func (x *CourseSection) GetTeacherIds() []string {
+	if x != nil {
+		return x.TeacherIds
+	}
+	return nil
+}
+
+func (x *CourseSection) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CourseSection) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *CourseSection) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *CourseSection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CourseSection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CourseSection) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CourseSection) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type CourseSections struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*CourseSection       `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseSections) Reset() {
+	*x = CourseSections{}
+	mi := &file_courses_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseSections) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSections) ProtoMessage() {}
+
+func (x *CourseSections) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSections.ProtoReflect.Descriptor instead.
+func (*CourseSections) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CourseSections) GetSections() []*CourseSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type AddCourseSectionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sections []*CourseSection       `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	// ordered stops inserting at the first failing section, otherwise every valid section is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all sections in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCourseSectionsRequest) Reset() {
+	*x = AddCourseSectionsRequest{}
+	mi := &file_courses_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCourseSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCourseSectionsRequest) ProtoMessage() {}
+
+func (x *AddCourseSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCourseSectionsRequest.ProtoReflect.Descriptor instead.
+func (*AddCourseSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddCourseSectionsRequest) GetSections() []*CourseSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *AddCourseSectionsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddCourseSectionsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddCourseSectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*CourseSection       `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCourseSectionsResponse) Reset() {
+	*x = AddCourseSectionsResponse{}
+	mi := &file_courses_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCourseSectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCourseSectionsResponse) ProtoMessage() {}
+
+func (x *AddCourseSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCourseSectionsResponse.ProtoReflect.Descriptor instead.
+func (*AddCourseSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddCourseSectionsResponse) GetSections() []*CourseSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *AddCourseSectionsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetCourseSectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only sections matching the non-empty fields of the filter are returned, listed IDs all have to be present
+	Section *CourseSection `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	SortBy  []*SortField   `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted sections
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCourseSectionsRequest) Reset() {
+	*x = GetCourseSectionsRequest{}
+	mi := &file_courses_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseSectionsRequest) ProtoMessage() {}
+
+func (x *GetCourseSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseSectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCourseSectionsRequest) GetSection() *CourseSection {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+func (x *GetCourseSectionsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetCourseSectionsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type CourseSectionId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the section, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseSectionId) Reset() {
+	*x = CourseSectionId{}
+	mi := &file_courses_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseSectionId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseSectionId) ProtoMessage() {}
+
+func (x *CourseSectionId) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseSectionId.ProtoReflect.Descriptor instead.
+func (*CourseSectionId) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CourseSectionId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CourseSectionId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteCourseSectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*CourseSectionId     `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all sections in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseSectionsRequest) Reset() {
+	*x = DeleteCourseSectionsRequest{}
+	mi := &file_courses_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseSectionsRequest) ProtoMessage() {}
+
+func (x *DeleteCourseSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseSectionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCourseSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCourseSectionsRequest) GetIds() []*CourseSectionId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteCourseSectionsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteCourseSectionsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCourseSectionsConfirmation) Reset() {
+	*x = DeleteCourseSectionsConfirmation{}
+	mi := &file_courses_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCourseSectionsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCourseSectionsConfirmation) ProtoMessage() {}
+
+func (x *DeleteCourseSectionsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCourseSectionsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteCourseSectionsConfirmation) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteCourseSectionsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteCourseSectionsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+// Enrollment links a student to a course section, dropped enrollments are kept as history
+// A student has completed a course once a section of it they stayed enrolled in has ended
+type Enrollment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId  string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	SectionId  string                 `protobuf:"bytes,3,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	CourseId   string                 `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Status     EnrollmentStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=main.EnrollmentStatus" json:"status,omitempty"`
+	EnrolledAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=enrolled_at,json=enrolledAt,proto3" json:"enrolled_at,omitempty"`
+	DroppedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	// version is increased on every change
+	Version int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	mi := &file_courses_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Enrollment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Enrollment) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Enrollment) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *Enrollment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Enrollment) GetStatus() EnrollmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnrollmentStatus_ENROLLED
+}
+
+func (x *Enrollment) GetEnrolledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrolledAt
+	}
+	return nil
+}
+
+func (x *Enrollment) GetDroppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DroppedAt
+	}
+	return nil
+}
+
+func (x *Enrollment) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Enrollment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Enrollment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Enrollment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Enrollment) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Enrollments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enrollments   []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrollments) Reset() {
+	*x = Enrollments{}
+	mi := &file_courses_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollments) ProtoMessage() {}
+
+func (x *Enrollments) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollments.ProtoReflect.Descriptor instead.
+func (*Enrollments) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Enrollments) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+type EnrollStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	StudentIds    []string               `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollStudentsRequest) Reset() {
+	*x = EnrollStudentsRequest{}
+	mi := &file_courses_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollStudentsRequest) ProtoMessage() {}
+
+func (x *EnrollStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollStudentsRequest.ProtoReflect.Descriptor instead.
+func (*EnrollStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EnrollStudentsRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *EnrollStudentsRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type EnrollStudentsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Enrollments []*Enrollment          `protobuf:"bytes,1,rep,name=enrollments,proto3" json:"enrollments,omitempty"`
+	// results reports the outcome for every student by their index in student_ids
+	Results       []*BulkItemResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollStudentsResponse) Reset() {
+	*x = EnrollStudentsResponse{}
+	mi := &file_courses_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollStudentsResponse) ProtoMessage() {}
+
+func (x *EnrollStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollStudentsResponse.ProtoReflect.Descriptor instead.
+func (*EnrollStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EnrollStudentsResponse) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+func (x *EnrollStudentsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DropStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SectionId     string                 `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	StudentIds    []string               `protobuf:"bytes,2,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropStudentsRequest) Reset() {
+	*x = DropStudentsRequest{}
+	mi := &file_courses_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropStudentsRequest) ProtoMessage() {}
+
+func (x *DropStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropStudentsRequest.ProtoReflect.Descriptor instead.
+func (*DropStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DropStudentsRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *DropStudentsRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type GetEnrollmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// at least one of student_id, section_id and course_id is required
+	StudentId string `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	SectionId string `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	CourseId  string `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// include_dropped also returns dropped enrollments
+	IncludeDropped bool `protobuf:"varint,4,opt,name=include_dropped,json=includeDropped,proto3" json:"include_dropped,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEnrollmentsRequest) Reset() {
+	*x = GetEnrollmentsRequest{}
+	mi := &file_courses_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnrollmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentsRequest) ProtoMessage() {}
+
+func (x *GetEnrollmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentsRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetEnrollmentsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetEnrollmentsRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *GetEnrollmentsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetEnrollmentsRequest) GetIncludeDropped() bool {
+	if x != nil {
+		return x.IncludeDropped
+	}
+	return false
+}
+
+type CourseRosterRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CourseId string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// section_id limits the roster to one section of the course
+	SectionId string `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	// term limits the roster to the sections of one term
+	Term          string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseRosterRequest) Reset() {
+	*x = CourseRosterRequest{}
+	mi := &file_courses_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRosterRequest) ProtoMessage() {}
+
+func (x *CourseRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRosterRequest.ProtoReflect.Descriptor instead.
+func (*CourseRosterRequest) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CourseRosterRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CourseRosterRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *CourseRosterRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type CourseRosterEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Student       *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	SectionId     string                 `protobuf:"bytes,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	EnrolledAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=enrolled_at,json=enrolledAt,proto3" json:"enrolled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseRosterEntry) Reset() {
+	*x = CourseRosterEntry{}
+	mi := &file_courses_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseRosterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRosterEntry) ProtoMessage() {}
+
+func (x *CourseRosterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRosterEntry.ProtoReflect.Descriptor instead.
+func (*CourseRosterEntry) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CourseRosterEntry) GetStudent() *Student {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *CourseRosterEntry) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *CourseRosterEntry) GetEnrolledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnrolledAt
+	}
+	return nil
+}
+
+type CourseRoster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CourseRosterEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourseRoster) Reset() {
+	*x = CourseRoster{}
+	mi := &file_courses_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseRoster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseRoster) ProtoMessage() {}
+
+func (x *CourseRoster) ProtoReflect() protoreflect.Message {
+	mi := &file_courses_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseRoster.ProtoReflect.Descriptor instead.
+func (*CourseRoster) Descriptor() ([]byte, []int) {
+	return file_courses_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CourseRoster) GetEntries() []*CourseRosterEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_courses_proto protoreflect.FileDescriptor
+
+const file_courses_proto_rawDesc = "" +
+	"\n" +
+	"\rcourses.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\"\xe8\x03\n" +
+	"\x06Course\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\acredits\x18\x04 \x01(\x05R\acredits\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x1f\n" +
+	"\vteacher_ids\x18\x06 \x03(\tR\n" +
+	"teacherIds\x12)\n" +
+	"\x10prerequisite_ids\x18\a \x03(\tR\x0fprerequisiteIds\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\n" +
+	" \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0e \x01(\tR\tupdatedBy\"1\n" +
+	"\aCourses\x12&\n" +
+	"\acourses\x18\x01 \x03(\v2\f.main.CourseR\acourses\"m\n" +
+	"\x11AddCoursesRequest\x12&\n" +
+	"\acourses\x18\x01 \x03(\v2\f.main.CourseR\acourses\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"l\n" +
+	"\x12AddCoursesResponse\x12&\n" +
+	"\acourses\x18\x01 \x03(\v2\f.main.CourseR\acourses\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x8c\x01\n" +
+	"\x11GetCoursesRequest\x12$\n" +
+	"\x06course\x18\x01 \x01(\v2\f.main.CourseR\x06course\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\x93\x01\n" +
+	"\x14UpdateCoursesRequest\x12&\n" +
+	"\acourses\x18\x01 \x03(\v2\f.main.CourseR\acourses\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"4\n" +
+	"\bCourseId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"P\n" +
+	"\x14DeleteCoursesRequest\x12 \n" +
+	"\x03ids\x18\x01 \x03(\v2\x0e.main.CourseIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"T\n" +
+	"\x19DeleteCoursesConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xa1\x05\n" +
+	"\rCourseSection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12L\n" +
+	"\x14enrollment_closes_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x12enrollmentClosesAt\x12\x1a\n" +
+	"\bcapacity\x18\b \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\benrolled\x18\t \x01(\x05R\benrolled\x12\x1f\n" +
+	"\vteacher_ids\x18\n" +
+	" \x03(\tR\n" +
+	"teacherIds\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\r \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x10 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x11 \x01(\tR\tupdatedBy\"A\n" +
+	"\x0eCourseSections\x12/\n" +
+	"\bsections\x18\x01 \x03(\v2\x13.main.CourseSectionR\bsections\"}\n" +
+	"\x18AddCourseSectionsRequest\x12/\n" +
+	"\bsections\x18\x01 \x03(\v2\x13.main.CourseSectionR\bsections\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"|\n" +
+	"\x19AddCourseSectionsResponse\x12/\n" +
+	"\bsections\x18\x01 \x03(\v2\x13.main.CourseSectionR\bsections\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x9c\x01\n" +
+	"\x18GetCourseSectionsRequest\x12-\n" +
+	"\asection\x18\x01 \x01(\v2\x13.main.CourseSectionR\asection\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\";\n" +
+	"\x0fCourseSectionId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"^\n" +
+	"\x1bDeleteCourseSectionsRequest\x12'\n" +
+	"\x03ids\x18\x01 \x03(\v2\x15.main.CourseSectionIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"[\n" +
+	" DeleteCourseSectionsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xed\x03\n" +
+	"\n" +
+	"Enrollment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x03 \x01(\tR\tsectionId\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\tR\bcourseId\x12.\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x16.main.EnrollmentStatusR\x06status\x12;\n" +
+	"\venrolled_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"enrolledAt\x129\n" +
+	"\n" +
+	"dropped_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdroppedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\"A\n" +
+	"\vEnrollments\x122\n" +
+	"\venrollments\x18\x01 \x03(\v2\x10.main.EnrollmentR\venrollments\"W\n" +
+	"\x15EnrollStudentsRequest\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x1f\n" +
+	"\vstudent_ids\x18\x02 \x03(\tR\n" +
+	"studentIds\"|\n" +
+	"\x16EnrollStudentsResponse\x122\n" +
+	"\venrollments\x18\x01 \x03(\v2\x10.main.EnrollmentR\venrollments\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"U\n" +
+	"\x13DropStudentsRequest\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x01 \x01(\tR\tsectionId\x12\x1f\n" +
+	"\vstudent_ids\x18\x02 \x03(\tR\n" +
+	"studentIds\"\x9b\x01\n" +
+	"\x15GetEnrollmentsRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\tR\tsectionId\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12'\n" +
+	"\x0finclude_dropped\x18\x04 \x01(\bR\x0eincludeDropped\"e\n" +
+	"\x13CourseRosterRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\tR\tsectionId\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\"\x98\x01\n" +
+	"\x11CourseRosterEntry\x12'\n" +
+	"\astudent\x18\x01 \x01(\v2\r.main.StudentR\astudent\x12\x1d\n" +
+	"\n" +
+	"section_id\x18\x02 \x01(\tR\tsectionId\x12;\n" +
+	"\venrolled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"enrolledAt\"A\n" +
+	"\fCourseRoster\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.main.CourseRosterEntryR\aentries*-\n" +
+	"\x10EnrollmentStatus\x12\f\n" +
+	"\bENROLLED\x10\x00\x12\v\n" +
+	"\aDROPPED\x10\x012\xa4\x06\n" +
+	"\x0eCoursesService\x124\n" +
+	"\n" +
+	"GetCourses\x12\x17.main.GetCoursesRequest\x1a\r.main.Courses\x12?\n" +
+	"\n" +
+	"AddCourses\x12\x17.main.AddCoursesRequest\x1a\x18.main.AddCoursesResponse\x12:\n" +
+	"\rUpdateCourses\x12\x1a.main.UpdateCoursesRequest\x1a\r.main.Courses\x12L\n" +
+	"\rDeleteCourses\x12\x1a.main.DeleteCoursesRequest\x1a\x1f.main.DeleteCoursesConfirmation\x12T\n" +
+	"\x11AddCourseSections\x12\x1e.main.AddCourseSectionsRequest\x1a\x1f.main.AddCourseSectionsResponse\x12I\n" +
+	"\x11GetCourseSections\x12\x1e.main.GetCourseSectionsRequest\x1a\x14.main.CourseSections\x12a\n" +
+	"\x14DeleteCourseSections\x12!.main.DeleteCourseSectionsRequest\x1a&.main.DeleteCourseSectionsConfirmation\x12K\n" +
+	"\x0eEnrollStudents\x12\x1b.main.EnrollStudentsRequest\x1a\x1c.main.EnrollStudentsResponse\x12<\n" +
+	"\fDropStudents\x12\x19.main.DropStudentsRequest\x1a\x11.main.Enrollments\x12@\n" +
+	"\x0eGetEnrollments\x12\x1b.main.GetEnrollmentsRequest\x1a\x11.main.Enrollments\x12@\n" +
+	"\x0fGetCourseRoster\x12\x19.main.CourseRosterRequest\x1a\x12.main.CourseRosterB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_courses_proto_rawDescOnce sync.Once
+	file_courses_proto_rawDescData []byte
+)
+
+func file_courses_proto_rawDescGZIP() []byte {
+	file_courses_proto_rawDescOnce.Do(func() {
+		file_courses_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_courses_proto_rawDesc), len(file_courses_proto_rawDesc)))
+	})
+	return file_courses_proto_rawDescData
+}
+
+var file_courses_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_courses_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_courses_proto_goTypes = []any{
+	(EnrollmentStatus)(0),                    // 0: main.EnrollmentStatus
+	(*Course)(nil),                           // 1: main.Course
+	(*Courses)(nil),                          // 2: main.Courses
+	(*AddCoursesRequest)(nil),                // 3: main.AddCoursesRequest
+	(*AddCoursesResponse)(nil),               // 4: main.AddCoursesResponse
+	(*GetCoursesRequest)(nil),                // 5: main.GetCoursesRequest
+	(*UpdateCoursesRequest)(nil),             // 6: main.UpdateCoursesRequest
+	(*CourseId)(nil),                         // 7: main.CourseId
+	(*DeleteCoursesRequest)(nil),             // 8: main.DeleteCoursesRequest
+	(*DeleteCoursesConfirmation)(nil),        // 9: main.DeleteCoursesConfirmation
+	(*CourseSection)(nil),                    // 10: main.CourseSection
+	(*CourseSections)(nil),                   // 11: main.CourseSections
+	(*AddCourseSectionsRequest)(nil),         // 12: main.AddCourseSectionsRequest
+	(*AddCourseSectionsResponse)(nil),        // 13: main.AddCourseSectionsResponse
+	(*GetCourseSectionsRequest)(nil),         // 14: main.GetCourseSectionsRequest
+	(*CourseSectionId)(nil),                  // 15: main.CourseSectionId
+	(*DeleteCourseSectionsRequest)(nil),      // 16: main.DeleteCourseSectionsRequest
+	(*DeleteCourseSectionsConfirmation)(nil), // 17: main.DeleteCourseSectionsConfirmation
+	(*Enrollment)(nil),                       // 18: main.Enrollment
+	(*Enrollments)(nil),                      // 19: main.Enrollments
+	(*EnrollStudentsRequest)(nil),            // 20: main.EnrollStudentsRequest
+	(*EnrollStudentsResponse)(nil),           // 21: main.EnrollStudentsResponse
+	(*DropStudentsRequest)(nil),              // 22: main.DropStudentsRequest
+	(*GetEnrollmentsRequest)(nil),            // 23: main.GetEnrollmentsRequest
+	(*CourseRosterRequest)(nil),              // 24: main.CourseRosterRequest
+	(*CourseRosterEntry)(nil),                // 25: main.CourseRosterEntry
+	(*CourseRoster)(nil),                     // 26: main.CourseRoster
+	(*timestamppb.Timestamp)(nil),            // 27: google.protobuf.Timestamp
+	(*BulkItemResult)(nil),                   // 28: main.BulkItemResult
+	(*SortField)(nil),                        // 29: main.SortField
+	(*fieldmaskpb.FieldMask)(nil),            // 30: google.protobuf.FieldMask
+	(*Student)(nil),                          // 31: main.Student
+}
+var file_courses_proto_depIdxs = []int32{
+	27, // 0: main.Course.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 1: main.Course.created_at:type_name -> google.protobuf.Timestamp
+	27, // 2: main.Course.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: main.Courses.courses:type_name -> main.Course
+	1,  // 4: main.AddCoursesRequest.courses:type_name -> main.Course
+	1,  // 5: main.AddCoursesResponse.courses:type_name -> main.Course
+	28, // 6: main.AddCoursesResponse.results:type_name -> main.BulkItemResult
+	1,  // 7: main.GetCoursesRequest.course:type_name -> main.Course
+	29, // 8: main.GetCoursesRequest.sort_by:type_name -> main.SortField
+	1,  // 9: main.UpdateCoursesRequest.courses:type_name -> main.Course
+	30, // 10: main.UpdateCoursesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 11: main.DeleteCoursesRequest.ids:type_name -> main.CourseId
+	27, // 12: main.CourseSection.starts_at:type_name -> google.protobuf.Timestamp
+	27, // 13: main.CourseSection.ends_at:type_name -> google.protobuf.Timestamp
+	27, // 14: main.CourseSection.enrollment_closes_at:type_name -> google.protobuf.Timestamp
+	27, // 15: main.CourseSection.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 16: main.CourseSection.created_at:type_name -> google.protobuf.Timestamp
+	27, // 17: main.CourseSection.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 18: main.CourseSections.sections:type_name -> main.CourseSection
+	10, // 19: main.AddCourseSectionsRequest.sections:type_name -> main.CourseSection
+	10, // 20: main.AddCourseSectionsResponse.sections:type_name -> main.CourseSection
+	28, // 21: main.AddCourseSectionsResponse.results:type_name -> main.BulkItemResult
+	10, // 22: main.GetCourseSectionsRequest.section:type_name -> main.CourseSection
+	29, // 23: main.GetCourseSectionsRequest.sort_by:type_name -> main.SortField
+	15, // 24: main.DeleteCourseSectionsRequest.ids:type_name -> main.CourseSectionId
+	0,  // 25: main.Enrollment.status:type_name -> main.EnrollmentStatus
+	27, // 26: main.Enrollment.enrolled_at:type_name -> google.protobuf.Timestamp
+	27, // 27: main.Enrollment.dropped_at:type_name -> google.protobuf.Timestamp
+	27, // 28: main.Enrollment.created_at:type_name -> google.protobuf.Timestamp
+	27, // 29: main.Enrollment.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 30: main.Enrollments.enrollments:type_name -> main.Enrollment
+	18, // 31: main.EnrollStudentsResponse.enrollments:type_name -> main.Enrollment
+	28, // 32: main.EnrollStudentsResponse.results:type_name -> main.BulkItemResult
+	31, // 33: main.CourseRosterEntry.student:type_name -> main.Student
+	27, // 34: main.CourseRosterEntry.enrolled_at:type_name -> google.protobuf.Timestamp
+	25, // 35: main.CourseRoster.entries:type_name -> main.CourseRosterEntry
+	5,  // 36: main.CoursesService.GetCourses:input_type -> main.GetCoursesRequest
+	3,  // 37: main.CoursesService.AddCourses:input_type -> main.AddCoursesRequest
+	6,  // 38: main.CoursesService.UpdateCourses:input_type -> main.UpdateCoursesRequest
+	8,  // 39: main.CoursesService.DeleteCourses:input_type -> main.DeleteCoursesRequest
+	12, // 40: main.CoursesService.AddCourseSections:input_type -> main.AddCourseSectionsRequest
+	14, // 41: main.CoursesService.GetCourseSections:input_type -> main.GetCourseSectionsRequest
+	16, // 42: main.CoursesService.DeleteCourseSections:input_type -> main.DeleteCourseSectionsRequest
+	20, // 43: main.CoursesService.EnrollStudents:input_type -> main.EnrollStudentsRequest
+	22, // 44: main.CoursesService.DropStudents:input_type -> main.DropStudentsRequest
+	23, // 45: main.CoursesService.GetEnrollments:input_type -> main.GetEnrollmentsRequest
+	24, // 46: main.CoursesService.GetCourseRoster:input_type -> main.CourseRosterRequest
+	2,  // 47: main.CoursesService.GetCourses:output_type -> main.Courses
+	4,  // 48: main.CoursesService.AddCourses:output_type -> main.AddCoursesResponse
+	2,  // 49: main.CoursesService.UpdateCourses:output_type -> main.Courses
+	9,  // 50: main.CoursesService.DeleteCourses:output_type -> main.DeleteCoursesConfirmation
+	13, // 51: main.CoursesService.AddCourseSections:output_type -> main.AddCourseSectionsResponse
+	11, // 52: main.CoursesService.GetCourseSections:output_type -> main.CourseSections
+	17, // 53: main.CoursesService.DeleteCourseSections:output_type -> main.DeleteCourseSectionsConfirmation
+	21, // 54: main.CoursesService.EnrollStudents:output_type -> main.EnrollStudentsResponse
+	19, // 55: main.CoursesService.DropStudents:output_type -> main.Enrollments
+	19, // 56: main.CoursesService.GetEnrollments:output_type -> main.Enrollments
+	26, // 57: main.CoursesService.GetCourseRoster:output_type -> main.CourseRoster
+	47, // [47:58] is the sub-list for method output_type
+	36, // [36:47] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_courses_proto_init() }
+func file_courses_proto_init() {
+	if File_courses_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_courses_proto_rawDesc), len(file_courses_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_courses_proto_goTypes,
+		DependencyIndexes: file_courses_proto_depIdxs,
+		EnumInfos:         file_courses_proto_enumTypes,
+		MessageInfos:      file_courses_proto_msgTypes,
+	}.Build()
+	File_courses_proto = out.File
+	file_courses_proto_goTypes = nil
+	file_courses_proto_depIdxs = nil
+}
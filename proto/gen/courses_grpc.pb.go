@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: courses.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CoursesService_GetCourses_FullMethodName           = "/main.CoursesService/GetCourses"
+	CoursesService_AddCourses_FullMethodName           = "/main.CoursesService/AddCourses"
+	CoursesService_UpdateCourses_FullMethodName        = "/main.CoursesService/UpdateCourses"
+	CoursesService_DeleteCourses_FullMethodName        = "/main.CoursesService/DeleteCourses"
+	CoursesService_AddCourseSections_FullMethodName    = "/main.CoursesService/AddCourseSections"
+	CoursesService_GetCourseSections_FullMethodName    = "/main.CoursesService/GetCourseSections"
+	CoursesService_DeleteCourseSections_FullMethodName = "/main.CoursesService/DeleteCourseSections"
+	CoursesService_EnrollStudents_FullMethodName       = "/main.CoursesService/EnrollStudents"
+	CoursesService_DropStudents_FullMethodName         = "/main.CoursesService/DropStudents"
+	CoursesService_GetEnrollments_FullMethodName       = "/main.CoursesService/GetEnrollments"
+	CoursesService_GetCourseRoster_FullMethodName      = "/main.CoursesService/GetCourseRoster"
+)
+
+// CoursesServiceClient is the client API for CoursesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to courses and the enrollment of students in them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type CoursesServiceClient interface {
+	// GetCourses retrieves a list of courses based on the provided criteria
+	GetCourses(ctx context.Context, in *GetCoursesRequest, opts ...grpc.CallOption) (*Courses, error)
+	// AddCourses adds new courses to the catalogue
+	AddCourses(ctx context.Context, in *AddCoursesRequest, opts ...grpc.CallOption) (*AddCoursesResponse, error)
+	// UpdateCourses updates existing course information
+	UpdateCourses(ctx context.Context, in *UpdateCoursesRequest, opts ...grpc.CallOption) (*Courses, error)
+	// DeleteCourses removes courses by their IDs, courses that still have sections or are a prerequisite are kept
+	DeleteCourses(ctx context.Context, in *DeleteCoursesRequest, opts ...grpc.CallOption) (*DeleteCoursesConfirmation, error)
+	// AddCourseSections opens sections of courses for a term
+	AddCourseSections(ctx context.Context, in *AddCourseSectionsRequest, opts ...grpc.CallOption) (*AddCourseSectionsResponse, error)
+	// GetCourseSections retrieves a list of course sections based on the provided criteria
+	GetCourseSections(ctx context.Context, in *GetCourseSectionsRequest, opts ...grpc.CallOption) (*CourseSections, error)
+	// DeleteCourseSections removes course sections by their IDs, sections with enrolled students are kept
+	DeleteCourseSections(ctx context.Context, in *DeleteCourseSectionsRequest, opts ...grpc.CallOption) (*DeleteCourseSectionsConfirmation, error)
+	// EnrollStudents enrolls students in a course section, every student is accepted or rejected on their own
+	EnrollStudents(ctx context.Context, in *EnrollStudentsRequest, opts ...grpc.CallOption) (*EnrollStudentsResponse, error)
+	// DropStudents drops the enrollment of students in a course section
+	DropStudents(ctx context.Context, in *DropStudentsRequest, opts ...grpc.CallOption) (*Enrollments, error)
+	// GetEnrollments retrieves the enrollments of a student, section or course
+	GetEnrollments(ctx context.Context, in *GetEnrollmentsRequest, opts ...grpc.CallOption) (*Enrollments, error)
+	// GetCourseRoster retrieves the students enrolled in a course, optionally limited to one section or term
+	GetCourseRoster(ctx context.Context, in *CourseRosterRequest, opts ...grpc.CallOption) (*CourseRoster, error)
+}
+
+type coursesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoursesServiceClient(cc grpc.ClientConnInterface) CoursesServiceClient {
+	return &coursesServiceClient{cc}
+}
+
+func (c *coursesServiceClient) GetCourses(ctx context.Context, in *GetCoursesRequest, opts ...grpc.CallOption) (*Courses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) AddCourses(ctx context.Context, in *AddCoursesRequest, opts ...grpc.CallOption) (*AddCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCoursesResponse)
+	err := c.cc.Invoke(ctx, CoursesService_AddCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) UpdateCourses(ctx context.Context, in *UpdateCoursesRequest, opts ...grpc.CallOption) (*Courses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courses)
+	err := c.cc.Invoke(ctx, CoursesService_UpdateCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DeleteCourses(ctx context.Context, in *DeleteCoursesRequest, opts ...grpc.CallOption) (*DeleteCoursesConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCoursesConfirmation)
+	err := c.cc.Invoke(ctx, CoursesService_DeleteCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) AddCourseSections(ctx context.Context, in *AddCourseSectionsRequest, opts ...grpc.CallOption) (*AddCourseSectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCourseSectionsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_AddCourseSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourseSections(ctx context.Context, in *GetCourseSectionsRequest, opts ...grpc.CallOption) (*CourseSections, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseSections)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourseSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DeleteCourseSections(ctx context.Context, in *DeleteCourseSectionsRequest, opts ...grpc.CallOption) (*DeleteCourseSectionsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCourseSectionsConfirmation)
+	err := c.cc.Invoke(ctx, CoursesService_DeleteCourseSections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) EnrollStudents(ctx context.Context, in *EnrollStudentsRequest, opts ...grpc.CallOption) (*EnrollStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollStudentsResponse)
+	err := c.cc.Invoke(ctx, CoursesService_EnrollStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) DropStudents(ctx context.Context, in *DropStudentsRequest, opts ...grpc.CallOption) (*Enrollments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Enrollments)
+	err := c.cc.Invoke(ctx, CoursesService_DropStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetEnrollments(ctx context.Context, in *GetEnrollmentsRequest, opts ...grpc.CallOption) (*Enrollments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Enrollments)
+	err := c.cc.Invoke(ctx, CoursesService_GetEnrollments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coursesServiceClient) GetCourseRoster(ctx context.Context, in *CourseRosterRequest, opts ...grpc.CallOption) (*CourseRoster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseRoster)
+	err := c.cc.Invoke(ctx, CoursesService_GetCourseRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoursesServiceServer is the server API for CoursesService service.
+// All implementations must embed UnimplementedCoursesServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to courses and the enrollment of students in them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type CoursesServiceServer interface {
+	// GetCourses retrieves a list of courses based on the provided criteria
+	GetCourses(context.Context, *GetCoursesRequest) (*Courses, error)
+	// AddCourses adds new courses to the catalogue
+	AddCourses(context.Context, *AddCoursesRequest) (*AddCoursesResponse, error)
+	// UpdateCourses updates existing course information
+	UpdateCourses(context.Context, *UpdateCoursesRequest) (*Courses, error)
+	// DeleteCourses removes courses by their IDs, courses that still have sections or are a prerequisite are kept
+	DeleteCourses(context.Context, *DeleteCoursesRequest) (*DeleteCoursesConfirmation, error)
+	// AddCourseSections opens sections of courses for a term
+	AddCourseSections(context.Context, *AddCourseSectionsRequest) (*AddCourseSectionsResponse, error)
+	// GetCourseSections retrieves a list of course sections based on the provided criteria
+	GetCourseSections(context.Context, *GetCourseSectionsRequest) (*CourseSections, error)
+	// DeleteCourseSections removes course sections by their IDs, sections with enrolled students are kept
+	DeleteCourseSections(context.Context, *DeleteCourseSectionsRequest) (*DeleteCourseSectionsConfirmation, error)
+	// EnrollStudents enrolls students in a course section, every student is accepted or rejected on their own
+	EnrollStudents(context.Context, *EnrollStudentsRequest) (*EnrollStudentsResponse, error)
+	// DropStudents drops the enrollment of students in a course section
+	DropStudents(context.Context, *DropStudentsRequest) (*Enrollments, error)
+	// GetEnrollments retrieves the enrollments of a student, section or course
+	GetEnrollments(context.Context, *GetEnrollmentsRequest) (*Enrollments, error)
+	// GetCourseRoster retrieves the students enrolled in a course, optionally limited to one section or term
+	GetCourseRoster(context.Context, *CourseRosterRequest) (*CourseRoster, error)
+	mustEmbedUnimplementedCoursesServiceServer()
+}
+
+// UnimplementedCoursesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCoursesServiceServer struct{}
+
+func (UnimplementedCoursesServiceServer) GetCourses(context.Context, *GetCoursesRequest) (*Courses, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddCourses(context.Context, *AddCoursesRequest) (*AddCoursesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) UpdateCourses(context.Context, *UpdateCoursesRequest) (*Courses, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeleteCourses(context.Context, *DeleteCoursesRequest) (*DeleteCoursesConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCourses not implemented")
+}
+func (UnimplementedCoursesServiceServer) AddCourseSections(context.Context, *AddCourseSectionsRequest) (*AddCourseSectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCourseSections not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseSections(context.Context, *GetCourseSectionsRequest) (*CourseSections, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCourseSections not implemented")
+}
+func (UnimplementedCoursesServiceServer) DeleteCourseSections(context.Context, *DeleteCourseSectionsRequest) (*DeleteCourseSectionsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCourseSections not implemented")
+}
+func (UnimplementedCoursesServiceServer) EnrollStudents(context.Context, *EnrollStudentsRequest) (*EnrollStudentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnrollStudents not implemented")
+}
+func (UnimplementedCoursesServiceServer) DropStudents(context.Context, *DropStudentsRequest) (*Enrollments, error) {
+	return nil, status.Error(codes.Unimplemented, "method DropStudents not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetEnrollments(context.Context, *GetEnrollmentsRequest) (*Enrollments, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEnrollments not implemented")
+}
+func (UnimplementedCoursesServiceServer) GetCourseRoster(context.Context, *CourseRosterRequest) (*CourseRoster, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCourseRoster not implemented")
+}
+func (UnimplementedCoursesServiceServer) mustEmbedUnimplementedCoursesServiceServer() {}
+func (UnimplementedCoursesServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCoursesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoursesServiceServer will
+// result in compilation errors.
+type UnsafeCoursesServiceServer interface {
+	mustEmbedUnimplementedCoursesServiceServer()
+}
+
+func RegisterCoursesServiceServer(s grpc.ServiceRegistrar, srv CoursesServiceServer) {
+	// If the following call panics, it indicates UnimplementedCoursesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CoursesService_ServiceDesc, srv)
+}
+
+func _CoursesService_GetCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourses(ctx, req.(*GetCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddCourses(ctx, req.(*AddCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_UpdateCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).UpdateCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_UpdateCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).UpdateCourses(ctx, req.(*UpdateCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeleteCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeleteCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeleteCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeleteCourses(ctx, req.(*DeleteCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_AddCourseSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCourseSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).AddCourseSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_AddCourseSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).AddCourseSections(ctx, req.(*AddCourseSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCourseSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourseSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourseSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourseSections(ctx, req.(*GetCourseSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DeleteCourseSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DeleteCourseSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DeleteCourseSections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DeleteCourseSections(ctx, req.(*DeleteCourseSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_EnrollStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).EnrollStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_EnrollStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).EnrollStudents(ctx, req.(*EnrollStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_DropStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).DropStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_DropStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).DropStudents(ctx, req.(*DropStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnrollmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetEnrollments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetEnrollments(ctx, req.(*GetEnrollmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoursesService_GetCourseRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoursesServiceServer).GetCourseRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoursesService_GetCourseRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoursesServiceServer).GetCourseRoster(ctx, req.(*CourseRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoursesService_ServiceDesc is the grpc.ServiceDesc for CoursesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoursesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.CoursesService",
+	HandlerType: (*CoursesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCourses",
+			Handler:    _CoursesService_GetCourses_Handler,
+		},
+		{
+			MethodName: "AddCourses",
+			Handler:    _CoursesService_AddCourses_Handler,
+		},
+		{
+			MethodName: "UpdateCourses",
+			Handler:    _CoursesService_UpdateCourses_Handler,
+		},
+		{
+			MethodName: "DeleteCourses",
+			Handler:    _CoursesService_DeleteCourses_Handler,
+		},
+		{
+			MethodName: "AddCourseSections",
+			Handler:    _CoursesService_AddCourseSections_Handler,
+		},
+		{
+			MethodName: "GetCourseSections",
+			Handler:    _CoursesService_GetCourseSections_Handler,
+		},
+		{
+			MethodName: "DeleteCourseSections",
+			Handler:    _CoursesService_DeleteCourseSections_Handler,
+		},
+		{
+			MethodName: "EnrollStudents",
+			Handler:    _CoursesService_EnrollStudents_Handler,
+		},
+		{
+			MethodName: "DropStudents",
+			Handler:    _CoursesService_DropStudents_Handler,
+		},
+		{
+			MethodName: "GetEnrollments",
+			Handler:    _CoursesService_GetEnrollments_Handler,
+		},
+		{
+			MethodName: "GetCourseRoster",
+			Handler:    _CoursesService_GetCourseRoster_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "courses.proto",
+}
@@ -9,6 +9,8 @@ package grpcapipb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +23,236 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AddExecsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Execs []*Exec                `protobuf:"bytes,1,rep,name=execs,proto3" json:"execs,omitempty"`
+	// ordered stops inserting at the first failing exec, otherwise every valid exec is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all execs in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExecsRequest) Reset() {
+	*x = AddExecsRequest{}
+	mi := &file_execs_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExecsRequest) ProtoMessage() {}
+
+func (x *AddExecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExecsRequest.ProtoReflect.Descriptor instead.
+func (*AddExecsRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddExecsRequest) GetExecs() []*Exec {
+	if x != nil {
+		return x.Execs
+	}
+	return nil
+}
+
+func (x *AddExecsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddExecsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type UpdateExecsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Execs []*Exec                `protobuf:"bytes,1,rep,name=execs,proto3" json:"execs,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every exec, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExecsRequest) Reset() {
+	*x = UpdateExecsRequest{}
+	mi := &file_execs_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExecsRequest) ProtoMessage() {}
+
+func (x *UpdateExecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExecsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExecsRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateExecsRequest) GetExecs() []*Exec {
+	if x != nil {
+		return x.Execs
+	}
+	return nil
+}
+
+func (x *UpdateExecsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateExecsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteExecsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*ExecId              `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all execs in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExecsRequest) Reset() {
+	*x = DeleteExecsRequest{}
+	mi := &file_execs_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExecsRequest) ProtoMessage() {}
+
+func (x *DeleteExecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExecsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExecsRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteExecsRequest) GetIds() []*ExecId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteExecsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddExecsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Execs         []*Exec                `protobuf:"bytes,1,rep,name=execs,proto3" json:"execs,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExecsResponse) Reset() {
+	*x = AddExecsResponse{}
+	mi := &file_execs_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExecsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExecsResponse) ProtoMessage() {}
+
+func (x *AddExecsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExecsResponse.ProtoReflect.Descriptor instead.
+func (*AddExecsResponse) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddExecsResponse) GetExecs() []*Exec {
+	if x != nil {
+		return x.Execs
+	}
+	return nil
+}
+
+func (x *AddExecsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type ExecLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -31,7 +263,7 @@ type ExecLoginRequest struct {
 
 func (x *ExecLoginRequest) Reset() {
 	*x = ExecLoginRequest{}
-	mi := &file_execs_proto_msgTypes[0]
+	mi := &file_execs_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +275,7 @@ func (x *ExecLoginRequest) String() string {
 func (*ExecLoginRequest) ProtoMessage() {}
 
 func (x *ExecLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[0]
+	mi := &file_execs_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +288,7 @@ func (x *ExecLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecLoginRequest.ProtoReflect.Descriptor instead.
 func (*ExecLoginRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{0}
+	return file_execs_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExecLoginRequest) GetUsername() string {
@@ -83,7 +315,7 @@ type ExecLoginResponse struct {
 
 func (x *ExecLoginResponse) Reset() {
 	*x = ExecLoginResponse{}
-	mi := &file_execs_proto_msgTypes[1]
+	mi := &file_execs_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +327,7 @@ func (x *ExecLoginResponse) String() string {
 func (*ExecLoginResponse) ProtoMessage() {}
 
 func (x *ExecLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[1]
+	mi := &file_execs_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +340,7 @@ func (x *ExecLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecLoginResponse.ProtoReflect.Descriptor instead.
 func (*ExecLoginResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{1}
+	return file_execs_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ExecLoginResponse) GetStatus() bool {
@@ -135,7 +367,7 @@ type ForgotPasswordResponse struct {
 
 func (x *ForgotPasswordResponse) Reset() {
 	*x = ForgotPasswordResponse{}
-	mi := &file_execs_proto_msgTypes[2]
+	mi := &file_execs_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +379,7 @@ func (x *ForgotPasswordResponse) String() string {
 func (*ForgotPasswordResponse) ProtoMessage() {}
 
 func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[2]
+	mi := &file_execs_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +392,7 @@ func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{2}
+	return file_execs_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ForgotPasswordResponse) GetConfirmation() bool {
@@ -186,7 +418,7 @@ type ForgotPasswordRequest struct {
 
 func (x *ForgotPasswordRequest) Reset() {
 	*x = ForgotPasswordRequest{}
-	mi := &file_execs_proto_msgTypes[3]
+	mi := &file_execs_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -198,7 +430,7 @@ func (x *ForgotPasswordRequest) String() string {
 func (*ForgotPasswordRequest) ProtoMessage() {}
 
 func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[3]
+	mi := &file_execs_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +443,7 @@ func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{3}
+	return file_execs_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ForgotPasswordRequest) GetEmail() string {
@@ -230,7 +462,7 @@ type Confirmation struct {
 
 func (x *Confirmation) Reset() {
 	*x = Confirmation{}
-	mi := &file_execs_proto_msgTypes[4]
+	mi := &file_execs_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +474,7 @@ func (x *Confirmation) String() string {
 func (*Confirmation) ProtoMessage() {}
 
 func (x *Confirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[4]
+	mi := &file_execs_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +487,7 @@ func (x *Confirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Confirmation.ProtoReflect.Descriptor instead.
 func (*Confirmation) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{4}
+	return file_execs_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Confirmation) GetConfirmation() bool {
@@ -276,7 +508,7 @@ type ResetPasswordRequest struct {
 
 func (x *ResetPasswordRequest) Reset() {
 	*x = ResetPasswordRequest{}
-	mi := &file_execs_proto_msgTypes[5]
+	mi := &file_execs_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +520,7 @@ func (x *ResetPasswordRequest) String() string {
 func (*ResetPasswordRequest) ProtoMessage() {}
 
 func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[5]
+	mi := &file_execs_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +533,7 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{5}
+	return file_execs_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ResetPasswordRequest) GetResetCode() string {
@@ -335,7 +567,7 @@ type UpdatePasswordResponse struct {
 
 func (x *UpdatePasswordResponse) Reset() {
 	*x = UpdatePasswordResponse{}
-	mi := &file_execs_proto_msgTypes[6]
+	mi := &file_execs_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +579,7 @@ func (x *UpdatePasswordResponse) String() string {
 func (*UpdatePasswordResponse) ProtoMessage() {}
 
 func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[6]
+	mi := &file_execs_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +592,7 @@ func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePasswordResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{6}
+	return file_execs_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdatePasswordResponse) GetPasswordUpdated() bool {
@@ -388,7 +620,7 @@ type UpdatePasswordRequest struct {
 
 func (x *UpdatePasswordRequest) Reset() {
 	*x = UpdatePasswordRequest{}
-	mi := &file_execs_proto_msgTypes[7]
+	mi := &file_execs_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +632,7 @@ func (x *UpdatePasswordRequest) String() string {
 func (*UpdatePasswordRequest) ProtoMessage() {}
 
 func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[7]
+	mi := &file_execs_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +645,7 @@ func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePasswordRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{7}
+	return file_execs_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdatePasswordRequest) GetId() string {
@@ -446,7 +678,7 @@ type ExecLogoutResponse struct {
 
 func (x *ExecLogoutResponse) Reset() {
 	*x = ExecLogoutResponse{}
-	mi := &file_execs_proto_msgTypes[8]
+	mi := &file_execs_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +690,7 @@ func (x *ExecLogoutResponse) String() string {
 func (*ExecLogoutResponse) ProtoMessage() {}
 
 func (x *ExecLogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[8]
+	mi := &file_execs_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +703,7 @@ func (x *ExecLogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecLogoutResponse.ProtoReflect.Descriptor instead.
 func (*ExecLogoutResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{8}
+	return file_execs_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ExecLogoutResponse) GetLoggedOut() bool {
@@ -489,7 +721,7 @@ type EmptyRequest struct {
 
 func (x *EmptyRequest) Reset() {
 	*x = EmptyRequest{}
-	mi := &file_execs_proto_msgTypes[9]
+	mi := &file_execs_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +733,7 @@ func (x *EmptyRequest) String() string {
 func (*EmptyRequest) ProtoMessage() {}
 
 func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[9]
+	mi := &file_execs_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,32 +746,38 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
 func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{9}
+	return file_execs_proto_rawDescGZIP(), []int{13}
 }
 
-type DeleteExecsConfirmation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+type CacheStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled is false when the server runs without a read cache, the counters are then zero
+	Enabled bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Hits    int64 `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses  int64 `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	// bypasses counts reads that skipped the cache through the cache-control: no-cache metadata
+	Bypasses      int64 `protobuf:"varint,4,opt,name=bypasses,proto3" json:"bypasses,omitempty"`
+	Invalidations int64 `protobuf:"varint,5,opt,name=invalidations,proto3" json:"invalidations,omitempty"`
+	Entries       int64 `protobuf:"varint,6,opt,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExecsConfirmation) Reset() {
-	*x = DeleteExecsConfirmation{}
-	mi := &file_execs_proto_msgTypes[10]
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	mi := &file_execs_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExecsConfirmation) String() string {
+func (x *CacheStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExecsConfirmation) ProtoMessage() {}
+func (*CacheStats) ProtoMessage() {}
 
-func (x *DeleteExecsConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[10]
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,35 +788,292 @@ func (x *DeleteExecsConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExecsConfirmation.ProtoReflect.Descriptor instead.
-func (*DeleteExecsConfirmation) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeleteExecsConfirmation) GetStatus() string {
+func (x *CacheStats) GetEnabled() bool {
 	if x != nil {
-		return x.Status
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *DeleteExecsConfirmation) GetDeletedIds() []string {
+func (x *CacheStats) GetHits() int64 {
 	if x != nil {
-		return x.DeletedIds
+		return x.Hits
 	}
-	return nil
+	return 0
 }
 
-type ExecId struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CacheStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
 }
 
-func (x *ExecId) Reset() {
-	*x = ExecId{}
-	mi := &file_execs_proto_msgTypes[11]
+func (x *CacheStats) GetBypasses() int64 {
+	if x != nil {
+		return x.Bypasses
+	}
+	return 0
+}
+
+func (x *CacheStats) GetInvalidations() int64 {
+	if x != nil {
+		return x.Invalidations
+	}
+	return 0
+}
+
+func (x *CacheStats) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+type WatchExecsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only changes to execs matching the non-empty fields of the filter are streamed
+	Filter *Exec `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resume_token of the last received event, the stream continues right after it
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchExecsRequest) Reset() {
+	*x = WatchExecsRequest{}
+	mi := &file_execs_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchExecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExecsRequest) ProtoMessage() {}
+
+func (x *WatchExecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExecsRequest.ProtoReflect.Descriptor instead.
+func (*WatchExecsRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchExecsRequest) GetFilter() *Exec {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchExecsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ExecEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ChangeType             `protobuf:"varint,1,opt,name=type,proto3,enum=main.ChangeType" json:"type,omitempty"`
+	// exec as stored after the change
+	Exec          *Exec                  `protobuf:"bytes,2,opt,name=exec,proto3" json:"exec,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecEvent) Reset() {
+	*x = ExecEvent{}
+	mi := &file_execs_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecEvent) ProtoMessage() {}
+
+func (x *ExecEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecEvent.ProtoReflect.Descriptor instead.
+func (*ExecEvent) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExecEvent) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ExecEvent) GetExec() *Exec {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *ExecEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ExecEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RestoreExecsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredIds   []string               `protobuf:"bytes,2,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreExecsConfirmation) Reset() {
+	*x = RestoreExecsConfirmation{}
+	mi := &file_execs_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreExecsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExecsConfirmation) ProtoMessage() {}
+
+func (x *RestoreExecsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExecsConfirmation.ProtoReflect.Descriptor instead.
+func (*RestoreExecsConfirmation) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreExecsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestoreExecsConfirmation) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
+type DeleteExecsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExecsConfirmation) Reset() {
+	*x = DeleteExecsConfirmation{}
+	mi := &file_execs_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExecsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExecsConfirmation) ProtoMessage() {}
+
+func (x *DeleteExecsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExecsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteExecsConfirmation) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteExecsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteExecsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type ExecId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the exec, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecId) Reset() {
+	*x = ExecId{}
+	mi := &file_execs_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -590,7 +1085,7 @@ func (x *ExecId) String() string {
 func (*ExecId) ProtoMessage() {}
 
 func (x *ExecId) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[11]
+	mi := &file_execs_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +1098,7 @@ func (x *ExecId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecId.ProtoReflect.Descriptor instead.
 func (*ExecId) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{11}
+	return file_execs_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ExecId) GetId() string {
@@ -613,6 +1108,13 @@ func (x *ExecId) GetId() string {
 	return ""
 }
 
+func (x *ExecId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ExecIds struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ids           []*ExecId              `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
@@ -622,7 +1124,7 @@ type ExecIds struct {
 
 func (x *ExecIds) Reset() {
 	*x = ExecIds{}
-	mi := &file_execs_proto_msgTypes[12]
+	mi := &file_execs_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -634,7 +1136,7 @@ func (x *ExecIds) String() string {
 func (*ExecIds) ProtoMessage() {}
 
 func (x *ExecIds) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[12]
+	mi := &file_execs_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +1149,7 @@ func (x *ExecIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecIds.ProtoReflect.Descriptor instead.
 func (*ExecIds) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{12}
+	return file_execs_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ExecIds) GetIds() []*ExecId {
@@ -658,16 +1160,20 @@ func (x *ExecIds) GetIds() []*ExecId {
 }
 
 type GetExecsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exec          *Exec                  `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
-	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Exec   *Exec                  `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
+	SortBy []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted execs
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// time_filters limit the execs to the given time ranges of their timestamp fields
+	TimeFilters   []*TimestampFilter `protobuf:"bytes,4,rep,name=time_filters,json=timeFilters,proto3" json:"time_filters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetExecsRequest) Reset() {
 	*x = GetExecsRequest{}
-	mi := &file_execs_proto_msgTypes[13]
+	mi := &file_execs_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -679,7 +1185,7 @@ func (x *GetExecsRequest) String() string {
 func (*GetExecsRequest) ProtoMessage() {}
 
 func (x *GetExecsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[13]
+	mi := &file_execs_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +1198,7 @@ func (x *GetExecsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExecsRequest.ProtoReflect.Descriptor instead.
 func (*GetExecsRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{13}
+	return file_execs_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetExecsRequest) GetExec() *Exec {
@@ -709,6 +1215,82 @@ func (x *GetExecsRequest) GetSortBy() []*SortField {
 	return nil
 }
 
+func (x *GetExecsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetExecsRequest) GetTimeFilters() []*TimestampFilter {
+	if x != nil {
+		return x.TimeFilters
+	}
+	return nil
+}
+
+type ExportExecsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query selects and orders the execs the same way GetExecs does
+	Query  *GetExecsRequest `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Format ImportFormat     `protobuf:"varint,2,opt,name=format,proto3,enum=main.ImportFormat" json:"format,omitempty"`
+	// columns lists the fields to export in order, all fields except the credentials are exported when it is empty
+	Columns       []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportExecsRequest) Reset() {
+	*x = ExportExecsRequest{}
+	mi := &file_execs_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportExecsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportExecsRequest) ProtoMessage() {}
+
+func (x *ExportExecsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportExecsRequest.ProtoReflect.Descriptor instead.
+func (*ExportExecsRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportExecsRequest) GetQuery() *GetExecsRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExportExecsRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_CSV
+}
+
+func (x *ExportExecsRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
 type Exec struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -717,19 +1299,28 @@ type Exec struct {
 	Email                string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 	Username             string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
 	Password             string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	PasswordChangedAt    string                 `protobuf:"bytes,7,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
-	UserCreatedAt        string                 `protobuf:"bytes,8,opt,name=user_created_at,json=userCreatedAt,proto3" json:"user_created_at,omitempty"`
 	PasswordResetToken   string                 `protobuf:"bytes,9,opt,name=password_reset_token,json=passwordResetToken,proto3" json:"password_reset_token,omitempty"`
 	PasswordTokenExpires string                 `protobuf:"bytes,10,opt,name=password_token_expires,json=passwordTokenExpires,proto3" json:"password_token_expires,omitempty"`
 	Role                 string                 `protobuf:"bytes,11,opt,name=role,proto3" json:"role,omitempty"`
 	InactiveStatus       bool                   `protobuf:"varint,12,opt,name=inactive_status,json=inactiveStatus,proto3" json:"inactive_status,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted execs, which are purged after the retention period
+	DeletedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy         string                 `protobuf:"bytes,15,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	PasswordChangedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,19,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,20,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Exec) Reset() {
 	*x = Exec{}
-	mi := &file_execs_proto_msgTypes[14]
+	mi := &file_execs_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +1332,7 @@ func (x *Exec) String() string {
 func (*Exec) ProtoMessage() {}
 
 func (x *Exec) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[14]
+	mi := &file_execs_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +1345,7 @@ func (x *Exec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Exec.ProtoReflect.Descriptor instead.
 func (*Exec) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{14}
+	return file_execs_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Exec) GetId() string {
@@ -799,46 +1390,88 @@ func (x *Exec) GetPassword() string {
 	return ""
 }
 
-func (x *Exec) GetPasswordChangedAt() string {
+func (x *Exec) GetPasswordResetToken() string {
 	if x != nil {
-		return x.PasswordChangedAt
+		return x.PasswordResetToken
 	}
 	return ""
 }
 
-func (x *Exec) GetUserCreatedAt() string {
+func (x *Exec) GetPasswordTokenExpires() string {
 	if x != nil {
-		return x.UserCreatedAt
+		return x.PasswordTokenExpires
 	}
 	return ""
 }
 
-func (x *Exec) GetPasswordResetToken() string {
+func (x *Exec) GetRole() string {
 	if x != nil {
-		return x.PasswordResetToken
+		return x.Role
 	}
 	return ""
 }
 
-func (x *Exec) GetPasswordTokenExpires() string {
+func (x *Exec) GetInactiveStatus() bool {
 	if x != nil {
-		return x.PasswordTokenExpires
+		return x.InactiveStatus
+	}
+	return false
+}
+
+func (x *Exec) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Exec) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Exec) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
 	}
 	return ""
 }
 
-func (x *Exec) GetRole() string {
+func (x *Exec) GetPasswordChangedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Role
+		return x.PasswordChangedAt
+	}
+	return nil
+}
+
+func (x *Exec) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Exec) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Exec) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *Exec) GetInactiveStatus() bool {
+func (x *Exec) GetUpdatedBy() string {
 	if x != nil {
-		return x.InactiveStatus
+		return x.UpdatedBy
 	}
-	return false
+	return ""
 }
 
 type Execs struct {
@@ -850,7 +1483,7 @@ type Execs struct {
 
 func (x *Execs) Reset() {
 	*x = Execs{}
-	mi := &file_execs_proto_msgTypes[15]
+	mi := &file_execs_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1495,7 @@ func (x *Execs) String() string {
 func (*Execs) ProtoMessage() {}
 
 func (x *Execs) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[15]
+	mi := &file_execs_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1508,7 @@ func (x *Execs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Execs.ProtoReflect.Descriptor instead.
 func (*Execs) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{15}
+	return file_execs_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Execs) GetExecs() []*Exec {
@@ -889,7 +1522,25 @@ var File_execs_proto protoreflect.FileDescriptor
 
 const file_execs_proto_rawDesc = "" +
 	"\n" +
-	"\vexecs.proto\x12\x04main\x1a\x0estudents.proto\"J\n" +
+	"\vexecs.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\"e\n" +
+	"\x0fAddExecsRequest\x12 \n" +
+	"\x05execs\x18\x01 \x03(\v2\n" +
+	".main.ExecR\x05execs\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"\x8b\x01\n" +
+	"\x12UpdateExecsRequest\x12 \n" +
+	"\x05execs\x18\x01 \x03(\v2\n" +
+	".main.ExecR\x05execs\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"L\n" +
+	"\x12DeleteExecsRequest\x12\x1e\n" +
+	"\x03ids\x18\x01 \x03(\v2\f.main.ExecIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"d\n" +
+	"\x10AddExecsResponse\x12 \n" +
+	"\x05execs\x18\x01 \x03(\v2\n" +
+	".main.ExecR\x05execs\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"J\n" +
 	"\x10ExecLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"A\n" +
@@ -918,19 +1569,48 @@ const file_execs_proto_rawDesc = "" +
 	"\x12ExecLogoutResponse\x12\x1d\n" +
 	"\n" +
 	"logged_out\x18\x01 \x01(\bR\tloggedOut\"\x0e\n" +
-	"\fEmptyRequest\"R\n" +
+	"\fEmptyRequest\"\xae\x01\n" +
+	"\n" +
+	"CacheStats\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04hits\x18\x02 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x03 \x01(\x03R\x06misses\x12\x1a\n" +
+	"\bbypasses\x18\x04 \x01(\x03R\bbypasses\x12$\n" +
+	"\rinvalidations\x18\x05 \x01(\x03R\rinvalidations\x12\x18\n" +
+	"\aentries\x18\x06 \x01(\x03R\aentries\"Z\n" +
+	"\x11WatchExecsRequest\x12\"\n" +
+	"\x06filter\x18\x01 \x01(\v2\n" +
+	".main.ExecR\x06filter\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\xb1\x01\n" +
+	"\tExecEvent\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.main.ChangeTypeR\x04type\x12\x1e\n" +
+	"\x04exec\x18\x02 \x01(\v2\n" +
+	".main.ExecR\x04exec\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"U\n" +
+	"\x18RestoreExecsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\frestored_ids\x18\x02 \x03(\tR\vrestoredIds\"R\n" +
 	"\x17DeleteExecsConfirmation\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\"\x18\n" +
+	"deletedIds\"2\n" +
 	"\x06ExecId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\")\n" +
 	"\aExecIds\x12\x1e\n" +
-	"\x03ids\x18\x01 \x03(\v2\f.main.ExecIdR\x03ids\"[\n" +
+	"\x03ids\x18\x01 \x03(\v2\f.main.ExecIdR\x03ids\"\xbe\x01\n" +
 	"\x0fGetExecsRequest\x12\x1e\n" +
 	"\x04exec\x18\x01 \x01(\v2\n" +
 	".main.ExecR\x04exec\x12(\n" +
-	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\x9d\x03\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\x128\n" +
+	"\ftime_filters\x18\x04 \x03(\v2\x15.main.TimestampFilterR\vtimeFilters\"\x87\x01\n" +
+	"\x12ExportExecsRequest\x12+\n" +
+	"\x05query\x18\x01 \x01(\v2\x15.main.GetExecsRequestR\x05query\x12*\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x12.main.ImportFormatR\x06format\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\"\xd6\x05\n" +
 	"\x04Exec\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -938,28 +1618,45 @@ const file_execs_proto_rawDesc = "" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1a\n" +
 	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x06 \x01(\tR\bpassword\x12.\n" +
-	"\x13password_changed_at\x18\a \x01(\tR\x11passwordChangedAt\x12&\n" +
-	"\x0fuser_created_at\x18\b \x01(\tR\ruserCreatedAt\x120\n" +
+	"\bpassword\x18\x06 \x01(\tR\bpassword\x120\n" +
 	"\x14password_reset_token\x18\t \x01(\tR\x12passwordResetToken\x124\n" +
 	"\x16password_token_expires\x18\n" +
 	" \x01(\tR\x14passwordTokenExpires\x12\x12\n" +
 	"\x04role\x18\v \x01(\tR\x04role\x12'\n" +
-	"\x0finactive_status\x18\f \x01(\bR\x0einactiveStatus\")\n" +
+	"\x0finactive_status\x18\f \x01(\bR\x0einactiveStatus\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x0f \x01(\tR\tdeletedBy\x12J\n" +
+	"\x13password_changed_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x11passwordChangedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x13 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x14 \x01(\tR\tupdatedByJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\x0fuser_created_at\")\n" +
 	"\x05Execs\x12 \n" +
 	"\x05execs\x18\x01 \x03(\v2\n" +
-	".main.ExecR\x05execs2\xcc\x04\n" +
+	".main.ExecR\x05execs2\xe7\x06\n" +
 	"\fExecsService\x12.\n" +
-	"\bGetExecs\x12\x15.main.GetExecsRequest\x1a\v.main.Execs\x12$\n" +
-	"\bAddExecs\x12\v.main.Execs\x1a\v.main.Execs\x12'\n" +
-	"\vUpdateExecs\x12\v.main.Execs\x1a\v.main.Execs\x12;\n" +
-	"\vDeleteExecs\x12\r.main.ExecIds\x1a\x1d.main.DeleteExecsConfirmation\x128\n" +
+	"\bGetExecs\x12\x15.main.GetExecsRequest\x1a\v.main.Execs\x129\n" +
+	"\bAddExecs\x12\x15.main.AddExecsRequest\x1a\x16.main.AddExecsResponse\x124\n" +
+	"\vUpdateExecs\x12\x18.main.UpdateExecsRequest\x1a\v.main.Execs\x12F\n" +
+	"\vDeleteExecs\x12\x18.main.DeleteExecsRequest\x1a\x1d.main.DeleteExecsConfirmation\x12=\n" +
+	"\fRestoreExecs\x12\r.main.ExecIds\x1a\x1e.main.RestoreExecsConfirmation\x128\n" +
+	"\n" +
+	"WatchExecs\x12\x17.main.WatchExecsRequest\x1a\x0f.main.ExecEvent0\x01\x12<\n" +
+	"\vExportExecs\x12\x18.main.ExportExecsRequest\x1a\x11.main.ExportChunk0\x01\x128\n" +
 	"\x05Login\x12\x16.main.ExecLoginRequest\x1a\x17.main.ExecLoginResponse\x126\n" +
 	"\x06Logout\x12\x12.main.EmptyRequest\x1a\x18.main.ExecLogoutResponse\x12K\n" +
 	"\x0eUpdatePassword\x12\x1b.main.UpdatePasswordRequest\x1a\x1c.main.UpdatePasswordResponse\x12?\n" +
 	"\rResetPassword\x12\x1a.main.ResetPasswordRequest\x1a\x12.main.Confirmation\x12K\n" +
 	"\x0eForgotPassword\x12\x1b.main.ForgotPasswordRequest\x1a\x1c.main.ForgotPasswordResponse\x123\n" +
-	"\x0eDeactivateUser\x12\r.main.ExecIds\x1a\x12.main.ConfirmationB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+	"\x0eDeactivateUser\x12\r.main.ExecIds\x1a\x12.main.Confirmation\x125\n" +
+	"\rGetCacheStats\x12\x12.main.EmptyRequest\x1a\x10.main.CacheStatsB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_execs_proto_rawDescOnce sync.Once
@@ -973,56 +1670,97 @@ func file_execs_proto_rawDescGZIP() []byte {
 	return file_execs_proto_rawDescData
 }
 
-var file_execs_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_execs_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_execs_proto_goTypes = []any{
-	(*ExecLoginRequest)(nil),        // 0: main.ExecLoginRequest
-	(*ExecLoginResponse)(nil),       // 1: main.ExecLoginResponse
-	(*ForgotPasswordResponse)(nil),  // 2: main.ForgotPasswordResponse
-	(*ForgotPasswordRequest)(nil),   // 3: main.ForgotPasswordRequest
-	(*Confirmation)(nil),            // 4: main.Confirmation
-	(*ResetPasswordRequest)(nil),    // 5: main.ResetPasswordRequest
-	(*UpdatePasswordResponse)(nil),  // 6: main.UpdatePasswordResponse
-	(*UpdatePasswordRequest)(nil),   // 7: main.UpdatePasswordRequest
-	(*ExecLogoutResponse)(nil),      // 8: main.ExecLogoutResponse
-	(*EmptyRequest)(nil),            // 9: main.EmptyRequest
-	(*DeleteExecsConfirmation)(nil), // 10: main.DeleteExecsConfirmation
-	(*ExecId)(nil),                  // 11: main.ExecId
-	(*ExecIds)(nil),                 // 12: main.ExecIds
-	(*GetExecsRequest)(nil),         // 13: main.GetExecsRequest
-	(*Exec)(nil),                    // 14: main.Exec
-	(*Execs)(nil),                   // 15: main.Execs
-	(*SortField)(nil),               // 16: main.SortField
+	(*AddExecsRequest)(nil),          // 0: main.AddExecsRequest
+	(*UpdateExecsRequest)(nil),       // 1: main.UpdateExecsRequest
+	(*DeleteExecsRequest)(nil),       // 2: main.DeleteExecsRequest
+	(*AddExecsResponse)(nil),         // 3: main.AddExecsResponse
+	(*ExecLoginRequest)(nil),         // 4: main.ExecLoginRequest
+	(*ExecLoginResponse)(nil),        // 5: main.ExecLoginResponse
+	(*ForgotPasswordResponse)(nil),   // 6: main.ForgotPasswordResponse
+	(*ForgotPasswordRequest)(nil),    // 7: main.ForgotPasswordRequest
+	(*Confirmation)(nil),             // 8: main.Confirmation
+	(*ResetPasswordRequest)(nil),     // 9: main.ResetPasswordRequest
+	(*UpdatePasswordResponse)(nil),   // 10: main.UpdatePasswordResponse
+	(*UpdatePasswordRequest)(nil),    // 11: main.UpdatePasswordRequest
+	(*ExecLogoutResponse)(nil),       // 12: main.ExecLogoutResponse
+	(*EmptyRequest)(nil),             // 13: main.EmptyRequest
+	(*CacheStats)(nil),               // 14: main.CacheStats
+	(*WatchExecsRequest)(nil),        // 15: main.WatchExecsRequest
+	(*ExecEvent)(nil),                // 16: main.ExecEvent
+	(*RestoreExecsConfirmation)(nil), // 17: main.RestoreExecsConfirmation
+	(*DeleteExecsConfirmation)(nil),  // 18: main.DeleteExecsConfirmation
+	(*ExecId)(nil),                   // 19: main.ExecId
+	(*ExecIds)(nil),                  // 20: main.ExecIds
+	(*GetExecsRequest)(nil),          // 21: main.GetExecsRequest
+	(*ExportExecsRequest)(nil),       // 22: main.ExportExecsRequest
+	(*Exec)(nil),                     // 23: main.Exec
+	(*Execs)(nil),                    // 24: main.Execs
+	(*fieldmaskpb.FieldMask)(nil),    // 25: google.protobuf.FieldMask
+	(*BulkItemResult)(nil),           // 26: main.BulkItemResult
+	(ChangeType)(0),                  // 27: main.ChangeType
+	(*timestamppb.Timestamp)(nil),    // 28: google.protobuf.Timestamp
+	(*SortField)(nil),                // 29: main.SortField
+	(*TimestampFilter)(nil),          // 30: main.TimestampFilter
+	(ImportFormat)(0),                // 31: main.ImportFormat
+	(*ExportChunk)(nil),              // 32: main.ExportChunk
 }
 var file_execs_proto_depIdxs = []int32{
-	11, // 0: main.ExecIds.ids:type_name -> main.ExecId
-	14, // 1: main.GetExecsRequest.exec:type_name -> main.Exec
-	16, // 2: main.GetExecsRequest.sort_by:type_name -> main.SortField
-	14, // 3: main.Execs.execs:type_name -> main.Exec
-	13, // 4: main.ExecsService.GetExecs:input_type -> main.GetExecsRequest
-	15, // 5: main.ExecsService.AddExecs:input_type -> main.Execs
-	15, // 6: main.ExecsService.UpdateExecs:input_type -> main.Execs
-	12, // 7: main.ExecsService.DeleteExecs:input_type -> main.ExecIds
-	0,  // 8: main.ExecsService.Login:input_type -> main.ExecLoginRequest
-	9,  // 9: main.ExecsService.Logout:input_type -> main.EmptyRequest
-	7,  // 10: main.ExecsService.UpdatePassword:input_type -> main.UpdatePasswordRequest
-	5,  // 11: main.ExecsService.ResetPassword:input_type -> main.ResetPasswordRequest
-	3,  // 12: main.ExecsService.ForgotPassword:input_type -> main.ForgotPasswordRequest
-	12, // 13: main.ExecsService.DeactivateUser:input_type -> main.ExecIds
-	15, // 14: main.ExecsService.GetExecs:output_type -> main.Execs
-	15, // 15: main.ExecsService.AddExecs:output_type -> main.Execs
-	15, // 16: main.ExecsService.UpdateExecs:output_type -> main.Execs
-	10, // 17: main.ExecsService.DeleteExecs:output_type -> main.DeleteExecsConfirmation
-	1,  // 18: main.ExecsService.Login:output_type -> main.ExecLoginResponse
-	8,  // 19: main.ExecsService.Logout:output_type -> main.ExecLogoutResponse
-	6,  // 20: main.ExecsService.UpdatePassword:output_type -> main.UpdatePasswordResponse
-	4,  // 21: main.ExecsService.ResetPassword:output_type -> main.Confirmation
-	2,  // 22: main.ExecsService.ForgotPassword:output_type -> main.ForgotPasswordResponse
-	4,  // 23: main.ExecsService.DeactivateUser:output_type -> main.Confirmation
-	14, // [14:24] is the sub-list for method output_type
-	4,  // [4:14] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	23, // 0: main.AddExecsRequest.execs:type_name -> main.Exec
+	23, // 1: main.UpdateExecsRequest.execs:type_name -> main.Exec
+	25, // 2: main.UpdateExecsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 3: main.DeleteExecsRequest.ids:type_name -> main.ExecId
+	23, // 4: main.AddExecsResponse.execs:type_name -> main.Exec
+	26, // 5: main.AddExecsResponse.results:type_name -> main.BulkItemResult
+	23, // 6: main.WatchExecsRequest.filter:type_name -> main.Exec
+	27, // 7: main.ExecEvent.type:type_name -> main.ChangeType
+	23, // 8: main.ExecEvent.exec:type_name -> main.Exec
+	28, // 9: main.ExecEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	19, // 10: main.ExecIds.ids:type_name -> main.ExecId
+	23, // 11: main.GetExecsRequest.exec:type_name -> main.Exec
+	29, // 12: main.GetExecsRequest.sort_by:type_name -> main.SortField
+	30, // 13: main.GetExecsRequest.time_filters:type_name -> main.TimestampFilter
+	21, // 14: main.ExportExecsRequest.query:type_name -> main.GetExecsRequest
+	31, // 15: main.ExportExecsRequest.format:type_name -> main.ImportFormat
+	28, // 16: main.Exec.deleted_at:type_name -> google.protobuf.Timestamp
+	28, // 17: main.Exec.password_changed_at:type_name -> google.protobuf.Timestamp
+	28, // 18: main.Exec.created_at:type_name -> google.protobuf.Timestamp
+	28, // 19: main.Exec.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 20: main.Execs.execs:type_name -> main.Exec
+	21, // 21: main.ExecsService.GetExecs:input_type -> main.GetExecsRequest
+	0,  // 22: main.ExecsService.AddExecs:input_type -> main.AddExecsRequest
+	1,  // 23: main.ExecsService.UpdateExecs:input_type -> main.UpdateExecsRequest
+	2,  // 24: main.ExecsService.DeleteExecs:input_type -> main.DeleteExecsRequest
+	20, // 25: main.ExecsService.RestoreExecs:input_type -> main.ExecIds
+	15, // 26: main.ExecsService.WatchExecs:input_type -> main.WatchExecsRequest
+	22, // 27: main.ExecsService.ExportExecs:input_type -> main.ExportExecsRequest
+	4,  // 28: main.ExecsService.Login:input_type -> main.ExecLoginRequest
+	13, // 29: main.ExecsService.Logout:input_type -> main.EmptyRequest
+	11, // 30: main.ExecsService.UpdatePassword:input_type -> main.UpdatePasswordRequest
+	9,  // 31: main.ExecsService.ResetPassword:input_type -> main.ResetPasswordRequest
+	7,  // 32: main.ExecsService.ForgotPassword:input_type -> main.ForgotPasswordRequest
+	20, // 33: main.ExecsService.DeactivateUser:input_type -> main.ExecIds
+	13, // 34: main.ExecsService.GetCacheStats:input_type -> main.EmptyRequest
+	24, // 35: main.ExecsService.GetExecs:output_type -> main.Execs
+	3,  // 36: main.ExecsService.AddExecs:output_type -> main.AddExecsResponse
+	24, // 37: main.ExecsService.UpdateExecs:output_type -> main.Execs
+	18, // 38: main.ExecsService.DeleteExecs:output_type -> main.DeleteExecsConfirmation
+	17, // 39: main.ExecsService.RestoreExecs:output_type -> main.RestoreExecsConfirmation
+	16, // 40: main.ExecsService.WatchExecs:output_type -> main.ExecEvent
+	32, // 41: main.ExecsService.ExportExecs:output_type -> main.ExportChunk
+	5,  // 42: main.ExecsService.Login:output_type -> main.ExecLoginResponse
+	12, // 43: main.ExecsService.Logout:output_type -> main.ExecLogoutResponse
+	10, // 44: main.ExecsService.UpdatePassword:output_type -> main.UpdatePasswordResponse
+	8,  // 45: main.ExecsService.ResetPassword:output_type -> main.Confirmation
+	6,  // 46: main.ExecsService.ForgotPassword:output_type -> main.ForgotPasswordResponse
+	8,  // 47: main.ExecsService.DeactivateUser:output_type -> main.Confirmation
+	14, // 48: main.ExecsService.GetCacheStats:output_type -> main.CacheStats
+	35, // [35:49] is the sub-list for method output_type
+	21, // [21:35] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_execs_proto_init() }
@@ -1037,7 +1775,7 @@ func file_execs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_execs_proto_rawDesc), len(file_execs_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
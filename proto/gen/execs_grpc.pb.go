@@ -23,12 +23,16 @@ const (
 	ExecsService_AddExecs_FullMethodName       = "/main.ExecsService/AddExecs"
 	ExecsService_UpdateExecs_FullMethodName    = "/main.ExecsService/UpdateExecs"
 	ExecsService_DeleteExecs_FullMethodName    = "/main.ExecsService/DeleteExecs"
+	ExecsService_RestoreExecs_FullMethodName   = "/main.ExecsService/RestoreExecs"
+	ExecsService_WatchExecs_FullMethodName     = "/main.ExecsService/WatchExecs"
+	ExecsService_ExportExecs_FullMethodName    = "/main.ExecsService/ExportExecs"
 	ExecsService_Login_FullMethodName          = "/main.ExecsService/Login"
 	ExecsService_Logout_FullMethodName         = "/main.ExecsService/Logout"
 	ExecsService_UpdatePassword_FullMethodName = "/main.ExecsService/UpdatePassword"
 	ExecsService_ResetPassword_FullMethodName  = "/main.ExecsService/ResetPassword"
 	ExecsService_ForgotPassword_FullMethodName = "/main.ExecsService/ForgotPassword"
 	ExecsService_DeactivateUser_FullMethodName = "/main.ExecsService/DeactivateUser"
+	ExecsService_GetCacheStats_FullMethodName  = "/main.ExecsService/GetCacheStats"
 )
 
 // ExecsServiceClient is the client API for ExecsService service.
@@ -40,11 +44,17 @@ type ExecsServiceClient interface {
 	// GetTeachers retrieves a list of execs based on the provided criteria
 	GetExecs(ctx context.Context, in *GetExecsRequest, opts ...grpc.CallOption) (*Execs, error)
 	// AddTeachers adds new execs to the system
-	AddExecs(ctx context.Context, in *Execs, opts ...grpc.CallOption) (*Execs, error)
+	AddExecs(ctx context.Context, in *AddExecsRequest, opts ...grpc.CallOption) (*AddExecsResponse, error)
 	// UpdateTeachers updates existing exec information
-	UpdateExecs(ctx context.Context, in *Execs, opts ...grpc.CallOption) (*Execs, error)
+	UpdateExecs(ctx context.Context, in *UpdateExecsRequest, opts ...grpc.CallOption) (*Execs, error)
 	// DeleteTeachers removes execs from the system by their IDs
-	DeleteExecs(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*DeleteExecsConfirmation, error)
+	DeleteExecs(ctx context.Context, in *DeleteExecsRequest, opts ...grpc.CallOption) (*DeleteExecsConfirmation, error)
+	// RestoreExecs brings back soft deleted execs by their IDs
+	RestoreExecs(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*RestoreExecsConfirmation, error)
+	// WatchExecs streams every change to the execs matching the filter as it happens
+	WatchExecs(ctx context.Context, in *WatchExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecEvent], error)
+	// ExportExecs streams the execs matching the criteria as a CSV or NDJSON file in chunks, credentials are never exported
+	ExportExecs(ctx context.Context, in *ExportExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 	// Login allows execs to login
 	Login(ctx context.Context, in *ExecLoginRequest, opts ...grpc.CallOption) (*ExecLoginResponse, error)
 	// Logout allows execs to logout
@@ -57,6 +67,8 @@ type ExecsServiceClient interface {
 	ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error)
 	// Allows execs to deactivate users
 	DeactivateUser(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*Confirmation, error)
+	// GetCacheStats reports how well the read cache in front of the repositories is doing
+	GetCacheStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CacheStats, error)
 }
 
 type execsServiceClient struct {
@@ -77,9 +89,9 @@ func (c *execsServiceClient) GetExecs(ctx context.Context, in *GetExecsRequest,
 	return out, nil
 }
 
-func (c *execsServiceClient) AddExecs(ctx context.Context, in *Execs, opts ...grpc.CallOption) (*Execs, error) {
+func (c *execsServiceClient) AddExecs(ctx context.Context, in *AddExecsRequest, opts ...grpc.CallOption) (*AddExecsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Execs)
+	out := new(AddExecsResponse)
 	err := c.cc.Invoke(ctx, ExecsService_AddExecs_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -87,7 +99,7 @@ func (c *execsServiceClient) AddExecs(ctx context.Context, in *Execs, opts ...gr
 	return out, nil
 }
 
-func (c *execsServiceClient) UpdateExecs(ctx context.Context, in *Execs, opts ...grpc.CallOption) (*Execs, error) {
+func (c *execsServiceClient) UpdateExecs(ctx context.Context, in *UpdateExecsRequest, opts ...grpc.CallOption) (*Execs, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Execs)
 	err := c.cc.Invoke(ctx, ExecsService_UpdateExecs_FullMethodName, in, out, cOpts...)
@@ -97,7 +109,7 @@ func (c *execsServiceClient) UpdateExecs(ctx context.Context, in *Execs, opts ..
 	return out, nil
 }
 
-func (c *execsServiceClient) DeleteExecs(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*DeleteExecsConfirmation, error) {
+func (c *execsServiceClient) DeleteExecs(ctx context.Context, in *DeleteExecsRequest, opts ...grpc.CallOption) (*DeleteExecsConfirmation, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteExecsConfirmation)
 	err := c.cc.Invoke(ctx, ExecsService_DeleteExecs_FullMethodName, in, out, cOpts...)
@@ -107,6 +119,54 @@ func (c *execsServiceClient) DeleteExecs(ctx context.Context, in *ExecIds, opts
 	return out, nil
 }
 
+func (c *execsServiceClient) RestoreExecs(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*RestoreExecsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreExecsConfirmation)
+	err := c.cc.Invoke(ctx, ExecsService_RestoreExecs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) WatchExecs(ctx context.Context, in *WatchExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExecEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExecsService_ServiceDesc.Streams[0], ExecsService_WatchExecs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchExecsRequest, ExecEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_WatchExecsClient = grpc.ServerStreamingClient[ExecEvent]
+
+func (c *execsServiceClient) ExportExecs(ctx context.Context, in *ExportExecsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExecsService_ServiceDesc.Streams[1], ExecsService_ExportExecs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportExecsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_ExportExecsClient = grpc.ServerStreamingClient[ExportChunk]
+
 func (c *execsServiceClient) Login(ctx context.Context, in *ExecLoginRequest, opts ...grpc.CallOption) (*ExecLoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecLoginResponse)
@@ -167,6 +227,16 @@ func (c *execsServiceClient) DeactivateUser(ctx context.Context, in *ExecIds, op
 	return out, nil
 }
 
+func (c *execsServiceClient) GetCacheStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CacheStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, ExecsService_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecsServiceServer is the server API for ExecsService service.
 // All implementations must embed UnimplementedExecsServiceServer
 // for forward compatibility.
@@ -176,11 +246,17 @@ type ExecsServiceServer interface {
 	// GetTeachers retrieves a list of execs based on the provided criteria
 	GetExecs(context.Context, *GetExecsRequest) (*Execs, error)
 	// AddTeachers adds new execs to the system
-	AddExecs(context.Context, *Execs) (*Execs, error)
+	AddExecs(context.Context, *AddExecsRequest) (*AddExecsResponse, error)
 	// UpdateTeachers updates existing exec information
-	UpdateExecs(context.Context, *Execs) (*Execs, error)
+	UpdateExecs(context.Context, *UpdateExecsRequest) (*Execs, error)
 	// DeleteTeachers removes execs from the system by their IDs
-	DeleteExecs(context.Context, *ExecIds) (*DeleteExecsConfirmation, error)
+	DeleteExecs(context.Context, *DeleteExecsRequest) (*DeleteExecsConfirmation, error)
+	// RestoreExecs brings back soft deleted execs by their IDs
+	RestoreExecs(context.Context, *ExecIds) (*RestoreExecsConfirmation, error)
+	// WatchExecs streams every change to the execs matching the filter as it happens
+	WatchExecs(*WatchExecsRequest, grpc.ServerStreamingServer[ExecEvent]) error
+	// ExportExecs streams the execs matching the criteria as a CSV or NDJSON file in chunks, credentials are never exported
+	ExportExecs(*ExportExecsRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	// Login allows execs to login
 	Login(context.Context, *ExecLoginRequest) (*ExecLoginResponse, error)
 	// Logout allows execs to logout
@@ -193,6 +269,8 @@ type ExecsServiceServer interface {
 	ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error)
 	// Allows execs to deactivate users
 	DeactivateUser(context.Context, *ExecIds) (*Confirmation, error)
+	// GetCacheStats reports how well the read cache in front of the repositories is doing
+	GetCacheStats(context.Context, *EmptyRequest) (*CacheStats, error)
 	mustEmbedUnimplementedExecsServiceServer()
 }
 
@@ -206,15 +284,24 @@ type UnimplementedExecsServiceServer struct{}
 func (UnimplementedExecsServiceServer) GetExecs(context.Context, *GetExecsRequest) (*Execs, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetExecs not implemented")
 }
-func (UnimplementedExecsServiceServer) AddExecs(context.Context, *Execs) (*Execs, error) {
+func (UnimplementedExecsServiceServer) AddExecs(context.Context, *AddExecsRequest) (*AddExecsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddExecs not implemented")
 }
-func (UnimplementedExecsServiceServer) UpdateExecs(context.Context, *Execs) (*Execs, error) {
+func (UnimplementedExecsServiceServer) UpdateExecs(context.Context, *UpdateExecsRequest) (*Execs, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateExecs not implemented")
 }
-func (UnimplementedExecsServiceServer) DeleteExecs(context.Context, *ExecIds) (*DeleteExecsConfirmation, error) {
+func (UnimplementedExecsServiceServer) DeleteExecs(context.Context, *DeleteExecsRequest) (*DeleteExecsConfirmation, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteExecs not implemented")
 }
+func (UnimplementedExecsServiceServer) RestoreExecs(context.Context, *ExecIds) (*RestoreExecsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreExecs not implemented")
+}
+func (UnimplementedExecsServiceServer) WatchExecs(*WatchExecsRequest, grpc.ServerStreamingServer[ExecEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchExecs not implemented")
+}
+func (UnimplementedExecsServiceServer) ExportExecs(*ExportExecsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportExecs not implemented")
+}
 func (UnimplementedExecsServiceServer) Login(context.Context, *ExecLoginRequest) (*ExecLoginResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
 }
@@ -233,6 +320,9 @@ func (UnimplementedExecsServiceServer) ForgotPassword(context.Context, *ForgotPa
 func (UnimplementedExecsServiceServer) DeactivateUser(context.Context, *ExecIds) (*Confirmation, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeactivateUser not implemented")
 }
+func (UnimplementedExecsServiceServer) GetCacheStats(context.Context, *EmptyRequest) (*CacheStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCacheStats not implemented")
+}
 func (UnimplementedExecsServiceServer) mustEmbedUnimplementedExecsServiceServer() {}
 func (UnimplementedExecsServiceServer) testEmbeddedByValue()                      {}
 
@@ -273,7 +363,7 @@ func _ExecsService_GetExecs_Handler(srv interface{}, ctx context.Context, dec fu
 }
 
 func _ExecsService_AddExecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Execs)
+	in := new(AddExecsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -285,13 +375,13 @@ func _ExecsService_AddExecs_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: ExecsService_AddExecs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExecsServiceServer).AddExecs(ctx, req.(*Execs))
+		return srv.(ExecsServiceServer).AddExecs(ctx, req.(*AddExecsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _ExecsService_UpdateExecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Execs)
+	in := new(UpdateExecsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -303,13 +393,13 @@ func _ExecsService_UpdateExecs_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: ExecsService_UpdateExecs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExecsServiceServer).UpdateExecs(ctx, req.(*Execs))
+		return srv.(ExecsServiceServer).UpdateExecs(ctx, req.(*UpdateExecsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _ExecsService_DeleteExecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecIds)
+	in := new(DeleteExecsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -321,11 +411,51 @@ func _ExecsService_DeleteExecs_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: ExecsService_DeleteExecs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExecsServiceServer).DeleteExecs(ctx, req.(*ExecIds))
+		return srv.(ExecsServiceServer).DeleteExecs(ctx, req.(*DeleteExecsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecsService_RestoreExecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).RestoreExecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_RestoreExecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).RestoreExecs(ctx, req.(*ExecIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_WatchExecs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchExecsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecsServiceServer).WatchExecs(m, &grpc.GenericServerStream[WatchExecsRequest, ExecEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_WatchExecsServer = grpc.ServerStreamingServer[ExecEvent]
+
+func _ExecsService_ExportExecs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportExecsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecsServiceServer).ExportExecs(m, &grpc.GenericServerStream[ExportExecsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExecsService_ExportExecsServer = grpc.ServerStreamingServer[ExportChunk]
+
 func _ExecsService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecLoginRequest)
 	if err := dec(in); err != nil {
@@ -434,6 +564,24 @@ func _ExecsService_DeactivateUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecsService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).GetCacheStats(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExecsService_ServiceDesc is the grpc.ServiceDesc for ExecsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +605,10 @@ var ExecsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExecs",
 			Handler:    _ExecsService_DeleteExecs_Handler,
 		},
+		{
+			MethodName: "RestoreExecs",
+			Handler:    _ExecsService_RestoreExecs_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _ExecsService_Login_Handler,
@@ -481,7 +633,22 @@ var ExecsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateUser",
 			Handler:    _ExecsService_DeactivateUser_Handler,
 		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _ExecsService_GetCacheStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchExecs",
+			Handler:       _ExecsService_WatchExecs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportExecs",
+			Handler:       _ExecsService_ExportExecs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "execs.proto",
 }
@@ -0,0 +1,2252 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AssessmentType int32
+
+const (
+	AssessmentType_TEST          AssessmentType = 0
+	AssessmentType_QUIZ          AssessmentType = 1
+	AssessmentType_HOMEWORK      AssessmentType = 2
+	AssessmentType_PROJECT       AssessmentType = 3
+	AssessmentType_EXAM          AssessmentType = 4
+	AssessmentType_PARTICIPATION AssessmentType = 5
+)
+
+// Enum value maps for AssessmentType.
+var (
+	AssessmentType_name = map[int32]string{
+		0: "TEST",
+		1: "QUIZ",
+		2: "HOMEWORK",
+		3: "PROJECT",
+		4: "EXAM",
+		5: "PARTICIPATION",
+	}
+	AssessmentType_value = map[string]int32{
+		"TEST":          0,
+		"QUIZ":          1,
+		"HOMEWORK":      2,
+		"PROJECT":       3,
+		"EXAM":          4,
+		"PARTICIPATION": 5,
+	}
+)
+
+func (x AssessmentType) Enum() *AssessmentType {
+	p := new(AssessmentType)
+	*p = x
+	return p
+}
+
+func (x AssessmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssessmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_grades_proto_enumTypes[0].Descriptor()
+}
+
+func (AssessmentType) Type() protoreflect.EnumType {
+	return &file_grades_proto_enumTypes[0]
+}
+
+func (x AssessmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssessmentType.Descriptor instead.
+func (AssessmentType) EnumDescriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{0}
+}
+
+// Assessment is graded work of a gradebook, which is either a subject of a class or a course, in a term
+type Assessment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// class_id and subject name the gradebook of a class, course_id that of a course, exactly one of class_id and course_id is set
+	ClassId  string         `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Subject  string         `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	CourseId string         `protobuf:"bytes,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term     string         `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	Title    string         `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Type     AssessmentType `protobuf:"varint,7,opt,name=type,proto3,enum=main.AssessmentType" json:"type,omitempty"`
+	MaxScore float64        `protobuf:"fixed64,8,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// category such as homework or exams, the weights of a gradebook are set per category
+	Category string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	DueAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted assessments, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,13,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,17,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assessment) Reset() {
+	*x = Assessment{}
+	mi := &file_grades_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assessment) ProtoMessage() {}
+
+func (x *Assessment) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assessment.ProtoReflect.Descriptor instead.
+func (*Assessment) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Assessment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assessment) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Assessment) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Assessment) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Assessment) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Assessment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Assessment) GetType() AssessmentType {
+	if x != nil {
+		return x.Type
+	}
+	return AssessmentType_TEST
+}
+
+func (x *Assessment) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Assessment) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Assessment) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Assessment) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Assessment) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Assessment) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Assessment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Assessment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Assessment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Assessment) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Assessments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assessments   []*Assessment          `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assessments) Reset() {
+	*x = Assessments{}
+	mi := &file_grades_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assessments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assessments) ProtoMessage() {}
+
+func (x *Assessments) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assessments.ProtoReflect.Descriptor instead.
+func (*Assessments) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Assessments) GetAssessments() []*Assessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+type AddAssessmentsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Assessments []*Assessment          `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	// ordered stops inserting at the first failing assessment, otherwise every valid assessment is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all assessments in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAssessmentsRequest) Reset() {
+	*x = AddAssessmentsRequest{}
+	mi := &file_grades_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAssessmentsRequest) ProtoMessage() {}
+
+func (x *AddAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*AddAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddAssessmentsRequest) GetAssessments() []*Assessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+func (x *AddAssessmentsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddAssessmentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddAssessmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assessments   []*Assessment          `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAssessmentsResponse) Reset() {
+	*x = AddAssessmentsResponse{}
+	mi := &file_grades_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAssessmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAssessmentsResponse) ProtoMessage() {}
+
+func (x *AddAssessmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAssessmentsResponse.ProtoReflect.Descriptor instead.
+func (*AddAssessmentsResponse) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddAssessmentsResponse) GetAssessments() []*Assessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+func (x *AddAssessmentsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetAssessmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only assessments matching the non-empty fields of the filter are returned
+	Assessment *Assessment  `protobuf:"bytes,1,opt,name=assessment,proto3" json:"assessment,omitempty"`
+	SortBy     []*SortField `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted assessments
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAssessmentsRequest) Reset() {
+	*x = GetAssessmentsRequest{}
+	mi := &file_grades_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentsRequest) ProtoMessage() {}
+
+func (x *GetAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAssessmentsRequest) GetAssessment() *Assessment {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+func (x *GetAssessmentsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetAssessmentsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateAssessmentsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Assessments []*Assessment          `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every assessment, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssessmentsRequest) Reset() {
+	*x = UpdateAssessmentsRequest{}
+	mi := &file_grades_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssessmentsRequest) ProtoMessage() {}
+
+func (x *UpdateAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAssessmentsRequest) GetAssessments() []*Assessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+func (x *UpdateAssessmentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateAssessmentsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type AssessmentId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the assessment, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessmentId) Reset() {
+	*x = AssessmentId{}
+	mi := &file_grades_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessmentId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentId) ProtoMessage() {}
+
+func (x *AssessmentId) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentId.ProtoReflect.Descriptor instead.
+func (*AssessmentId) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssessmentId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssessmentId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteAssessmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*AssessmentId        `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all assessments in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssessmentsRequest) Reset() {
+	*x = DeleteAssessmentsRequest{}
+	mi := &file_grades_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssessmentsRequest) ProtoMessage() {}
+
+func (x *DeleteAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAssessmentsRequest) GetIds() []*AssessmentId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteAssessmentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteAssessmentsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssessmentsConfirmation) Reset() {
+	*x = DeleteAssessmentsConfirmation{}
+	mi := &file_grades_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssessmentsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssessmentsConfirmation) ProtoMessage() {}
+
+func (x *DeleteAssessmentsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssessmentsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteAssessmentsConfirmation) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAssessmentsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAssessmentsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+// Score is the result of a student for an assessment, a student has a single score per assessment
+type Score struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssessmentId string                 `protobuf:"bytes,2,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	StudentId    string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Score        float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	Comment      string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	// changes lists every change of the score, oldest first
+	Changes []*ScoreChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	// version is increased on every change, send it back when changing the score to detect concurrent edits
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_grades_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Score) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Score) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *Score) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Score) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Score) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Score) GetChanges() []*ScoreChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *Score) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Score) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Score) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Score) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Score) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type ScoreChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviousScore float64                `protobuf:"fixed64,1,opt,name=previous_score,json=previousScore,proto3" json:"previous_score,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreChange) Reset() {
+	*x = ScoreChange{}
+	mi := &file_grades_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreChange) ProtoMessage() {}
+
+func (x *ScoreChange) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreChange.ProtoReflect.Descriptor instead.
+func (*ScoreChange) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScoreChange) GetPreviousScore() float64 {
+	if x != nil {
+		return x.PreviousScore
+	}
+	return 0
+}
+
+func (x *ScoreChange) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScoreChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *ScoreChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+type Scores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []*Score               `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scores) Reset() {
+	*x = Scores{}
+	mi := &file_grades_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scores) ProtoMessage() {}
+
+func (x *Scores) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scores.ProtoReflect.Descriptor instead.
+func (*Scores) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Scores) GetScores() []*Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+// ScoreEntry records the score of one student in a RecordScoresRequest
+type ScoreEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Score     float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Comment   string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// reason is required when changing a recorded score
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expected version of the recorded score, a change fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreEntry) Reset() {
+	*x = ScoreEntry{}
+	mi := &file_grades_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreEntry) ProtoMessage() {}
+
+func (x *ScoreEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreEntry.ProtoReflect.Descriptor instead.
+func (*ScoreEntry) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScoreEntry) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ScoreEntry) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreEntry) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ScoreEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScoreEntry) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RecordScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssessmentId  string                 `protobuf:"bytes,1,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	Entries       []*ScoreEntry          `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordScoresRequest) Reset() {
+	*x = RecordScoresRequest{}
+	mi := &file_grades_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordScoresRequest) ProtoMessage() {}
+
+func (x *RecordScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordScoresRequest.ProtoReflect.Descriptor instead.
+func (*RecordScoresRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RecordScoresRequest) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *RecordScoresRequest) GetEntries() []*ScoreEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RecordScoresResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Scores []*Score               `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	// results reports the outcome for every entry by its index
+	Results       []*BulkItemResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordScoresResponse) Reset() {
+	*x = RecordScoresResponse{}
+	mi := &file_grades_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordScoresResponse) ProtoMessage() {}
+
+func (x *RecordScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordScoresResponse.ProtoReflect.Descriptor instead.
+func (*RecordScoresResponse) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RecordScoresResponse) GetScores() []*Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *RecordScoresResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetScoresRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// at least one of assessment_id and student_id is required
+	AssessmentId  string `protobuf:"bytes,1,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	StudentId     string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoresRequest) Reset() {
+	*x = GetScoresRequest{}
+	mi := &file_grades_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoresRequest) ProtoMessage() {}
+
+func (x *GetScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoresRequest.ProtoReflect.Descriptor instead.
+func (*GetScoresRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetScoresRequest) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *GetScoresRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// CategoryWeights sets how much every category counts towards the average of a gradebook in a term
+// Categories without a weight do not count, without any weights every category counts the same
+type CategoryWeights struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ClassId  string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Subject  string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	CourseId string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term     string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	Weights  map[string]float64     `protobuf:"bytes,5,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// updated_at and updated_by are maintained by the server
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryWeights) Reset() {
+	*x = CategoryWeights{}
+	mi := &file_grades_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryWeights) ProtoMessage() {}
+
+func (x *CategoryWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryWeights.ProtoReflect.Descriptor instead.
+func (*CategoryWeights) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CategoryWeights) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *CategoryWeights) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CategoryWeights) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CategoryWeights) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *CategoryWeights) GetWeights() map[string]float64 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *CategoryWeights) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CategoryWeights) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type GetCategoryWeightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Term          string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryWeightsRequest) Reset() {
+	*x = GetCategoryWeightsRequest{}
+	mi := &file_grades_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryWeightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryWeightsRequest) ProtoMessage() {}
+
+func (x *GetCategoryWeightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryWeightsRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryWeightsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCategoryWeightsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *GetCategoryWeightsRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *GetCategoryWeightsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetCategoryWeightsRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+// GradeBand gives the letter and grade points of the averages from min_percentage up to the next band
+type GradeBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Letter        string                 `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	MinPercentage float64                `protobuf:"fixed64,2,opt,name=min_percentage,json=minPercentage,proto3" json:"min_percentage,omitempty"`
+	GradePoints   float64                `protobuf:"fixed64,3,opt,name=grade_points,json=gradePoints,proto3" json:"grade_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeBand) Reset() {
+	*x = GradeBand{}
+	mi := &file_grades_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeBand) ProtoMessage() {}
+
+func (x *GradeBand) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeBand.ProtoReflect.Descriptor instead.
+func (*GradeBand) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GradeBand) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *GradeBand) GetMinPercentage() float64 {
+	if x != nil {
+		return x.MinPercentage
+	}
+	return 0
+}
+
+func (x *GradeBand) GetGradePoints() float64 {
+	if x != nil {
+		return x.GradePoints
+	}
+	return 0
+}
+
+// GradingScale turns averages into letter grades, its bands are kept from the highest to the lowest
+// A scale has a band starting at 0 so every average gets a letter
+type GradingScale struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Bands []*GradeBand           `protobuf:"bytes,1,rep,name=bands,proto3" json:"bands,omitempty"`
+	// updated_at and updated_by are maintained by the server, they are empty while the default scale is in use
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradingScale) Reset() {
+	*x = GradingScale{}
+	mi := &file_grades_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradingScale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingScale) ProtoMessage() {}
+
+func (x *GradingScale) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingScale.ProtoReflect.Descriptor instead.
+func (*GradingScale) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GradingScale) GetBands() []*GradeBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *GradingScale) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GradingScale) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type StudentGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGradesRequest) Reset() {
+	*x = StudentGradesRequest{}
+	mi := &file_grades_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGradesRequest) ProtoMessage() {}
+
+func (x *StudentGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGradesRequest.ProtoReflect.Descriptor instead.
+func (*StudentGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StudentGradesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentGradesRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type CategoryAverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAverage) Reset() {
+	*x = CategoryAverage{}
+	mi := &file_grades_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAverage) ProtoMessage() {}
+
+func (x *CategoryAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAverage.ProtoReflect.Descriptor instead.
+func (*CategoryAverage) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CategoryAverage) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryAverage) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *CategoryAverage) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// SubjectGrade is the grade of a student in one gradebook, only gradebooks with scores are reported
+type SubjectGrade struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ClassId  string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Subject  string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	CourseId string                 `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	// average is the weighted average of the category percentages
+	Average     float64 `protobuf:"fixed64,4,opt,name=average,proto3" json:"average,omitempty"`
+	Letter      string  `protobuf:"bytes,5,opt,name=letter,proto3" json:"letter,omitempty"`
+	GradePoints float64 `protobuf:"fixed64,6,opt,name=grade_points,json=gradePoints,proto3" json:"grade_points,omitempty"`
+	// credits of the course, a class subject counts as 1
+	Credits       int32              `protobuf:"varint,7,opt,name=credits,proto3" json:"credits,omitempty"`
+	Categories    []*CategoryAverage `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubjectGrade) Reset() {
+	*x = SubjectGrade{}
+	mi := &file_grades_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubjectGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectGrade) ProtoMessage() {}
+
+func (x *SubjectGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectGrade.ProtoReflect.Descriptor instead.
+func (*SubjectGrade) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SubjectGrade) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *SubjectGrade) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SubjectGrade) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *SubjectGrade) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *SubjectGrade) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *SubjectGrade) GetGradePoints() float64 {
+	if x != nil {
+		return x.GradePoints
+	}
+	return 0
+}
+
+func (x *SubjectGrade) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *SubjectGrade) GetCategories() []*CategoryAverage {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type StudentGrades struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Term      string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Subjects  []*SubjectGrade        `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// gpa is the average of the grade points weighted by credits
+	Gpa float64 `protobuf:"fixed64,4,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	// finalized tells whether the term is locked
+	Finalized     bool `protobuf:"varint,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGrades) Reset() {
+	*x = StudentGrades{}
+	mi := &file_grades_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGrades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGrades) ProtoMessage() {}
+
+func (x *StudentGrades) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGrades.ProtoReflect.Descriptor instead.
+func (*StudentGrades) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StudentGrades) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentGrades) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *StudentGrades) GetSubjects() []*SubjectGrade {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *StudentGrades) GetGpa() float64 {
+	if x != nil {
+		return x.Gpa
+	}
+	return 0
+}
+
+func (x *StudentGrades) GetFinalized() bool {
+	if x != nil {
+		return x.Finalized
+	}
+	return false
+}
+
+type TermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermRequest) Reset() {
+	*x = TermRequest{}
+	mi := &file_grades_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermRequest) ProtoMessage() {}
+
+func (x *TermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermRequest.ProtoReflect.Descriptor instead.
+func (*TermRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TermRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type TermLock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	FinalizedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finalized_at,json=finalizedAt,proto3" json:"finalized_at,omitempty"`
+	FinalizedBy   string                 `protobuf:"bytes,3,opt,name=finalized_by,json=finalizedBy,proto3" json:"finalized_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermLock) Reset() {
+	*x = TermLock{}
+	mi := &file_grades_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermLock) ProtoMessage() {}
+
+func (x *TermLock) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermLock.ProtoReflect.Descriptor instead.
+func (*TermLock) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TermLock) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *TermLock) GetFinalizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizedAt
+	}
+	return nil
+}
+
+func (x *TermLock) GetFinalizedBy() string {
+	if x != nil {
+		return x.FinalizedBy
+	}
+	return ""
+}
+
+type TermLocks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locks         []*TermLock            `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TermLocks) Reset() {
+	*x = TermLocks{}
+	mi := &file_grades_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TermLocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermLocks) ProtoMessage() {}
+
+func (x *TermLocks) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermLocks.ProtoReflect.Descriptor instead.
+func (*TermLocks) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TermLocks) GetLocks() []*TermLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+var File_grades_proto protoreflect.FileDescriptor
+
+const file_grades_proto_rawDesc = "" +
+	"\n" +
+	"\fgrades.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0estudents.proto\x1a\vexecs.proto\"\xd6\x04\n" +
+	"\n" +
+	"Assessment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x05 \x01(\tR\x04term\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12(\n" +
+	"\x04type\x18\a \x01(\x0e2\x14.main.AssessmentTypeR\x04type\x12\x1b\n" +
+	"\tmax_score\x18\b \x01(\x01R\bmaxScore\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x121\n" +
+	"\x06due_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\r \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x10 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x11 \x01(\tR\tupdatedBy\"A\n" +
+	"\vAssessments\x122\n" +
+	"\vassessments\x18\x01 \x03(\v2\x10.main.AssessmentR\vassessments\"}\n" +
+	"\x15AddAssessmentsRequest\x122\n" +
+	"\vassessments\x18\x01 \x03(\v2\x10.main.AssessmentR\vassessments\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"|\n" +
+	"\x16AddAssessmentsResponse\x122\n" +
+	"\vassessments\x18\x01 \x03(\v2\x10.main.AssessmentR\vassessments\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x9c\x01\n" +
+	"\x15GetAssessmentsRequest\x120\n" +
+	"\n" +
+	"assessment\x18\x01 \x01(\v2\x10.main.AssessmentR\n" +
+	"assessment\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\xa3\x01\n" +
+	"\x18UpdateAssessmentsRequest\x122\n" +
+	"\vassessments\x18\x01 \x03(\v2\x10.main.AssessmentR\vassessments\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"8\n" +
+	"\fAssessmentId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"X\n" +
+	"\x18DeleteAssessmentsRequest\x12$\n" +
+	"\x03ids\x18\x01 \x03(\v2\x12.main.AssessmentIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"X\n" +
+	"\x1dDeleteAssessmentsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\x86\x03\n" +
+	"\x05Score\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rassessment_id\x18\x02 \x01(\tR\fassessmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12+\n" +
+	"\achanges\x18\x06 \x03(\v2\x11.main.ScoreChangeR\achanges\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\"\xbc\x01\n" +
+	"\vScoreChange\x12%\n" +
+	"\x0eprevious_score\x18\x01 \x01(\x01R\rpreviousScore\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tR\tchangedBy\"-\n" +
+	"\x06Scores\x12#\n" +
+	"\x06scores\x18\x01 \x03(\v2\v.main.ScoreR\x06scores\"\x8d\x01\n" +
+	"\n" +
+	"ScoreEntry\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"f\n" +
+	"\x13RecordScoresRequest\x12#\n" +
+	"\rassessment_id\x18\x01 \x01(\tR\fassessmentId\x12*\n" +
+	"\aentries\x18\x02 \x03(\v2\x10.main.ScoreEntryR\aentries\"k\n" +
+	"\x14RecordScoresResponse\x12#\n" +
+	"\x06scores\x18\x01 \x03(\v2\v.main.ScoreR\x06scores\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"V\n" +
+	"\x10GetScoresRequest\x12#\n" +
+	"\rassessment_id\x18\x01 \x01(\tR\fassessmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\"\xcb\x02\n" +
+	"\x0fCategoryWeights\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\x12<\n" +
+	"\aweights\x18\x05 \x03(\v2\".main.CategoryWeights.WeightsEntryR\aweights\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x81\x01\n" +
+	"\x19GetCategoryWeightsRequest\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\"m\n" +
+	"\tGradeBand\x12\x16\n" +
+	"\x06letter\x18\x01 \x01(\tR\x06letter\x12%\n" +
+	"\x0emin_percentage\x18\x02 \x01(\x01R\rminPercentage\x12!\n" +
+	"\fgrade_points\x18\x03 \x01(\x01R\vgradePoints\"\x8f\x01\n" +
+	"\fGradingScale\x12%\n" +
+	"\x05bands\x18\x01 \x03(\v2\x0f.main.GradeBandR\x05bands\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"I\n" +
+	"\x14StudentGradesRequest\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\"e\n" +
+	"\x0fCategoryAverage\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x02 \x01(\x01R\n" +
+	"percentage\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\"\x86\x02\n" +
+	"\fSubjectGrade\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\tR\bcourseId\x12\x18\n" +
+	"\aaverage\x18\x04 \x01(\x01R\aaverage\x12\x16\n" +
+	"\x06letter\x18\x05 \x01(\tR\x06letter\x12!\n" +
+	"\fgrade_points\x18\x06 \x01(\x01R\vgradePoints\x12\x18\n" +
+	"\acredits\x18\a \x01(\x05R\acredits\x125\n" +
+	"\n" +
+	"categories\x18\b \x03(\v2\x15.main.CategoryAverageR\n" +
+	"categories\"\xa2\x01\n" +
+	"\rStudentGrades\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\x12.\n" +
+	"\bsubjects\x18\x03 \x03(\v2\x12.main.SubjectGradeR\bsubjects\x12\x10\n" +
+	"\x03gpa\x18\x04 \x01(\x01R\x03gpa\x12\x1c\n" +
+	"\tfinalized\x18\x05 \x01(\bR\tfinalized\"!\n" +
+	"\vTermRequest\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\"\x80\x01\n" +
+	"\bTermLock\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12=\n" +
+	"\ffinalized_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vfinalizedAt\x12!\n" +
+	"\ffinalized_by\x18\x03 \x01(\tR\vfinalizedBy\"1\n" +
+	"\tTermLocks\x12$\n" +
+	"\x05locks\x18\x01 \x03(\v2\x0e.main.TermLockR\x05locks*\\\n" +
+	"\x0eAssessmentType\x12\b\n" +
+	"\x04TEST\x10\x00\x12\b\n" +
+	"\x04QUIZ\x10\x01\x12\f\n" +
+	"\bHOMEWORK\x10\x02\x12\v\n" +
+	"\aPROJECT\x10\x03\x12\b\n" +
+	"\x04EXAM\x10\x04\x12\x11\n" +
+	"\rPARTICIPATION\x10\x052\xa0\a\n" +
+	"\rGradesService\x12K\n" +
+	"\x0eAddAssessments\x12\x1b.main.AddAssessmentsRequest\x1a\x1c.main.AddAssessmentsResponse\x12@\n" +
+	"\x0eGetAssessments\x12\x1b.main.GetAssessmentsRequest\x1a\x11.main.Assessments\x12F\n" +
+	"\x11UpdateAssessments\x12\x1e.main.UpdateAssessmentsRequest\x1a\x11.main.Assessments\x12X\n" +
+	"\x11DeleteAssessments\x12\x1e.main.DeleteAssessmentsRequest\x1a#.main.DeleteAssessmentsConfirmation\x12E\n" +
+	"\fRecordScores\x12\x19.main.RecordScoresRequest\x1a\x1a.main.RecordScoresResponse\x121\n" +
+	"\tGetScores\x12\x16.main.GetScoresRequest\x1a\f.main.Scores\x12B\n" +
+	"\x12SetCategoryWeights\x12\x15.main.CategoryWeights\x1a\x15.main.CategoryWeights\x12L\n" +
+	"\x12GetCategoryWeights\x12\x1f.main.GetCategoryWeightsRequest\x1a\x15.main.CategoryWeights\x129\n" +
+	"\x0fSetGradingScale\x12\x12.main.GradingScale\x1a\x12.main.GradingScale\x129\n" +
+	"\x0fGetGradingScale\x12\x12.main.EmptyRequest\x1a\x12.main.GradingScale\x12C\n" +
+	"\x10GetStudentGrades\x12\x1a.main.StudentGradesRequest\x1a\x13.main.StudentGrades\x121\n" +
+	"\fFinalizeTerm\x12\x11.main.TermRequest\x1a\x0e.main.TermLock\x12/\n" +
+	"\n" +
+	"ReopenTerm\x12\x11.main.TermRequest\x1a\x0e.main.TermLock\x123\n" +
+	"\fGetTermLocks\x12\x12.main.EmptyRequest\x1a\x0f.main.TermLocksB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_grades_proto_rawDescOnce sync.Once
+	file_grades_proto_rawDescData []byte
+)
+
+func file_grades_proto_rawDescGZIP() []byte {
+	file_grades_proto_rawDescOnce.Do(func() {
+		file_grades_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_grades_proto_rawDesc), len(file_grades_proto_rawDesc)))
+	})
+	return file_grades_proto_rawDescData
+}
+
+var file_grades_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_grades_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_grades_proto_goTypes = []any{
+	(AssessmentType)(0),                   // 0: main.AssessmentType
+	(*Assessment)(nil),                    // 1: main.Assessment
+	(*Assessments)(nil),                   // 2: main.Assessments
+	(*AddAssessmentsRequest)(nil),         // 3: main.AddAssessmentsRequest
+	(*AddAssessmentsResponse)(nil),        // 4: main.AddAssessmentsResponse
+	(*GetAssessmentsRequest)(nil),         // 5: main.GetAssessmentsRequest
+	(*UpdateAssessmentsRequest)(nil),      // 6: main.UpdateAssessmentsRequest
+	(*AssessmentId)(nil),                  // 7: main.AssessmentId
+	(*DeleteAssessmentsRequest)(nil),      // 8: main.DeleteAssessmentsRequest
+	(*DeleteAssessmentsConfirmation)(nil), // 9: main.DeleteAssessmentsConfirmation
+	(*Score)(nil),                         // 10: main.Score
+	(*ScoreChange)(nil),                   // 11: main.ScoreChange
+	(*Scores)(nil),                        // 12: main.Scores
+	(*ScoreEntry)(nil),                    // 13: main.ScoreEntry
+	(*RecordScoresRequest)(nil),           // 14: main.RecordScoresRequest
+	(*RecordScoresResponse)(nil),          // 15: main.RecordScoresResponse
+	(*GetScoresRequest)(nil),              // 16: main.GetScoresRequest
+	(*CategoryWeights)(nil),               // 17: main.CategoryWeights
+	(*GetCategoryWeightsRequest)(nil),     // 18: main.GetCategoryWeightsRequest
+	(*GradeBand)(nil),                     // 19: main.GradeBand
+	(*GradingScale)(nil),                  // 20: main.GradingScale
+	(*StudentGradesRequest)(nil),          // 21: main.StudentGradesRequest
+	(*CategoryAverage)(nil),               // 22: main.CategoryAverage
+	(*SubjectGrade)(nil),                  // 23: main.SubjectGrade
+	(*StudentGrades)(nil),                 // 24: main.StudentGrades
+	(*TermRequest)(nil),                   // 25: main.TermRequest
+	(*TermLock)(nil),                      // 26: main.TermLock
+	(*TermLocks)(nil),                     // 27: main.TermLocks
+	nil,                                   // 28: main.CategoryWeights.WeightsEntry
+	(*timestamppb.Timestamp)(nil),         // 29: google.protobuf.Timestamp
+	(*BulkItemResult)(nil),                // 30: main.BulkItemResult
+	(*SortField)(nil),                     // 31: main.SortField
+	(*fieldmaskpb.FieldMask)(nil),         // 32: google.protobuf.FieldMask
+	(*EmptyRequest)(nil),                  // 33: main.EmptyRequest
+}
+var file_grades_proto_depIdxs = []int32{
+	0,  // 0: main.Assessment.type:type_name -> main.AssessmentType
+	29, // 1: main.Assessment.due_at:type_name -> google.protobuf.Timestamp
+	29, // 2: main.Assessment.deleted_at:type_name -> google.protobuf.Timestamp
+	29, // 3: main.Assessment.created_at:type_name -> google.protobuf.Timestamp
+	29, // 4: main.Assessment.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: main.Assessments.assessments:type_name -> main.Assessment
+	1,  // 6: main.AddAssessmentsRequest.assessments:type_name -> main.Assessment
+	1,  // 7: main.AddAssessmentsResponse.assessments:type_name -> main.Assessment
+	30, // 8: main.AddAssessmentsResponse.results:type_name -> main.BulkItemResult
+	1,  // 9: main.GetAssessmentsRequest.assessment:type_name -> main.Assessment
+	31, // 10: main.GetAssessmentsRequest.sort_by:type_name -> main.SortField
+	1,  // 11: main.UpdateAssessmentsRequest.assessments:type_name -> main.Assessment
+	32, // 12: main.UpdateAssessmentsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 13: main.DeleteAssessmentsRequest.ids:type_name -> main.AssessmentId
+	11, // 14: main.Score.changes:type_name -> main.ScoreChange
+	29, // 15: main.Score.created_at:type_name -> google.protobuf.Timestamp
+	29, // 16: main.Score.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 17: main.ScoreChange.changed_at:type_name -> google.protobuf.Timestamp
+	10, // 18: main.Scores.scores:type_name -> main.Score
+	13, // 19: main.RecordScoresRequest.entries:type_name -> main.ScoreEntry
+	10, // 20: main.RecordScoresResponse.scores:type_name -> main.Score
+	30, // 21: main.RecordScoresResponse.results:type_name -> main.BulkItemResult
+	28, // 22: main.CategoryWeights.weights:type_name -> main.CategoryWeights.WeightsEntry
+	29, // 23: main.CategoryWeights.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 24: main.GradingScale.bands:type_name -> main.GradeBand
+	29, // 25: main.GradingScale.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 26: main.SubjectGrade.categories:type_name -> main.CategoryAverage
+	23, // 27: main.StudentGrades.subjects:type_name -> main.SubjectGrade
+	29, // 28: main.TermLock.finalized_at:type_name -> google.protobuf.Timestamp
+	26, // 29: main.TermLocks.locks:type_name -> main.TermLock
+	3,  // 30: main.GradesService.AddAssessments:input_type -> main.AddAssessmentsRequest
+	5,  // 31: main.GradesService.GetAssessments:input_type -> main.GetAssessmentsRequest
+	6,  // 32: main.GradesService.UpdateAssessments:input_type -> main.UpdateAssessmentsRequest
+	8,  // 33: main.GradesService.DeleteAssessments:input_type -> main.DeleteAssessmentsRequest
+	14, // 34: main.GradesService.RecordScores:input_type -> main.RecordScoresRequest
+	16, // 35: main.GradesService.GetScores:input_type -> main.GetScoresRequest
+	17, // 36: main.GradesService.SetCategoryWeights:input_type -> main.CategoryWeights
+	18, // 37: main.GradesService.GetCategoryWeights:input_type -> main.GetCategoryWeightsRequest
+	20, // 38: main.GradesService.SetGradingScale:input_type -> main.GradingScale
+	33, // 39: main.GradesService.GetGradingScale:input_type -> main.EmptyRequest
+	21, // 40: main.GradesService.GetStudentGrades:input_type -> main.StudentGradesRequest
+	25, // 41: main.GradesService.FinalizeTerm:input_type -> main.TermRequest
+	25, // 42: main.GradesService.ReopenTerm:input_type -> main.TermRequest
+	33, // 43: main.GradesService.GetTermLocks:input_type -> main.EmptyRequest
+	4,  // 44: main.GradesService.AddAssessments:output_type -> main.AddAssessmentsResponse
+	2,  // 45: main.GradesService.GetAssessments:output_type -> main.Assessments
+	2,  // 46: main.GradesService.UpdateAssessments:output_type -> main.Assessments
+	9,  // 47: main.GradesService.DeleteAssessments:output_type -> main.DeleteAssessmentsConfirmation
+	15, // 48: main.GradesService.RecordScores:output_type -> main.RecordScoresResponse
+	12, // 49: main.GradesService.GetScores:output_type -> main.Scores
+	17, // 50: main.GradesService.SetCategoryWeights:output_type -> main.CategoryWeights
+	17, // 51: main.GradesService.GetCategoryWeights:output_type -> main.CategoryWeights
+	20, // 52: main.GradesService.SetGradingScale:output_type -> main.GradingScale
+	20, // 53: main.GradesService.GetGradingScale:output_type -> main.GradingScale
+	24, // 54: main.GradesService.GetStudentGrades:output_type -> main.StudentGrades
+	26, // 55: main.GradesService.FinalizeTerm:output_type -> main.TermLock
+	26, // 56: main.GradesService.ReopenTerm:output_type -> main.TermLock
+	27, // 57: main.GradesService.GetTermLocks:output_type -> main.TermLocks
+	44, // [44:58] is the sub-list for method output_type
+	30, // [30:44] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_grades_proto_init() }
+func file_grades_proto_init() {
+	if File_grades_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	file_execs_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grades_proto_rawDesc), len(file_grades_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_grades_proto_goTypes,
+		DependencyIndexes: file_grades_proto_depIdxs,
+		EnumInfos:         file_grades_proto_enumTypes,
+		MessageInfos:      file_grades_proto_msgTypes,
+	}.Build()
+	File_grades_proto = out.File
+	file_grades_proto_goTypes = nil
+	file_grades_proto_depIdxs = nil
+}
@@ -0,0 +1,649 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GradesService_AddAssessments_FullMethodName     = "/main.GradesService/AddAssessments"
+	GradesService_GetAssessments_FullMethodName     = "/main.GradesService/GetAssessments"
+	GradesService_UpdateAssessments_FullMethodName  = "/main.GradesService/UpdateAssessments"
+	GradesService_DeleteAssessments_FullMethodName  = "/main.GradesService/DeleteAssessments"
+	GradesService_RecordScores_FullMethodName       = "/main.GradesService/RecordScores"
+	GradesService_GetScores_FullMethodName          = "/main.GradesService/GetScores"
+	GradesService_SetCategoryWeights_FullMethodName = "/main.GradesService/SetCategoryWeights"
+	GradesService_GetCategoryWeights_FullMethodName = "/main.GradesService/GetCategoryWeights"
+	GradesService_SetGradingScale_FullMethodName    = "/main.GradesService/SetGradingScale"
+	GradesService_GetGradingScale_FullMethodName    = "/main.GradesService/GetGradingScale"
+	GradesService_GetStudentGrades_FullMethodName   = "/main.GradesService/GetStudentGrades"
+	GradesService_FinalizeTerm_FullMethodName       = "/main.GradesService/FinalizeTerm"
+	GradesService_ReopenTerm_FullMethodName         = "/main.GradesService/ReopenTerm"
+	GradesService_GetTermLocks_FullMethodName       = "/main.GradesService/GetTermLocks"
+)
+
+// GradesServiceClient is the client API for GradesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to assessments, scores and the grades worked out from them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type GradesServiceClient interface {
+	// AddAssessments defines new assessments of a class subject or course
+	AddAssessments(ctx context.Context, in *AddAssessmentsRequest, opts ...grpc.CallOption) (*AddAssessmentsResponse, error)
+	// GetAssessments retrieves a list of assessments based on the provided criteria
+	GetAssessments(ctx context.Context, in *GetAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error)
+	// UpdateAssessments updates existing assessments
+	UpdateAssessments(ctx context.Context, in *UpdateAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error)
+	// DeleteAssessments removes assessments by their IDs, their scores no longer count
+	DeleteAssessments(ctx context.Context, in *DeleteAssessmentsRequest, opts ...grpc.CallOption) (*DeleteAssessmentsConfirmation, error)
+	// RecordScores records or changes the scores of students for an assessment, every entry is accepted or rejected on its own
+	RecordScores(ctx context.Context, in *RecordScoresRequest, opts ...grpc.CallOption) (*RecordScoresResponse, error)
+	// GetScores retrieves the scores of an assessment or student
+	GetScores(ctx context.Context, in *GetScoresRequest, opts ...grpc.CallOption) (*Scores, error)
+	// SetCategoryWeights sets how much every category counts towards the average of a gradebook in a term
+	SetCategoryWeights(ctx context.Context, in *CategoryWeights, opts ...grpc.CallOption) (*CategoryWeights, error)
+	// GetCategoryWeights retrieves the category weights of a gradebook in a term
+	GetCategoryWeights(ctx context.Context, in *GetCategoryWeightsRequest, opts ...grpc.CallOption) (*CategoryWeights, error)
+	// SetGradingScale replaces the scale turning averages into letter grades and grade points
+	SetGradingScale(ctx context.Context, in *GradingScale, opts ...grpc.CallOption) (*GradingScale, error)
+	// GetGradingScale retrieves the grading scale in use
+	GetGradingScale(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GradingScale, error)
+	// GetStudentGrades works out the weighted averages, letter grades and GPA of a student in a term
+	GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*StudentGrades, error)
+	// FinalizeTerm locks the assessments, scores and category weights of a term
+	FinalizeTerm(ctx context.Context, in *TermRequest, opts ...grpc.CallOption) (*TermLock, error)
+	// ReopenTerm lifts the lock of a finalized term
+	ReopenTerm(ctx context.Context, in *TermRequest, opts ...grpc.CallOption) (*TermLock, error)
+	// GetTermLocks lists the finalized terms
+	GetTermLocks(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TermLocks, error)
+}
+
+type gradesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradesServiceClient(cc grpc.ClientConnInterface) GradesServiceClient {
+	return &gradesServiceClient{cc}
+}
+
+func (c *gradesServiceClient) AddAssessments(ctx context.Context, in *AddAssessmentsRequest, opts ...grpc.CallOption) (*AddAssessmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAssessmentsResponse)
+	err := c.cc.Invoke(ctx, GradesService_AddAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetAssessments(ctx context.Context, in *GetAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_GetAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) UpdateAssessments(ctx context.Context, in *UpdateAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_UpdateAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) DeleteAssessments(ctx context.Context, in *DeleteAssessmentsRequest, opts ...grpc.CallOption) (*DeleteAssessmentsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssessmentsConfirmation)
+	err := c.cc.Invoke(ctx, GradesService_DeleteAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) RecordScores(ctx context.Context, in *RecordScoresRequest, opts ...grpc.CallOption) (*RecordScoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordScoresResponse)
+	err := c.cc.Invoke(ctx, GradesService_RecordScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetScores(ctx context.Context, in *GetScoresRequest, opts ...grpc.CallOption) (*Scores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scores)
+	err := c.cc.Invoke(ctx, GradesService_GetScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SetCategoryWeights(ctx context.Context, in *CategoryWeights, opts ...grpc.CallOption) (*CategoryWeights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryWeights)
+	err := c.cc.Invoke(ctx, GradesService_SetCategoryWeights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetCategoryWeights(ctx context.Context, in *GetCategoryWeightsRequest, opts ...grpc.CallOption) (*CategoryWeights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryWeights)
+	err := c.cc.Invoke(ctx, GradesService_GetCategoryWeights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) SetGradingScale(ctx context.Context, in *GradingScale, opts ...grpc.CallOption) (*GradingScale, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingScale)
+	err := c.cc.Invoke(ctx, GradesService_SetGradingScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradingScale(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GradingScale, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingScale)
+	err := c.cc.Invoke(ctx, GradesService_GetGradingScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*StudentGrades, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StudentGrades)
+	err := c.cc.Invoke(ctx, GradesService_GetStudentGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) FinalizeTerm(ctx context.Context, in *TermRequest, opts ...grpc.CallOption) (*TermLock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermLock)
+	err := c.cc.Invoke(ctx, GradesService_FinalizeTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) ReopenTerm(ctx context.Context, in *TermRequest, opts ...grpc.CallOption) (*TermLock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermLock)
+	err := c.cc.Invoke(ctx, GradesService_ReopenTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetTermLocks(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*TermLocks, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TermLocks)
+	err := c.cc.Invoke(ctx, GradesService_GetTermLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradesServiceServer is the server API for GradesService service.
+// All implementations must embed UnimplementedGradesServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to assessments, scores and the grades worked out from them
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type GradesServiceServer interface {
+	// AddAssessments defines new assessments of a class subject or course
+	AddAssessments(context.Context, *AddAssessmentsRequest) (*AddAssessmentsResponse, error)
+	// GetAssessments retrieves a list of assessments based on the provided criteria
+	GetAssessments(context.Context, *GetAssessmentsRequest) (*Assessments, error)
+	// UpdateAssessments updates existing assessments
+	UpdateAssessments(context.Context, *UpdateAssessmentsRequest) (*Assessments, error)
+	// DeleteAssessments removes assessments by their IDs, their scores no longer count
+	DeleteAssessments(context.Context, *DeleteAssessmentsRequest) (*DeleteAssessmentsConfirmation, error)
+	// RecordScores records or changes the scores of students for an assessment, every entry is accepted or rejected on its own
+	RecordScores(context.Context, *RecordScoresRequest) (*RecordScoresResponse, error)
+	// GetScores retrieves the scores of an assessment or student
+	GetScores(context.Context, *GetScoresRequest) (*Scores, error)
+	// SetCategoryWeights sets how much every category counts towards the average of a gradebook in a term
+	SetCategoryWeights(context.Context, *CategoryWeights) (*CategoryWeights, error)
+	// GetCategoryWeights retrieves the category weights of a gradebook in a term
+	GetCategoryWeights(context.Context, *GetCategoryWeightsRequest) (*CategoryWeights, error)
+	// SetGradingScale replaces the scale turning averages into letter grades and grade points
+	SetGradingScale(context.Context, *GradingScale) (*GradingScale, error)
+	// GetGradingScale retrieves the grading scale in use
+	GetGradingScale(context.Context, *EmptyRequest) (*GradingScale, error)
+	// GetStudentGrades works out the weighted averages, letter grades and GPA of a student in a term
+	GetStudentGrades(context.Context, *StudentGradesRequest) (*StudentGrades, error)
+	// FinalizeTerm locks the assessments, scores and category weights of a term
+	FinalizeTerm(context.Context, *TermRequest) (*TermLock, error)
+	// ReopenTerm lifts the lock of a finalized term
+	ReopenTerm(context.Context, *TermRequest) (*TermLock, error)
+	// GetTermLocks lists the finalized terms
+	GetTermLocks(context.Context, *EmptyRequest) (*TermLocks, error)
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+// UnimplementedGradesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGradesServiceServer struct{}
+
+func (UnimplementedGradesServiceServer) AddAssessments(context.Context, *AddAssessmentsRequest) (*AddAssessmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) GetAssessments(context.Context, *GetAssessmentsRequest) (*Assessments, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) UpdateAssessments(context.Context, *UpdateAssessmentsRequest) (*Assessments, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) DeleteAssessments(context.Context, *DeleteAssessmentsRequest) (*DeleteAssessmentsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) RecordScores(context.Context, *RecordScoresRequest) (*RecordScoresResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordScores not implemented")
+}
+func (UnimplementedGradesServiceServer) GetScores(context.Context, *GetScoresRequest) (*Scores, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScores not implemented")
+}
+func (UnimplementedGradesServiceServer) SetCategoryWeights(context.Context, *CategoryWeights) (*CategoryWeights, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCategoryWeights not implemented")
+}
+func (UnimplementedGradesServiceServer) GetCategoryWeights(context.Context, *GetCategoryWeightsRequest) (*CategoryWeights, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCategoryWeights not implemented")
+}
+func (UnimplementedGradesServiceServer) SetGradingScale(context.Context, *GradingScale) (*GradingScale, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGradingScale not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradingScale(context.Context, *EmptyRequest) (*GradingScale, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGradingScale not implemented")
+}
+func (UnimplementedGradesServiceServer) GetStudentGrades(context.Context, *StudentGradesRequest) (*StudentGrades, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStudentGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) FinalizeTerm(context.Context, *TermRequest) (*TermLock, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinalizeTerm not implemented")
+}
+func (UnimplementedGradesServiceServer) ReopenTerm(context.Context, *TermRequest) (*TermLock, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReopenTerm not implemented")
+}
+func (UnimplementedGradesServiceServer) GetTermLocks(context.Context, *EmptyRequest) (*TermLocks, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTermLocks not implemented")
+}
+func (UnimplementedGradesServiceServer) mustEmbedUnimplementedGradesServiceServer() {}
+func (UnimplementedGradesServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGradesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradesServiceServer will
+// result in compilation errors.
+type UnsafeGradesServiceServer interface {
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+func RegisterGradesServiceServer(s grpc.ServiceRegistrar, srv GradesServiceServer) {
+	// If the following call panics, it indicates UnimplementedGradesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GradesService_ServiceDesc, srv)
+}
+
+func _GradesService_AddAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).AddAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_AddAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).AddAssessments(ctx, req.(*AddAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetAssessments(ctx, req.(*GetAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_UpdateAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).UpdateAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_UpdateAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).UpdateAssessments(ctx, req.(*UpdateAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_DeleteAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).DeleteAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_DeleteAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).DeleteAssessments(ctx, req.(*DeleteAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_RecordScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).RecordScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_RecordScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).RecordScores(ctx, req.(*RecordScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetScores(ctx, req.(*GetScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetCategoryWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryWeights)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetCategoryWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetCategoryWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetCategoryWeights(ctx, req.(*CategoryWeights))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetCategoryWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetCategoryWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetCategoryWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetCategoryWeights(ctx, req.(*GetCategoryWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_SetGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradingScale)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).SetGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_SetGradingScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).SetGradingScale(ctx, req.(*GradingScale))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradingScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradingScale(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetStudentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetStudentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetStudentGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetStudentGrades(ctx, req.(*StudentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_FinalizeTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).FinalizeTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_FinalizeTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).FinalizeTerm(ctx, req.(*TermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_ReopenTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).ReopenTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_ReopenTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).ReopenTerm(ctx, req.(*TermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetTermLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetTermLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetTermLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetTermLocks(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradesService_ServiceDesc is the grpc.ServiceDesc for GradesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.GradesService",
+	HandlerType: (*GradesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAssessments",
+			Handler:    _GradesService_AddAssessments_Handler,
+		},
+		{
+			MethodName: "GetAssessments",
+			Handler:    _GradesService_GetAssessments_Handler,
+		},
+		{
+			MethodName: "UpdateAssessments",
+			Handler:    _GradesService_UpdateAssessments_Handler,
+		},
+		{
+			MethodName: "DeleteAssessments",
+			Handler:    _GradesService_DeleteAssessments_Handler,
+		},
+		{
+			MethodName: "RecordScores",
+			Handler:    _GradesService_RecordScores_Handler,
+		},
+		{
+			MethodName: "GetScores",
+			Handler:    _GradesService_GetScores_Handler,
+		},
+		{
+			MethodName: "SetCategoryWeights",
+			Handler:    _GradesService_SetCategoryWeights_Handler,
+		},
+		{
+			MethodName: "GetCategoryWeights",
+			Handler:    _GradesService_GetCategoryWeights_Handler,
+		},
+		{
+			MethodName: "SetGradingScale",
+			Handler:    _GradesService_SetGradingScale_Handler,
+		},
+		{
+			MethodName: "GetGradingScale",
+			Handler:    _GradesService_GetGradingScale_Handler,
+		},
+		{
+			MethodName: "GetStudentGrades",
+			Handler:    _GradesService_GetStudentGrades_Handler,
+		},
+		{
+			MethodName: "FinalizeTerm",
+			Handler:    _GradesService_FinalizeTerm_Handler,
+		},
+		{
+			MethodName: "ReopenTerm",
+			Handler:    _GradesService_ReopenTerm_Handler,
+		},
+		{
+			MethodName: "GetTermLocks",
+			Handler:    _GradesService_GetTermLocks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "grades.proto",
+}
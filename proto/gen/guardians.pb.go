@@ -0,0 +1,724 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: guardians.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Guardians struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guardians     []*Guardian            `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guardians) Reset() {
+	*x = Guardians{}
+	mi := &file_guardians_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guardians) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardians) ProtoMessage() {}
+
+func (x *Guardians) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardians.ProtoReflect.Descriptor instead.
+func (*Guardians) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Guardians) GetGuardians() []*Guardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+type AddGuardiansRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Guardians []*Guardian            `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// ordered stops inserting at the first failing guardian, otherwise every valid guardian is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all guardians in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGuardiansRequest) Reset() {
+	*x = AddGuardiansRequest{}
+	mi := &file_guardians_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGuardiansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGuardiansRequest) ProtoMessage() {}
+
+func (x *AddGuardiansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGuardiansRequest.ProtoReflect.Descriptor instead.
+func (*AddGuardiansRequest) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddGuardiansRequest) GetGuardians() []*Guardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+func (x *AddGuardiansRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddGuardiansRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddGuardiansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guardians     []*Guardian            `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGuardiansResponse) Reset() {
+	*x = AddGuardiansResponse{}
+	mi := &file_guardians_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGuardiansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGuardiansResponse) ProtoMessage() {}
+
+func (x *AddGuardiansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGuardiansResponse.ProtoReflect.Descriptor instead.
+func (*AddGuardiansResponse) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddGuardiansResponse) GetGuardians() []*Guardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+func (x *AddGuardiansResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetGuardiansRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only guardians matching the non-empty fields of the filter are returned, student_ids match guardians linked to all of them
+	// and students are not matched
+	Guardian *Guardian    `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	SortBy   []*SortField `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted guardians
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGuardiansRequest) Reset() {
+	*x = GetGuardiansRequest{}
+	mi := &file_guardians_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuardiansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuardiansRequest) ProtoMessage() {}
+
+func (x *GetGuardiansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuardiansRequest.ProtoReflect.Descriptor instead.
+func (*GetGuardiansRequest) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGuardiansRequest) GetGuardian() *Guardian {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (x *GetGuardiansRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetGuardiansRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateGuardiansRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Guardians []*Guardian            `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every guardian, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuardiansRequest) Reset() {
+	*x = UpdateGuardiansRequest{}
+	mi := &file_guardians_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuardiansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuardiansRequest) ProtoMessage() {}
+
+func (x *UpdateGuardiansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuardiansRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGuardiansRequest) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateGuardiansRequest) GetGuardians() []*Guardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
+}
+
+func (x *UpdateGuardiansRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateGuardiansRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type GuardianId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the guardian, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianId) Reset() {
+	*x = GuardianId{}
+	mi := &file_guardians_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianId) ProtoMessage() {}
+
+func (x *GuardianId) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianId.ProtoReflect.Descriptor instead.
+func (*GuardianId) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GuardianId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GuardianId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteGuardiansRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*GuardianId          `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all guardians in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuardiansRequest) Reset() {
+	*x = DeleteGuardiansRequest{}
+	mi := &file_guardians_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuardiansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuardiansRequest) ProtoMessage() {}
+
+func (x *DeleteGuardiansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuardiansRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuardiansRequest) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteGuardiansRequest) GetIds() []*GuardianId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteGuardiansRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteGuardiansConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuardiansConfirmation) Reset() {
+	*x = DeleteGuardiansConfirmation{}
+	mi := &file_guardians_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuardiansConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuardiansConfirmation) ProtoMessage() {}
+
+func (x *DeleteGuardiansConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuardiansConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteGuardiansConfirmation) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteGuardiansConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteGuardiansConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type GuardianLink struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	GuardianId string                 `protobuf:"bytes,1,opt,name=guardian_id,json=guardianId,proto3" json:"guardian_id,omitempty"`
+	StudentId  string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// relationship of the guardian to the student, required when linking
+	Relationship string `protobuf:"bytes,3,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	// emergency_priority makes the guardian an emergency contact of the student when it is above 0
+	EmergencyPriority int32 `protobuf:"varint,4,opt,name=emergency_priority,json=emergencyPriority,proto3" json:"emergency_priority,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GuardianLink) Reset() {
+	*x = GuardianLink{}
+	mi := &file_guardians_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianLink) ProtoMessage() {}
+
+func (x *GuardianLink) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianLink.ProtoReflect.Descriptor instead.
+func (*GuardianLink) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GuardianLink) GetGuardianId() string {
+	if x != nil {
+		return x.GuardianId
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *GuardianLink) GetEmergencyPriority() int32 {
+	if x != nil {
+		return x.EmergencyPriority
+	}
+	return 0
+}
+
+type GuardianLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Links []*GuardianLink        `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	// atomic changes all links in a single transaction, so either all of them are changed or none
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuardianLinksRequest) Reset() {
+	*x = GuardianLinksRequest{}
+	mi := &file_guardians_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianLinksRequest) ProtoMessage() {}
+
+func (x *GuardianLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guardians_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianLinksRequest.ProtoReflect.Descriptor instead.
+func (*GuardianLinksRequest) Descriptor() ([]byte, []int) {
+	return file_guardians_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GuardianLinksRequest) GetLinks() []*GuardianLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *GuardianLinksRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+var File_guardians_proto protoreflect.FileDescriptor
+
+const file_guardians_proto_rawDesc = "" +
+	"\n" +
+	"\x0fguardians.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x0estudents.proto\"9\n" +
+	"\tGuardians\x12,\n" +
+	"\tguardians\x18\x01 \x03(\v2\x0e.main.GuardianR\tguardians\"u\n" +
+	"\x13AddGuardiansRequest\x12,\n" +
+	"\tguardians\x18\x01 \x03(\v2\x0e.main.GuardianR\tguardians\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"t\n" +
+	"\x14AddGuardiansResponse\x12,\n" +
+	"\tguardians\x18\x01 \x03(\v2\x0e.main.GuardianR\tguardians\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x94\x01\n" +
+	"\x13GetGuardiansRequest\x12*\n" +
+	"\bguardian\x18\x01 \x01(\v2\x0e.main.GuardianR\bguardian\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\x9b\x01\n" +
+	"\x16UpdateGuardiansRequest\x12,\n" +
+	"\tguardians\x18\x01 \x03(\v2\x0e.main.GuardianR\tguardians\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"6\n" +
+	"\n" +
+	"GuardianId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"T\n" +
+	"\x16DeleteGuardiansRequest\x12\"\n" +
+	"\x03ids\x18\x01 \x03(\v2\x10.main.GuardianIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"V\n" +
+	"\x1bDeleteGuardiansConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\xa1\x01\n" +
+	"\fGuardianLink\x12\x1f\n" +
+	"\vguardian_id\x18\x01 \x01(\tR\n" +
+	"guardianId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\"\n" +
+	"\frelationship\x18\x03 \x01(\tR\frelationship\x12-\n" +
+	"\x12emergency_priority\x18\x04 \x01(\x05R\x11emergencyPriority\"X\n" +
+	"\x14GuardianLinksRequest\x12(\n" +
+	"\x05links\x18\x01 \x03(\v2\x12.main.GuardianLinkR\x05links\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic2\xa9\x03\n" +
+	"\x10GuardiansService\x12E\n" +
+	"\fAddGuardians\x12\x19.main.AddGuardiansRequest\x1a\x1a.main.AddGuardiansResponse\x12:\n" +
+	"\fGetGuardians\x12\x19.main.GetGuardiansRequest\x1a\x0f.main.Guardians\x12@\n" +
+	"\x0fUpdateGuardians\x12\x1c.main.UpdateGuardiansRequest\x1a\x0f.main.Guardians\x12R\n" +
+	"\x0fDeleteGuardians\x12\x1c.main.DeleteGuardiansRequest\x1a!.main.DeleteGuardiansConfirmation\x12<\n" +
+	"\rLinkGuardians\x12\x1a.main.GuardianLinksRequest\x1a\x0f.main.Guardians\x12>\n" +
+	"\x0fUnlinkGuardians\x12\x1a.main.GuardianLinksRequest\x1a\x0f.main.GuardiansB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_guardians_proto_rawDescOnce sync.Once
+	file_guardians_proto_rawDescData []byte
+)
+
+func file_guardians_proto_rawDescGZIP() []byte {
+	file_guardians_proto_rawDescOnce.Do(func() {
+		file_guardians_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_guardians_proto_rawDesc), len(file_guardians_proto_rawDesc)))
+	})
+	return file_guardians_proto_rawDescData
+}
+
+var file_guardians_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_guardians_proto_goTypes = []any{
+	(*Guardians)(nil),                   // 0: main.Guardians
+	(*AddGuardiansRequest)(nil),         // 1: main.AddGuardiansRequest
+	(*AddGuardiansResponse)(nil),        // 2: main.AddGuardiansResponse
+	(*GetGuardiansRequest)(nil),         // 3: main.GetGuardiansRequest
+	(*UpdateGuardiansRequest)(nil),      // 4: main.UpdateGuardiansRequest
+	(*GuardianId)(nil),                  // 5: main.GuardianId
+	(*DeleteGuardiansRequest)(nil),      // 6: main.DeleteGuardiansRequest
+	(*DeleteGuardiansConfirmation)(nil), // 7: main.DeleteGuardiansConfirmation
+	(*GuardianLink)(nil),                // 8: main.GuardianLink
+	(*GuardianLinksRequest)(nil),        // 9: main.GuardianLinksRequest
+	(*Guardian)(nil),                    // 10: main.Guardian
+	(*BulkItemResult)(nil),              // 11: main.BulkItemResult
+	(*SortField)(nil),                   // 12: main.SortField
+	(*fieldmaskpb.FieldMask)(nil),       // 13: google.protobuf.FieldMask
+}
+var file_guardians_proto_depIdxs = []int32{
+	10, // 0: main.Guardians.guardians:type_name -> main.Guardian
+	10, // 1: main.AddGuardiansRequest.guardians:type_name -> main.Guardian
+	10, // 2: main.AddGuardiansResponse.guardians:type_name -> main.Guardian
+	11, // 3: main.AddGuardiansResponse.results:type_name -> main.BulkItemResult
+	10, // 4: main.GetGuardiansRequest.guardian:type_name -> main.Guardian
+	12, // 5: main.GetGuardiansRequest.sort_by:type_name -> main.SortField
+	10, // 6: main.UpdateGuardiansRequest.guardians:type_name -> main.Guardian
+	13, // 7: main.UpdateGuardiansRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 8: main.DeleteGuardiansRequest.ids:type_name -> main.GuardianId
+	8,  // 9: main.GuardianLinksRequest.links:type_name -> main.GuardianLink
+	1,  // 10: main.GuardiansService.AddGuardians:input_type -> main.AddGuardiansRequest
+	3,  // 11: main.GuardiansService.GetGuardians:input_type -> main.GetGuardiansRequest
+	4,  // 12: main.GuardiansService.UpdateGuardians:input_type -> main.UpdateGuardiansRequest
+	6,  // 13: main.GuardiansService.DeleteGuardians:input_type -> main.DeleteGuardiansRequest
+	9,  // 14: main.GuardiansService.LinkGuardians:input_type -> main.GuardianLinksRequest
+	9,  // 15: main.GuardiansService.UnlinkGuardians:input_type -> main.GuardianLinksRequest
+	2,  // 16: main.GuardiansService.AddGuardians:output_type -> main.AddGuardiansResponse
+	0,  // 17: main.GuardiansService.GetGuardians:output_type -> main.Guardians
+	0,  // 18: main.GuardiansService.UpdateGuardians:output_type -> main.Guardians
+	7,  // 19: main.GuardiansService.DeleteGuardians:output_type -> main.DeleteGuardiansConfirmation
+	0,  // 20: main.GuardiansService.LinkGuardians:output_type -> main.Guardians
+	0,  // 21: main.GuardiansService.UnlinkGuardians:output_type -> main.Guardians
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_guardians_proto_init() }
+func file_guardians_proto_init() {
+	if File_guardians_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_guardians_proto_rawDesc), len(file_guardians_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_guardians_proto_goTypes,
+		DependencyIndexes: file_guardians_proto_depIdxs,
+		MessageInfos:      file_guardians_proto_msgTypes,
+	}.Build()
+	File_guardians_proto = out.File
+	file_guardians_proto_goTypes = nil
+	file_guardians_proto_depIdxs = nil
+}
@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: guardians.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GuardiansService_AddGuardians_FullMethodName    = "/main.GuardiansService/AddGuardians"
+	GuardiansService_GetGuardians_FullMethodName    = "/main.GuardiansService/GetGuardians"
+	GuardiansService_UpdateGuardians_FullMethodName = "/main.GuardiansService/UpdateGuardians"
+	GuardiansService_DeleteGuardians_FullMethodName = "/main.GuardiansService/DeleteGuardians"
+	GuardiansService_LinkGuardians_FullMethodName   = "/main.GuardiansService/LinkGuardians"
+	GuardiansService_UnlinkGuardians_FullMethodName = "/main.GuardiansService/UnlinkGuardians"
+)
+
+// GuardiansServiceClient is the client API for GuardiansService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to guardians and the students they are linked to
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type GuardiansServiceClient interface {
+	// AddGuardians adds new guardians, optionally linked to their students right away
+	AddGuardians(ctx context.Context, in *AddGuardiansRequest, opts ...grpc.CallOption) (*AddGuardiansResponse, error)
+	// GetGuardians retrieves a list of guardians based on the provided criteria
+	GetGuardians(ctx context.Context, in *GetGuardiansRequest, opts ...grpc.CallOption) (*Guardians, error)
+	// UpdateGuardians updates existing guardians, their students are changed with LinkGuardians and UnlinkGuardians
+	UpdateGuardians(ctx context.Context, in *UpdateGuardiansRequest, opts ...grpc.CallOption) (*Guardians, error)
+	// DeleteGuardians removes guardians by their IDs
+	DeleteGuardians(ctx context.Context, in *DeleteGuardiansRequest, opts ...grpc.CallOption) (*DeleteGuardiansConfirmation, error)
+	// LinkGuardians links guardians to students, linking them again replaces the relationship and emergency priority
+	LinkGuardians(ctx context.Context, in *GuardianLinksRequest, opts ...grpc.CallOption) (*Guardians, error)
+	// UnlinkGuardians removes links between guardians and students
+	UnlinkGuardians(ctx context.Context, in *GuardianLinksRequest, opts ...grpc.CallOption) (*Guardians, error)
+}
+
+type guardiansServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGuardiansServiceClient(cc grpc.ClientConnInterface) GuardiansServiceClient {
+	return &guardiansServiceClient{cc}
+}
+
+func (c *guardiansServiceClient) AddGuardians(ctx context.Context, in *AddGuardiansRequest, opts ...grpc.CallOption) (*AddGuardiansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGuardiansResponse)
+	err := c.cc.Invoke(ctx, GuardiansService_AddGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) GetGuardians(ctx context.Context, in *GetGuardiansRequest, opts ...grpc.CallOption) (*Guardians, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardians)
+	err := c.cc.Invoke(ctx, GuardiansService_GetGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) UpdateGuardians(ctx context.Context, in *UpdateGuardiansRequest, opts ...grpc.CallOption) (*Guardians, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardians)
+	err := c.cc.Invoke(ctx, GuardiansService_UpdateGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) DeleteGuardians(ctx context.Context, in *DeleteGuardiansRequest, opts ...grpc.CallOption) (*DeleteGuardiansConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGuardiansConfirmation)
+	err := c.cc.Invoke(ctx, GuardiansService_DeleteGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) LinkGuardians(ctx context.Context, in *GuardianLinksRequest, opts ...grpc.CallOption) (*Guardians, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardians)
+	err := c.cc.Invoke(ctx, GuardiansService_LinkGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guardiansServiceClient) UnlinkGuardians(ctx context.Context, in *GuardianLinksRequest, opts ...grpc.CallOption) (*Guardians, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Guardians)
+	err := c.cc.Invoke(ctx, GuardiansService_UnlinkGuardians_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GuardiansServiceServer is the server API for GuardiansService service.
+// All implementations must embed UnimplementedGuardiansServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to guardians and the students they are linked to
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type GuardiansServiceServer interface {
+	// AddGuardians adds new guardians, optionally linked to their students right away
+	AddGuardians(context.Context, *AddGuardiansRequest) (*AddGuardiansResponse, error)
+	// GetGuardians retrieves a list of guardians based on the provided criteria
+	GetGuardians(context.Context, *GetGuardiansRequest) (*Guardians, error)
+	// UpdateGuardians updates existing guardians, their students are changed with LinkGuardians and UnlinkGuardians
+	UpdateGuardians(context.Context, *UpdateGuardiansRequest) (*Guardians, error)
+	// DeleteGuardians removes guardians by their IDs
+	DeleteGuardians(context.Context, *DeleteGuardiansRequest) (*DeleteGuardiansConfirmation, error)
+	// LinkGuardians links guardians to students, linking them again replaces the relationship and emergency priority
+	LinkGuardians(context.Context, *GuardianLinksRequest) (*Guardians, error)
+	// UnlinkGuardians removes links between guardians and students
+	UnlinkGuardians(context.Context, *GuardianLinksRequest) (*Guardians, error)
+	mustEmbedUnimplementedGuardiansServiceServer()
+}
+
+// UnimplementedGuardiansServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGuardiansServiceServer struct{}
+
+func (UnimplementedGuardiansServiceServer) AddGuardians(context.Context, *AddGuardiansRequest) (*AddGuardiansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) GetGuardians(context.Context, *GetGuardiansRequest) (*Guardians, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) UpdateGuardians(context.Context, *UpdateGuardiansRequest) (*Guardians, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) DeleteGuardians(context.Context, *DeleteGuardiansRequest) (*DeleteGuardiansConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) LinkGuardians(context.Context, *GuardianLinksRequest) (*Guardians, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) UnlinkGuardians(context.Context, *GuardianLinksRequest) (*Guardians, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkGuardians not implemented")
+}
+func (UnimplementedGuardiansServiceServer) mustEmbedUnimplementedGuardiansServiceServer() {}
+func (UnimplementedGuardiansServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeGuardiansServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GuardiansServiceServer will
+// result in compilation errors.
+type UnsafeGuardiansServiceServer interface {
+	mustEmbedUnimplementedGuardiansServiceServer()
+}
+
+func RegisterGuardiansServiceServer(s grpc.ServiceRegistrar, srv GuardiansServiceServer) {
+	// If the following call panics, it indicates UnimplementedGuardiansServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GuardiansService_ServiceDesc, srv)
+}
+
+func _GuardiansService_AddGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).AddGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_AddGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).AddGuardians(ctx, req.(*AddGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_GetGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).GetGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_GetGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).GetGuardians(ctx, req.(*GetGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_UpdateGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).UpdateGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_UpdateGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).UpdateGuardians(ctx, req.(*UpdateGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_DeleteGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).DeleteGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_DeleteGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).DeleteGuardians(ctx, req.(*DeleteGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_LinkGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).LinkGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_LinkGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).LinkGuardians(ctx, req.(*GuardianLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuardiansService_UnlinkGuardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardiansServiceServer).UnlinkGuardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuardiansService_UnlinkGuardians_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardiansServiceServer).UnlinkGuardians(ctx, req.(*GuardianLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GuardiansService_ServiceDesc is the grpc.ServiceDesc for GuardiansService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GuardiansService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.GuardiansService",
+	HandlerType: (*GuardiansServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddGuardians",
+			Handler:    _GuardiansService_AddGuardians_Handler,
+		},
+		{
+			MethodName: "GetGuardians",
+			Handler:    _GuardiansService_GetGuardians_Handler,
+		},
+		{
+			MethodName: "UpdateGuardians",
+			Handler:    _GuardiansService_UpdateGuardians_Handler,
+		},
+		{
+			MethodName: "DeleteGuardians",
+			Handler:    _GuardiansService_DeleteGuardians_Handler,
+		},
+		{
+			MethodName: "LinkGuardians",
+			Handler:    _GuardiansService_LinkGuardians_Handler,
+		},
+		{
+			MethodName: "UnlinkGuardians",
+			Handler:    _GuardiansService_UnlinkGuardians_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardians.proto",
+}
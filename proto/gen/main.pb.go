@@ -10,6 +10,8 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,29 +24,1012 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Role of a teacher in a class they are assigned to
+type TeachingRole int32
+
+const (
+	TeachingRole_SUBJECT_TEACHER  TeachingRole = 0
+	TeachingRole_HOMEROOM_TEACHER TeachingRole = 1
+)
+
+// Enum value maps for TeachingRole.
+var (
+	TeachingRole_name = map[int32]string{
+		0: "SUBJECT_TEACHER",
+		1: "HOMEROOM_TEACHER",
+	}
+	TeachingRole_value = map[string]int32{
+		"SUBJECT_TEACHER":  0,
+		"HOMEROOM_TEACHER": 1,
+	}
+)
+
+func (x TeachingRole) Enum() *TeachingRole {
+	p := new(TeachingRole)
+	*p = x
+	return p
+}
+
+func (x TeachingRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TeachingRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_main_proto_enumTypes[0].Descriptor()
+}
+
+func (TeachingRole) Type() protoreflect.EnumType {
+	return &file_main_proto_enumTypes[0]
+}
+
+func (x TeachingRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TeachingRole.Descriptor instead.
+func (TeachingRole) EnumDescriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{0}
+}
+
+type AddTeachersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Teachers []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	// ordered stops inserting at the first failing teacher, otherwise every valid teacher is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all teachers in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeachersRequest) Reset() {
+	*x = AddTeachersRequest{}
+	mi := &file_main_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeachersRequest) ProtoMessage() {}
+
+func (x *AddTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeachersRequest.ProtoReflect.Descriptor instead.
+func (*AddTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddTeachersRequest) GetTeachers() []*Teacher {
+	if x != nil {
+		return x.Teachers
+	}
+	return nil
+}
+
+func (x *AddTeachersRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddTeachersRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type UpdateTeachersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Teachers []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every teacher, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeachersRequest) Reset() {
+	*x = UpdateTeachersRequest{}
+	mi := &file_main_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeachersRequest) ProtoMessage() {}
+
+func (x *UpdateTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeachersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateTeachersRequest) GetTeachers() []*Teacher {
+	if x != nil {
+		return x.Teachers
+	}
+	return nil
+}
+
+func (x *UpdateTeachersRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateTeachersRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTeachersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*TeacherId           `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all teachers in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeachersRequest) Reset() {
+	*x = DeleteTeachersRequest{}
+	mi := &file_main_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeachersRequest) ProtoMessage() {}
+
+func (x *DeleteTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeachersRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteTeachersRequest) GetIds() []*TeacherId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteTeachersRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddTeachersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teachers      []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeachersResponse) Reset() {
+	*x = AddTeachersResponse{}
+	mi := &file_main_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeachersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeachersResponse) ProtoMessage() {}
+
+func (x *AddTeachersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeachersResponse.ProtoReflect.Descriptor instead.
+func (*AddTeachersResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTeachersResponse) GetTeachers() []*Teacher {
+	if x != nil {
+		return x.Teachers
+	}
+	return nil
+}
+
+func (x *AddTeachersResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WatchTeachersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only changes to teachers matching the non-empty fields of the filter are streamed
+	Filter *Teacher `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resume_token of the last received event, the stream continues right after it
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTeachersRequest) Reset() {
+	*x = WatchTeachersRequest{}
+	mi := &file_main_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTeachersRequest) ProtoMessage() {}
+
+func (x *WatchTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTeachersRequest.ProtoReflect.Descriptor instead.
+func (*WatchTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchTeachersRequest) GetFilter() *Teacher {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchTeachersRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type TeacherEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ChangeType             `protobuf:"varint,1,opt,name=type,proto3,enum=main.ChangeType" json:"type,omitempty"`
+	// teacher as stored after the change
+	Teacher       *Teacher               `protobuf:"bytes,2,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeacherEvent) Reset() {
+	*x = TeacherEvent{}
+	mi := &file_main_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeacherEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeacherEvent) ProtoMessage() {}
+
+func (x *TeacherEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeacherEvent.ProtoReflect.Descriptor instead.
+func (*TeacherEvent) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TeacherEvent) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *TeacherEvent) GetTeacher() *Teacher {
+	if x != nil {
+		return x.Teacher
+	}
+	return nil
+}
+
+func (x *TeacherEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *TeacherEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RestoreTeachersConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredIds   []string               `protobuf:"bytes,2,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTeachersConfirmation) Reset() {
+	*x = RestoreTeachersConfirmation{}
+	mi := &file_main_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTeachersConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTeachersConfirmation) ProtoMessage() {}
+
+func (x *RestoreTeachersConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTeachersConfirmation.ProtoReflect.Descriptor instead.
+func (*RestoreTeachersConfirmation) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RestoreTeachersConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestoreTeachersConfirmation) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
 type DeleteTeachersConfirmation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeachersConfirmation) Reset() {
+	*x = DeleteTeachersConfirmation{}
+	mi := &file_main_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeachersConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeachersConfirmation) ProtoMessage() {}
+
+func (x *DeleteTeachersConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeachersConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteTeachersConfirmation) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteTeachersConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteTeachersConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type TeacherId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the teacher, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeacherId) Reset() {
+	*x = TeacherId{}
+	mi := &file_main_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeacherId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeacherId) ProtoMessage() {}
+
+func (x *TeacherId) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeacherId.ProtoReflect.Descriptor instead.
+func (*TeacherId) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TeacherId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TeacherId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type TeacherIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []*TeacherId           `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeacherIds) Reset() {
+	*x = TeacherIds{}
+	mi := &file_main_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeacherIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeacherIds) ProtoMessage() {}
+
+func (x *TeacherIds) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeacherIds.ProtoReflect.Descriptor instead.
+func (*TeacherIds) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TeacherIds) GetIds() []*TeacherId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// ClassTeacherRequest selects the students a teacher teaches, it reads the same as the TeacherId the roster RPCs used to take
+type ClassTeacherRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// subject limits the roster to the classes the teacher teaches that subject in, all assignments count when it is empty
+	Subject       string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassTeacherRequest) Reset() {
+	*x = ClassTeacherRequest{}
+	mi := &file_main_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassTeacherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassTeacherRequest) ProtoMessage() {}
+
+func (x *ClassTeacherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassTeacherRequest.ProtoReflect.Descriptor instead.
+func (*ClassTeacherRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClassTeacherRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClassTeacherRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type GetTeachersRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Teacher *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
+	SortBy  []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted teachers
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// time_filters limit the teachers to the given time ranges of their timestamp fields
+	TimeFilters   []*TimestampFilter `protobuf:"bytes,4,rep,name=time_filters,json=timeFilters,proto3" json:"time_filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeachersRequest) Reset() {
+	*x = GetTeachersRequest{}
+	mi := &file_main_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeachersRequest) ProtoMessage() {}
+
+func (x *GetTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeachersRequest.ProtoReflect.Descriptor instead.
+func (*GetTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTeachersRequest) GetTeacher() *Teacher {
+	if x != nil {
+		return x.Teacher
+	}
+	return nil
+}
+
+func (x *GetTeachersRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetTeachersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetTeachersRequest) GetTimeFilters() []*TimestampFilter {
+	if x != nil {
+		return x.TimeFilters
+	}
+	return nil
+}
+
+type ExportTeachersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query selects and orders the teachers the same way GetTeachers does
+	Query  *GetTeachersRequest `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Format ImportFormat        `protobuf:"varint,2,opt,name=format,proto3,enum=main.ImportFormat" json:"format,omitempty"`
+	// columns lists the fields to export in order, all fields are exported when it is empty
+	Columns       []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTeachersRequest) Reset() {
+	*x = ExportTeachersRequest{}
+	mi := &file_main_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTeachersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTeachersRequest) ProtoMessage() {}
+
+func (x *ExportTeachersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTeachersRequest.ProtoReflect.Descriptor instead.
+func (*ExportTeachersRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportTeachersRequest) GetQuery() *GetTeachersRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExportTeachersRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_CSV
+}
+
+func (x *ExportTeachersRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type Teacher struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// subject is the main subject of the teacher, the subjects taught in every class are kept by the teaching assignments
+	Subject string `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted teachers, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,9,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,13,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Teacher) Reset() {
+	*x = Teacher{}
+	mi := &file_main_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Teacher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Teacher) ProtoMessage() {}
+
+func (x *Teacher) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Teacher.ProtoReflect.Descriptor instead.
+func (*Teacher) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Teacher) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Teacher) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Teacher) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Teacher) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Teacher) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Teacher) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Teacher) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Teacher) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Teacher) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Teacher) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Teacher) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Teacher) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type Teachers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teachers      []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Teachers) Reset() {
+	*x = Teachers{}
+	mi := &file_main_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Teachers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Teachers) ProtoMessage() {}
+
+func (x *Teachers) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Teachers.ProtoReflect.Descriptor instead.
+func (*Teachers) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Teachers) GetTeachers() []*Teacher {
+	if x != nil {
+		return x.Teachers
+	}
+	return nil
+}
+
+// TeachingAssignment records that a teacher teaches a subject in a class, several teachers assigned to the same
+// class and subject co-teach it
+type TeachingAssignment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherId string                 `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// subject taught in the class, it may be left empty for homeroom assignments
+	Subject string       `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Role    TeachingRole `protobuf:"varint,5,opt,name=role,proto3,enum=main.TeachingRole" json:"role,omitempty"`
+	// version is increased on every change, send it back with a delete to detect concurrent edits
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted assignments, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,8,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeachersConfirmation) Reset() {
-	*x = DeleteTeachersConfirmation{}
-	mi := &file_main_proto_msgTypes[0]
+func (x *TeachingAssignment) Reset() {
+	*x = TeachingAssignment{}
+	mi := &file_main_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeachersConfirmation) String() string {
+func (x *TeachingAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeachersConfirmation) ProtoMessage() {}
+func (*TeachingAssignment) ProtoMessage() {}
 
-func (x *DeleteTeachersConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[0]
+func (x *TeachingAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,47 +1040,117 @@ func (x *DeleteTeachersConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeachersConfirmation.ProtoReflect.Descriptor instead.
-func (*DeleteTeachersConfirmation) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use TeachingAssignment.ProtoReflect.Descriptor instead.
+func (*TeachingAssignment) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DeleteTeachersConfirmation) GetStatus() string {
+func (x *TeachingAssignment) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteTeachersConfirmation) GetDeletedIds() []string {
+func (x *TeachingAssignment) GetTeacherId() string {
 	if x != nil {
-		return x.DeletedIds
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetRole() TeachingRole {
+	if x != nil {
+		return x.Role
+	}
+	return TeachingRole_SUBJECT_TEACHER
+}
+
+func (x *TeachingAssignment) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TeachingAssignment) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
 	}
 	return nil
 }
 
-type TeacherId struct {
+func (x *TeachingAssignment) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TeachingAssignment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TeachingAssignment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type TeachingAssignments struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Assignments   []*TeachingAssignment  `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TeacherId) Reset() {
-	*x = TeacherId{}
-	mi := &file_main_proto_msgTypes[1]
+func (x *TeachingAssignments) Reset() {
+	*x = TeachingAssignments{}
+	mi := &file_main_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TeacherId) String() string {
+func (x *TeachingAssignments) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TeacherId) ProtoMessage() {}
+func (*TeachingAssignments) ProtoMessage() {}
 
-func (x *TeacherId) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[1]
+func (x *TeachingAssignments) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,40 +1161,44 @@ func (x *TeacherId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TeacherId.ProtoReflect.Descriptor instead.
-func (*TeacherId) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TeachingAssignments.ProtoReflect.Descriptor instead.
+func (*TeachingAssignments) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TeacherId) GetId() string {
+func (x *TeachingAssignments) GetAssignments() []*TeachingAssignment {
 	if x != nil {
-		return x.Id
+		return x.Assignments
 	}
-	return ""
+	return nil
 }
 
-type TeacherIds struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []*TeacherId           `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+type AddTeachingAssignmentsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Assignments []*TeachingAssignment  `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	// ordered stops inserting at the first failing assignment, otherwise every valid assignment is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all assignments in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TeacherIds) Reset() {
-	*x = TeacherIds{}
-	mi := &file_main_proto_msgTypes[2]
+func (x *AddTeachingAssignmentsRequest) Reset() {
+	*x = AddTeachingAssignmentsRequest{}
+	mi := &file_main_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TeacherIds) String() string {
+func (x *AddTeachingAssignmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TeacherIds) ProtoMessage() {}
+func (*AddTeachingAssignmentsRequest) ProtoMessage() {}
 
-func (x *TeacherIds) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[2]
+func (x *AddTeachingAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,41 +1209,55 @@ func (x *TeacherIds) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TeacherIds.ProtoReflect.Descriptor instead.
-func (*TeacherIds) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use AddTeachingAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*AddTeachingAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TeacherIds) GetIds() []*TeacherId {
+func (x *AddTeachingAssignmentsRequest) GetAssignments() []*TeachingAssignment {
 	if x != nil {
-		return x.Ids
+		return x.Assignments
 	}
 	return nil
 }
 
-type GetTeachersRequest struct {
+func (x *AddTeachingAssignmentsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *AddTeachingAssignmentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddTeachingAssignmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teacher       *Teacher               `protobuf:"bytes,1,opt,name=teacher,proto3" json:"teacher,omitempty"`
-	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Assignments   []*TeachingAssignment  `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeachersRequest) Reset() {
-	*x = GetTeachersRequest{}
-	mi := &file_main_proto_msgTypes[3]
+func (x *AddTeachingAssignmentsResponse) Reset() {
+	*x = AddTeachingAssignmentsResponse{}
+	mi := &file_main_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeachersRequest) String() string {
+func (x *AddTeachingAssignmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeachersRequest) ProtoMessage() {}
+func (*AddTeachingAssignmentsResponse) ProtoMessage() {}
 
-func (x *GetTeachersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[3]
+func (x *AddTeachingAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,52 +1268,111 @@ func (x *GetTeachersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeachersRequest.ProtoReflect.Descriptor instead.
-func (*GetTeachersRequest) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AddTeachingAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*AddTeachingAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetTeachersRequest) GetTeacher() *Teacher {
+func (x *AddTeachingAssignmentsResponse) GetAssignments() []*TeachingAssignment {
 	if x != nil {
-		return x.Teacher
+		return x.Assignments
 	}
 	return nil
 }
 
-func (x *GetTeachersRequest) GetSortBy() []*SortField {
+func (x *AddTeachingAssignmentsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetTeachingAssignmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only assignments matching the non-empty fields of the filter are returned, the role cannot be filtered on
+	Assignment *TeachingAssignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	SortBy     []*SortField        `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted assignments
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTeachingAssignmentsRequest) Reset() {
+	*x = GetTeachingAssignmentsRequest{}
+	mi := &file_main_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeachingAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeachingAssignmentsRequest) ProtoMessage() {}
+
+func (x *GetTeachingAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeachingAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeachingAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTeachingAssignmentsRequest) GetAssignment() *TeachingAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *GetTeachingAssignmentsRequest) GetSortBy() []*SortField {
 	if x != nil {
 		return x.SortBy
 	}
 	return nil
 }
 
-type Teacher struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Class         string                 `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
-	Subject       string                 `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+func (x *GetTeachingAssignmentsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type TeachingAssignmentId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the assignment, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Teacher) Reset() {
-	*x = Teacher{}
-	mi := &file_main_proto_msgTypes[4]
+func (x *TeachingAssignmentId) Reset() {
+	*x = TeachingAssignmentId{}
+	mi := &file_main_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Teacher) String() string {
+func (x *TeachingAssignmentId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Teacher) ProtoMessage() {}
+func (*TeachingAssignmentId) ProtoMessage() {}
 
-func (x *Teacher) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[4]
+func (x *TeachingAssignmentId) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,75 +1383,101 @@ func (x *Teacher) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Teacher.ProtoReflect.Descriptor instead.
-func (*Teacher) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use TeachingAssignmentId.ProtoReflect.Descriptor instead.
+func (*TeachingAssignmentId) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Teacher) GetId() string {
+func (x *TeachingAssignmentId) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Teacher) GetFirstName() string {
+func (x *TeachingAssignmentId) GetVersion() int64 {
 	if x != nil {
-		return x.FirstName
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *Teacher) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+type DeleteTeachingAssignmentsRequest struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Ids   []*TeachingAssignmentId `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all assignments in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Teacher) GetEmail() string {
+func (x *DeleteTeachingAssignmentsRequest) Reset() {
+	*x = DeleteTeachingAssignmentsRequest{}
+	mi := &file_main_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeachingAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeachingAssignmentsRequest) ProtoMessage() {}
+
+func (x *DeleteTeachingAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[21]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Teacher) GetClass() string {
+// Deprecated: Use DeleteTeachingAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeachingAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteTeachingAssignmentsRequest) GetIds() []*TeachingAssignmentId {
 	if x != nil {
-		return x.Class
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *Teacher) GetSubject() string {
+func (x *DeleteTeachingAssignmentsRequest) GetAtomic() bool {
 	if x != nil {
-		return x.Subject
+		return x.Atomic
 	}
-	return ""
+	return false
 }
 
-type Teachers struct {
+type DeleteTeachingAssignmentsConfirmation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teachers      []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Teachers) Reset() {
-	*x = Teachers{}
-	mi := &file_main_proto_msgTypes[5]
+func (x *DeleteTeachingAssignmentsConfirmation) Reset() {
+	*x = DeleteTeachingAssignmentsConfirmation{}
+	mi := &file_main_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Teachers) String() string {
+func (x *DeleteTeachingAssignmentsConfirmation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Teachers) ProtoMessage() {}
+func (*DeleteTeachingAssignmentsConfirmation) ProtoMessage() {}
 
-func (x *Teachers) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[5]
+func (x *DeleteTeachingAssignmentsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,14 +1488,21 @@ func (x *Teachers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Teachers.ProtoReflect.Descriptor instead.
-func (*Teachers) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DeleteTeachingAssignmentsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteTeachingAssignmentsConfirmation) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Teachers) GetTeachers() []*Teacher {
+func (x *DeleteTeachingAssignmentsConfirmation) GetStatus() string {
 	if x != nil {
-		return x.Teachers
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteTeachingAssignmentsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
 	}
 	return nil
 }
@@ -347,36 +1512,142 @@ var File_main_proto protoreflect.FileDescriptor
 const file_main_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"main.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\x0estudents.proto\"U\n" +
+	"main.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x0estudents.proto\"q\n" +
+	"\x12AddTeachersRequest\x12)\n" +
+	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"\x97\x01\n" +
+	"\x15UpdateTeachersRequest\x12)\n" +
+	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"R\n" +
+	"\x15DeleteTeachersRequest\x12!\n" +
+	"\x03ids\x18\x01 \x03(\v2\x0f.main.TeacherIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"p\n" +
+	"\x13AddTeachersResponse\x12)\n" +
+	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"`\n" +
+	"\x14WatchTeachersRequest\x12%\n" +
+	"\x06filter\x18\x01 \x01(\v2\r.main.TeacherR\x06filter\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\xbd\x01\n" +
+	"\fTeacherEvent\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.main.ChangeTypeR\x04type\x12'\n" +
+	"\ateacher\x18\x02 \x01(\v2\r.main.TeacherR\ateacher\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"X\n" +
+	"\x1bRestoreTeachersConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\frestored_ids\x18\x02 \x03(\tR\vrestoredIds\"U\n" +
 	"\x1aDeleteTeachersConfirmation\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\"\x1b\n" +
+	"deletedIds\"5\n" +
 	"\tTeacherId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"/\n" +
 	"\n" +
 	"TeacherIds\x12!\n" +
-	"\x03ids\x18\x01 \x03(\v2\x0f.main.TeacherIdR\x03ids\"g\n" +
+	"\x03ids\x18\x01 \x03(\v2\x0f.main.TeacherIdR\x03ids\"E\n" +
+	"\x13ClassTeacherRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubjectJ\x04\b\x02\x10\x03\"\xca\x01\n" +
 	"\x12GetTeachersRequest\x12'\n" +
 	"\ateacher\x18\x01 \x01(\v2\r.main.TeacherR\ateacher\x12(\n" +
-	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\x9b\x01\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\x128\n" +
+	"\ftime_filters\x18\x04 \x03(\v2\x15.main.TimestampFilterR\vtimeFilters\"\x8d\x01\n" +
+	"\x15ExportTeachersRequest\x12.\n" +
+	"\x05query\x18\x01 \x01(\v2\x18.main.GetTeachersRequestR\x05query\x12*\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x12.main.ImportFormatR\x06format\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\"\xca\x03\n" +
 	"\aTeacher\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
-	"\x05class\x18\x05 \x01(\tR\x05class\x12\x18\n" +
-	"\asubject\x18\x06 \x01(\tR\asubject\"5\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x18\n" +
+	"\asubject\x18\x06 \x01(\tR\asubject\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\t \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\f \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\r \x01(\tR\tupdatedByJ\x04\b\x05\x10\x06J\x04\b\x0e\x10\x0fR\x05classR\bclass_id\"5\n" +
 	"\bTeachers\x12)\n" +
-	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers2\xf5\x02\n" +
+	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers\"\xc8\x03\n" +
+	"\x12TeachingAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tR\tteacherId\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\tR\aclassId\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12&\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x12.main.TeachingRoleR\x04role\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\b \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\"Q\n" +
+	"\x13TeachingAssignments\x12:\n" +
+	"\vassignments\x18\x01 \x03(\v2\x18.main.TeachingAssignmentR\vassignments\"\x8d\x01\n" +
+	"\x1dAddTeachingAssignmentsRequest\x12:\n" +
+	"\vassignments\x18\x01 \x03(\v2\x18.main.TeachingAssignmentR\vassignments\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"\x8c\x01\n" +
+	"\x1eAddTeachingAssignmentsResponse\x12:\n" +
+	"\vassignments\x18\x01 \x03(\v2\x18.main.TeachingAssignmentR\vassignments\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\xac\x01\n" +
+	"\x1dGetTeachingAssignmentsRequest\x128\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x18.main.TeachingAssignmentR\n" +
+	"assignment\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"@\n" +
+	"\x14TeachingAssignmentId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"h\n" +
+	" DeleteTeachingAssignmentsRequest\x12,\n" +
+	"\x03ids\x18\x01 \x03(\v2\x1a.main.TeachingAssignmentIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"`\n" +
+	"%DeleteTeachingAssignmentsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds*9\n" +
+	"\fTeachingRole\x12\x13\n" +
+	"\x0fSUBJECT_TEACHER\x10\x00\x12\x14\n" +
+	"\x10HOMEROOM_TEACHER\x10\x012\xf2\a\n" +
 	"\x0fTeachersService\x127\n" +
-	"\vGetTeachers\x12\x18.main.GetTeachersRequest\x1a\x0e.main.Teachers\x12-\n" +
-	"\vAddTeachers\x12\x0e.main.Teachers\x1a\x0e.main.Teachers\x120\n" +
-	"\x0eUpdateTeachers\x12\x0e.main.Teachers\x1a\x0e.main.Teachers\x12D\n" +
-	"\x0eDeleteTeachers\x12\x10.main.TeacherIds\x1a .main.DeleteTeachersConfirmation\x12<\n" +
-	"\x19GetStudentsByClassTeacher\x12\x0f.main.TeacherId\x1a\x0e.main.Students\x12D\n" +
-	"\x1dGetStudentCountByClassTeacher\x12\x0f.main.TeacherId\x1a\x12.main.StudentCountB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+	"\vGetTeachers\x12\x18.main.GetTeachersRequest\x1a\x0e.main.Teachers\x12B\n" +
+	"\vAddTeachers\x12\x18.main.AddTeachersRequest\x1a\x19.main.AddTeachersResponse\x12=\n" +
+	"\x0eUpdateTeachers\x12\x1b.main.UpdateTeachersRequest\x1a\x0e.main.Teachers\x12O\n" +
+	"\x0eDeleteTeachers\x12\x1b.main.DeleteTeachersRequest\x1a .main.DeleteTeachersConfirmation\x12F\n" +
+	"\x0fRestoreTeachers\x12\x10.main.TeacherIds\x1a!.main.RestoreTeachersConfirmation\x12A\n" +
+	"\rWatchTeachers\x12\x1a.main.WatchTeachersRequest\x1a\x12.main.TeacherEvent0\x01\x12:\n" +
+	"\x0eImportTeachers\x12\x11.main.ImportChunk\x1a\x13.main.ImportSummary(\x01\x12B\n" +
+	"\x0eExportTeachers\x12\x1b.main.ExportTeachersRequest\x1a\x11.main.ExportChunk0\x01\x12F\n" +
+	"\x19GetStudentsByClassTeacher\x12\x19.main.ClassTeacherRequest\x1a\x0e.main.Students\x12N\n" +
+	"\x1dGetStudentCountByClassTeacher\x12\x19.main.ClassTeacherRequest\x1a\x12.main.StudentCount\x12c\n" +
+	"\x16AddTeachingAssignments\x12#.main.AddTeachingAssignmentsRequest\x1a$.main.AddTeachingAssignmentsResponse\x12X\n" +
+	"\x16GetTeachingAssignments\x12#.main.GetTeachingAssignmentsRequest\x1a\x19.main.TeachingAssignments\x12p\n" +
+	"\x19DeleteTeachingAssignments\x12&.main.DeleteTeachingAssignmentsRequest\x1a+.main.DeleteTeachingAssignmentsConfirmationB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_main_proto_rawDescOnce sync.Once
@@ -390,40 +1661,109 @@ func file_main_proto_rawDescGZIP() []byte {
 	return file_main_proto_rawDescData
 }
 
-var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_main_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_main_proto_goTypes = []any{
-	(*DeleteTeachersConfirmation)(nil), // 0: main.DeleteTeachersConfirmation
-	(*TeacherId)(nil),                  // 1: main.TeacherId
-	(*TeacherIds)(nil),                 // 2: main.TeacherIds
-	(*GetTeachersRequest)(nil),         // 3: main.GetTeachersRequest
-	(*Teacher)(nil),                    // 4: main.Teacher
-	(*Teachers)(nil),                   // 5: main.Teachers
-	(*SortField)(nil),                  // 6: main.SortField
-	(*Students)(nil),                   // 7: main.Students
-	(*StudentCount)(nil),               // 8: main.StudentCount
+	(TeachingRole)(0),                             // 0: main.TeachingRole
+	(*AddTeachersRequest)(nil),                    // 1: main.AddTeachersRequest
+	(*UpdateTeachersRequest)(nil),                 // 2: main.UpdateTeachersRequest
+	(*DeleteTeachersRequest)(nil),                 // 3: main.DeleteTeachersRequest
+	(*AddTeachersResponse)(nil),                   // 4: main.AddTeachersResponse
+	(*WatchTeachersRequest)(nil),                  // 5: main.WatchTeachersRequest
+	(*TeacherEvent)(nil),                          // 6: main.TeacherEvent
+	(*RestoreTeachersConfirmation)(nil),           // 7: main.RestoreTeachersConfirmation
+	(*DeleteTeachersConfirmation)(nil),            // 8: main.DeleteTeachersConfirmation
+	(*TeacherId)(nil),                             // 9: main.TeacherId
+	(*TeacherIds)(nil),                            // 10: main.TeacherIds
+	(*ClassTeacherRequest)(nil),                   // 11: main.ClassTeacherRequest
+	(*GetTeachersRequest)(nil),                    // 12: main.GetTeachersRequest
+	(*ExportTeachersRequest)(nil),                 // 13: main.ExportTeachersRequest
+	(*Teacher)(nil),                               // 14: main.Teacher
+	(*Teachers)(nil),                              // 15: main.Teachers
+	(*TeachingAssignment)(nil),                    // 16: main.TeachingAssignment
+	(*TeachingAssignments)(nil),                   // 17: main.TeachingAssignments
+	(*AddTeachingAssignmentsRequest)(nil),         // 18: main.AddTeachingAssignmentsRequest
+	(*AddTeachingAssignmentsResponse)(nil),        // 19: main.AddTeachingAssignmentsResponse
+	(*GetTeachingAssignmentsRequest)(nil),         // 20: main.GetTeachingAssignmentsRequest
+	(*TeachingAssignmentId)(nil),                  // 21: main.TeachingAssignmentId
+	(*DeleteTeachingAssignmentsRequest)(nil),      // 22: main.DeleteTeachingAssignmentsRequest
+	(*DeleteTeachingAssignmentsConfirmation)(nil), // 23: main.DeleteTeachingAssignmentsConfirmation
+	(*fieldmaskpb.FieldMask)(nil),                 // 24: google.protobuf.FieldMask
+	(*BulkItemResult)(nil),                        // 25: main.BulkItemResult
+	(ChangeType)(0),                               // 26: main.ChangeType
+	(*timestamppb.Timestamp)(nil),                 // 27: google.protobuf.Timestamp
+	(*SortField)(nil),                             // 28: main.SortField
+	(*TimestampFilter)(nil),                       // 29: main.TimestampFilter
+	(ImportFormat)(0),                             // 30: main.ImportFormat
+	(*ImportChunk)(nil),                           // 31: main.ImportChunk
+	(*ImportSummary)(nil),                         // 32: main.ImportSummary
+	(*ExportChunk)(nil),                           // 33: main.ExportChunk
+	(*Students)(nil),                              // 34: main.Students
+	(*StudentCount)(nil),                          // 35: main.StudentCount
 }
 var file_main_proto_depIdxs = []int32{
-	1,  // 0: main.TeacherIds.ids:type_name -> main.TeacherId
-	4,  // 1: main.GetTeachersRequest.teacher:type_name -> main.Teacher
-	6,  // 2: main.GetTeachersRequest.sort_by:type_name -> main.SortField
-	4,  // 3: main.Teachers.teachers:type_name -> main.Teacher
-	3,  // 4: main.TeachersService.GetTeachers:input_type -> main.GetTeachersRequest
-	5,  // 5: main.TeachersService.AddTeachers:input_type -> main.Teachers
-	5,  // 6: main.TeachersService.UpdateTeachers:input_type -> main.Teachers
-	2,  // 7: main.TeachersService.DeleteTeachers:input_type -> main.TeacherIds
-	1,  // 8: main.TeachersService.GetStudentsByClassTeacher:input_type -> main.TeacherId
-	1,  // 9: main.TeachersService.GetStudentCountByClassTeacher:input_type -> main.TeacherId
-	5,  // 10: main.TeachersService.GetTeachers:output_type -> main.Teachers
-	5,  // 11: main.TeachersService.AddTeachers:output_type -> main.Teachers
-	5,  // 12: main.TeachersService.UpdateTeachers:output_type -> main.Teachers
-	0,  // 13: main.TeachersService.DeleteTeachers:output_type -> main.DeleteTeachersConfirmation
-	7,  // 14: main.TeachersService.GetStudentsByClassTeacher:output_type -> main.Students
-	8,  // 15: main.TeachersService.GetStudentCountByClassTeacher:output_type -> main.StudentCount
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	14, // 0: main.AddTeachersRequest.teachers:type_name -> main.Teacher
+	14, // 1: main.UpdateTeachersRequest.teachers:type_name -> main.Teacher
+	24, // 2: main.UpdateTeachersRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,  // 3: main.DeleteTeachersRequest.ids:type_name -> main.TeacherId
+	14, // 4: main.AddTeachersResponse.teachers:type_name -> main.Teacher
+	25, // 5: main.AddTeachersResponse.results:type_name -> main.BulkItemResult
+	14, // 6: main.WatchTeachersRequest.filter:type_name -> main.Teacher
+	26, // 7: main.TeacherEvent.type:type_name -> main.ChangeType
+	14, // 8: main.TeacherEvent.teacher:type_name -> main.Teacher
+	27, // 9: main.TeacherEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	9,  // 10: main.TeacherIds.ids:type_name -> main.TeacherId
+	14, // 11: main.GetTeachersRequest.teacher:type_name -> main.Teacher
+	28, // 12: main.GetTeachersRequest.sort_by:type_name -> main.SortField
+	29, // 13: main.GetTeachersRequest.time_filters:type_name -> main.TimestampFilter
+	12, // 14: main.ExportTeachersRequest.query:type_name -> main.GetTeachersRequest
+	30, // 15: main.ExportTeachersRequest.format:type_name -> main.ImportFormat
+	27, // 16: main.Teacher.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 17: main.Teacher.created_at:type_name -> google.protobuf.Timestamp
+	27, // 18: main.Teacher.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 19: main.Teachers.teachers:type_name -> main.Teacher
+	0,  // 20: main.TeachingAssignment.role:type_name -> main.TeachingRole
+	27, // 21: main.TeachingAssignment.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 22: main.TeachingAssignment.created_at:type_name -> google.protobuf.Timestamp
+	27, // 23: main.TeachingAssignment.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 24: main.TeachingAssignments.assignments:type_name -> main.TeachingAssignment
+	16, // 25: main.AddTeachingAssignmentsRequest.assignments:type_name -> main.TeachingAssignment
+	16, // 26: main.AddTeachingAssignmentsResponse.assignments:type_name -> main.TeachingAssignment
+	25, // 27: main.AddTeachingAssignmentsResponse.results:type_name -> main.BulkItemResult
+	16, // 28: main.GetTeachingAssignmentsRequest.assignment:type_name -> main.TeachingAssignment
+	28, // 29: main.GetTeachingAssignmentsRequest.sort_by:type_name -> main.SortField
+	21, // 30: main.DeleteTeachingAssignmentsRequest.ids:type_name -> main.TeachingAssignmentId
+	12, // 31: main.TeachersService.GetTeachers:input_type -> main.GetTeachersRequest
+	1,  // 32: main.TeachersService.AddTeachers:input_type -> main.AddTeachersRequest
+	2,  // 33: main.TeachersService.UpdateTeachers:input_type -> main.UpdateTeachersRequest
+	3,  // 34: main.TeachersService.DeleteTeachers:input_type -> main.DeleteTeachersRequest
+	10, // 35: main.TeachersService.RestoreTeachers:input_type -> main.TeacherIds
+	5,  // 36: main.TeachersService.WatchTeachers:input_type -> main.WatchTeachersRequest
+	31, // 37: main.TeachersService.ImportTeachers:input_type -> main.ImportChunk
+	13, // 38: main.TeachersService.ExportTeachers:input_type -> main.ExportTeachersRequest
+	11, // 39: main.TeachersService.GetStudentsByClassTeacher:input_type -> main.ClassTeacherRequest
+	11, // 40: main.TeachersService.GetStudentCountByClassTeacher:input_type -> main.ClassTeacherRequest
+	18, // 41: main.TeachersService.AddTeachingAssignments:input_type -> main.AddTeachingAssignmentsRequest
+	20, // 42: main.TeachersService.GetTeachingAssignments:input_type -> main.GetTeachingAssignmentsRequest
+	22, // 43: main.TeachersService.DeleteTeachingAssignments:input_type -> main.DeleteTeachingAssignmentsRequest
+	15, // 44: main.TeachersService.GetTeachers:output_type -> main.Teachers
+	4,  // 45: main.TeachersService.AddTeachers:output_type -> main.AddTeachersResponse
+	15, // 46: main.TeachersService.UpdateTeachers:output_type -> main.Teachers
+	8,  // 47: main.TeachersService.DeleteTeachers:output_type -> main.DeleteTeachersConfirmation
+	7,  // 48: main.TeachersService.RestoreTeachers:output_type -> main.RestoreTeachersConfirmation
+	6,  // 49: main.TeachersService.WatchTeachers:output_type -> main.TeacherEvent
+	32, // 50: main.TeachersService.ImportTeachers:output_type -> main.ImportSummary
+	33, // 51: main.TeachersService.ExportTeachers:output_type -> main.ExportChunk
+	34, // 52: main.TeachersService.GetStudentsByClassTeacher:output_type -> main.Students
+	35, // 53: main.TeachersService.GetStudentCountByClassTeacher:output_type -> main.StudentCount
+	19, // 54: main.TeachersService.AddTeachingAssignments:output_type -> main.AddTeachingAssignmentsResponse
+	17, // 55: main.TeachersService.GetTeachingAssignments:output_type -> main.TeachingAssignments
+	23, // 56: main.TeachersService.DeleteTeachingAssignments:output_type -> main.DeleteTeachingAssignmentsConfirmation
+	44, // [44:57] is the sub-list for method output_type
+	31, // [31:44] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_main_proto_init() }
@@ -437,13 +1777,14 @@ func file_main_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_main_proto_rawDesc), len(file_main_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_main_proto_goTypes,
 		DependencyIndexes: file_main_proto_depIdxs,
+		EnumInfos:         file_main_proto_enumTypes,
 		MessageInfos:      file_main_proto_msgTypes,
 	}.Build()
 	File_main_proto = out.File
@@ -23,8 +23,15 @@ const (
 	TeachersService_AddTeachers_FullMethodName                   = "/main.TeachersService/AddTeachers"
 	TeachersService_UpdateTeachers_FullMethodName                = "/main.TeachersService/UpdateTeachers"
 	TeachersService_DeleteTeachers_FullMethodName                = "/main.TeachersService/DeleteTeachers"
+	TeachersService_RestoreTeachers_FullMethodName               = "/main.TeachersService/RestoreTeachers"
+	TeachersService_WatchTeachers_FullMethodName                 = "/main.TeachersService/WatchTeachers"
+	TeachersService_ImportTeachers_FullMethodName                = "/main.TeachersService/ImportTeachers"
+	TeachersService_ExportTeachers_FullMethodName                = "/main.TeachersService/ExportTeachers"
 	TeachersService_GetStudentsByClassTeacher_FullMethodName     = "/main.TeachersService/GetStudentsByClassTeacher"
 	TeachersService_GetStudentCountByClassTeacher_FullMethodName = "/main.TeachersService/GetStudentCountByClassTeacher"
+	TeachersService_AddTeachingAssignments_FullMethodName        = "/main.TeachersService/AddTeachingAssignments"
+	TeachersService_GetTeachingAssignments_FullMethodName        = "/main.TeachersService/GetTeachingAssignments"
+	TeachersService_DeleteTeachingAssignments_FullMethodName     = "/main.TeachersService/DeleteTeachingAssignments"
 )
 
 // TeachersServiceClient is the client API for TeachersService service.
@@ -36,15 +43,29 @@ type TeachersServiceClient interface {
 	// GetTeachers retrieves a list of teachers based on the provided criteria
 	GetTeachers(ctx context.Context, in *GetTeachersRequest, opts ...grpc.CallOption) (*Teachers, error)
 	// AddTeachers adds new teachers to the system
-	AddTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error)
+	AddTeachers(ctx context.Context, in *AddTeachersRequest, opts ...grpc.CallOption) (*AddTeachersResponse, error)
 	// UpdateTeachers updates existing teacher information
-	UpdateTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error)
+	UpdateTeachers(ctx context.Context, in *UpdateTeachersRequest, opts ...grpc.CallOption) (*Teachers, error)
 	// DeleteTeachers removes teachers from the system by their IDs
-	DeleteTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*DeleteTeachersConfirmation, error)
-	// GetStudentsByClassTeacher retrieves all students for a specific class teacher
-	GetStudentsByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*Students, error)
-	// GetStudentCountByClassTeacher returns the total number of students for a class teacher
-	GetStudentCountByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*StudentCount, error)
+	DeleteTeachers(ctx context.Context, in *DeleteTeachersRequest, opts ...grpc.CallOption) (*DeleteTeachersConfirmation, error)
+	// RestoreTeachers brings back soft deleted teachers by their IDs
+	RestoreTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*RestoreTeachersConfirmation, error)
+	// WatchTeachers streams every change to the teachers matching the filter as it happens
+	WatchTeachers(ctx context.Context, in *WatchTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TeacherEvent], error)
+	// ImportTeachers adds or updates teachers from a CSV or NDJSON file streamed in chunks
+	ImportTeachers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportChunk, ImportSummary], error)
+	// ExportTeachers streams the teachers matching the criteria as a CSV or NDJSON file in chunks
+	ExportTeachers(ctx context.Context, in *ExportTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// GetStudentsByClassTeacher retrieves the students of every class a teacher is assigned to
+	GetStudentsByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*Students, error)
+	// GetStudentCountByClassTeacher returns the number of students in every class a teacher is assigned to
+	GetStudentCountByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*StudentCount, error)
+	// AddTeachingAssignments assigns teachers to the classes and subjects they teach
+	AddTeachingAssignments(ctx context.Context, in *AddTeachingAssignmentsRequest, opts ...grpc.CallOption) (*AddTeachingAssignmentsResponse, error)
+	// GetTeachingAssignments retrieves a list of teaching assignments based on the provided criteria
+	GetTeachingAssignments(ctx context.Context, in *GetTeachingAssignmentsRequest, opts ...grpc.CallOption) (*TeachingAssignments, error)
+	// DeleteTeachingAssignments removes teaching assignments by their IDs
+	DeleteTeachingAssignments(ctx context.Context, in *DeleteTeachingAssignmentsRequest, opts ...grpc.CallOption) (*DeleteTeachingAssignmentsConfirmation, error)
 }
 
 type teachersServiceClient struct {
@@ -65,9 +86,9 @@ func (c *teachersServiceClient) GetTeachers(ctx context.Context, in *GetTeachers
 	return out, nil
 }
 
-func (c *teachersServiceClient) AddTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error) {
+func (c *teachersServiceClient) AddTeachers(ctx context.Context, in *AddTeachersRequest, opts ...grpc.CallOption) (*AddTeachersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Teachers)
+	out := new(AddTeachersResponse)
 	err := c.cc.Invoke(ctx, TeachersService_AddTeachers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -75,7 +96,7 @@ func (c *teachersServiceClient) AddTeachers(ctx context.Context, in *Teachers, o
 	return out, nil
 }
 
-func (c *teachersServiceClient) UpdateTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error) {
+func (c *teachersServiceClient) UpdateTeachers(ctx context.Context, in *UpdateTeachersRequest, opts ...grpc.CallOption) (*Teachers, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Teachers)
 	err := c.cc.Invoke(ctx, TeachersService_UpdateTeachers_FullMethodName, in, out, cOpts...)
@@ -85,7 +106,7 @@ func (c *teachersServiceClient) UpdateTeachers(ctx context.Context, in *Teachers
 	return out, nil
 }
 
-func (c *teachersServiceClient) DeleteTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*DeleteTeachersConfirmation, error) {
+func (c *teachersServiceClient) DeleteTeachers(ctx context.Context, in *DeleteTeachersRequest, opts ...grpc.CallOption) (*DeleteTeachersConfirmation, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteTeachersConfirmation)
 	err := c.cc.Invoke(ctx, TeachersService_DeleteTeachers_FullMethodName, in, out, cOpts...)
@@ -95,7 +116,68 @@ func (c *teachersServiceClient) DeleteTeachers(ctx context.Context, in *TeacherI
 	return out, nil
 }
 
-func (c *teachersServiceClient) GetStudentsByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*Students, error) {
+func (c *teachersServiceClient) RestoreTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*RestoreTeachersConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTeachersConfirmation)
+	err := c.cc.Invoke(ctx, TeachersService_RestoreTeachers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teachersServiceClient) WatchTeachers(ctx context.Context, in *WatchTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TeacherEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TeachersService_ServiceDesc.Streams[0], TeachersService_WatchTeachers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTeachersRequest, TeacherEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_WatchTeachersClient = grpc.ServerStreamingClient[TeacherEvent]
+
+func (c *teachersServiceClient) ImportTeachers(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportChunk, ImportSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TeachersService_ServiceDesc.Streams[1], TeachersService_ImportTeachers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportChunk, ImportSummary]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ImportTeachersClient = grpc.ClientStreamingClient[ImportChunk, ImportSummary]
+
+func (c *teachersServiceClient) ExportTeachers(ctx context.Context, in *ExportTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TeachersService_ServiceDesc.Streams[2], TeachersService_ExportTeachers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTeachersRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ExportTeachersClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *teachersServiceClient) GetStudentsByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*Students, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Students)
 	err := c.cc.Invoke(ctx, TeachersService_GetStudentsByClassTeacher_FullMethodName, in, out, cOpts...)
@@ -105,7 +187,7 @@ func (c *teachersServiceClient) GetStudentsByClassTeacher(ctx context.Context, i
 	return out, nil
 }
 
-func (c *teachersServiceClient) GetStudentCountByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*StudentCount, error) {
+func (c *teachersServiceClient) GetStudentCountByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*StudentCount, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StudentCount)
 	err := c.cc.Invoke(ctx, TeachersService_GetStudentCountByClassTeacher_FullMethodName, in, out, cOpts...)
@@ -115,6 +197,36 @@ func (c *teachersServiceClient) GetStudentCountByClassTeacher(ctx context.Contex
 	return out, nil
 }
 
+func (c *teachersServiceClient) AddTeachingAssignments(ctx context.Context, in *AddTeachingAssignmentsRequest, opts ...grpc.CallOption) (*AddTeachingAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeachingAssignmentsResponse)
+	err := c.cc.Invoke(ctx, TeachersService_AddTeachingAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teachersServiceClient) GetTeachingAssignments(ctx context.Context, in *GetTeachingAssignmentsRequest, opts ...grpc.CallOption) (*TeachingAssignments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeachingAssignments)
+	err := c.cc.Invoke(ctx, TeachersService_GetTeachingAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teachersServiceClient) DeleteTeachingAssignments(ctx context.Context, in *DeleteTeachingAssignmentsRequest, opts ...grpc.CallOption) (*DeleteTeachingAssignmentsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeachingAssignmentsConfirmation)
+	err := c.cc.Invoke(ctx, TeachersService_DeleteTeachingAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TeachersServiceServer is the server API for TeachersService service.
 // All implementations must embed UnimplementedTeachersServiceServer
 // for forward compatibility.
@@ -124,15 +236,29 @@ type TeachersServiceServer interface {
 	// GetTeachers retrieves a list of teachers based on the provided criteria
 	GetTeachers(context.Context, *GetTeachersRequest) (*Teachers, error)
 	// AddTeachers adds new teachers to the system
-	AddTeachers(context.Context, *Teachers) (*Teachers, error)
+	AddTeachers(context.Context, *AddTeachersRequest) (*AddTeachersResponse, error)
 	// UpdateTeachers updates existing teacher information
-	UpdateTeachers(context.Context, *Teachers) (*Teachers, error)
+	UpdateTeachers(context.Context, *UpdateTeachersRequest) (*Teachers, error)
 	// DeleteTeachers removes teachers from the system by their IDs
-	DeleteTeachers(context.Context, *TeacherIds) (*DeleteTeachersConfirmation, error)
-	// GetStudentsByClassTeacher retrieves all students for a specific class teacher
-	GetStudentsByClassTeacher(context.Context, *TeacherId) (*Students, error)
-	// GetStudentCountByClassTeacher returns the total number of students for a class teacher
-	GetStudentCountByClassTeacher(context.Context, *TeacherId) (*StudentCount, error)
+	DeleteTeachers(context.Context, *DeleteTeachersRequest) (*DeleteTeachersConfirmation, error)
+	// RestoreTeachers brings back soft deleted teachers by their IDs
+	RestoreTeachers(context.Context, *TeacherIds) (*RestoreTeachersConfirmation, error)
+	// WatchTeachers streams every change to the teachers matching the filter as it happens
+	WatchTeachers(*WatchTeachersRequest, grpc.ServerStreamingServer[TeacherEvent]) error
+	// ImportTeachers adds or updates teachers from a CSV or NDJSON file streamed in chunks
+	ImportTeachers(grpc.ClientStreamingServer[ImportChunk, ImportSummary]) error
+	// ExportTeachers streams the teachers matching the criteria as a CSV or NDJSON file in chunks
+	ExportTeachers(*ExportTeachersRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// GetStudentsByClassTeacher retrieves the students of every class a teacher is assigned to
+	GetStudentsByClassTeacher(context.Context, *ClassTeacherRequest) (*Students, error)
+	// GetStudentCountByClassTeacher returns the number of students in every class a teacher is assigned to
+	GetStudentCountByClassTeacher(context.Context, *ClassTeacherRequest) (*StudentCount, error)
+	// AddTeachingAssignments assigns teachers to the classes and subjects they teach
+	AddTeachingAssignments(context.Context, *AddTeachingAssignmentsRequest) (*AddTeachingAssignmentsResponse, error)
+	// GetTeachingAssignments retrieves a list of teaching assignments based on the provided criteria
+	GetTeachingAssignments(context.Context, *GetTeachingAssignmentsRequest) (*TeachingAssignments, error)
+	// DeleteTeachingAssignments removes teaching assignments by their IDs
+	DeleteTeachingAssignments(context.Context, *DeleteTeachingAssignmentsRequest) (*DeleteTeachingAssignmentsConfirmation, error)
 	mustEmbedUnimplementedTeachersServiceServer()
 }
 
@@ -146,21 +272,42 @@ type UnimplementedTeachersServiceServer struct{}
 func (UnimplementedTeachersServiceServer) GetTeachers(context.Context, *GetTeachersRequest) (*Teachers, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTeachers not implemented")
 }
-func (UnimplementedTeachersServiceServer) AddTeachers(context.Context, *Teachers) (*Teachers, error) {
+func (UnimplementedTeachersServiceServer) AddTeachers(context.Context, *AddTeachersRequest) (*AddTeachersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddTeachers not implemented")
 }
-func (UnimplementedTeachersServiceServer) UpdateTeachers(context.Context, *Teachers) (*Teachers, error) {
+func (UnimplementedTeachersServiceServer) UpdateTeachers(context.Context, *UpdateTeachersRequest) (*Teachers, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateTeachers not implemented")
 }
-func (UnimplementedTeachersServiceServer) DeleteTeachers(context.Context, *TeacherIds) (*DeleteTeachersConfirmation, error) {
+func (UnimplementedTeachersServiceServer) DeleteTeachers(context.Context, *DeleteTeachersRequest) (*DeleteTeachersConfirmation, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTeachers not implemented")
 }
-func (UnimplementedTeachersServiceServer) GetStudentsByClassTeacher(context.Context, *TeacherId) (*Students, error) {
+func (UnimplementedTeachersServiceServer) RestoreTeachers(context.Context, *TeacherIds) (*RestoreTeachersConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTeachers not implemented")
+}
+func (UnimplementedTeachersServiceServer) WatchTeachers(*WatchTeachersRequest, grpc.ServerStreamingServer[TeacherEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchTeachers not implemented")
+}
+func (UnimplementedTeachersServiceServer) ImportTeachers(grpc.ClientStreamingServer[ImportChunk, ImportSummary]) error {
+	return status.Error(codes.Unimplemented, "method ImportTeachers not implemented")
+}
+func (UnimplementedTeachersServiceServer) ExportTeachers(*ExportTeachersRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportTeachers not implemented")
+}
+func (UnimplementedTeachersServiceServer) GetStudentsByClassTeacher(context.Context, *ClassTeacherRequest) (*Students, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStudentsByClassTeacher not implemented")
 }
-func (UnimplementedTeachersServiceServer) GetStudentCountByClassTeacher(context.Context, *TeacherId) (*StudentCount, error) {
+func (UnimplementedTeachersServiceServer) GetStudentCountByClassTeacher(context.Context, *ClassTeacherRequest) (*StudentCount, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStudentCountByClassTeacher not implemented")
 }
+func (UnimplementedTeachersServiceServer) AddTeachingAssignments(context.Context, *AddTeachingAssignmentsRequest) (*AddTeachingAssignmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTeachingAssignments not implemented")
+}
+func (UnimplementedTeachersServiceServer) GetTeachingAssignments(context.Context, *GetTeachingAssignmentsRequest) (*TeachingAssignments, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeachingAssignments not implemented")
+}
+func (UnimplementedTeachersServiceServer) DeleteTeachingAssignments(context.Context, *DeleteTeachingAssignmentsRequest) (*DeleteTeachingAssignmentsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTeachingAssignments not implemented")
+}
 func (UnimplementedTeachersServiceServer) mustEmbedUnimplementedTeachersServiceServer() {}
 func (UnimplementedTeachersServiceServer) testEmbeddedByValue()                         {}
 
@@ -201,7 +348,7 @@ func _TeachersService_GetTeachers_Handler(srv interface{}, ctx context.Context,
 }
 
 func _TeachersService_AddTeachers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Teachers)
+	in := new(AddTeachersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -213,13 +360,13 @@ func _TeachersService_AddTeachers_Handler(srv interface{}, ctx context.Context,
 		FullMethod: TeachersService_AddTeachers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeachersServiceServer).AddTeachers(ctx, req.(*Teachers))
+		return srv.(TeachersServiceServer).AddTeachers(ctx, req.(*AddTeachersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TeachersService_UpdateTeachers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Teachers)
+	in := new(UpdateTeachersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -231,13 +378,13 @@ func _TeachersService_UpdateTeachers_Handler(srv interface{}, ctx context.Contex
 		FullMethod: TeachersService_UpdateTeachers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeachersServiceServer).UpdateTeachers(ctx, req.(*Teachers))
+		return srv.(TeachersServiceServer).UpdateTeachers(ctx, req.(*UpdateTeachersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TeachersService_DeleteTeachers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TeacherIds)
+	in := new(DeleteTeachersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -249,13 +396,60 @@ func _TeachersService_DeleteTeachers_Handler(srv interface{}, ctx context.Contex
 		FullMethod: TeachersService_DeleteTeachers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeachersServiceServer).DeleteTeachers(ctx, req.(*TeacherIds))
+		return srv.(TeachersServiceServer).DeleteTeachers(ctx, req.(*DeleteTeachersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeachersService_RestoreTeachers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).RestoreTeachers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_RestoreTeachers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).RestoreTeachers(ctx, req.(*TeacherIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_WatchTeachers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTeachersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TeachersServiceServer).WatchTeachers(m, &grpc.GenericServerStream[WatchTeachersRequest, TeacherEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_WatchTeachersServer = grpc.ServerStreamingServer[TeacherEvent]
+
+func _TeachersService_ImportTeachers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TeachersServiceServer).ImportTeachers(&grpc.GenericServerStream[ImportChunk, ImportSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ImportTeachersServer = grpc.ClientStreamingServer[ImportChunk, ImportSummary]
+
+func _TeachersService_ExportTeachers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTeachersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TeachersServiceServer).ExportTeachers(m, &grpc.GenericServerStream[ExportTeachersRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeachersService_ExportTeachersServer = grpc.ServerStreamingServer[ExportChunk]
+
 func _TeachersService_GetStudentsByClassTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TeacherId)
+	in := new(ClassTeacherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -267,13 +461,13 @@ func _TeachersService_GetStudentsByClassTeacher_Handler(srv interface{}, ctx con
 		FullMethod: TeachersService_GetStudentsByClassTeacher_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeachersServiceServer).GetStudentsByClassTeacher(ctx, req.(*TeacherId))
+		return srv.(TeachersServiceServer).GetStudentsByClassTeacher(ctx, req.(*ClassTeacherRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TeachersService_GetStudentCountByClassTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TeacherId)
+	in := new(ClassTeacherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -285,7 +479,61 @@ func _TeachersService_GetStudentCountByClassTeacher_Handler(srv interface{}, ctx
 		FullMethod: TeachersService_GetStudentCountByClassTeacher_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeachersServiceServer).GetStudentCountByClassTeacher(ctx, req.(*TeacherId))
+		return srv.(TeachersServiceServer).GetStudentCountByClassTeacher(ctx, req.(*ClassTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_AddTeachingAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeachingAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).AddTeachingAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_AddTeachingAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).AddTeachingAssignments(ctx, req.(*AddTeachingAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_GetTeachingAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeachingAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).GetTeachingAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_GetTeachingAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).GetTeachingAssignments(ctx, req.(*GetTeachingAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_DeleteTeachingAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeachingAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).DeleteTeachingAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_DeleteTeachingAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).DeleteTeachingAssignments(ctx, req.(*DeleteTeachingAssignmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -313,6 +561,10 @@ var TeachersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTeachers",
 			Handler:    _TeachersService_DeleteTeachers_Handler,
 		},
+		{
+			MethodName: "RestoreTeachers",
+			Handler:    _TeachersService_RestoreTeachers_Handler,
+		},
 		{
 			MethodName: "GetStudentsByClassTeacher",
 			Handler:    _TeachersService_GetStudentsByClassTeacher_Handler,
@@ -321,7 +573,35 @@ var TeachersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStudentCountByClassTeacher",
 			Handler:    _TeachersService_GetStudentCountByClassTeacher_Handler,
 		},
+		{
+			MethodName: "AddTeachingAssignments",
+			Handler:    _TeachersService_AddTeachingAssignments_Handler,
+		},
+		{
+			MethodName: "GetTeachingAssignments",
+			Handler:    _TeachersService_GetTeachingAssignments_Handler,
+		},
+		{
+			MethodName: "DeleteTeachingAssignments",
+			Handler:    _TeachersService_DeleteTeachingAssignments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTeachers",
+			Handler:       _TeachersService_WatchTeachers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportTeachers",
+			Handler:       _TeachersService_ImportTeachers_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportTeachers",
+			Handler:       _TeachersService_ExportTeachers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
 }
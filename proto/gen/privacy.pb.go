@@ -0,0 +1,752 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: privacy.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PersonType int32
+
+const (
+	PersonType_STUDENT PersonType = 0
+	PersonType_TEACHER PersonType = 1
+	PersonType_EXEC    PersonType = 2
+	// GUARDIAN is only served with the mongodb storage backend, which keeps guardians
+	PersonType_GUARDIAN PersonType = 3
+)
+
+// Enum value maps for PersonType.
+var (
+	PersonType_name = map[int32]string{
+		0: "STUDENT",
+		1: "TEACHER",
+		2: "EXEC",
+		3: "GUARDIAN",
+	}
+	PersonType_value = map[string]int32{
+		"STUDENT":  0,
+		"TEACHER":  1,
+		"EXEC":     2,
+		"GUARDIAN": 3,
+	}
+)
+
+func (x PersonType) Enum() *PersonType {
+	p := new(PersonType)
+	*p = x
+	return p
+}
+
+func (x PersonType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PersonType) Descriptor() protoreflect.EnumDescriptor {
+	return file_privacy_proto_enumTypes[0].Descriptor()
+}
+
+func (PersonType) Type() protoreflect.EnumType {
+	return &file_privacy_proto_enumTypes[0]
+}
+
+func (x PersonType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PersonType.Descriptor instead.
+func (PersonType) EnumDescriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{0}
+}
+
+type ErasureMode int32
+
+const (
+	// ANONYMIZE replaces the personal fields of the person's own record and keeps the record
+	ErasureMode_ANONYMIZE ErasureMode = 0
+	// DELETE removes the person's own record permanently
+	ErasureMode_DELETE ErasureMode = 1
+)
+
+// Enum value maps for ErasureMode.
+var (
+	ErasureMode_name = map[int32]string{
+		0: "ANONYMIZE",
+		1: "DELETE",
+	}
+	ErasureMode_value = map[string]int32{
+		"ANONYMIZE": 0,
+		"DELETE":    1,
+	}
+)
+
+func (x ErasureMode) Enum() *ErasureMode {
+	p := new(ErasureMode)
+	*p = x
+	return p
+}
+
+func (x ErasureMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErasureMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_privacy_proto_enumTypes[1].Descriptor()
+}
+
+func (ErasureMode) Type() protoreflect.EnumType {
+	return &file_privacy_proto_enumTypes[1]
+}
+
+func (x ErasureMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErasureMode.Descriptor instead.
+func (ErasureMode) EnumDescriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{1}
+}
+
+type PersonRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PersonType             `protobuf:"varint,1,opt,name=type,proto3,enum=main.PersonType" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonRef) Reset() {
+	*x = PersonRef{}
+	mi := &file_privacy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRef) ProtoMessage() {}
+
+func (x *PersonRef) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRef.ProtoReflect.Descriptor instead.
+func (*PersonRef) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PersonRef) GetType() PersonType {
+	if x != nil {
+		return x.Type
+	}
+	return PersonType_STUDENT
+}
+
+func (x *PersonRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PersonDataBundle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *PersonRef             `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Records       []*PersonRecord        `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonDataBundle) Reset() {
+	*x = PersonDataBundle{}
+	mi := &file_privacy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonDataBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonDataBundle) ProtoMessage() {}
+
+func (x *PersonDataBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonDataBundle.ProtoReflect.Descriptor instead.
+func (*PersonDataBundle) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PersonDataBundle) GetPerson() *PersonRef {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *PersonDataBundle) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *PersonDataBundle) GetRecords() []*PersonRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// PersonRecord is a stored record that references the person
+type PersonRecord struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Collection string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Id         string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// fields holding the person's ID, empty for the person's own record
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// document is the stored record as relaxed extended JSON, credentials are never included
+	Document      string `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonRecord) Reset() {
+	*x = PersonRecord{}
+	mi := &file_privacy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRecord) ProtoMessage() {}
+
+func (x *PersonRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRecord.ProtoReflect.Descriptor instead.
+func (*PersonRecord) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PersonRecord) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *PersonRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PersonRecord) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *PersonRecord) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+// References to the person in other records are anonymized in both modes
+type ErasePersonRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Person *PersonRef             `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Mode   ErasureMode            `protobuf:"varint,2,opt,name=mode,proto3,enum=main.ErasureMode" json:"mode,omitempty"`
+	// reason is kept in the audit record
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErasePersonRequest) Reset() {
+	*x = ErasePersonRequest{}
+	mi := &file_privacy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasePersonRequest) ProtoMessage() {}
+
+func (x *ErasePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasePersonRequest.ProtoReflect.Descriptor instead.
+func (*ErasePersonRequest) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ErasePersonRequest) GetPerson() *PersonRef {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *ErasePersonRequest) GetMode() ErasureMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ErasureMode_ANONYMIZE
+}
+
+func (x *ErasePersonRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ErasePersonResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// audit_id identifies the audit record written for the erasure
+	AuditId              string `protobuf:"bytes,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	RecordsAnonymized    int64  `protobuf:"varint,2,opt,name=records_anonymized,json=recordsAnonymized,proto3" json:"records_anonymized,omitempty"`
+	RecordsDeleted       int64  `protobuf:"varint,3,opt,name=records_deleted,json=recordsDeleted,proto3" json:"records_deleted,omitempty"`
+	ReferencesAnonymized int64  `protobuf:"varint,4,opt,name=references_anonymized,json=referencesAnonymized,proto3" json:"references_anonymized,omitempty"`
+	// files_deleted counts the submissions of an erased student whose uploaded files were deleted
+	FilesDeleted  int64 `protobuf:"varint,5,opt,name=files_deleted,json=filesDeleted,proto3" json:"files_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErasePersonResponse) Reset() {
+	*x = ErasePersonResponse{}
+	mi := &file_privacy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasePersonResponse) ProtoMessage() {}
+
+func (x *ErasePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasePersonResponse.ProtoReflect.Descriptor instead.
+func (*ErasePersonResponse) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ErasePersonResponse) GetAuditId() string {
+	if x != nil {
+		return x.AuditId
+	}
+	return ""
+}
+
+func (x *ErasePersonResponse) GetRecordsAnonymized() int64 {
+	if x != nil {
+		return x.RecordsAnonymized
+	}
+	return 0
+}
+
+func (x *ErasePersonResponse) GetRecordsDeleted() int64 {
+	if x != nil {
+		return x.RecordsDeleted
+	}
+	return 0
+}
+
+func (x *ErasePersonResponse) GetReferencesAnonymized() int64 {
+	if x != nil {
+		return x.ReferencesAnonymized
+	}
+	return 0
+}
+
+func (x *ErasePersonResponse) GetFilesDeleted() int64 {
+	if x != nil {
+		return x.FilesDeleted
+	}
+	return 0
+}
+
+type LegalHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *PersonRef             `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegalHoldRequest) Reset() {
+	*x = LegalHoldRequest{}
+	mi := &file_privacy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalHoldRequest) ProtoMessage() {}
+
+func (x *LegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*LegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LegalHoldRequest) GetPerson() *PersonRef {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *LegalHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type LegalHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *PersonRef             `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	PlacedBy      string                 `protobuf:"bytes,3,opt,name=placed_by,json=placedBy,proto3" json:"placed_by,omitempty"`
+	PlacedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegalHold) Reset() {
+	*x = LegalHold{}
+	mi := &file_privacy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegalHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalHold) ProtoMessage() {}
+
+func (x *LegalHold) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalHold.ProtoReflect.Descriptor instead.
+func (*LegalHold) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LegalHold) GetPerson() *PersonRef {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *LegalHold) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LegalHold) GetPlacedBy() string {
+	if x != nil {
+		return x.PlacedBy
+	}
+	return ""
+}
+
+func (x *LegalHold) GetPlacedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return nil
+}
+
+type LegalHolds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LegalHolds    []*LegalHold           `protobuf:"bytes,1,rep,name=legal_holds,json=legalHolds,proto3" json:"legal_holds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegalHolds) Reset() {
+	*x = LegalHolds{}
+	mi := &file_privacy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegalHolds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalHolds) ProtoMessage() {}
+
+func (x *LegalHolds) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalHolds.ProtoReflect.Descriptor instead.
+func (*LegalHolds) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LegalHolds) GetLegalHolds() []*LegalHold {
+	if x != nil {
+		return x.LegalHolds
+	}
+	return nil
+}
+
+var File_privacy_proto protoreflect.FileDescriptor
+
+const file_privacy_proto_rawDesc = "" +
+	"\n" +
+	"\rprivacy.proto\x12\x04main\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\vexecs.proto\"A\n" +
+	"\tPersonRef\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.main.PersonTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xa8\x01\n" +
+	"\x10PersonDataBundle\x12'\n" +
+	"\x06person\x18\x01 \x01(\v2\x0f.main.PersonRefR\x06person\x12=\n" +
+	"\fgenerated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12,\n" +
+	"\arecords\x18\x03 \x03(\v2\x12.main.PersonRecordR\arecords\"r\n" +
+	"\fPersonRecord\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x1a\n" +
+	"\bdocument\x18\x04 \x01(\tR\bdocument\"|\n" +
+	"\x12ErasePersonRequest\x12'\n" +
+	"\x06person\x18\x01 \x01(\v2\x0f.main.PersonRefR\x06person\x12%\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x11.main.ErasureModeR\x04mode\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xe2\x01\n" +
+	"\x13ErasePersonResponse\x12\x19\n" +
+	"\baudit_id\x18\x01 \x01(\tR\aauditId\x12-\n" +
+	"\x12records_anonymized\x18\x02 \x01(\x03R\x11recordsAnonymized\x12'\n" +
+	"\x0frecords_deleted\x18\x03 \x01(\x03R\x0erecordsDeleted\x123\n" +
+	"\x15references_anonymized\x18\x04 \x01(\x03R\x14referencesAnonymized\x12#\n" +
+	"\rfiles_deleted\x18\x05 \x01(\x03R\ffilesDeleted\"S\n" +
+	"\x10LegalHoldRequest\x12'\n" +
+	"\x06person\x18\x01 \x01(\v2\x0f.main.PersonRefR\x06person\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xa2\x01\n" +
+	"\tLegalHold\x12'\n" +
+	"\x06person\x18\x01 \x01(\v2\x0f.main.PersonRefR\x06person\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tplaced_by\x18\x03 \x01(\tR\bplacedBy\x127\n" +
+	"\tplaced_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bplacedAt\">\n" +
+	"\n" +
+	"LegalHolds\x120\n" +
+	"\vlegal_holds\x18\x01 \x03(\v2\x0f.main.LegalHoldR\n" +
+	"legalHolds*>\n" +
+	"\n" +
+	"PersonType\x12\v\n" +
+	"\aSTUDENT\x10\x00\x12\v\n" +
+	"\aTEACHER\x10\x01\x12\b\n" +
+	"\x04EXEC\x10\x02\x12\f\n" +
+	"\bGUARDIAN\x10\x03*(\n" +
+	"\vErasureMode\x12\r\n" +
+	"\tANONYMIZE\x10\x00\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x012\xbc\x02\n" +
+	"\x0ePrivacyService\x12;\n" +
+	"\x10ExportPersonData\x12\x0f.main.PersonRef\x1a\x16.main.PersonDataBundle\x12B\n" +
+	"\vErasePerson\x12\x18.main.ErasePersonRequest\x1a\x19.main.ErasePersonResponse\x129\n" +
+	"\x0ePlaceLegalHold\x12\x16.main.LegalHoldRequest\x1a\x0f.main.LegalHold\x127\n" +
+	"\x10ReleaseLegalHold\x12\x0f.main.PersonRef\x1a\x12.main.Confirmation\x125\n" +
+	"\rGetLegalHolds\x12\x12.main.EmptyRequest\x1a\x10.main.LegalHoldsB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_privacy_proto_rawDescOnce sync.Once
+	file_privacy_proto_rawDescData []byte
+)
+
+func file_privacy_proto_rawDescGZIP() []byte {
+	file_privacy_proto_rawDescOnce.Do(func() {
+		file_privacy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_privacy_proto_rawDesc), len(file_privacy_proto_rawDesc)))
+	})
+	return file_privacy_proto_rawDescData
+}
+
+var file_privacy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_privacy_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_privacy_proto_goTypes = []any{
+	(PersonType)(0),               // 0: main.PersonType
+	(ErasureMode)(0),              // 1: main.ErasureMode
+	(*PersonRef)(nil),             // 2: main.PersonRef
+	(*PersonDataBundle)(nil),      // 3: main.PersonDataBundle
+	(*PersonRecord)(nil),          // 4: main.PersonRecord
+	(*ErasePersonRequest)(nil),    // 5: main.ErasePersonRequest
+	(*ErasePersonResponse)(nil),   // 6: main.ErasePersonResponse
+	(*LegalHoldRequest)(nil),      // 7: main.LegalHoldRequest
+	(*LegalHold)(nil),             // 8: main.LegalHold
+	(*LegalHolds)(nil),            // 9: main.LegalHolds
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*EmptyRequest)(nil),          // 11: main.EmptyRequest
+	(*Confirmation)(nil),          // 12: main.Confirmation
+}
+var file_privacy_proto_depIdxs = []int32{
+	0,  // 0: main.PersonRef.type:type_name -> main.PersonType
+	2,  // 1: main.PersonDataBundle.person:type_name -> main.PersonRef
+	10, // 2: main.PersonDataBundle.generated_at:type_name -> google.protobuf.Timestamp
+	4,  // 3: main.PersonDataBundle.records:type_name -> main.PersonRecord
+	2,  // 4: main.ErasePersonRequest.person:type_name -> main.PersonRef
+	1,  // 5: main.ErasePersonRequest.mode:type_name -> main.ErasureMode
+	2,  // 6: main.LegalHoldRequest.person:type_name -> main.PersonRef
+	2,  // 7: main.LegalHold.person:type_name -> main.PersonRef
+	10, // 8: main.LegalHold.placed_at:type_name -> google.protobuf.Timestamp
+	8,  // 9: main.LegalHolds.legal_holds:type_name -> main.LegalHold
+	2,  // 10: main.PrivacyService.ExportPersonData:input_type -> main.PersonRef
+	5,  // 11: main.PrivacyService.ErasePerson:input_type -> main.ErasePersonRequest
+	7,  // 12: main.PrivacyService.PlaceLegalHold:input_type -> main.LegalHoldRequest
+	2,  // 13: main.PrivacyService.ReleaseLegalHold:input_type -> main.PersonRef
+	11, // 14: main.PrivacyService.GetLegalHolds:input_type -> main.EmptyRequest
+	3,  // 15: main.PrivacyService.ExportPersonData:output_type -> main.PersonDataBundle
+	6,  // 16: main.PrivacyService.ErasePerson:output_type -> main.ErasePersonResponse
+	8,  // 17: main.PrivacyService.PlaceLegalHold:output_type -> main.LegalHold
+	12, // 18: main.PrivacyService.ReleaseLegalHold:output_type -> main.Confirmation
+	9,  // 19: main.PrivacyService.GetLegalHolds:output_type -> main.LegalHolds
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_privacy_proto_init() }
+func file_privacy_proto_init() {
+	if File_privacy_proto != nil {
+		return
+	}
+	file_execs_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_privacy_proto_rawDesc), len(file_privacy_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_privacy_proto_goTypes,
+		DependencyIndexes: file_privacy_proto_depIdxs,
+		EnumInfos:         file_privacy_proto_enumTypes,
+		MessageInfos:      file_privacy_proto_msgTypes,
+	}.Build()
+	File_privacy_proto = out.File
+	file_privacy_proto_goTypes = nil
+	file_privacy_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: privacy.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PrivacyService_ExportPersonData_FullMethodName = "/main.PrivacyService/ExportPersonData"
+	PrivacyService_ErasePerson_FullMethodName      = "/main.PrivacyService/ErasePerson"
+	PrivacyService_PlaceLegalHold_FullMethodName   = "/main.PrivacyService/PlaceLegalHold"
+	PrivacyService_ReleaseLegalHold_FullMethodName = "/main.PrivacyService/ReleaseLegalHold"
+	PrivacyService_GetLegalHolds_FullMethodName    = "/main.PrivacyService/GetLegalHolds"
+)
+
+// PrivacyServiceClient is the client API for PrivacyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to privacy requests of the people whose data is stored
+type PrivacyServiceClient interface {
+	// ExportPersonData gathers every record referencing a person into one bundle
+	ExportPersonData(ctx context.Context, in *PersonRef, opts ...grpc.CallOption) (*PersonDataBundle, error)
+	// ErasePerson anonymizes or deletes the records of a person, unless the person is under legal hold
+	ErasePerson(ctx context.Context, in *ErasePersonRequest, opts ...grpc.CallOption) (*ErasePersonResponse, error)
+	// PlaceLegalHold keeps a person from being erased until the hold is released
+	PlaceLegalHold(ctx context.Context, in *LegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error)
+	// ReleaseLegalHold lifts the legal hold of a person
+	ReleaseLegalHold(ctx context.Context, in *PersonRef, opts ...grpc.CallOption) (*Confirmation, error)
+	// GetLegalHolds lists every active legal hold
+	GetLegalHolds(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*LegalHolds, error)
+}
+
+type privacyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPrivacyServiceClient(cc grpc.ClientConnInterface) PrivacyServiceClient {
+	return &privacyServiceClient{cc}
+}
+
+func (c *privacyServiceClient) ExportPersonData(ctx context.Context, in *PersonRef, opts ...grpc.CallOption) (*PersonDataBundle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersonDataBundle)
+	err := c.cc.Invoke(ctx, PrivacyService_ExportPersonData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyServiceClient) ErasePerson(ctx context.Context, in *ErasePersonRequest, opts ...grpc.CallOption) (*ErasePersonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErasePersonResponse)
+	err := c.cc.Invoke(ctx, PrivacyService_ErasePerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyServiceClient) PlaceLegalHold(ctx context.Context, in *LegalHoldRequest, opts ...grpc.CallOption) (*LegalHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LegalHold)
+	err := c.cc.Invoke(ctx, PrivacyService_PlaceLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyServiceClient) ReleaseLegalHold(ctx context.Context, in *PersonRef, opts ...grpc.CallOption) (*Confirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Confirmation)
+	err := c.cc.Invoke(ctx, PrivacyService_ReleaseLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyServiceClient) GetLegalHolds(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*LegalHolds, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LegalHolds)
+	err := c.cc.Invoke(ctx, PrivacyService_GetLegalHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrivacyServiceServer is the server API for PrivacyService service.
+// All implementations must embed UnimplementedPrivacyServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to privacy requests of the people whose data is stored
+type PrivacyServiceServer interface {
+	// ExportPersonData gathers every record referencing a person into one bundle
+	ExportPersonData(context.Context, *PersonRef) (*PersonDataBundle, error)
+	// ErasePerson anonymizes or deletes the records of a person, unless the person is under legal hold
+	ErasePerson(context.Context, *ErasePersonRequest) (*ErasePersonResponse, error)
+	// PlaceLegalHold keeps a person from being erased until the hold is released
+	PlaceLegalHold(context.Context, *LegalHoldRequest) (*LegalHold, error)
+	// ReleaseLegalHold lifts the legal hold of a person
+	ReleaseLegalHold(context.Context, *PersonRef) (*Confirmation, error)
+	// GetLegalHolds lists every active legal hold
+	GetLegalHolds(context.Context, *EmptyRequest) (*LegalHolds, error)
+	mustEmbedUnimplementedPrivacyServiceServer()
+}
+
+// UnimplementedPrivacyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPrivacyServiceServer struct{}
+
+func (UnimplementedPrivacyServiceServer) ExportPersonData(context.Context, *PersonRef) (*PersonDataBundle, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportPersonData not implemented")
+}
+func (UnimplementedPrivacyServiceServer) ErasePerson(context.Context, *ErasePersonRequest) (*ErasePersonResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ErasePerson not implemented")
+}
+func (UnimplementedPrivacyServiceServer) PlaceLegalHold(context.Context, *LegalHoldRequest) (*LegalHold, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlaceLegalHold not implemented")
+}
+func (UnimplementedPrivacyServiceServer) ReleaseLegalHold(context.Context, *PersonRef) (*Confirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseLegalHold not implemented")
+}
+func (UnimplementedPrivacyServiceServer) GetLegalHolds(context.Context, *EmptyRequest) (*LegalHolds, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLegalHolds not implemented")
+}
+func (UnimplementedPrivacyServiceServer) mustEmbedUnimplementedPrivacyServiceServer() {}
+func (UnimplementedPrivacyServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePrivacyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PrivacyServiceServer will
+// result in compilation errors.
+type UnsafePrivacyServiceServer interface {
+	mustEmbedUnimplementedPrivacyServiceServer()
+}
+
+func RegisterPrivacyServiceServer(s grpc.ServiceRegistrar, srv PrivacyServiceServer) {
+	// If the following call panics, it indicates UnimplementedPrivacyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PrivacyService_ServiceDesc, srv)
+}
+
+func _PrivacyService_ExportPersonData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyServiceServer).ExportPersonData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyService_ExportPersonData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyServiceServer).ExportPersonData(ctx, req.(*PersonRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyService_ErasePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ErasePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyServiceServer).ErasePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyService_ErasePerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyServiceServer).ErasePerson(ctx, req.(*ErasePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyService_PlaceLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyServiceServer).PlaceLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyService_PlaceLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyServiceServer).PlaceLegalHold(ctx, req.(*LegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyService_ReleaseLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyServiceServer).ReleaseLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyService_ReleaseLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyServiceServer).ReleaseLegalHold(ctx, req.(*PersonRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyService_GetLegalHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyServiceServer).GetLegalHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyService_GetLegalHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyServiceServer).GetLegalHolds(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PrivacyService_ServiceDesc is the grpc.ServiceDesc for PrivacyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PrivacyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.PrivacyService",
+	HandlerType: (*PrivacyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportPersonData",
+			Handler:    _PrivacyService_ExportPersonData_Handler,
+		},
+		{
+			MethodName: "ErasePerson",
+			Handler:    _PrivacyService_ErasePerson_Handler,
+		},
+		{
+			MethodName: "PlaceLegalHold",
+			Handler:    _PrivacyService_PlaceLegalHold_Handler,
+		},
+		{
+			MethodName: "ReleaseLegalHold",
+			Handler:    _PrivacyService_ReleaseLegalHold_Handler,
+		},
+		{
+			MethodName: "GetLegalHolds",
+			Handler:    _PrivacyService_GetLegalHolds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "privacy.proto",
+}
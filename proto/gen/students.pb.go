@@ -9,6 +9,8 @@ package grpcapipb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +23,159 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ChangeType tells what happened to a record in a watch event, soft deletes are reported as DELETED
+// Records that are permanently removed, when purged or erased, are also DELETED, but their event only carries the ID
+type ChangeType int32
+
+const (
+	// CHANGE_TYPE_UNSPECIFIED is never sent, it keeps an empty event from reading as a create
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	ChangeType_CREATED                 ChangeType = 1
+	ChangeType_UPDATED                 ChangeType = 2
+	ChangeType_DELETED                 ChangeType = 3
+	// LEFT is sent when an update makes a record stop matching the filter of the watch, the event only carries the ID
+	ChangeType_LEFT ChangeType = 4
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+		4: "LEFT",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CREATED":                 1,
+		"UPDATED":                 2,
+		"DELETED":                 3,
+		"LEFT":                    4,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_students_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_students_proto_enumTypes[0]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportFormat int32
+
+const (
+	ImportFormat_CSV    ImportFormat = 0
+	ImportFormat_NDJSON ImportFormat = 1
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "CSV",
+		1: "NDJSON",
+	}
+	ImportFormat_value = map[string]int32{
+		"CSV":    0,
+		"NDJSON": 1,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_students_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_students_proto_enumTypes[1]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportMode int32
+
+const (
+	// INSERT_ONLY adds every row and rejects rows whose email already exists
+	ImportMode_INSERT_ONLY ImportMode = 0
+	// UPSERT_BY_EMAIL updates the record with the email of the row, or adds it when there is none
+	ImportMode_UPSERT_BY_EMAIL ImportMode = 1
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "INSERT_ONLY",
+		1: "UPSERT_BY_EMAIL",
+	}
+	ImportMode_value = map[string]int32{
+		"INSERT_ONLY":     0,
+		"UPSERT_BY_EMAIL": 1,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_students_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_students_proto_enumTypes[2]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{2}
+}
+
 type Order int32
 
 const (
@@ -51,11 +206,11 @@ func (x Order) String() string {
 }
 
 func (Order) Descriptor() protoreflect.EnumDescriptor {
-	return file_students_proto_enumTypes[0].Descriptor()
+	return file_students_proto_enumTypes[3].Descriptor()
 }
 
 func (Order) Type() protoreflect.EnumType {
-	return &file_students_proto_enumTypes[0]
+	return &file_students_proto_enumTypes[3]
 }
 
 func (x Order) Number() protoreflect.EnumNumber {
@@ -64,31 +219,34 @@ func (x Order) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Order.Descriptor instead.
 func (Order) EnumDescriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{0}
+	return file_students_proto_rawDescGZIP(), []int{3}
 }
 
-type StudentCount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	StudentCount  int32                  `protobuf:"varint,2,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+type AddStudentsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Students []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	// ordered stops inserting at the first failing student, otherwise every valid student is inserted
+	Ordered bool `protobuf:"varint,2,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// atomic inserts all students in a single transaction, so either all of them are added or none
+	Atomic        bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentCount) Reset() {
-	*x = StudentCount{}
+func (x *AddStudentsRequest) Reset() {
+	*x = AddStudentsRequest{}
 	mi := &file_students_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentCount) String() string {
+func (x *AddStudentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentCount) ProtoMessage() {}
+func (*AddStudentsRequest) ProtoMessage() {}
 
-func (x *StudentCount) ProtoReflect() protoreflect.Message {
+func (x *AddStudentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_students_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -100,47 +258,58 @@ func (x *StudentCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentCount.ProtoReflect.Descriptor instead.
-func (*StudentCount) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddStudentsRequest.ProtoReflect.Descriptor instead.
+func (*AddStudentsRequest) Descriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *StudentCount) GetStatus() bool {
+func (x *AddStudentsRequest) GetStudents() []*Student {
 	if x != nil {
-		return x.Status
+		return x.Students
+	}
+	return nil
+}
+
+func (x *AddStudentsRequest) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
 	}
 	return false
 }
 
-func (x *StudentCount) GetStudentCount() int32 {
+func (x *AddStudentsRequest) GetAtomic() bool {
 	if x != nil {
-		return x.StudentCount
+		return x.Atomic
 	}
-	return 0
+	return false
 }
 
-type DeleteStudentsConfirmation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+type UpdateStudentsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Students []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every student, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStudentsConfirmation) Reset() {
-	*x = DeleteStudentsConfirmation{}
+func (x *UpdateStudentsRequest) Reset() {
+	*x = UpdateStudentsRequest{}
 	mi := &file_students_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStudentsConfirmation) String() string {
+func (x *UpdateStudentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStudentsConfirmation) ProtoMessage() {}
+func (*UpdateStudentsRequest) ProtoMessage() {}
 
-func (x *DeleteStudentsConfirmation) ProtoReflect() protoreflect.Message {
+func (x *UpdateStudentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_students_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -152,46 +321,55 @@ func (x *DeleteStudentsConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStudentsConfirmation.ProtoReflect.Descriptor instead.
-func (*DeleteStudentsConfirmation) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateStudentsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStudentsRequest) Descriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *DeleteStudentsConfirmation) GetStatus() string {
+func (x *UpdateStudentsRequest) GetStudents() []*Student {
 	if x != nil {
-		return x.Status
+		return x.Students
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteStudentsConfirmation) GetDeletedIds() []string {
+func (x *UpdateStudentsRequest) GetAtomic() bool {
 	if x != nil {
-		return x.DeletedIds
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateStudentsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
 	}
 	return nil
 }
 
-type StudentId struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type DeleteStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*StudentId           `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all students in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentId) Reset() {
-	*x = StudentId{}
+func (x *DeleteStudentsRequest) Reset() {
+	*x = DeleteStudentsRequest{}
 	mi := &file_students_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentId) String() string {
+func (x *DeleteStudentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentId) ProtoMessage() {}
+func (*DeleteStudentsRequest) ProtoMessage() {}
 
-func (x *StudentId) ProtoReflect() protoreflect.Message {
+func (x *DeleteStudentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_students_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -203,39 +381,47 @@ func (x *StudentId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentId.ProtoReflect.Descriptor instead.
-func (*StudentId) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteStudentsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStudentsRequest) Descriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *StudentId) GetId() string {
+func (x *DeleteStudentsRequest) GetIds() []*StudentId {
 	if x != nil {
-		return x.Id
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-type StudentIds struct {
+func (x *DeleteStudentsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddStudentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []*StudentId           `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Students      []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	Results       []*BulkItemResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentIds) Reset() {
-	*x = StudentIds{}
+func (x *AddStudentsResponse) Reset() {
+	*x = AddStudentsResponse{}
 	mi := &file_students_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentIds) String() string {
+func (x *AddStudentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentIds) ProtoMessage() {}
+func (*AddStudentsResponse) ProtoMessage() {}
 
-func (x *StudentIds) ProtoReflect() protoreflect.Message {
+func (x *AddStudentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_students_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -247,42 +433,51 @@ func (x *StudentIds) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentIds.ProtoReflect.Descriptor instead.
-func (*StudentIds) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddStudentsResponse.ProtoReflect.Descriptor instead.
+func (*AddStudentsResponse) Descriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *StudentIds) GetIds() []*StudentId {
+func (x *AddStudentsResponse) GetStudents() []*Student {
 	if x != nil {
-		return x.Ids
+		return x.Students
 	}
 	return nil
 }
 
-type GetStudentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Student       *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
-	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	PageNumber    int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+func (x *AddStudentsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BulkItemResult reports the outcome of a single item of a bulk request
+type BulkItemResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Index int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id    string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// error_code holds the gRPC status code of a failed item and is 0 (OK) on success
+	ErrorCode     int32  `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentsRequest) Reset() {
-	*x = GetStudentsRequest{}
+func (x *BulkItemResult) Reset() {
+	*x = BulkItemResult{}
 	mi := &file_students_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentsRequest) String() string {
+func (x *BulkItemResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentsRequest) ProtoMessage() {}
+func (*BulkItemResult) ProtoMessage() {}
 
-func (x *GetStudentsRequest) ProtoReflect() protoreflect.Message {
+func (x *BulkItemResult) ProtoReflect() protoreflect.Message {
 	mi := &file_students_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,61 +489,61 @@ func (x *GetStudentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentsRequest.ProtoReflect.Descriptor instead.
-func (*GetStudentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkItemResult.ProtoReflect.Descriptor instead.
+func (*BulkItemResult) Descriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetStudentsRequest) GetStudent() *Student {
+func (x *BulkItemResult) GetIndex() int32 {
 	if x != nil {
-		return x.Student
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *GetStudentsRequest) GetSortBy() []*SortField {
+func (x *BulkItemResult) GetId() string {
 	if x != nil {
-		return x.SortBy
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStudentsRequest) GetPageNumber() int32 {
+func (x *BulkItemResult) GetErrorCode() int32 {
 	if x != nil {
-		return x.PageNumber
+		return x.ErrorCode
 	}
 	return 0
 }
 
-func (x *GetStudentsRequest) GetPageSize() int32 {
+func (x *BulkItemResult) GetErrorMessage() string {
 	if x != nil {
-		return x.PageSize
+		return x.ErrorMessage
 	}
-	return 0
+	return ""
 }
 
-type SortField struct {
+type StudentCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Order         Order                  `protobuf:"varint,2,opt,name=order,proto3,enum=main.Order" json:"order,omitempty"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	StudentCount  int32                  `protobuf:"varint,2,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SortField) Reset() {
-	*x = SortField{}
+func (x *StudentCount) Reset() {
+	*x = StudentCount{}
 	mi := &file_students_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SortField) String() string {
+func (x *StudentCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortField) ProtoMessage() {}
+func (*StudentCount) ProtoMessage() {}
 
-func (x *SortField) ProtoReflect() protoreflect.Message {
+func (x *StudentCount) ProtoReflect() protoreflect.Message {
 	mi := &file_students_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -360,50 +555,49 @@ func (x *SortField) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
-func (*SortField) Descriptor() ([]byte, []int) {
+// Deprecated: Use StudentCount.ProtoReflect.Descriptor instead.
+func (*StudentCount) Descriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SortField) GetField() string {
+func (x *StudentCount) GetStatus() bool {
 	if x != nil {
-		return x.Field
+		return x.Status
 	}
-	return ""
+	return false
 }
 
-func (x *SortField) GetOrder() Order {
+func (x *StudentCount) GetStudentCount() int32 {
 	if x != nil {
-		return x.Order
+		return x.StudentCount
 	}
-	return Order_ASC
+	return 0
 }
 
-type Student struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Class         string                 `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
+type WatchStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only changes to students matching the non-empty fields of the filter are streamed
+	Filter *Student `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resume_token of the last received event, the stream continues right after it
+	ResumeToken   string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Student) Reset() {
-	*x = Student{}
+func (x *WatchStudentsRequest) Reset() {
+	*x = WatchStudentsRequest{}
 	mi := &file_students_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) String() string {
+func (x *WatchStudentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Student) ProtoMessage() {}
+func (*WatchStudentsRequest) ProtoMessage() {}
 
-func (x *Student) ProtoReflect() protoreflect.Message {
+func (x *WatchStudentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_students_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -415,44 +609,980 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Student.ProtoReflect.Descriptor instead.
-func (*Student) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchStudentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchStudentsRequest) Descriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Student) GetId() string {
+func (x *WatchStudentsRequest) GetFilter() *Student {
 	if x != nil {
-		return x.Id
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *Student) GetFirstName() string {
+func (x *WatchStudentsRequest) GetResumeToken() string {
 	if x != nil {
-		return x.FirstName
+		return x.ResumeToken
 	}
 	return ""
 }
 
-func (x *Student) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+type StudentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ChangeType             `protobuf:"varint,1,opt,name=type,proto3,enum=main.ChangeType" json:"type,omitempty"`
+	// student as stored after the change
+	Student       *Student               `protobuf:"bytes,2,opt,name=student,proto3" json:"student,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Student) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x *StudentEvent) Reset() {
+	*x = StudentEvent{}
+	mi := &file_students_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) GetClass() string {
-	if x != nil {
-		return x.Class
-	}
-	return ""
+func (x *StudentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentEvent) ProtoMessage() {}
+
+func (x *StudentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentEvent.ProtoReflect.Descriptor instead.
+func (*StudentEvent) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StudentEvent) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *StudentEvent) GetStudent() *Student {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *StudentEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *StudentEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RestoreStudentsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RestoredIds   []string               `protobuf:"bytes,2,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStudentsConfirmation) Reset() {
+	*x = RestoreStudentsConfirmation{}
+	mi := &file_students_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStudentsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStudentsConfirmation) ProtoMessage() {}
+
+func (x *RestoreStudentsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStudentsConfirmation.ProtoReflect.Descriptor instead.
+func (*RestoreStudentsConfirmation) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreStudentsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RestoreStudentsConfirmation) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
+type DeleteStudentsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStudentsConfirmation) Reset() {
+	*x = DeleteStudentsConfirmation{}
+	mi := &file_students_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStudentsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStudentsConfirmation) ProtoMessage() {}
+
+func (x *DeleteStudentsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStudentsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteStudentsConfirmation) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteStudentsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteStudentsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type StudentId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the student, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentId) Reset() {
+	*x = StudentId{}
+	mi := &file_students_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentId) ProtoMessage() {}
+
+func (x *StudentId) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentId.ProtoReflect.Descriptor instead.
+func (*StudentId) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StudentId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StudentId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type StudentIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []*StudentId           `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentIds) Reset() {
+	*x = StudentIds{}
+	mi := &file_students_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentIds) ProtoMessage() {}
+
+func (x *StudentIds) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentIds.ProtoReflect.Descriptor instead.
+func (*StudentIds) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StudentIds) GetIds() []*StudentId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetStudentsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Student    *Student               `protobuf:"bytes,1,opt,name=student,proto3" json:"student,omitempty"`
+	SortBy     []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageNumber int32                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize   int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// include_deleted also returns soft deleted students
+	IncludeDeleted bool `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// time_filters limit the students to the given time ranges of their timestamp fields
+	TimeFilters []*TimestampFilter `protobuf:"bytes,6,rep,name=time_filters,json=timeFilters,proto3" json:"time_filters,omitempty"`
+	// include_guardians fills in the guardians of every student returned, it needs the mongodb storage backend
+	IncludeGuardians bool `protobuf:"varint,7,opt,name=include_guardians,json=includeGuardians,proto3" json:"include_guardians,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetStudentsRequest) Reset() {
+	*x = GetStudentsRequest{}
+	mi := &file_students_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentsRequest) ProtoMessage() {}
+
+func (x *GetStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentsRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetStudentsRequest) GetStudent() *Student {
+	if x != nil {
+		return x.Student
+	}
+	return nil
+}
+
+func (x *GetStudentsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetStudentsRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetStudentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetStudentsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetStudentsRequest) GetTimeFilters() []*TimestampFilter {
+	if x != nil {
+		return x.TimeFilters
+	}
+	return nil
+}
+
+func (x *GetStudentsRequest) GetIncludeGuardians() bool {
+	if x != nil {
+		return x.IncludeGuardians
+	}
+	return false
+}
+
+// TimestampFilter matches records whose timestamp field lies in [from, to), either bound may be left out
+type TimestampFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field is one of created_at, updated_at or deleted_at (and password_changed_at for execs)
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimestampFilter) Reset() {
+	*x = TimestampFilter{}
+	mi := &file_students_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimestampFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampFilter) ProtoMessage() {}
+
+func (x *TimestampFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampFilter.ProtoReflect.Descriptor instead.
+func (*TimestampFilter) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TimestampFilter) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TimestampFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TimestampFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type SortField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Order         Order                  `protobuf:"varint,2,opt,name=order,proto3,enum=main.Order" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortField) Reset() {
+	*x = SortField{}
+	mi := &file_students_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortField) ProtoMessage() {}
+
+func (x *SortField) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
+func (*SortField) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SortField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortField) GetOrder() Order {
+	if x != nil {
+		return x.Order
+	}
+	return Order_ASC
+}
+
+// ImportChunk carries a piece of an import file, the options are taken from the first chunk
+type ImportChunk struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format ImportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=main.ImportFormat" json:"format,omitempty"`
+	Mode   ImportMode             `protobuf:"varint,2,opt,name=mode,proto3,enum=main.ImportMode" json:"mode,omitempty"`
+	// dry_run validates every row and reports what would happen without writing anything
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// header_mapping maps CSV headers or NDJSON keys to field names such as first_name
+	// Headers that are not mapped must already be field names
+	HeaderMapping map[string]string `protobuf:"bytes,4,rep,name=header_mapping,json=headerMapping,proto3" json:"header_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          []byte            `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportChunk) Reset() {
+	*x = ImportChunk{}
+	mi := &file_students_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChunk) ProtoMessage() {}
+
+func (x *ImportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChunk.ProtoReflect.Descriptor instead.
+func (*ImportChunk) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportChunk) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_CSV
+}
+
+func (x *ImportChunk) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_INSERT_ONLY
+}
+
+func (x *ImportChunk) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportChunk) GetHeaderMapping() map[string]string {
+	if x != nil {
+		return x.HeaderMapping
+	}
+	return nil
+}
+
+func (x *ImportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line of the input the row starts on, the CSV header is line 1
+	Line int32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// field is empty when the error concerns the whole row
+	Field         string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_students_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Inserted      int32                  `protobuf:"varint,2,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSummary) Reset() {
+	*x = ImportSummary{}
+	mi := &file_students_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSummary) ProtoMessage() {}
+
+func (x *ImportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSummary.ProtoReflect.Descriptor instead.
+func (*ImportSummary) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportSummary) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportSummary) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExportStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query selects and orders the students the same way GetStudents does
+	Query  *GetStudentsRequest `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Format ImportFormat        `protobuf:"varint,2,opt,name=format,proto3,enum=main.ImportFormat" json:"format,omitempty"`
+	// columns lists the fields to export in order, all fields are exported when it is empty
+	Columns       []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStudentsRequest) Reset() {
+	*x = ExportStudentsRequest{}
+	mi := &file_students_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStudentsRequest) ProtoMessage() {}
+
+func (x *ExportStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ExportStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportStudentsRequest) GetQuery() *GetStudentsRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExportStudentsRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_CSV
+}
+
+func (x *ExportStudentsRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// ExportChunk carries a piece of an export file, the pieces are sent in order
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_students_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Student struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// class_id is the ID of the class the student belongs to
+	ClassId string `protobuf:"bytes,13,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted students, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,8,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// guardians are only filled in when asked for, they are linked with GuardiansService
+	Guardians     []*Guardian `protobuf:"bytes,14,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Student) Reset() {
+	*x = Student{}
+	mi := &file_students_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Student) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Student) ProtoMessage() {}
+
+func (x *Student) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Student.ProtoReflect.Descriptor instead.
+func (*Student) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Student) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Student) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Student) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Student) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Student) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Student) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Student) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Student) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Student) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Student) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Student) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Student) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Student) GetGuardians() []*Guardian {
+	if x != nil {
+		return x.Guardians
+	}
+	return nil
 }
 
 type Students struct {
@@ -464,7 +1594,7 @@ type Students struct {
 
 func (x *Students) Reset() {
 	*x = Students{}
-	mi := &file_students_proto_msgTypes[7]
+	mi := &file_students_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +1606,7 @@ func (x *Students) String() string {
 func (*Students) ProtoMessage() {}
 
 func (x *Students) ProtoReflect() protoreflect.Message {
-	mi := &file_students_proto_msgTypes[7]
+	mi := &file_students_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +1619,7 @@ func (x *Students) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Students.ProtoReflect.Descriptor instead.
 func (*Students) Descriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{7}
+	return file_students_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Students) GetStudents() []*Student {
@@ -499,49 +1629,416 @@ func (x *Students) GetStudents() []*Student {
 	return nil
 }
 
+// Guardian is a parent or other contact of students, a guardian can be linked to several students and a student to several guardians
+type Guardian struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Phone     string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email     string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Address   string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	// custody_notes record custody arrangements such as who may pick the students up
+	CustodyNotes string `protobuf:"bytes,9,opt,name=custody_notes,json=custodyNotes,proto3" json:"custody_notes,omitempty"`
+	// student_ids are the students the guardian is linked to, they are filled in from students
+	StudentIds []string `protobuf:"bytes,10,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted guardians, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,13,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy string                 `protobuf:"bytes,17,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// students are the links of the guardian to students, each with how the guardian relates to that student
+	Students      []*GuardianStudent `protobuf:"bytes,18,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guardian) Reset() {
+	*x = Guardian{}
+	mi := &file_students_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guardian) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardian) ProtoMessage() {}
+
+func (x *Guardian) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardian.ProtoReflect.Descriptor instead.
+func (*Guardian) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Guardian) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Guardian) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Guardian) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Guardian) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Guardian) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Guardian) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Guardian) GetCustodyNotes() string {
+	if x != nil {
+		return x.CustodyNotes
+	}
+	return ""
+}
+
+func (x *Guardian) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *Guardian) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Guardian) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Guardian) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Guardian) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Guardian) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Guardian) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Guardian) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Guardian) GetStudents() []*GuardianStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+// GuardianStudent links a guardian to one student
+type GuardianStudent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// relationship to the student such as mother, father or grandparent
+	Relationship string `protobuf:"bytes,2,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	// emergency_priority orders the emergency contacts of the student starting at 1, 0 means the guardian is not one of them
+	EmergencyPriority int32 `protobuf:"varint,3,opt,name=emergency_priority,json=emergencyPriority,proto3" json:"emergency_priority,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GuardianStudent) Reset() {
+	*x = GuardianStudent{}
+	mi := &file_students_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuardianStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianStudent) ProtoMessage() {}
+
+func (x *GuardianStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianStudent.ProtoReflect.Descriptor instead.
+func (*GuardianStudent) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GuardianStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GuardianStudent) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *GuardianStudent) GetEmergencyPriority() int32 {
+	if x != nil {
+		return x.EmergencyPriority
+	}
+	return 0
+}
+
 var File_students_proto protoreflect.FileDescriptor
 
 const file_students_proto_rawDesc = "" +
 	"\n" +
-	"\x0estudents.proto\x12\x04main\"K\n" +
+	"\x0estudents.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"q\n" +
+	"\x12AddStudentsRequest\x12)\n" +
+	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents\x12\x18\n" +
+	"\aordered\x18\x02 \x01(\bR\aordered\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"\x97\x01\n" +
+	"\x15UpdateStudentsRequest\x12)\n" +
+	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"R\n" +
+	"\x15DeleteStudentsRequest\x12!\n" +
+	"\x03ids\x18\x01 \x03(\v2\x0f.main.StudentIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"p\n" +
+	"\x13AddStudentsResponse\x12)\n" +
+	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"z\n" +
+	"\x0eBulkItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x05R\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"K\n" +
 	"\fStudentCount\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12#\n" +
-	"\rstudent_count\x18\x02 \x01(\x05R\fstudentCount\"U\n" +
+	"\rstudent_count\x18\x02 \x01(\x05R\fstudentCount\"`\n" +
+	"\x14WatchStudentsRequest\x12%\n" +
+	"\x06filter\x18\x01 \x01(\v2\r.main.StudentR\x06filter\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\xbd\x01\n" +
+	"\fStudentEvent\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.main.ChangeTypeR\x04type\x12'\n" +
+	"\astudent\x18\x02 \x01(\v2\r.main.StudentR\astudent\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"X\n" +
+	"\x1bRestoreStudentsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\frestored_ids\x18\x02 \x03(\tR\vrestoredIds\"U\n" +
 	"\x1aDeleteStudentsConfirmation\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\"\x1b\n" +
+	"deletedIds\"5\n" +
 	"\tStudentId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"/\n" +
 	"\n" +
 	"StudentIds\x12!\n" +
-	"\x03ids\x18\x01 \x03(\v2\x0f.main.StudentIdR\x03ids\"\xa5\x01\n" +
+	"\x03ids\x18\x01 \x03(\v2\x0f.main.StudentIdR\x03ids\"\xb5\x02\n" +
 	"\x12GetStudentsRequest\x12'\n" +
 	"\astudent\x18\x01 \x01(\v2\r.main.StudentR\astudent\x12(\n" +
 	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x1f\n" +
 	"\vpage_number\x18\x03 \x01(\x05R\n" +
 	"pageNumber\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"D\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\x128\n" +
+	"\ftime_filters\x18\x06 \x03(\v2\x15.main.TimestampFilterR\vtimeFilters\x12+\n" +
+	"\x11include_guardians\x18\a \x01(\bR\x10includeGuardians\"\x83\x01\n" +
+	"\x0fTimestampFilter\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"D\n" +
 	"\tSortField\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12!\n" +
-	"\x05order\x18\x02 \x01(\x0e2\v.main.OrderR\x05order\"\x81\x01\n" +
+	"\x05order\x18\x02 \x01(\x0e2\v.main.OrderR\x05order\"\x9b\x02\n" +
+	"\vImportChunk\x12*\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x12.main.ImportFormatR\x06format\x12$\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x10.main.ImportModeR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12K\n" +
+	"\x0eheader_mapping\x18\x04 \x03(\v2$.main.ImportChunk.HeaderMappingEntryR\rheaderMapping\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x1a@\n" +
+	"\x12HeaderMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xc3\x01\n" +
+	"\rImportSummary\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12\x1a\n" +
+	"\binserted\x18\x02 \x01(\x05R\binserted\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12,\n" +
+	"\x06errors\x18\x06 \x03(\v2\x14.main.ImportRowErrorR\x06errors\"\x8d\x01\n" +
+	"\x15ExportStudentsRequest\x12.\n" +
+	"\x05query\x18\x01 \x01(\v2\x18.main.GetStudentsRequestR\x05query\x12*\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x12.main.ImportFormatR\x06format\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xe9\x03\n" +
 	"\aStudent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
-	"\x05class\x18\x05 \x01(\tR\x05class\"5\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x19\n" +
+	"\bclass_id\x18\r \x01(\tR\aclassId\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\b \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\x12,\n" +
+	"\tguardians\x18\x0e \x03(\v2\x0e.main.GuardianR\tguardiansJ\x04\b\x05\x10\x06R\x05class\"5\n" +
 	"\bStudents\x12)\n" +
-	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents*\x19\n" +
+	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents\"\xeb\x04\n" +
+	"\bGuardian\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12#\n" +
+	"\rcustody_notes\x18\t \x01(\tR\fcustodyNotes\x12\x1f\n" +
+	"\vstudent_ids\x18\n" +
+	" \x03(\tR\n" +
+	"studentIds\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\r \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x10 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x11 \x01(\tR\tupdatedBy\x121\n" +
+	"\bstudents\x18\x12 \x03(\v2\x15.main.GuardianStudentR\bstudentsJ\x04\b\x04\x10\x05J\x04\b\b\x10\tR\frelationshipR\x12emergency_priority\"\x83\x01\n" +
+	"\x0fGuardianStudent\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\"\n" +
+	"\frelationship\x18\x02 \x01(\tR\frelationship\x12-\n" +
+	"\x12emergency_priority\x18\x03 \x01(\x05R\x11emergencyPriority*Z\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aUPDATED\x10\x02\x12\v\n" +
+	"\aDELETED\x10\x03\x12\b\n" +
+	"\x04LEFT\x10\x04*#\n" +
+	"\fImportFormat\x12\a\n" +
+	"\x03CSV\x10\x00\x12\n" +
+	"\n" +
+	"\x06NDJSON\x10\x01*2\n" +
+	"\n" +
+	"ImportMode\x12\x0f\n" +
+	"\vINSERT_ONLY\x10\x00\x12\x13\n" +
+	"\x0fUPSERT_BY_EMAIL\x10\x01*\x19\n" +
 	"\x05Order\x12\a\n" +
 	"\x03ASC\x10\x00\x12\a\n" +
-	"\x03DSC\x10\x012\xf1\x01\n" +
+	"\x03DSC\x10\x012\xa9\x04\n" +
 	"\x0fStudentsSercies\x127\n" +
-	"\vGetStudents\x12\x18.main.GetStudentsRequest\x1a\x0e.main.Students\x12-\n" +
-	"\vAddStudents\x12\x0e.main.Students\x1a\x0e.main.Students\x120\n" +
-	"\x0eUpdateStudents\x12\x0e.main.Students\x1a\x0e.main.Students\x12D\n" +
-	"\x0eDeleteStudents\x12\x10.main.StudentIds\x1a .main.DeleteStudentsConfirmationB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+	"\vGetStudents\x12\x18.main.GetStudentsRequest\x1a\x0e.main.Students\x12B\n" +
+	"\vAddStudents\x12\x18.main.AddStudentsRequest\x1a\x19.main.AddStudentsResponse\x12=\n" +
+	"\x0eUpdateStudents\x12\x1b.main.UpdateStudentsRequest\x1a\x0e.main.Students\x12O\n" +
+	"\x0eDeleteStudents\x12\x1b.main.DeleteStudentsRequest\x1a .main.DeleteStudentsConfirmation\x12F\n" +
+	"\x0fRestoreStudents\x12\x10.main.StudentIds\x1a!.main.RestoreStudentsConfirmation\x12A\n" +
+	"\rWatchStudents\x12\x1a.main.WatchStudentsRequest\x1a\x12.main.StudentEvent0\x01\x12:\n" +
+	"\x0eImportStudents\x12\x11.main.ImportChunk\x1a\x13.main.ImportSummary(\x01\x12B\n" +
+	"\x0eExportStudents\x12\x1b.main.ExportStudentsRequest\x1a\x11.main.ExportChunk0\x01B\x15Z\x13proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_students_proto_rawDescOnce sync.Once
@@ -555,38 +2052,95 @@ func file_students_proto_rawDescGZIP() []byte {
 	return file_students_proto_rawDescData
 }
 
-var file_students_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_students_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_students_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_students_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_students_proto_goTypes = []any{
-	(Order)(0),                         // 0: main.Order
-	(*StudentCount)(nil),               // 1: main.StudentCount
-	(*DeleteStudentsConfirmation)(nil), // 2: main.DeleteStudentsConfirmation
-	(*StudentId)(nil),                  // 3: main.StudentId
-	(*StudentIds)(nil),                 // 4: main.StudentIds
-	(*GetStudentsRequest)(nil),         // 5: main.GetStudentsRequest
-	(*SortField)(nil),                  // 6: main.SortField
-	(*Student)(nil),                    // 7: main.Student
-	(*Students)(nil),                   // 8: main.Students
+	(ChangeType)(0),                     // 0: main.ChangeType
+	(ImportFormat)(0),                   // 1: main.ImportFormat
+	(ImportMode)(0),                     // 2: main.ImportMode
+	(Order)(0),                          // 3: main.Order
+	(*AddStudentsRequest)(nil),          // 4: main.AddStudentsRequest
+	(*UpdateStudentsRequest)(nil),       // 5: main.UpdateStudentsRequest
+	(*DeleteStudentsRequest)(nil),       // 6: main.DeleteStudentsRequest
+	(*AddStudentsResponse)(nil),         // 7: main.AddStudentsResponse
+	(*BulkItemResult)(nil),              // 8: main.BulkItemResult
+	(*StudentCount)(nil),                // 9: main.StudentCount
+	(*WatchStudentsRequest)(nil),        // 10: main.WatchStudentsRequest
+	(*StudentEvent)(nil),                // 11: main.StudentEvent
+	(*RestoreStudentsConfirmation)(nil), // 12: main.RestoreStudentsConfirmation
+	(*DeleteStudentsConfirmation)(nil),  // 13: main.DeleteStudentsConfirmation
+	(*StudentId)(nil),                   // 14: main.StudentId
+	(*StudentIds)(nil),                  // 15: main.StudentIds
+	(*GetStudentsRequest)(nil),          // 16: main.GetStudentsRequest
+	(*TimestampFilter)(nil),             // 17: main.TimestampFilter
+	(*SortField)(nil),                   // 18: main.SortField
+	(*ImportChunk)(nil),                 // 19: main.ImportChunk
+	(*ImportRowError)(nil),              // 20: main.ImportRowError
+	(*ImportSummary)(nil),               // 21: main.ImportSummary
+	(*ExportStudentsRequest)(nil),       // 22: main.ExportStudentsRequest
+	(*ExportChunk)(nil),                 // 23: main.ExportChunk
+	(*Student)(nil),                     // 24: main.Student
+	(*Students)(nil),                    // 25: main.Students
+	(*Guardian)(nil),                    // 26: main.Guardian
+	(*GuardianStudent)(nil),             // 27: main.GuardianStudent
+	nil,                                 // 28: main.ImportChunk.HeaderMappingEntry
+	(*fieldmaskpb.FieldMask)(nil),       // 29: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),       // 30: google.protobuf.Timestamp
 }
 var file_students_proto_depIdxs = []int32{
-	3, // 0: main.StudentIds.ids:type_name -> main.StudentId
-	7, // 1: main.GetStudentsRequest.student:type_name -> main.Student
-	6, // 2: main.GetStudentsRequest.sort_by:type_name -> main.SortField
-	0, // 3: main.SortField.order:type_name -> main.Order
-	7, // 4: main.Students.students:type_name -> main.Student
-	5, // 5: main.StudentsSercies.GetStudents:input_type -> main.GetStudentsRequest
-	8, // 6: main.StudentsSercies.AddStudents:input_type -> main.Students
-	8, // 7: main.StudentsSercies.UpdateStudents:input_type -> main.Students
-	4, // 8: main.StudentsSercies.DeleteStudents:input_type -> main.StudentIds
-	8, // 9: main.StudentsSercies.GetStudents:output_type -> main.Students
-	8, // 10: main.StudentsSercies.AddStudents:output_type -> main.Students
-	8, // 11: main.StudentsSercies.UpdateStudents:output_type -> main.Students
-	2, // 12: main.StudentsSercies.DeleteStudents:output_type -> main.DeleteStudentsConfirmation
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	24, // 0: main.AddStudentsRequest.students:type_name -> main.Student
+	24, // 1: main.UpdateStudentsRequest.students:type_name -> main.Student
+	29, // 2: main.UpdateStudentsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14, // 3: main.DeleteStudentsRequest.ids:type_name -> main.StudentId
+	24, // 4: main.AddStudentsResponse.students:type_name -> main.Student
+	8,  // 5: main.AddStudentsResponse.results:type_name -> main.BulkItemResult
+	24, // 6: main.WatchStudentsRequest.filter:type_name -> main.Student
+	0,  // 7: main.StudentEvent.type:type_name -> main.ChangeType
+	24, // 8: main.StudentEvent.student:type_name -> main.Student
+	30, // 9: main.StudentEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	14, // 10: main.StudentIds.ids:type_name -> main.StudentId
+	24, // 11: main.GetStudentsRequest.student:type_name -> main.Student
+	18, // 12: main.GetStudentsRequest.sort_by:type_name -> main.SortField
+	17, // 13: main.GetStudentsRequest.time_filters:type_name -> main.TimestampFilter
+	30, // 14: main.TimestampFilter.from:type_name -> google.protobuf.Timestamp
+	30, // 15: main.TimestampFilter.to:type_name -> google.protobuf.Timestamp
+	3,  // 16: main.SortField.order:type_name -> main.Order
+	1,  // 17: main.ImportChunk.format:type_name -> main.ImportFormat
+	2,  // 18: main.ImportChunk.mode:type_name -> main.ImportMode
+	28, // 19: main.ImportChunk.header_mapping:type_name -> main.ImportChunk.HeaderMappingEntry
+	20, // 20: main.ImportSummary.errors:type_name -> main.ImportRowError
+	16, // 21: main.ExportStudentsRequest.query:type_name -> main.GetStudentsRequest
+	1,  // 22: main.ExportStudentsRequest.format:type_name -> main.ImportFormat
+	30, // 23: main.Student.deleted_at:type_name -> google.protobuf.Timestamp
+	30, // 24: main.Student.created_at:type_name -> google.protobuf.Timestamp
+	30, // 25: main.Student.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 26: main.Student.guardians:type_name -> main.Guardian
+	24, // 27: main.Students.students:type_name -> main.Student
+	30, // 28: main.Guardian.deleted_at:type_name -> google.protobuf.Timestamp
+	30, // 29: main.Guardian.created_at:type_name -> google.protobuf.Timestamp
+	30, // 30: main.Guardian.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 31: main.Guardian.students:type_name -> main.GuardianStudent
+	16, // 32: main.StudentsSercies.GetStudents:input_type -> main.GetStudentsRequest
+	4,  // 33: main.StudentsSercies.AddStudents:input_type -> main.AddStudentsRequest
+	5,  // 34: main.StudentsSercies.UpdateStudents:input_type -> main.UpdateStudentsRequest
+	6,  // 35: main.StudentsSercies.DeleteStudents:input_type -> main.DeleteStudentsRequest
+	15, // 36: main.StudentsSercies.RestoreStudents:input_type -> main.StudentIds
+	10, // 37: main.StudentsSercies.WatchStudents:input_type -> main.WatchStudentsRequest
+	19, // 38: main.StudentsSercies.ImportStudents:input_type -> main.ImportChunk
+	22, // 39: main.StudentsSercies.ExportStudents:input_type -> main.ExportStudentsRequest
+	25, // 40: main.StudentsSercies.GetStudents:output_type -> main.Students
+	7,  // 41: main.StudentsSercies.AddStudents:output_type -> main.AddStudentsResponse
+	25, // 42: main.StudentsSercies.UpdateStudents:output_type -> main.Students
+	13, // 43: main.StudentsSercies.DeleteStudents:output_type -> main.DeleteStudentsConfirmation
+	12, // 44: main.StudentsSercies.RestoreStudents:output_type -> main.RestoreStudentsConfirmation
+	11, // 45: main.StudentsSercies.WatchStudents:output_type -> main.StudentEvent
+	21, // 46: main.StudentsSercies.ImportStudents:output_type -> main.ImportSummary
+	23, // 47: main.StudentsSercies.ExportStudents:output_type -> main.ExportChunk
+	40, // [40:48] is the sub-list for method output_type
+	32, // [32:40] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_students_proto_init() }
@@ -599,8 +2153,8 @@ func file_students_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_students_proto_rawDesc), len(file_students_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      4,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
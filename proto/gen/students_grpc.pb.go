@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StudentsSercies_GetStudents_FullMethodName    = "/main.StudentsSercies/GetStudents"
-	StudentsSercies_AddStudents_FullMethodName    = "/main.StudentsSercies/AddStudents"
-	StudentsSercies_UpdateStudents_FullMethodName = "/main.StudentsSercies/UpdateStudents"
-	StudentsSercies_DeleteStudents_FullMethodName = "/main.StudentsSercies/DeleteStudents"
+	StudentsSercies_GetStudents_FullMethodName     = "/main.StudentsSercies/GetStudents"
+	StudentsSercies_AddStudents_FullMethodName     = "/main.StudentsSercies/AddStudents"
+	StudentsSercies_UpdateStudents_FullMethodName  = "/main.StudentsSercies/UpdateStudents"
+	StudentsSercies_DeleteStudents_FullMethodName  = "/main.StudentsSercies/DeleteStudents"
+	StudentsSercies_RestoreStudents_FullMethodName = "/main.StudentsSercies/RestoreStudents"
+	StudentsSercies_WatchStudents_FullMethodName   = "/main.StudentsSercies/WatchStudents"
+	StudentsSercies_ImportStudents_FullMethodName  = "/main.StudentsSercies/ImportStudents"
+	StudentsSercies_ExportStudents_FullMethodName  = "/main.StudentsSercies/ExportStudents"
 )
 
 // StudentsSerciesClient is the client API for StudentsSercies service.
@@ -34,11 +38,19 @@ type StudentsSerciesClient interface {
 	// GetStudents retrieves a list of students based on the provided criteria
 	GetStudents(ctx context.Context, in *GetStudentsRequest, opts ...grpc.CallOption) (*Students, error)
 	// AddStudents adds new students to the system
-	AddStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error)
+	AddStudents(ctx context.Context, in *AddStudentsRequest, opts ...grpc.CallOption) (*AddStudentsResponse, error)
 	// UpdateStudents updates existing student information
-	UpdateStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error)
+	UpdateStudents(ctx context.Context, in *UpdateStudentsRequest, opts ...grpc.CallOption) (*Students, error)
 	// DeleteStudents removes students from the system by their IDs
-	DeleteStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*DeleteStudentsConfirmation, error)
+	DeleteStudents(ctx context.Context, in *DeleteStudentsRequest, opts ...grpc.CallOption) (*DeleteStudentsConfirmation, error)
+	// RestoreStudents brings back soft deleted students by their IDs
+	RestoreStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*RestoreStudentsConfirmation, error)
+	// WatchStudents streams every change to the students matching the filter as it happens
+	WatchStudents(ctx context.Context, in *WatchStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StudentEvent], error)
+	// ImportStudents adds or updates students from a CSV or NDJSON file streamed in chunks
+	ImportStudents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportChunk, ImportSummary], error)
+	// ExportStudents streams the students matching the criteria as a CSV or NDJSON file in chunks
+	ExportStudents(ctx context.Context, in *ExportStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 }
 
 type studentsSerciesClient struct {
@@ -59,9 +71,9 @@ func (c *studentsSerciesClient) GetStudents(ctx context.Context, in *GetStudents
 	return out, nil
 }
 
-func (c *studentsSerciesClient) AddStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error) {
+func (c *studentsSerciesClient) AddStudents(ctx context.Context, in *AddStudentsRequest, opts ...grpc.CallOption) (*AddStudentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Students)
+	out := new(AddStudentsResponse)
 	err := c.cc.Invoke(ctx, StudentsSercies_AddStudents_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -69,7 +81,7 @@ func (c *studentsSerciesClient) AddStudents(ctx context.Context, in *Students, o
 	return out, nil
 }
 
-func (c *studentsSerciesClient) UpdateStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error) {
+func (c *studentsSerciesClient) UpdateStudents(ctx context.Context, in *UpdateStudentsRequest, opts ...grpc.CallOption) (*Students, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Students)
 	err := c.cc.Invoke(ctx, StudentsSercies_UpdateStudents_FullMethodName, in, out, cOpts...)
@@ -79,7 +91,7 @@ func (c *studentsSerciesClient) UpdateStudents(ctx context.Context, in *Students
 	return out, nil
 }
 
-func (c *studentsSerciesClient) DeleteStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*DeleteStudentsConfirmation, error) {
+func (c *studentsSerciesClient) DeleteStudents(ctx context.Context, in *DeleteStudentsRequest, opts ...grpc.CallOption) (*DeleteStudentsConfirmation, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteStudentsConfirmation)
 	err := c.cc.Invoke(ctx, StudentsSercies_DeleteStudents_FullMethodName, in, out, cOpts...)
@@ -89,6 +101,67 @@ func (c *studentsSerciesClient) DeleteStudents(ctx context.Context, in *StudentI
 	return out, nil
 }
 
+func (c *studentsSerciesClient) RestoreStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*RestoreStudentsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreStudentsConfirmation)
+	err := c.cc.Invoke(ctx, StudentsSercies_RestoreStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentsSerciesClient) WatchStudents(ctx context.Context, in *WatchStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StudentEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StudentsSercies_ServiceDesc.Streams[0], StudentsSercies_WatchStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchStudentsRequest, StudentEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsSercies_WatchStudentsClient = grpc.ServerStreamingClient[StudentEvent]
+
+func (c *studentsSerciesClient) ImportStudents(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportChunk, ImportSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StudentsSercies_ServiceDesc.Streams[1], StudentsSercies_ImportStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportChunk, ImportSummary]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsSercies_ImportStudentsClient = grpc.ClientStreamingClient[ImportChunk, ImportSummary]
+
+func (c *studentsSerciesClient) ExportStudents(ctx context.Context, in *ExportStudentsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StudentsSercies_ServiceDesc.Streams[2], StudentsSercies_ExportStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportStudentsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsSercies_ExportStudentsClient = grpc.ServerStreamingClient[ExportChunk]
+
 // StudentsSerciesServer is the server API for StudentsSercies service.
 // All implementations must embed UnimplementedStudentsSerciesServer
 // for forward compatibility.
@@ -98,11 +171,19 @@ type StudentsSerciesServer interface {
 	// GetStudents retrieves a list of students based on the provided criteria
 	GetStudents(context.Context, *GetStudentsRequest) (*Students, error)
 	// AddStudents adds new students to the system
-	AddStudents(context.Context, *Students) (*Students, error)
+	AddStudents(context.Context, *AddStudentsRequest) (*AddStudentsResponse, error)
 	// UpdateStudents updates existing student information
-	UpdateStudents(context.Context, *Students) (*Students, error)
+	UpdateStudents(context.Context, *UpdateStudentsRequest) (*Students, error)
 	// DeleteStudents removes students from the system by their IDs
-	DeleteStudents(context.Context, *StudentIds) (*DeleteStudentsConfirmation, error)
+	DeleteStudents(context.Context, *DeleteStudentsRequest) (*DeleteStudentsConfirmation, error)
+	// RestoreStudents brings back soft deleted students by their IDs
+	RestoreStudents(context.Context, *StudentIds) (*RestoreStudentsConfirmation, error)
+	// WatchStudents streams every change to the students matching the filter as it happens
+	WatchStudents(*WatchStudentsRequest, grpc.ServerStreamingServer[StudentEvent]) error
+	// ImportStudents adds or updates students from a CSV or NDJSON file streamed in chunks
+	ImportStudents(grpc.ClientStreamingServer[ImportChunk, ImportSummary]) error
+	// ExportStudents streams the students matching the criteria as a CSV or NDJSON file in chunks
+	ExportStudents(*ExportStudentsRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	mustEmbedUnimplementedStudentsSerciesServer()
 }
 
@@ -116,15 +197,27 @@ type UnimplementedStudentsSerciesServer struct{}
 func (UnimplementedStudentsSerciesServer) GetStudents(context.Context, *GetStudentsRequest) (*Students, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStudents not implemented")
 }
-func (UnimplementedStudentsSerciesServer) AddStudents(context.Context, *Students) (*Students, error) {
+func (UnimplementedStudentsSerciesServer) AddStudents(context.Context, *AddStudentsRequest) (*AddStudentsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddStudents not implemented")
 }
-func (UnimplementedStudentsSerciesServer) UpdateStudents(context.Context, *Students) (*Students, error) {
+func (UnimplementedStudentsSerciesServer) UpdateStudents(context.Context, *UpdateStudentsRequest) (*Students, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateStudents not implemented")
 }
-func (UnimplementedStudentsSerciesServer) DeleteStudents(context.Context, *StudentIds) (*DeleteStudentsConfirmation, error) {
+func (UnimplementedStudentsSerciesServer) DeleteStudents(context.Context, *DeleteStudentsRequest) (*DeleteStudentsConfirmation, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteStudents not implemented")
 }
+func (UnimplementedStudentsSerciesServer) RestoreStudents(context.Context, *StudentIds) (*RestoreStudentsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreStudents not implemented")
+}
+func (UnimplementedStudentsSerciesServer) WatchStudents(*WatchStudentsRequest, grpc.ServerStreamingServer[StudentEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchStudents not implemented")
+}
+func (UnimplementedStudentsSerciesServer) ImportStudents(grpc.ClientStreamingServer[ImportChunk, ImportSummary]) error {
+	return status.Error(codes.Unimplemented, "method ImportStudents not implemented")
+}
+func (UnimplementedStudentsSerciesServer) ExportStudents(*ExportStudentsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportStudents not implemented")
+}
 func (UnimplementedStudentsSerciesServer) mustEmbedUnimplementedStudentsSerciesServer() {}
 func (UnimplementedStudentsSerciesServer) testEmbeddedByValue()                         {}
 
@@ -165,7 +258,7 @@ func _StudentsSercies_GetStudents_Handler(srv interface{}, ctx context.Context,
 }
 
 func _StudentsSercies_AddStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Students)
+	in := new(AddStudentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -177,13 +270,13 @@ func _StudentsSercies_AddStudents_Handler(srv interface{}, ctx context.Context,
 		FullMethod: StudentsSercies_AddStudents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentsSerciesServer).AddStudents(ctx, req.(*Students))
+		return srv.(StudentsSerciesServer).AddStudents(ctx, req.(*AddStudentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _StudentsSercies_UpdateStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Students)
+	in := new(UpdateStudentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -195,13 +288,13 @@ func _StudentsSercies_UpdateStudents_Handler(srv interface{}, ctx context.Contex
 		FullMethod: StudentsSercies_UpdateStudents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentsSerciesServer).UpdateStudents(ctx, req.(*Students))
+		return srv.(StudentsSerciesServer).UpdateStudents(ctx, req.(*UpdateStudentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _StudentsSercies_DeleteStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StudentIds)
+	in := new(DeleteStudentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -213,11 +306,58 @@ func _StudentsSercies_DeleteStudents_Handler(srv interface{}, ctx context.Contex
 		FullMethod: StudentsSercies_DeleteStudents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StudentsSerciesServer).DeleteStudents(ctx, req.(*StudentIds))
+		return srv.(StudentsSerciesServer).DeleteStudents(ctx, req.(*DeleteStudentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentsSercies_RestoreStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentsSerciesServer).RestoreStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentsSercies_RestoreStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentsSerciesServer).RestoreStudents(ctx, req.(*StudentIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentsSercies_WatchStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStudentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StudentsSerciesServer).WatchStudents(m, &grpc.GenericServerStream[WatchStudentsRequest, StudentEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsSercies_WatchStudentsServer = grpc.ServerStreamingServer[StudentEvent]
+
+func _StudentsSercies_ImportStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StudentsSerciesServer).ImportStudents(&grpc.GenericServerStream[ImportChunk, ImportSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsSercies_ImportStudentsServer = grpc.ClientStreamingServer[ImportChunk, ImportSummary]
+
+func _StudentsSercies_ExportStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStudentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StudentsSerciesServer).ExportStudents(m, &grpc.GenericServerStream[ExportStudentsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StudentsSercies_ExportStudentsServer = grpc.ServerStreamingServer[ExportChunk]
+
 // StudentsSercies_ServiceDesc is the grpc.ServiceDesc for StudentsSercies service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,7 +381,27 @@ var StudentsSercies_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStudents",
 			Handler:    _StudentsSercies_DeleteStudents_Handler,
 		},
+		{
+			MethodName: "RestoreStudents",
+			Handler:    _StudentsSercies_RestoreStudents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStudents",
+			Handler:       _StudentsSercies_WatchStudents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportStudents",
+			Handler:       _StudentsSercies_ImportStudents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportStudents",
+			Handler:       _StudentsSercies_ExportStudents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "students.proto",
 }
@@ -0,0 +1,970 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: timetable.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Weekday int32
+
+const (
+	Weekday_MONDAY    Weekday = 0
+	Weekday_TUESDAY   Weekday = 1
+	Weekday_WEDNESDAY Weekday = 2
+	Weekday_THURSDAY  Weekday = 3
+	Weekday_FRIDAY    Weekday = 4
+	Weekday_SATURDAY  Weekday = 5
+	Weekday_SUNDAY    Weekday = 6
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "MONDAY",
+		1: "TUESDAY",
+		2: "WEDNESDAY",
+		3: "THURSDAY",
+		4: "FRIDAY",
+		5: "SATURDAY",
+		6: "SUNDAY",
+	}
+	Weekday_value = map[string]int32{
+		"MONDAY":    0,
+		"TUESDAY":   1,
+		"WEDNESDAY": 2,
+		"THURSDAY":  3,
+		"FRIDAY":    4,
+		"SATURDAY":  5,
+		"SUNDAY":    6,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_timetable_proto_enumTypes[0].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_timetable_proto_enumTypes[0]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{0}
+}
+
+// TimetableSlot has a teacher teach a subject to a class in a room, every week on the same day and period
+// A teacher, class or room is booked only once per day and period
+type TimetableSlot struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Subject   string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	TeacherId string                 `protobuf:"bytes,4,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// room is optional, slots without a room are never double-booked by room
+	Room string  `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	Day  Weekday `protobuf:"varint,6,opt,name=day,proto3,enum=main.Weekday" json:"day,omitempty"`
+	// period of the school day, starting at 1
+	Period int32 `protobuf:"varint,7,opt,name=period,proto3" json:"period,omitempty"`
+	// version is increased on every change, send it back with an update to detect concurrent edits
+	Version int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at and deleted_by are only set on soft deleted slots, which are purged after the retention period
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy string                 `protobuf:"bytes,10,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// created_at, updated_at, created_by and updated_by are maintained by the server
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,14,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableSlot) Reset() {
+	*x = TimetableSlot{}
+	mi := &file_timetable_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimetableSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableSlot) ProtoMessage() {}
+
+func (x *TimetableSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableSlot.ProtoReflect.Descriptor instead.
+func (*TimetableSlot) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimetableSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimetableSlot) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *TimetableSlot) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TimetableSlot) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *TimetableSlot) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *TimetableSlot) GetDay() Weekday {
+	if x != nil {
+		return x.Day
+	}
+	return Weekday_MONDAY
+}
+
+func (x *TimetableSlot) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *TimetableSlot) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TimetableSlot) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TimetableSlot) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *TimetableSlot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TimetableSlot) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TimetableSlot) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *TimetableSlot) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type TimetableSlots struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*TimetableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableSlots) Reset() {
+	*x = TimetableSlots{}
+	mi := &file_timetable_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimetableSlots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableSlots) ProtoMessage() {}
+
+func (x *TimetableSlots) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableSlots.ProtoReflect.Descriptor instead.
+func (*TimetableSlots) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimetableSlots) GetSlots() []*TimetableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type AddTimetableSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slots []*TimetableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	// atomic adds either all slots or none, otherwise every slot is accepted or rejected on its own
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTimetableSlotsRequest) Reset() {
+	*x = AddTimetableSlotsRequest{}
+	mi := &file_timetable_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTimetableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTimetableSlotsRequest) ProtoMessage() {}
+
+func (x *AddTimetableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTimetableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*AddTimetableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddTimetableSlotsRequest) GetSlots() []*TimetableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *AddTimetableSlotsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type AddTimetableSlotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slots []*TimetableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	// results reports the outcome for every slot by its index, double-booking slots are rejected with FAILED_PRECONDITION
+	Results       []*BulkItemResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTimetableSlotsResponse) Reset() {
+	*x = AddTimetableSlotsResponse{}
+	mi := &file_timetable_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTimetableSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTimetableSlotsResponse) ProtoMessage() {}
+
+func (x *AddTimetableSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTimetableSlotsResponse.ProtoReflect.Descriptor instead.
+func (*AddTimetableSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTimetableSlotsResponse) GetSlots() []*TimetableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *AddTimetableSlotsResponse) GetResults() []*BulkItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetTimetableSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only slots matching the non-empty fields of the filter are returned, the day only filters together with a period
+	Slot   *TimetableSlot `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	SortBy []*SortField   `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// include_deleted also returns soft deleted slots
+	IncludeDeleted bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTimetableSlotsRequest) Reset() {
+	*x = GetTimetableSlotsRequest{}
+	mi := &file_timetable_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimetableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimetableSlotsRequest) ProtoMessage() {}
+
+func (x *GetTimetableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimetableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*GetTimetableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTimetableSlotsRequest) GetSlot() *TimetableSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *GetTimetableSlotsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *GetTimetableSlotsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UpdateTimetableSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slots []*TimetableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	// atomic applies all updates in a single transaction, so either all of them are applied or none
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// update_mask limits the update to the listed fields of every slot, masked fields left empty are cleared
+	// Without a mask every non-empty field is updated
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimetableSlotsRequest) Reset() {
+	*x = UpdateTimetableSlotsRequest{}
+	mi := &file_timetable_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimetableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimetableSlotsRequest) ProtoMessage() {}
+
+func (x *UpdateTimetableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimetableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimetableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTimetableSlotsRequest) GetSlots() []*TimetableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *UpdateTimetableSlotsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpdateTimetableSlotsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type TimetableSlotId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected version of the slot, the delete fails with FAILED_PRECONDITION if it was modified since
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableSlotId) Reset() {
+	*x = TimetableSlotId{}
+	mi := &file_timetable_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimetableSlotId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimetableSlotId) ProtoMessage() {}
+
+func (x *TimetableSlotId) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimetableSlotId.ProtoReflect.Descriptor instead.
+func (*TimetableSlotId) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TimetableSlotId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimetableSlotId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteTimetableSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []*TimetableSlotId     `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// atomic deletes all slots in a single transaction and fails if any of them does not exist
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimetableSlotsRequest) Reset() {
+	*x = DeleteTimetableSlotsRequest{}
+	mi := &file_timetable_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimetableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimetableSlotsRequest) ProtoMessage() {}
+
+func (x *DeleteTimetableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimetableSlotsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTimetableSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteTimetableSlotsRequest) GetIds() []*TimetableSlotId {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteTimetableSlotsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type DeleteTimetableSlotsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimetableSlotsConfirmation) Reset() {
+	*x = DeleteTimetableSlotsConfirmation{}
+	mi := &file_timetable_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimetableSlotsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimetableSlotsConfirmation) ProtoMessage() {}
+
+func (x *DeleteTimetableSlotsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimetableSlotsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteTimetableSlotsConfirmation) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteTimetableSlotsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteTimetableSlotsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type FreeResourcesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Day    Weekday                `protobuf:"varint,1,opt,name=day,proto3,enum=main.Weekday" json:"day,omitempty"`
+	Period int32                  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// subject limits the teachers to those teaching it, as their main subject or in a teaching assignment
+	Subject       string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeResourcesRequest) Reset() {
+	*x = FreeResourcesRequest{}
+	mi := &file_timetable_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeResourcesRequest) ProtoMessage() {}
+
+func (x *FreeResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeResourcesRequest.ProtoReflect.Descriptor instead.
+func (*FreeResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FreeResourcesRequest) GetDay() Weekday {
+	if x != nil {
+		return x.Day
+	}
+	return Weekday_MONDAY
+}
+
+func (x *FreeResourcesRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *FreeResourcesRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type FreeResources struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Teachers []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
+	// rooms lists the rooms used anywhere in the timetable that are free in the period
+	Rooms         []string `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreeResources) Reset() {
+	*x = FreeResources{}
+	mi := &file_timetable_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreeResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeResources) ProtoMessage() {}
+
+func (x *FreeResources) ProtoReflect() protoreflect.Message {
+	mi := &file_timetable_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeResources.ProtoReflect.Descriptor instead.
+func (*FreeResources) Descriptor() ([]byte, []int) {
+	return file_timetable_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FreeResources) GetTeachers() []*Teacher {
+	if x != nil {
+		return x.Teachers
+	}
+	return nil
+}
+
+func (x *FreeResources) GetRooms() []string {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+var File_timetable_proto protoreflect.FileDescriptor
+
+const file_timetable_proto_rawDesc = "" +
+	"\n" +
+	"\x0ftimetable.proto\x12\x04main\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\rclasses.proto\x1a\n" +
+	"main.proto\x1a\x0estudents.proto\"\xe8\x03\n" +
+	"\rTimetableSlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x04 \x01(\tR\tteacherId\x12\x12\n" +
+	"\x04room\x18\x05 \x01(\tR\x04room\x12\x1f\n" +
+	"\x03day\x18\x06 \x01(\x0e2\r.main.WeekdayR\x03day\x12\x16\n" +
+	"\x06period\x18\a \x01(\x05R\x06period\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\n" +
+	" \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0e \x01(\tR\tupdatedBy\";\n" +
+	"\x0eTimetableSlots\x12)\n" +
+	"\x05slots\x18\x01 \x03(\v2\x13.main.TimetableSlotR\x05slots\"]\n" +
+	"\x18AddTimetableSlotsRequest\x12)\n" +
+	"\x05slots\x18\x01 \x03(\v2\x13.main.TimetableSlotR\x05slots\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"v\n" +
+	"\x19AddTimetableSlotsResponse\x12)\n" +
+	"\x05slots\x18\x01 \x03(\v2\x13.main.TimetableSlotR\x05slots\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.main.BulkItemResultR\aresults\"\x96\x01\n" +
+	"\x18GetTimetableSlotsRequest\x12'\n" +
+	"\x04slot\x18\x01 \x01(\v2\x13.main.TimetableSlotR\x04slot\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12'\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bR\x0eincludeDeleted\"\x9d\x01\n" +
+	"\x1bUpdateTimetableSlotsRequest\x12)\n" +
+	"\x05slots\x18\x01 \x03(\v2\x13.main.TimetableSlotR\x05slots\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\";\n" +
+	"\x0fTimetableSlotId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"^\n" +
+	"\x1bDeleteTimetableSlotsRequest\x12'\n" +
+	"\x03ids\x18\x01 \x03(\v2\x15.main.TimetableSlotIdR\x03ids\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"[\n" +
+	" DeleteTimetableSlotsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"i\n" +
+	"\x14FreeResourcesRequest\x12\x1f\n" +
+	"\x03day\x18\x01 \x01(\x0e2\r.main.WeekdayR\x03day\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x05R\x06period\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\"P\n" +
+	"\rFreeResources\x12)\n" +
+	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers\x12\x14\n" +
+	"\x05rooms\x18\x02 \x03(\tR\x05rooms*e\n" +
+	"\aWeekday\x12\n" +
+	"\n" +
+	"\x06MONDAY\x10\x00\x12\v\n" +
+	"\aTUESDAY\x10\x01\x12\r\n" +
+	"\tWEDNESDAY\x10\x02\x12\f\n" +
+	"\bTHURSDAY\x10\x03\x12\n" +
+	"\n" +
+	"\x06FRIDAY\x10\x04\x12\f\n" +
+	"\bSATURDAY\x10\x05\x12\n" +
+	"\n" +
+	"\x06SUNDAY\x10\x062\xa4\x04\n" +
+	"\x10TimetableService\x12T\n" +
+	"\x11AddTimetableSlots\x12\x1e.main.AddTimetableSlotsRequest\x1a\x1f.main.AddTimetableSlotsResponse\x12I\n" +
+	"\x11GetTimetableSlots\x12\x1e.main.GetTimetableSlotsRequest\x1a\x14.main.TimetableSlots\x12O\n" +
+	"\x14UpdateTimetableSlots\x12!.main.UpdateTimetableSlotsRequest\x1a\x14.main.TimetableSlots\x12a\n" +
+	"\x14DeleteTimetableSlots\x12!.main.DeleteTimetableSlotsRequest\x1a&.main.DeleteTimetableSlotsConfirmation\x12<\n" +
+	"\x13GetTeacherTimetable\x12\x0f.main.TeacherId\x1a\x14.main.TimetableSlots\x128\n" +
+	"\x11GetClassTimetable\x12\r.main.ClassId\x1a\x14.main.TimetableSlots\x12C\n" +
+	"\x10GetFreeResources\x12\x1a.main.FreeResourcesRequest\x1a\x13.main.FreeResourcesB\x15Z\x13proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_timetable_proto_rawDescOnce sync.Once
+	file_timetable_proto_rawDescData []byte
+)
+
+func file_timetable_proto_rawDescGZIP() []byte {
+	file_timetable_proto_rawDescOnce.Do(func() {
+		file_timetable_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_timetable_proto_rawDesc), len(file_timetable_proto_rawDesc)))
+	})
+	return file_timetable_proto_rawDescData
+}
+
+var file_timetable_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_timetable_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_timetable_proto_goTypes = []any{
+	(Weekday)(0),                             // 0: main.Weekday
+	(*TimetableSlot)(nil),                    // 1: main.TimetableSlot
+	(*TimetableSlots)(nil),                   // 2: main.TimetableSlots
+	(*AddTimetableSlotsRequest)(nil),         // 3: main.AddTimetableSlotsRequest
+	(*AddTimetableSlotsResponse)(nil),        // 4: main.AddTimetableSlotsResponse
+	(*GetTimetableSlotsRequest)(nil),         // 5: main.GetTimetableSlotsRequest
+	(*UpdateTimetableSlotsRequest)(nil),      // 6: main.UpdateTimetableSlotsRequest
+	(*TimetableSlotId)(nil),                  // 7: main.TimetableSlotId
+	(*DeleteTimetableSlotsRequest)(nil),      // 8: main.DeleteTimetableSlotsRequest
+	(*DeleteTimetableSlotsConfirmation)(nil), // 9: main.DeleteTimetableSlotsConfirmation
+	(*FreeResourcesRequest)(nil),             // 10: main.FreeResourcesRequest
+	(*FreeResources)(nil),                    // 11: main.FreeResources
+	(*timestamppb.Timestamp)(nil),            // 12: google.protobuf.Timestamp
+	(*BulkItemResult)(nil),                   // 13: main.BulkItemResult
+	(*SortField)(nil),                        // 14: main.SortField
+	(*fieldmaskpb.FieldMask)(nil),            // 15: google.protobuf.FieldMask
+	(*Teacher)(nil),                          // 16: main.Teacher
+	(*TeacherId)(nil),                        // 17: main.TeacherId
+	(*ClassId)(nil),                          // 18: main.ClassId
+}
+var file_timetable_proto_depIdxs = []int32{
+	0,  // 0: main.TimetableSlot.day:type_name -> main.Weekday
+	12, // 1: main.TimetableSlot.deleted_at:type_name -> google.protobuf.Timestamp
+	12, // 2: main.TimetableSlot.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: main.TimetableSlot.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: main.TimetableSlots.slots:type_name -> main.TimetableSlot
+	1,  // 5: main.AddTimetableSlotsRequest.slots:type_name -> main.TimetableSlot
+	1,  // 6: main.AddTimetableSlotsResponse.slots:type_name -> main.TimetableSlot
+	13, // 7: main.AddTimetableSlotsResponse.results:type_name -> main.BulkItemResult
+	1,  // 8: main.GetTimetableSlotsRequest.slot:type_name -> main.TimetableSlot
+	14, // 9: main.GetTimetableSlotsRequest.sort_by:type_name -> main.SortField
+	1,  // 10: main.UpdateTimetableSlotsRequest.slots:type_name -> main.TimetableSlot
+	15, // 11: main.UpdateTimetableSlotsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 12: main.DeleteTimetableSlotsRequest.ids:type_name -> main.TimetableSlotId
+	0,  // 13: main.FreeResourcesRequest.day:type_name -> main.Weekday
+	16, // 14: main.FreeResources.teachers:type_name -> main.Teacher
+	3,  // 15: main.TimetableService.AddTimetableSlots:input_type -> main.AddTimetableSlotsRequest
+	5,  // 16: main.TimetableService.GetTimetableSlots:input_type -> main.GetTimetableSlotsRequest
+	6,  // 17: main.TimetableService.UpdateTimetableSlots:input_type -> main.UpdateTimetableSlotsRequest
+	8,  // 18: main.TimetableService.DeleteTimetableSlots:input_type -> main.DeleteTimetableSlotsRequest
+	17, // 19: main.TimetableService.GetTeacherTimetable:input_type -> main.TeacherId
+	18, // 20: main.TimetableService.GetClassTimetable:input_type -> main.ClassId
+	10, // 21: main.TimetableService.GetFreeResources:input_type -> main.FreeResourcesRequest
+	4,  // 22: main.TimetableService.AddTimetableSlots:output_type -> main.AddTimetableSlotsResponse
+	2,  // 23: main.TimetableService.GetTimetableSlots:output_type -> main.TimetableSlots
+	2,  // 24: main.TimetableService.UpdateTimetableSlots:output_type -> main.TimetableSlots
+	9,  // 25: main.TimetableService.DeleteTimetableSlots:output_type -> main.DeleteTimetableSlotsConfirmation
+	2,  // 26: main.TimetableService.GetTeacherTimetable:output_type -> main.TimetableSlots
+	2,  // 27: main.TimetableService.GetClassTimetable:output_type -> main.TimetableSlots
+	11, // 28: main.TimetableService.GetFreeResources:output_type -> main.FreeResources
+	22, // [22:29] is the sub-list for method output_type
+	15, // [15:22] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_timetable_proto_init() }
+func file_timetable_proto_init() {
+	if File_timetable_proto != nil {
+		return
+	}
+	file_classes_proto_init()
+	file_main_proto_init()
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_timetable_proto_rawDesc), len(file_timetable_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timetable_proto_goTypes,
+		DependencyIndexes: file_timetable_proto_depIdxs,
+		EnumInfos:         file_timetable_proto_enumTypes,
+		MessageInfos:      file_timetable_proto_msgTypes,
+	}.Build()
+	File_timetable_proto = out.File
+	file_timetable_proto_goTypes = nil
+	file_timetable_proto_depIdxs = nil
+}
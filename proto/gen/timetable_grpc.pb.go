@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: timetable.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TimetableService_AddTimetableSlots_FullMethodName    = "/main.TimetableService/AddTimetableSlots"
+	TimetableService_GetTimetableSlots_FullMethodName    = "/main.TimetableService/GetTimetableSlots"
+	TimetableService_UpdateTimetableSlots_FullMethodName = "/main.TimetableService/UpdateTimetableSlots"
+	TimetableService_DeleteTimetableSlots_FullMethodName = "/main.TimetableService/DeleteTimetableSlots"
+	TimetableService_GetTeacherTimetable_FullMethodName  = "/main.TimetableService/GetTeacherTimetable"
+	TimetableService_GetClassTimetable_FullMethodName    = "/main.TimetableService/GetClassTimetable"
+	TimetableService_GetFreeResources_FullMethodName     = "/main.TimetableService/GetFreeResources"
+)
+
+// TimetableServiceClient is the client API for TimetableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// All the RPC's related to the weekly timetable
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type TimetableServiceClient interface {
+	// AddTimetableSlots adds slots to the timetable, slots double-booking a teacher, class or room are rejected
+	AddTimetableSlots(ctx context.Context, in *AddTimetableSlotsRequest, opts ...grpc.CallOption) (*AddTimetableSlotsResponse, error)
+	// GetTimetableSlots retrieves a list of slots based on the provided criteria
+	GetTimetableSlots(ctx context.Context, in *GetTimetableSlotsRequest, opts ...grpc.CallOption) (*TimetableSlots, error)
+	// UpdateTimetableSlots updates existing slots, an update double-booking a teacher, class or room fails
+	UpdateTimetableSlots(ctx context.Context, in *UpdateTimetableSlotsRequest, opts ...grpc.CallOption) (*TimetableSlots, error)
+	// DeleteTimetableSlots removes slots by their IDs
+	DeleteTimetableSlots(ctx context.Context, in *DeleteTimetableSlotsRequest, opts ...grpc.CallOption) (*DeleteTimetableSlotsConfirmation, error)
+	// GetTeacherTimetable retrieves the week of a teacher ordered by day and period
+	GetTeacherTimetable(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*TimetableSlots, error)
+	// GetClassTimetable retrieves the week of a class ordered by day and period
+	GetClassTimetable(ctx context.Context, in *ClassId, opts ...grpc.CallOption) (*TimetableSlots, error)
+	// GetFreeResources lists the teachers and rooms that are free in a period, for cover planning
+	GetFreeResources(ctx context.Context, in *FreeResourcesRequest, opts ...grpc.CallOption) (*FreeResources, error)
+}
+
+type timetableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimetableServiceClient(cc grpc.ClientConnInterface) TimetableServiceClient {
+	return &timetableServiceClient{cc}
+}
+
+func (c *timetableServiceClient) AddTimetableSlots(ctx context.Context, in *AddTimetableSlotsRequest, opts ...grpc.CallOption) (*AddTimetableSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTimetableSlotsResponse)
+	err := c.cc.Invoke(ctx, TimetableService_AddTimetableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetTimetableSlots(ctx context.Context, in *GetTimetableSlotsRequest, opts ...grpc.CallOption) (*TimetableSlots, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimetableSlots)
+	err := c.cc.Invoke(ctx, TimetableService_GetTimetableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) UpdateTimetableSlots(ctx context.Context, in *UpdateTimetableSlotsRequest, opts ...grpc.CallOption) (*TimetableSlots, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimetableSlots)
+	err := c.cc.Invoke(ctx, TimetableService_UpdateTimetableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) DeleteTimetableSlots(ctx context.Context, in *DeleteTimetableSlotsRequest, opts ...grpc.CallOption) (*DeleteTimetableSlotsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTimetableSlotsConfirmation)
+	err := c.cc.Invoke(ctx, TimetableService_DeleteTimetableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetTeacherTimetable(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*TimetableSlots, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimetableSlots)
+	err := c.cc.Invoke(ctx, TimetableService_GetTeacherTimetable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetClassTimetable(ctx context.Context, in *ClassId, opts ...grpc.CallOption) (*TimetableSlots, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimetableSlots)
+	err := c.cc.Invoke(ctx, TimetableService_GetClassTimetable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetFreeResources(ctx context.Context, in *FreeResourcesRequest, opts ...grpc.CallOption) (*FreeResources, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreeResources)
+	err := c.cc.Invoke(ctx, TimetableService_GetFreeResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimetableServiceServer is the server API for TimetableService service.
+// All implementations must embed UnimplementedTimetableServiceServer
+// for forward compatibility.
+//
+// All the RPC's related to the weekly timetable
+// Only served with the mongodb storage backend, a server running on sqlite does not register it
+type TimetableServiceServer interface {
+	// AddTimetableSlots adds slots to the timetable, slots double-booking a teacher, class or room are rejected
+	AddTimetableSlots(context.Context, *AddTimetableSlotsRequest) (*AddTimetableSlotsResponse, error)
+	// GetTimetableSlots retrieves a list of slots based on the provided criteria
+	GetTimetableSlots(context.Context, *GetTimetableSlotsRequest) (*TimetableSlots, error)
+	// UpdateTimetableSlots updates existing slots, an update double-booking a teacher, class or room fails
+	UpdateTimetableSlots(context.Context, *UpdateTimetableSlotsRequest) (*TimetableSlots, error)
+	// DeleteTimetableSlots removes slots by their IDs
+	DeleteTimetableSlots(context.Context, *DeleteTimetableSlotsRequest) (*DeleteTimetableSlotsConfirmation, error)
+	// GetTeacherTimetable retrieves the week of a teacher ordered by day and period
+	GetTeacherTimetable(context.Context, *TeacherId) (*TimetableSlots, error)
+	// GetClassTimetable retrieves the week of a class ordered by day and period
+	GetClassTimetable(context.Context, *ClassId) (*TimetableSlots, error)
+	// GetFreeResources lists the teachers and rooms that are free in a period, for cover planning
+	GetFreeResources(context.Context, *FreeResourcesRequest) (*FreeResources, error)
+	mustEmbedUnimplementedTimetableServiceServer()
+}
+
+// UnimplementedTimetableServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimetableServiceServer struct{}
+
+func (UnimplementedTimetableServiceServer) AddTimetableSlots(context.Context, *AddTimetableSlotsRequest) (*AddTimetableSlotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTimetableSlots not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetTimetableSlots(context.Context, *GetTimetableSlotsRequest) (*TimetableSlots, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTimetableSlots not implemented")
+}
+func (UnimplementedTimetableServiceServer) UpdateTimetableSlots(context.Context, *UpdateTimetableSlotsRequest) (*TimetableSlots, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTimetableSlots not implemented")
+}
+func (UnimplementedTimetableServiceServer) DeleteTimetableSlots(context.Context, *DeleteTimetableSlotsRequest) (*DeleteTimetableSlotsConfirmation, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTimetableSlots not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetTeacherTimetable(context.Context, *TeacherId) (*TimetableSlots, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeacherTimetable not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetClassTimetable(context.Context, *ClassId) (*TimetableSlots, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClassTimetable not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetFreeResources(context.Context, *FreeResourcesRequest) (*FreeResources, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFreeResources not implemented")
+}
+func (UnimplementedTimetableServiceServer) mustEmbedUnimplementedTimetableServiceServer() {}
+func (UnimplementedTimetableServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTimetableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimetableServiceServer will
+// result in compilation errors.
+type UnsafeTimetableServiceServer interface {
+	mustEmbedUnimplementedTimetableServiceServer()
+}
+
+func RegisterTimetableServiceServer(s grpc.ServiceRegistrar, srv TimetableServiceServer) {
+	// If the following call panics, it indicates UnimplementedTimetableServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimetableService_ServiceDesc, srv)
+}
+
+func _TimetableService_AddTimetableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTimetableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).AddTimetableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_AddTimetableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).AddTimetableSlots(ctx, req.(*AddTimetableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetTimetableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimetableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetTimetableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetTimetableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetTimetableSlots(ctx, req.(*GetTimetableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_UpdateTimetableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTimetableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).UpdateTimetableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_UpdateTimetableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).UpdateTimetableSlots(ctx, req.(*UpdateTimetableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_DeleteTimetableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTimetableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).DeleteTimetableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_DeleteTimetableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).DeleteTimetableSlots(ctx, req.(*DeleteTimetableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetTeacherTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetTeacherTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetTeacherTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetTeacherTimetable(ctx, req.(*TeacherId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetClassTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetClassTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetClassTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetClassTimetable(ctx, req.(*ClassId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetFreeResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreeResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetFreeResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetFreeResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetFreeResources(ctx, req.(*FreeResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimetableService_ServiceDesc is the grpc.ServiceDesc for TimetableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimetableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.TimetableService",
+	HandlerType: (*TimetableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTimetableSlots",
+			Handler:    _TimetableService_AddTimetableSlots_Handler,
+		},
+		{
+			MethodName: "GetTimetableSlots",
+			Handler:    _TimetableService_GetTimetableSlots_Handler,
+		},
+		{
+			MethodName: "UpdateTimetableSlots",
+			Handler:    _TimetableService_UpdateTimetableSlots_Handler,
+		},
+		{
+			MethodName: "DeleteTimetableSlots",
+			Handler:    _TimetableService_DeleteTimetableSlots_Handler,
+		},
+		{
+			MethodName: "GetTeacherTimetable",
+			Handler:    _TimetableService_GetTeacherTimetable_Handler,
+		},
+		{
+			MethodName: "GetClassTimetable",
+			Handler:    _TimetableService_GetClassTimetable_Handler,
+		},
+		{
+			MethodName: "GetFreeResources",
+			Handler:    _TimetableService_GetFreeResources_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timetable.proto",
+}